@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// MaxStatusTextLength is the maximum length of a custom status text.
+	MaxStatusTextLength = 100
+
+	// MaxAutoReplyLength is the maximum length of an out-of-office auto-reply message.
+	MaxAutoReplyLength = 1000
+
+	availabilityCacheKeyPrefix = "availability:"
+)
+
+// CustomStatus is a user-chosen status shown next to the user's name.
+type CustomStatus struct {
+	Emoji   string     `json:"emoji,omitempty"`
+	Text    string     `json:"text,omitempty"`
+	ClearAt *time.Time `json:"clearAt,omitempty"` // nil keeps the status until it is cleared
+}
+
+// DoNotDisturb holds a user's do-not-disturb settings.
+// The schedule repeats every week; EndTime earlier than StartTime wraps past midnight.
+type DoNotDisturb struct {
+	Enabled     bool       `json:"enabled"`
+	StartTime   string     `json:"startTime,omitempty"`   // "HH:MM" in Timezone
+	EndTime     string     `json:"endTime,omitempty"`     // "HH:MM" in Timezone
+	Weekdays    []int      `json:"weekdays,omitempty"`    // 0 = Sunday; empty means every day
+	Timezone    string     `json:"timezone,omitempty"`    // IANA name, defaults to UTC
+	SnoozeUntil *time.Time `json:"snoozeUntil,omitempty"` // manual DND regardless of the schedule
+}
+
+// OutOfOffice is a period during which the user is away.
+type OutOfOffice struct {
+	StartAt time.Time `json:"startAt"`
+	EndAt   time.Time `json:"endAt"`
+	Message string    `json:"message,omitempty"` // auto-reply shown to people who reach the user
+}
+
+// UserAvailability is a user's status, do-not-disturb and out-of-office settings.
+// user-service owns it and mirrors it to Redis so other services can read it without a request.
+type UserAvailability struct {
+	UserID       uuid.UUID     `json:"userId"`
+	CustomStatus *CustomStatus `json:"customStatus,omitempty"`
+	DoNotDisturb *DoNotDisturb `json:"doNotDisturb,omitempty"`
+	OutOfOffice  *OutOfOffice  `json:"outOfOffice,omitempty"`
+}
+
+// ActiveStatus returns the custom status if it is set and has not been cleared by now.
+func (a *UserAvailability) ActiveStatus(now time.Time) *CustomStatus {
+	if a == nil || a.CustomStatus == nil {
+		return nil
+	}
+	if a.CustomStatus.ClearAt != nil && !now.Before(*a.CustomStatus.ClearAt) {
+		return nil
+	}
+	return a.CustomStatus
+}
+
+// ActiveOutOfOffice returns the out-of-office period if now falls inside it.
+func (a *UserAvailability) ActiveOutOfOffice(now time.Time) *OutOfOffice {
+	if a == nil || a.OutOfOffice == nil {
+		return nil
+	}
+	if now.Before(a.OutOfOffice.StartAt) || !now.Before(a.OutOfOffice.EndAt) {
+		return nil
+	}
+	return a.OutOfOffice
+}
+
+// IsDoNotDisturb reports whether the user does not want to be interrupted at now.
+func (a *UserAvailability) IsDoNotDisturb(now time.Time) bool {
+	if a == nil || a.DoNotDisturb == nil {
+		return false
+	}
+	return a.DoNotDisturb.Active(now)
+}
+
+// Active reports whether do-not-disturb applies at now, either snoozed or scheduled.
+func (d *DoNotDisturb) Active(now time.Time) bool {
+	if d.SnoozeUntil != nil && now.Before(*d.SnoozeUntil) {
+		return true
+	}
+	if !d.Enabled {
+		return false
+	}
+
+	start, err := ParseClockTime(d.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := ParseClockTime(d.EndTime)
+	if err != nil || start == end {
+		return false
+	}
+
+	local := now.In(d.location())
+	minute := local.Hour()*60 + local.Minute()
+	day := local.Weekday()
+	if start > end && minute < end {
+		// 자정을 넘기는 일정은 전날 시작한 것으로 본다
+		day = (day + 6) % 7
+	}
+	if !d.coversWeekday(day) {
+		return false
+	}
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// Validate checks that the schedule can be evaluated.
+func (d *DoNotDisturb) Validate() error {
+	if d.Enabled || d.StartTime != "" || d.EndTime != "" {
+		start, err := ParseClockTime(d.StartTime)
+		if err != nil {
+			return fmt.Errorf("invalid startTime: %w", err)
+		}
+		end, err := ParseClockTime(d.EndTime)
+		if err != nil {
+			return fmt.Errorf("invalid endTime: %w", err)
+		}
+		if start == end {
+			return errors.New("startTime and endTime must differ")
+		}
+	}
+	for _, day := range d.Weekdays {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("invalid weekday %d", day)
+		}
+	}
+	if d.Timezone != "" {
+		if _, err := time.LoadLocation(d.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *DoNotDisturb) location() *time.Location {
+	if d.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(d.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (d *DoNotDisturb) coversWeekday(day time.Weekday) bool {
+	if len(d.Weekdays) == 0 {
+		return true
+	}
+	for _, candidate := range d.Weekdays {
+		if candidate == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseClockTime parses "HH:MM" and returns minutes since midnight.
+func ParseClockTime(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// AvailabilityCacheKey returns the Redis key under which user-service mirrors a user's availability.
+func AvailabilityCacheKey(userID uuid.UUID) string {
+	return availabilityCacheKeyPrefix + userID.String()
+}
+
+// StoreAvailability mirrors a user's availability to Redis. A nil client is a no-op.
+func StoreAvailability(ctx context.Context, rdb *redis.Client, availability *UserAvailability) error {
+	if rdb == nil {
+		return nil
+	}
+	data, err := json.Marshal(availability)
+	if err != nil {
+		return err
+	}
+	return rdb.Set(ctx, AvailabilityCacheKey(availability.UserID), data, 0).Err()
+}
+
+// DeleteAvailability removes a user's mirrored availability. A nil client is a no-op.
+func DeleteAvailability(ctx context.Context, rdb *redis.Client, userID uuid.UUID) error {
+	if rdb == nil {
+		return nil
+	}
+	return rdb.Del(ctx, AvailabilityCacheKey(userID)).Err()
+}
+
+// LoadAvailability reads a user's mirrored availability.
+// It returns nil without an error when the user has none or the client is nil.
+func LoadAvailability(ctx context.Context, rdb *redis.Client, userID uuid.UUID) (*UserAvailability, error) {
+	if rdb == nil {
+		return nil, nil
+	}
+	data, err := rdb.Get(ctx, AvailabilityCacheKey(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var availability UserAvailability
+	if err := json.Unmarshal(data, &availability); err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
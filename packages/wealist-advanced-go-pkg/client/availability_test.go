@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDoNotDisturb_Active(t *testing.T) {
+	// 2024-01-01 is a Monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		dnd      DoNotDisturb
+		now      time.Time
+		expected bool
+	}{
+		{"disabled", DoNotDisturb{StartTime: "09:00", EndTime: "17:00"}, at(1, 10, 0), false},
+		{"inside daytime schedule", DoNotDisturb{Enabled: true, StartTime: "09:00", EndTime: "17:00"}, at(1, 10, 0), true},
+		{"end is exclusive", DoNotDisturb{Enabled: true, StartTime: "09:00", EndTime: "17:00"}, at(1, 17, 0), false},
+		{"overnight before midnight", DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00"}, at(1, 23, 30), true},
+		{"overnight after midnight", DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00"}, at(2, 7, 59), true},
+		{"overnight outside", DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00"}, at(2, 12, 0), false},
+		{"weekday not covered", DoNotDisturb{Enabled: true, StartTime: "09:00", EndTime: "17:00", Weekdays: []int{0, 6}}, at(1, 10, 0), false},
+		// 일요일 밤에 시작한 일정은 월요일 새벽까지 이어진다
+		{"overnight counts from previous day", DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00", Weekdays: []int{0}}, at(1, 3, 0), true},
+		{"timezone applied", DoNotDisturb{Enabled: true, StartTime: "09:00", EndTime: "17:00", Timezone: "Asia/Seoul"}, at(1, 1, 0), true},
+		{"snoozed", DoNotDisturb{SnoozeUntil: timePtr(at(1, 12, 0))}, at(1, 11, 0), true},
+		{"snooze expired", DoNotDisturb{SnoozeUntil: timePtr(at(1, 12, 0))}, at(1, 12, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dnd.Active(tt.now); got != tt.expected {
+				t.Errorf("Active() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDoNotDisturb_Validate(t *testing.T) {
+	valid := DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00", Weekdays: []int{1, 5}, Timezone: "UTC"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() returned %v for a valid schedule", err)
+	}
+
+	invalid := []DoNotDisturb{
+		{Enabled: true, StartTime: "25:00", EndTime: "08:00"},
+		{Enabled: true, StartTime: "08:00", EndTime: "08:00"},
+		{Enabled: true, StartTime: "22:00", EndTime: "08:00", Weekdays: []int{7}},
+		{Enabled: true, StartTime: "22:00", EndTime: "08:00", Timezone: "Mars/Olympus"},
+	}
+	for _, dnd := range invalid {
+		if err := dnd.Validate(); err == nil {
+			t.Errorf("Validate() accepted %+v", dnd)
+		}
+	}
+}
+
+func TestUserAvailability_ActivePeriods(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	availability := &UserAvailability{
+		CustomStatus: &CustomStatus{Text: "In a meeting", ClearAt: timePtr(now.Add(time.Hour))},
+		OutOfOffice:  &OutOfOffice{StartAt: now.Add(-time.Hour), EndAt: now.Add(24 * time.Hour), Message: "Back tomorrow"},
+	}
+
+	if availability.ActiveStatus(now) == nil {
+		t.Error("ActiveStatus() returned nil before clearAt")
+	}
+	if availability.ActiveStatus(now.Add(time.Hour)) != nil {
+		t.Error("ActiveStatus() returned a status after clearAt")
+	}
+	if availability.ActiveOutOfOffice(now) == nil {
+		t.Error("ActiveOutOfOffice() returned nil inside the period")
+	}
+	if availability.ActiveOutOfOffice(now.Add(48*time.Hour)) != nil {
+		t.Error("ActiveOutOfOffice() returned a period after it ended")
+	}
+
+	var none *UserAvailability
+	if none.ActiveStatus(now) != nil || none.ActiveOutOfOffice(now) != nil || none.IsDoNotDisturb(now) {
+		t.Error("nil availability should report nothing")
+	}
+}
+
+func TestAvailabilityCache_NilClient(t *testing.T) {
+	userID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	if got := AvailabilityCacheKey(userID); got != "availability:22222222-2222-2222-2222-222222222222" {
+		t.Errorf("AvailabilityCacheKey() = %s", got)
+	}
+
+	ctx := context.Background()
+	if err := StoreAvailability(ctx, nil, &UserAvailability{UserID: userID}); err != nil {
+		t.Errorf("StoreAvailability() with nil client returned %v", err)
+	}
+	if availability, err := LoadAvailability(ctx, nil, userID); availability != nil || err != nil {
+		t.Errorf("LoadAvailability() with nil client = %v, %v", availability, err)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,398 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// BatchProfilesEndpoint is the user-service endpoint that resolves many profiles at once.
+	BatchProfilesEndpoint = "/internal/profiles/batch"
+
+	// MaxBatchProfiles is the maximum number of profiles resolved by a single batch request.
+	MaxBatchProfiles = 100
+
+	// DefaultProfileCacheTTL is how long resolved profiles stay in Redis.
+	DefaultProfileCacheTTL = 10 * time.Minute
+
+	// DefaultProfileBatchWindow is how long concurrent lookups are collected before a batch is sent.
+	DefaultProfileBatchWindow = 5 * time.Millisecond
+
+	// ProfileCacheDB is the Redis database that holds cached profiles. Services use different
+	// databases for their own data, so resolvers and user-service invalidation share this one.
+	ProfileCacheDB = 0
+
+	profileCacheKeyPrefix = "profile:"
+)
+
+// ErrProfileNotFound is returned when user-service has no profile for the requested pair.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfileKey identifies a workspace-specific user profile.
+type ProfileKey struct {
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	UserID      uuid.UUID `json:"userId"`
+}
+
+// BatchProfilesRequest is the request body of the batch profile endpoint.
+type BatchProfilesRequest struct {
+	Profiles []ProfileKey `json:"profiles"`
+}
+
+// BatchProfilesResponse is the response body of the batch profile endpoint.
+// Pairs without a profile are omitted.
+type BatchProfilesResponse struct {
+	Profiles []WorkspaceProfile `json:"profiles"`
+}
+
+// ProfileCacheKey returns the Redis key under which a workspace profile is cached.
+// user-service deletes this key whenever the profile changes.
+func ProfileCacheKey(workspaceID, userID uuid.UUID) string {
+	return profileCacheKeyPrefix + workspaceID.String() + ":" + userID.String()
+}
+
+// NewProfileCacheClient returns a client for ProfileCacheDB that shares the server and credentials of rdb.
+// rdb is returned as is when it already uses that database; a nil client stays nil.
+func NewProfileCacheClient(rdb *redis.Client) *redis.Client {
+	if rdb == nil {
+		return nil
+	}
+	opts := rdb.Options()
+	if opts.DB == ProfileCacheDB {
+		return rdb
+	}
+	cacheOpts := *opts
+	cacheOpts.DB = ProfileCacheDB
+	return redis.NewClient(&cacheOpts)
+}
+
+// InvalidateProfile removes a cached workspace profile. A nil client is a no-op.
+func InvalidateProfile(ctx context.Context, rdb *redis.Client, workspaceID, userID uuid.UUID) error {
+	if rdb == nil {
+		return nil
+	}
+	return rdb.Del(ctx, ProfileCacheKey(workspaceID, userID)).Err()
+}
+
+// ProfileResolverConfig configures a ProfileResolver.
+type ProfileResolverConfig struct {
+	BaseURL      string        // user-service host (e.g., http://user-service:8081)
+	Timeout      time.Duration // HTTP timeout per batch request
+	Logger       *zap.Logger
+	Redis        *redis.Client // nil disables caching
+	CacheTTL     time.Duration // defaults to DefaultProfileCacheTTL
+	BatchWindow  time.Duration // defaults to DefaultProfileBatchWindow
+	MaxBatchSize int           // defaults to MaxBatchProfiles
+}
+
+// profileResult is delivered to a waiting Get call.
+type profileResult struct {
+	profile *WorkspaceProfile
+	err     error
+}
+
+// ProfileResolver resolves workspace profiles through the user-service batch endpoint.
+// Concurrent Get calls are coalesced into a single request and results are cached in Redis.
+type ProfileResolver struct {
+	*BaseHTTPClient
+	redis        *redis.Client
+	cacheTTL     time.Duration
+	batchWindow  time.Duration
+	maxBatchSize int
+
+	mu       sync.Mutex
+	pending  map[ProfileKey][]chan profileResult
+	batchCtx context.Context
+	timer    *time.Timer
+}
+
+// NewProfileResolver creates a new ProfileResolver.
+func NewProfileResolver(cfg ProfileResolverConfig) *ProfileResolver {
+	if cfg.Logger == nil {
+		cfg.Logger = zap.NewNop()
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultProfileCacheTTL
+	}
+	if cfg.BatchWindow <= 0 {
+		cfg.BatchWindow = DefaultProfileBatchWindow
+	}
+	if cfg.MaxBatchSize <= 0 || cfg.MaxBatchSize > MaxBatchProfiles {
+		cfg.MaxBatchSize = MaxBatchProfiles
+	}
+
+	return &ProfileResolver{
+		BaseHTTPClient: NewBaseHTTPClient(cfg.BaseURL, cfg.Timeout, cfg.Logger),
+		redis:          cfg.Redis,
+		cacheTTL:       cfg.CacheTTL,
+		batchWindow:    cfg.BatchWindow,
+		maxBatchSize:   cfg.MaxBatchSize,
+	}
+}
+
+// Get resolves a single profile. Lookups issued concurrently from different
+// goroutines are sent to user-service as one batch request.
+func (r *ProfileResolver) Get(ctx context.Context, workspaceID, userID uuid.UUID) (*WorkspaceProfile, error) {
+	key := ProfileKey{WorkspaceID: workspaceID, UserID: userID}
+
+	cached := r.getCached(ctx, []ProfileKey{key})
+	if profile, ok := cached[key]; ok {
+		return profile, nil
+	}
+
+	ch := r.enqueue(ctx, key)
+	select {
+	case res := <-ch:
+		return res.profile, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Resolve resolves many profiles at once. Pairs without a profile are missing from the result.
+func (r *ProfileResolver) Resolve(ctx context.Context, keys []ProfileKey) (map[ProfileKey]*WorkspaceProfile, error) {
+	unique := dedupeProfileKeys(keys)
+	if len(unique) == 0 {
+		return map[ProfileKey]*WorkspaceProfile{}, nil
+	}
+
+	result := r.getCached(ctx, unique)
+
+	misses := make([]ProfileKey, 0, len(unique)-len(result))
+	for _, key := range unique {
+		if _, ok := result[key]; !ok {
+			misses = append(misses, key)
+		}
+	}
+
+	for start := 0; start < len(misses); start += r.maxBatchSize {
+		end := min(start+r.maxBatchSize, len(misses))
+		fetched, err := r.fetch(ctx, misses[start:end])
+		if err != nil {
+			return result, err
+		}
+		for key, profile := range fetched {
+			result[key] = profile
+		}
+	}
+
+	return result, nil
+}
+
+// enqueue adds a lookup to the pending batch and returns the channel its result is delivered on
+func (r *ProfileResolver) enqueue(ctx context.Context, key ProfileKey) chan profileResult {
+	ch := make(chan profileResult, 1)
+
+	r.mu.Lock()
+	if r.pending == nil {
+		r.pending = make(map[ProfileKey][]chan profileResult)
+		// 첫 요청의 trace context는 유지하되, 호출자 취소가 배치 전체를 취소하지 않도록 분리
+		r.batchCtx = context.WithoutCancel(ctx)
+		r.timer = time.AfterFunc(r.batchWindow, r.flush)
+	}
+	r.pending[key] = append(r.pending[key], ch)
+
+	if len(r.pending) < r.maxBatchSize {
+		r.mu.Unlock()
+		return ch
+	}
+
+	// 배치가 가득 차면 타이머를 기다리지 않고 즉시 전송
+	r.timer.Stop()
+	batchCtx, batch := r.takePendingLocked()
+	r.mu.Unlock()
+
+	go r.run(batchCtx, batch)
+	return ch
+}
+
+// flush sends the pending batch when the batch window elapses
+func (r *ProfileResolver) flush() {
+	r.mu.Lock()
+	batchCtx, batch := r.takePendingLocked()
+	r.mu.Unlock()
+
+	if len(batch) > 0 {
+		r.run(batchCtx, batch)
+	}
+}
+
+// takePendingLocked detaches the pending batch. The caller must hold r.mu.
+func (r *ProfileResolver) takePendingLocked() (context.Context, map[ProfileKey][]chan profileResult) {
+	batchCtx, batch := r.batchCtx, r.pending
+	r.pending = nil
+	r.batchCtx = nil
+	r.timer = nil
+	return batchCtx, batch
+}
+
+// run resolves a detached batch and delivers the results to all waiters
+func (r *ProfileResolver) run(ctx context.Context, batch map[ProfileKey][]chan profileResult) {
+	keys := make([]ProfileKey, 0, len(batch))
+	for key := range batch {
+		keys = append(keys, key)
+	}
+
+	profiles, err := r.fetch(ctx, keys)
+	for key, waiters := range batch {
+		res := profileResult{err: err}
+		if err == nil {
+			if profile, ok := profiles[key]; ok {
+				res.profile = profile
+			} else {
+				res.err = ErrProfileNotFound
+			}
+		}
+		for _, ch := range waiters {
+			ch <- res
+		}
+	}
+}
+
+// fetch calls the batch endpoint and caches the returned profiles
+func (r *ProfileResolver) fetch(ctx context.Context, keys []ProfileKey) (map[ProfileKey]*WorkspaceProfile, error) {
+	url := r.BuildURL(BatchProfilesEndpoint)
+	log := otel.WithTraceContext(ctx, r.Logger)
+
+	body, err := json.Marshal(BatchProfilesRequest{Profiles: keys})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	otel.InjectTraceHeaders(ctx, req)
+	req.Header.Set("Content-Type", "application/json")
+
+	startTime := time.Now()
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		log.Error("Failed to resolve profiles",
+			zap.Error(err),
+			zap.String("http.url", url),
+			zap.Int("profile.count", len(keys)),
+		)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Error("User service returned non-success status for profile batch",
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(respBody)),
+		)
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var batch BatchProfilesResponse
+	if err := json.Unmarshal(respBody, &batch); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	profiles := make(map[ProfileKey]*WorkspaceProfile, len(batch.Profiles))
+	for i := range batch.Profiles {
+		profile := &batch.Profiles[i]
+		profiles[ProfileKey{WorkspaceID: profile.WorkspaceID, UserID: profile.UserID}] = profile
+	}
+
+	log.Debug("Profiles resolved",
+		zap.Int("profile.requested", len(keys)),
+		zap.Int("profile.found", len(profiles)),
+		zap.Duration("http.duration", time.Since(startTime)),
+	)
+
+	r.setCached(ctx, profiles)
+	return profiles, nil
+}
+
+// getCached returns the cached profiles among keys; cache errors are treated as misses
+func (r *ProfileResolver) getCached(ctx context.Context, keys []ProfileKey) map[ProfileKey]*WorkspaceProfile {
+	result := make(map[ProfileKey]*WorkspaceProfile, len(keys))
+	if r.redis == nil || len(keys) == 0 {
+		return result
+	}
+
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = ProfileCacheKey(key.WorkspaceID, key.UserID)
+	}
+
+	values, err := r.redis.MGet(ctx, cacheKeys...).Result()
+	if err != nil {
+		r.Logger.Warn("Failed to read profile cache", zap.Error(err))
+		return result
+	}
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var profile WorkspaceProfile
+		if err := json.Unmarshal([]byte(raw), &profile); err != nil {
+			continue
+		}
+		result[keys[i]] = &profile
+	}
+	return result
+}
+
+// setCached stores resolved profiles; cache errors are logged and ignored
+func (r *ProfileResolver) setCached(ctx context.Context, profiles map[ProfileKey]*WorkspaceProfile) {
+	if r.redis == nil || len(profiles) == 0 {
+		return
+	}
+
+	pipe := r.redis.Pipeline()
+	for key, profile := range profiles {
+		data, err := json.Marshal(profile)
+		if err != nil {
+			continue
+		}
+		pipe.Set(ctx, ProfileCacheKey(key.WorkspaceID, key.UserID), data, r.cacheTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.Logger.Warn("Failed to write profile cache", zap.Error(err))
+	}
+}
+
+// dedupeProfileKeys removes duplicate keys and keys without a user while keeping order.
+// uuid.Nil is a valid workspace ID (the default profile workspace).
+func dedupeProfileKeys(keys []ProfileKey) []ProfileKey {
+	seen := make(map[ProfileKey]struct{}, len(keys))
+	unique := make([]ProfileKey, 0, len(keys))
+	for _, key := range keys {
+		if key.UserID == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, key)
+	}
+	return unique
+}
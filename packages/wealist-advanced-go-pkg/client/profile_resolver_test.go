@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// newBatchServer serves the batch endpoint from a fixed set of profiles and counts requests
+func newBatchServer(t *testing.T, profiles map[ProfileKey]WorkspaceProfile, calls *int32, sizes chan<- int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if r.Method != http.MethodPost || r.URL.Path != "/api"+BatchProfilesEndpoint {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var req BatchProfilesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if sizes != nil {
+			sizes <- len(req.Profiles)
+		}
+
+		resp := BatchProfilesResponse{Profiles: []WorkspaceProfile{}}
+		for _, key := range req.Profiles {
+			if profile, ok := profiles[key]; ok {
+				resp.Profiles = append(resp.Profiles, profile)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestProfileCacheKey(t *testing.T) {
+	workspaceID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	userID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	expected := "profile:11111111-1111-1111-1111-111111111111:22222222-2222-2222-2222-222222222222"
+	if got := ProfileCacheKey(workspaceID, userID); got != expected {
+		t.Errorf("ProfileCacheKey() = %s, want %s", got, expected)
+	}
+
+	// Nil Redis client should be a no-op
+	if err := InvalidateProfile(context.Background(), nil, workspaceID, userID); err != nil {
+		t.Errorf("InvalidateProfile() with nil client returned %v", err)
+	}
+}
+
+func TestNewProfileCacheClient(t *testing.T) {
+	if NewProfileCacheClient(nil) != nil {
+		t.Error("NewProfileCacheClient(nil) should be nil")
+	}
+
+	shared := redis.NewClient(&redis.Options{Addr: "redis:6379", Password: "secret", DB: ProfileCacheDB})
+	defer shared.Close()
+	if got := NewProfileCacheClient(shared); got != shared {
+		t.Error("NewProfileCacheClient() should reuse a client of the profile cache DB")
+	}
+
+	// Board caches its own data in DB 1; profiles must still land where user-service invalidates them
+	own := redis.NewClient(&redis.Options{Addr: "redis:6379", Password: "secret", DB: 1})
+	defer own.Close()
+	got := NewProfileCacheClient(own)
+	defer got.Close()
+	if got == own {
+		t.Fatal("NewProfileCacheClient() should derive a new client for another DB")
+	}
+	opts := got.Options()
+	if opts.DB != ProfileCacheDB || opts.Addr != "redis:6379" || opts.Password != "secret" {
+		t.Errorf("NewProfileCacheClient() options = DB %d addr %s, want DB %d on the same server", opts.DB, opts.Addr, ProfileCacheDB)
+	}
+	if own.Options().DB != 1 {
+		t.Error("NewProfileCacheClient() must not change the original client")
+	}
+}
+
+func TestProfileResolver_Resolve(t *testing.T) {
+	workspaceID := uuid.New()
+	known := ProfileKey{WorkspaceID: workspaceID, UserID: uuid.New()}
+	unknown := ProfileKey{WorkspaceID: workspaceID, UserID: uuid.New()}
+	profiles := map[ProfileKey]WorkspaceProfile{
+		known: {WorkspaceID: workspaceID, UserID: known.UserID, NickName: "Alice"},
+	}
+
+	var calls int32
+	server := newBatchServer(t, profiles, &calls, nil)
+	defer server.Close()
+
+	resolver := NewProfileResolver(ProfileResolverConfig{BaseURL: server.URL, Timeout: time.Second})
+	result, err := resolver.Resolve(context.Background(), []ProfileKey{known, unknown, known})
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+	if profile, ok := result[known]; !ok || profile.NickName != "Alice" {
+		t.Errorf("expected profile for known key, got %+v", profile)
+	}
+	if _, ok := result[unknown]; ok {
+		t.Error("expected unknown key to be missing from result")
+	}
+}
+
+func TestProfileResolver_Resolve_SplitsLargeBatches(t *testing.T) {
+	workspaceID := uuid.New()
+	keys := make([]ProfileKey, 5)
+	for i := range keys {
+		keys[i] = ProfileKey{WorkspaceID: workspaceID, UserID: uuid.New()}
+	}
+
+	var calls int32
+	sizes := make(chan int, 10)
+	server := newBatchServer(t, nil, &calls, sizes)
+	defer server.Close()
+
+	resolver := NewProfileResolver(ProfileResolverConfig{BaseURL: server.URL, Timeout: time.Second, MaxBatchSize: 2})
+	if _, err := resolver.Resolve(context.Background(), keys); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	close(sizes)
+	var got []int
+	for size := range sizes {
+		got = append(got, size)
+	}
+	if len(got) != 3 || got[0] != 2 || got[1] != 2 || got[2] != 1 {
+		t.Errorf("expected batch sizes [2 2 1], got %v", got)
+	}
+}
+
+func TestProfileResolver_Get_CoalescesConcurrentLookups(t *testing.T) {
+	workspaceID := uuid.New()
+	profiles := make(map[ProfileKey]WorkspaceProfile)
+	keys := make([]ProfileKey, 10)
+	for i := range keys {
+		keys[i] = ProfileKey{WorkspaceID: workspaceID, UserID: uuid.New()}
+		profiles[keys[i]] = WorkspaceProfile{WorkspaceID: workspaceID, UserID: keys[i].UserID, NickName: keys[i].UserID.String()}
+	}
+
+	var calls int32
+	server := newBatchServer(t, profiles, &calls, nil)
+	defer server.Close()
+
+	resolver := NewProfileResolver(ProfileResolverConfig{
+		BaseURL:     server.URL,
+		Timeout:     time.Second,
+		BatchWindow: 50 * time.Millisecond,
+	})
+
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key ProfileKey) {
+			defer wg.Done()
+			profile, err := resolver.Get(context.Background(), key.WorkspaceID, key.UserID)
+			if err != nil {
+				t.Errorf("Get() error = %v", err)
+				return
+			}
+			if profile.NickName != key.UserID.String() {
+				t.Errorf("Get() returned profile for wrong user: %s", profile.NickName)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected concurrent lookups to share 1 request, got %d", got)
+	}
+}
+
+func TestProfileResolver_Get_NotFound(t *testing.T) {
+	var calls int32
+	server := newBatchServer(t, nil, &calls, nil)
+	defer server.Close()
+
+	resolver := NewProfileResolver(ProfileResolverConfig{BaseURL: server.URL, Timeout: time.Second})
+	if _, err := resolver.Get(context.Background(), uuid.New(), uuid.New()); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+}
+
+func TestProfileResolver_Get_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	resolver := NewProfileResolver(ProfileResolverConfig{BaseURL: server.URL, Timeout: time.Second})
+	_, err := resolver.Get(context.Background(), uuid.New(), uuid.New())
+	if err == nil || errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected server error, got %v", err)
+	}
+}
@@ -10,14 +10,48 @@ type WorkspaceValidationResponse struct {
 	UserID      uuid.UUID `json:"userId"`
 	Valid       bool      `json:"valid"`
 	IsValid     bool      `json:"isValid"`
-	IsMember    bool      `json:"isMember"` // User Service returns this field
+	IsMember    bool      `json:"isMember"`           // User Service returns this field
+	RoleName    string    `json:"roleName,omitempty"` // OWNER, ADMIN or MEMBER; set for members only
+
+	// Guest scope. Guests are not workspace members and may only access the listed projects.
+	IsGuest                bool        `json:"isGuest"`
+	GuestProjectIDs        []uuid.UUID `json:"guestProjectIds,omitempty"`        // board-service projects
+	GuestStorageProjectIDs []uuid.UUID `json:"guestStorageProjectIds,omitempty"` // storage-service projects
 }
 
 // IsWorkspaceMember returns true if any of the validation fields indicates membership.
+// Guests are never workspace members.
 func (r *WorkspaceValidationResponse) IsWorkspaceMember() bool {
 	return r.Valid || r.IsValid || r.IsMember
 }
 
+// IsWorkspaceAdmin returns true if the user is a member with the OWNER or ADMIN role.
+// Visitors of public workspaces have no role and are never admins.
+func (r *WorkspaceValidationResponse) IsWorkspaceAdmin() bool {
+	return r.IsWorkspaceMember() && (r.RoleName == "OWNER" || r.RoleName == "ADMIN")
+}
+
+// CanAccessProject returns true if the user may access the board-service project,
+// either as a workspace member or as a guest the project was shared with.
+func (r *WorkspaceValidationResponse) CanAccessProject(projectID uuid.UUID) bool {
+	return r.IsWorkspaceMember() || (r.IsGuest && containsID(r.GuestProjectIDs, projectID))
+}
+
+// CanAccessStorageProject returns true if the user may access the storage-service project,
+// either as a workspace member or as a guest the project was shared with.
+func (r *WorkspaceValidationResponse) CanAccessStorageProject(projectID uuid.UUID) bool {
+	return r.IsWorkspaceMember() || (r.IsGuest && containsID(r.GuestStorageProjectIDs, projectID))
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
 // UserProfile represents basic user profile information.
 type UserProfile struct {
 	UserID   uuid.UUID `json:"userId"`
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestWorkspaceValidationResponse_GuestScope(t *testing.T) {
+	shared := uuid.New()
+	other := uuid.New()
+
+	var resp WorkspaceValidationResponse
+	body := `{"isMember":false,"isGuest":true,"guestProjectIds":["` + shared.String() + `"]}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if resp.IsWorkspaceMember() {
+		t.Error("guest must not be reported as a workspace member")
+	}
+	if !resp.CanAccessProject(shared) {
+		t.Error("guest should access the shared project")
+	}
+	if resp.CanAccessProject(other) {
+		t.Error("guest should not access a project that was not shared")
+	}
+	if resp.CanAccessStorageProject(shared) {
+		t.Error("board project grants must not apply to storage projects")
+	}
+
+	member := WorkspaceValidationResponse{IsMember: true}
+	if !member.CanAccessProject(other) || !member.CanAccessStorageProject(other) {
+		t.Error("workspace members should access every project")
+	}
+}
+
+func TestWorkspaceValidationResponse_IsWorkspaceAdmin(t *testing.T) {
+	tests := []struct {
+		name string
+		resp WorkspaceValidationResponse
+		want bool
+	}{
+		{"owner", WorkspaceValidationResponse{IsMember: true, RoleName: "OWNER"}, true},
+		{"admin", WorkspaceValidationResponse{IsMember: true, RoleName: "ADMIN"}, true},
+		{"member", WorkspaceValidationResponse{IsMember: true, RoleName: "MEMBER"}, false},
+		{"public workspace visitor", WorkspaceValidationResponse{IsMember: true}, false},
+		{"role without membership", WorkspaceValidationResponse{RoleName: "ADMIN"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.resp.IsWorkspaceAdmin(); got != tt.want {
+				t.Errorf("IsWorkspaceAdmin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+const (
+	// SnapshotEndpoint is the ops-service endpoint that serves the snapshot of an environment
+	SnapshotEndpoint = "/flags/snapshot"
+
+	// DefaultRefreshInterval is how often a Client re-fetches the snapshot in case a change notification was missed
+	DefaultRefreshInterval = time.Minute
+
+	// DefaultTimeout bounds one snapshot request
+	DefaultTimeout = 5 * time.Second
+
+	snapshotChannelPrefix  = "feature_flags:"
+	snapshotCacheKeyPrefix = "feature_flags:snapshot:"
+)
+
+// SnapshotChannel returns the Redis channel on which ops-service publishes the snapshots of an environment
+func SnapshotChannel(env string) string {
+	return snapshotChannelPrefix + env
+}
+
+// SnapshotCacheKey returns the Redis key holding the latest snapshot of an environment.
+// Clients read it when ops-service is unreachable.
+func SnapshotCacheKey(env string) string {
+	return snapshotCacheKeyPrefix + env
+}
+
+// PublishSnapshot stores the snapshot in Redis and notifies the clients of its environment.
+// A nil client is a no-op.
+func PublishSnapshot(ctx context.Context, rdb *redis.Client, snapshot *Snapshot) error {
+	if rdb == nil {
+		return nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := rdb.Set(ctx, SnapshotCacheKey(snapshot.Environment), data, 0).Err(); err != nil {
+		return err
+	}
+	return rdb.Publish(ctx, SnapshotChannel(snapshot.Environment), data).Err()
+}
+
+// LoadSnapshot reads the latest snapshot of an environment from Redis.
+// It returns nil without error when nothing is stored or the client is nil.
+func LoadSnapshot(ctx context.Context, rdb *redis.Client, env string) (*Snapshot, error) {
+	if rdb == nil {
+		return nil, nil
+	}
+	data, err := rdb.Get(ctx, SnapshotCacheKey(env)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Config configures a Client
+type Config struct {
+	BaseURL         string        // ops-service host (e.g., http://ops-service:8080)
+	Environment     string        // environment whose values are served (e.g., prod)
+	InternalAPIKey  string        // sent as x-internal-api-key; the snapshot endpoint is internal
+	Timeout         time.Duration // defaults to DefaultTimeout
+	RefreshInterval time.Duration // defaults to DefaultRefreshInterval
+	Redis           *redis.Client // nil disables change notifications and the Redis fallback
+	Logger          *zap.Logger
+}
+
+// Client evaluates flags locally against the latest snapshot of its environment.
+// Until a snapshot is loaded, and for unknown flags or values of the wrong type,
+// every getter returns the default passed by the caller. A nil *Client is valid and
+// always returns defaults.
+type Client struct {
+	*client.BaseHTTPClient
+	environment     string
+	internalAPIKey  string
+	refreshInterval time.Duration
+	redis           *redis.Client
+
+	mu        sync.RWMutex
+	snapshot  *Snapshot
+	listeners []func(*Snapshot)
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     sync.WaitGroup
+}
+
+// NewClient creates a new Client. Call Start to load the snapshot and follow changes.
+func NewClient(cfg Config) *Client {
+	if cfg.Logger == nil {
+		cfg.Logger = zap.NewNop()
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	return &Client{
+		BaseHTTPClient:  client.NewBaseHTTPClient(cfg.BaseURL, cfg.Timeout, cfg.Logger),
+		environment:     cfg.Environment,
+		internalAPIKey:  cfg.InternalAPIKey,
+		refreshInterval: cfg.RefreshInterval,
+		redis:           cfg.Redis,
+		stop:            make(chan struct{}),
+	}
+}
+
+// NewStaticClient creates a Client that serves a fixed snapshot and never contacts ops-service.
+// It is meant for tests and local runs.
+func NewStaticClient(snapshot *Snapshot) *Client {
+	c := NewClient(Config{Environment: snapshot.Environment})
+	c.apply(snapshot)
+	return c
+}
+
+// Start loads the snapshot and follows changes until Close is called.
+// A failed initial load is logged, not returned: the client serves defaults and keeps retrying.
+func (c *Client) Start(ctx context.Context) {
+	if err := c.load(ctx); err != nil {
+		c.Logger.Warn("Feature flags unavailable, serving defaults",
+			zap.String("environment", c.environment),
+			zap.Error(err))
+	}
+
+	if c.redis != nil {
+		c.done.Add(1)
+		go c.subscribe()
+	}
+	c.done.Add(1)
+	go c.poll()
+}
+
+// Close stops following changes
+func (c *Client) Close() {
+	c.stopOnce.Do(func() { close(c.stop) })
+	c.done.Wait()
+}
+
+// Refresh fetches the snapshot from ops-service
+func (c *Client) Refresh(ctx context.Context) error {
+	snapshot, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	c.apply(snapshot)
+	return nil
+}
+
+// OnChange registers fn to be called after the flags of the snapshot changed
+func (c *Client) OnChange(fn func(*Snapshot)) {
+	c.mu.Lock()
+	c.listeners = append(c.listeners, fn)
+	c.mu.Unlock()
+}
+
+// Snapshot returns the current snapshot, or nil if none was loaded yet
+func (c *Client) Snapshot() *Snapshot {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.snapshot
+}
+
+// Bool evaluates a bool flag
+func (c *Client) Bool(key string, ectx EvalContext, def bool) bool {
+	var v bool
+	if !c.decode(key, TypeBool, ectx, &v) {
+		return def
+	}
+	return v
+}
+
+// String evaluates a string flag
+func (c *Client) String(key string, ectx EvalContext, def string) string {
+	var v string
+	if !c.decode(key, TypeString, ectx, &v) {
+		return def
+	}
+	return v
+}
+
+// Number evaluates a number flag
+func (c *Client) Number(key string, ectx EvalContext, def float64) float64 {
+	var v float64
+	if !c.decode(key, TypeNumber, ectx, &v) {
+		return def
+	}
+	return v
+}
+
+// JSON evaluates a JSON flag into out. It returns false and leaves out untouched
+// when the default applies, so callers fill out with their default first.
+func (c *Client) JSON(key string, ectx EvalContext, out interface{}) bool {
+	var raw json.RawMessage
+	if !c.decode(key, TypeJSON, ectx, &raw) {
+		return false
+	}
+	return json.Unmarshal(raw, out) == nil
+}
+
+// decode evaluates the flag and decodes its value into out
+func (c *Client) decode(key string, t FlagType, ectx EvalContext, out interface{}) bool {
+	snapshot := c.Snapshot()
+	if snapshot == nil {
+		return false
+	}
+	flag, ok := snapshot.Flags[key]
+	if !ok || flag.Type != t {
+		return false
+	}
+	raw := flag.Evaluate(ectx)
+	if len(raw) == 0 {
+		return false
+	}
+	return json.Unmarshal(raw, out) == nil
+}
+
+// apply replaces the snapshot unless it belongs to another environment or is older than the current one.
+// Listeners are called when the flags changed.
+func (c *Client) apply(snapshot *Snapshot) {
+	if snapshot == nil || snapshot.Environment != c.environment {
+		return
+	}
+
+	c.mu.Lock()
+	current := c.snapshot
+	if current != nil && snapshot.GeneratedAt.Before(current.GeneratedAt) {
+		c.mu.Unlock()
+		return
+	}
+	c.snapshot = snapshot
+	changed := current == nil || !reflect.DeepEqual(current.Flags, snapshot.Flags)
+	listeners := append([]func(*Snapshot){}, c.listeners...)
+	c.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	c.Logger.Info("Feature flags updated",
+		zap.String("environment", c.environment),
+		zap.Int("flag_count", len(snapshot.Flags)))
+	for _, fn := range listeners {
+		fn(snapshot)
+	}
+}
+
+// load fetches the snapshot from ops-service, falling back to the copy in Redis
+func (c *Client) load(ctx context.Context) error {
+	err := c.Refresh(ctx)
+	if err == nil {
+		return nil
+	}
+
+	cached, cacheErr := LoadSnapshot(ctx, c.redis, c.environment)
+	if cacheErr != nil || cached == nil {
+		return err
+	}
+	c.Logger.Warn("ops-service unavailable, using feature flags cached in Redis",
+		zap.String("environment", c.environment),
+		zap.Error(err))
+	c.apply(cached)
+	return nil
+}
+
+// poll re-fetches the snapshot periodically
+func (c *Client) poll() {
+	defer c.done.Done()
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+			if err := c.load(ctx); err != nil {
+				c.Logger.Warn("Failed to refresh feature flags", zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+// subscribe applies the snapshots ops-service publishes on change
+func (c *Client) subscribe() {
+	defer c.done.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pubsub := c.redis.Subscribe(ctx, SnapshotChannel(c.environment))
+	defer func() { _ = pubsub.Close() }()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var snapshot Snapshot
+			if err := json.Unmarshal([]byte(msg.Payload), &snapshot); err != nil {
+				c.Logger.Warn("Ignoring invalid feature flag snapshot", zap.Error(err))
+				continue
+			}
+			c.apply(&snapshot)
+		}
+	}
+}
+
+// fetch calls the snapshot endpoint
+func (c *Client) fetch(ctx context.Context) (*Snapshot, error) {
+	endpoint := c.BuildURL(SnapshotEndpoint) + "?env=" + url.QueryEscape(c.environment)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.internalAPIKey != "" {
+		req.Header.Set("x-internal-api-key", c.internalAPIKey)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	// ops-service wraps payloads as {"success": true, "data": ...}
+	var result struct {
+		Data Snapshot `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &result.Data, nil
+}
@@ -0,0 +1,145 @@
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testSnapshot(env string, at time.Time, enabled bool) Snapshot {
+	return Snapshot{
+		Environment: env,
+		GeneratedAt: at,
+		Flags: map[string]Flag{
+			"board.new-editor": {Key: "board.new-editor", Type: TypeBool, Enabled: enabled,
+				DefaultValue: json.RawMessage(`false`), Value: json.RawMessage(`true`)},
+			"storage.upload-limit-mb": {Key: "storage.upload-limit-mb", Type: TypeNumber, Enabled: true,
+				DefaultValue: json.RawMessage(`100`), Value: json.RawMessage(`500`)},
+			"chat.theme": {Key: "chat.theme", Type: TypeJSON, Enabled: true,
+				DefaultValue: json.RawMessage(`{}`), Value: json.RawMessage(`{"color":"blue"}`)},
+		},
+	}
+}
+
+func TestClient_DefaultsWithoutSnapshot(t *testing.T) {
+	var nilClient *Client
+	if !nilClient.Bool("board.new-editor", EvalContext{}, true) {
+		t.Error("nil client must return the default")
+	}
+
+	c := NewClient(Config{BaseURL: "http://127.0.0.1:1", Environment: "prod"})
+	if got := c.Number("storage.upload-limit-mb", EvalContext{}, 100); got != 100 {
+		t.Errorf("Number() = %v, want default", got)
+	}
+}
+
+func TestNewStaticClient(t *testing.T) {
+	snapshot := testSnapshot("prod", time.Now(), true)
+	c := NewStaticClient(&snapshot)
+	if !c.Bool("board.new-editor", EvalContext{}, false) {
+		t.Error("Bool() should serve the static snapshot")
+	}
+	if got := c.Number("storage.upload-limit-mb", EvalContext{}, 100); got != 500 {
+		t.Errorf("Number() = %v, want 500", got)
+	}
+}
+
+func TestClient_Refresh(t *testing.T) {
+	now := time.Now()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api"+SnapshotEndpoint || r.URL.Query().Get("env") != "prod" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("x-internal-api-key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"data":    testSnapshot("prod", now, true),
+		})
+	}))
+	defer server.Close()
+
+	if err := NewClient(Config{BaseURL: server.URL, Environment: "prod"}).Refresh(context.Background()); err == nil {
+		t.Error("Refresh() without the internal API key should fail")
+	}
+
+	c := NewClient(Config{BaseURL: server.URL, Environment: "prod", InternalAPIKey: "secret"})
+	changes := 0
+	c.OnChange(func(*Snapshot) { changes++ })
+
+	if err := c.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if !c.Bool("board.new-editor", EvalContext{}, false) {
+		t.Error("Bool() = false, want flag value")
+	}
+	if got := c.Number("storage.upload-limit-mb", EvalContext{}, 100); got != 500 {
+		t.Errorf("Number() = %v, want 500", got)
+	}
+	// 타입이 다르면 기본값
+	if got := c.String("board.new-editor", EvalContext{}, "fallback"); got != "fallback" {
+		t.Errorf("String() on bool flag = %q, want default", got)
+	}
+	theme := map[string]string{"color": "gray"}
+	if !c.JSON("chat.theme", EvalContext{}, &theme) || theme["color"] != "blue" {
+		t.Errorf("JSON() = %v, want blue", theme)
+	}
+
+	// 변경이 없으면 알림 없음
+	if err := c.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if changes != 1 {
+		t.Errorf("OnChange called %d times, want 1", changes)
+	}
+}
+
+func TestClient_ApplyIgnoresStaleAndForeignSnapshots(t *testing.T) {
+	now := time.Now()
+	c := NewClient(Config{Environment: "prod"})
+
+	current := testSnapshot("prod", now, true)
+	c.apply(&current)
+
+	stale := testSnapshot("prod", now.Add(-time.Minute), false)
+	c.apply(&stale)
+	if !c.Bool("board.new-editor", EvalContext{}, false) {
+		t.Error("older snapshot must not replace the current one")
+	}
+
+	foreign := testSnapshot("dev", now.Add(time.Minute), false)
+	c.apply(&foreign)
+	if !c.Bool("board.new-editor", EvalContext{}, false) {
+		t.Error("snapshot of another environment must be ignored")
+	}
+
+	newer := testSnapshot("prod", now.Add(time.Minute), false)
+	c.apply(&newer)
+	if c.Bool("board.new-editor", EvalContext{}, true) {
+		t.Error("newer snapshot must disable the flag")
+	}
+}
+
+func TestClient_FailedFetchKeepsSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(Config{BaseURL: server.URL, Environment: "prod"})
+	snapshot := testSnapshot("prod", time.Now(), true)
+	c.apply(&snapshot)
+
+	if err := c.Refresh(context.Background()); err == nil {
+		t.Fatal("Refresh() error = nil, want error")
+	}
+	if !c.Bool("board.new-editor", EvalContext{}, false) {
+		t.Error("last snapshot must be kept when ops-service is down")
+	}
+}
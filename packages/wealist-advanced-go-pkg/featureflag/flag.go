@@ -0,0 +1,191 @@
+// Package featureflag provides the feature flag model shared by ops-service and the services.
+//
+// ops-service owns the flags and serves a per-environment Snapshot. Services evaluate
+// flags locally with a Client that keeps the latest snapshot in memory, receives changes
+// over Redis pub/sub and falls back to the caller's defaults when no snapshot is available.
+package featureflag
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"time"
+)
+
+// FlagType is the type of the values a flag serves
+type FlagType string
+
+const (
+	TypeBool   FlagType = "bool"
+	TypeString FlagType = "string"
+	TypeNumber FlagType = "number"
+	TypeJSON   FlagType = "json"
+)
+
+// MaxPercentage is the upper bound of a percentage rollout
+const MaxPercentage = 100
+
+var (
+	keyPattern         = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+	environmentPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+)
+
+// ValidateKey checks a flag key such as "board.new-editor"
+func ValidateKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return fmt.Errorf("invalid flag key %q: use lowercase letters, digits, '.', '_' and '-'", key)
+	}
+	return nil
+}
+
+// ValidateEnvironment checks an environment name such as "prod"
+func ValidateEnvironment(env string) error {
+	if !environmentPattern.MatchString(env) {
+		return fmt.Errorf("invalid environment %q: use lowercase letters, digits and '-'", env)
+	}
+	return nil
+}
+
+// IsValid reports whether t is a known flag type
+func (t FlagType) IsValid() bool {
+	switch t {
+	case TypeBool, TypeString, TypeNumber, TypeJSON:
+		return true
+	}
+	return false
+}
+
+// ValidateValue checks that raw is a JSON value of type t
+func (t FlagType) ValidateValue(raw json.RawMessage) error {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return fmt.Errorf("value is required")
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return fmt.Errorf("value is not valid JSON: %w", err)
+	}
+
+	ok := false
+	switch t {
+	case TypeBool:
+		_, ok = v.(bool)
+	case TypeString:
+		_, ok = v.(string)
+	case TypeNumber:
+		_, ok = v.(float64)
+	case TypeJSON:
+		ok = v != nil
+	default:
+		return fmt.Errorf("unknown flag type %q", t)
+	}
+	if !ok {
+		return fmt.Errorf("value %s is not a %s", string(raw), t)
+	}
+	return nil
+}
+
+// Rule serves Value to the contexts it matches. All conditions that are set must match:
+// a rule with workspace IDs and a percentage rolls out to that share of users in those workspaces.
+type Rule struct {
+	WorkspaceIDs []string        `json:"workspaceIds,omitempty"`
+	UserIDs      []string        `json:"userIds,omitempty"`
+	Percentage   *int            `json:"percentage,omitempty"` // 0-100, bucketed by user (or workspace without a user)
+	Value        json.RawMessage `json:"value"`
+}
+
+// Validate checks the rule against the flag type
+func (r Rule) Validate(t FlagType) error {
+	if len(r.WorkspaceIDs) == 0 && len(r.UserIDs) == 0 && r.Percentage == nil {
+		return fmt.Errorf("rule needs workspace IDs, user IDs or a percentage")
+	}
+	if r.Percentage != nil && (*r.Percentage < 0 || *r.Percentage > MaxPercentage) {
+		return fmt.Errorf("percentage must be between 0 and %d", MaxPercentage)
+	}
+	return t.ValidateValue(r.Value)
+}
+
+// Flag is a flag as served to one environment.
+// A disabled flag serves DefaultValue; an enabled flag serves the value of the first
+// matching rule, or Value when no rule matches.
+type Flag struct {
+	Key          string          `json:"key"`
+	Type         FlagType        `json:"type"`
+	Enabled      bool            `json:"enabled"`
+	DefaultValue json.RawMessage `json:"defaultValue"`
+	Value        json.RawMessage `json:"value,omitempty"`
+	Rules        []Rule          `json:"rules,omitempty"`
+}
+
+// EvalContext identifies who a flag is evaluated for. Both fields are optional.
+type EvalContext struct {
+	WorkspaceID string
+	UserID      string
+}
+
+// Evaluate returns the raw value the flag serves to ectx
+func (f *Flag) Evaluate(ectx EvalContext) json.RawMessage {
+	if !f.Enabled {
+		return f.DefaultValue
+	}
+	for _, rule := range f.Rules {
+		if rule.matches(f.Key, ectx) {
+			return rule.Value
+		}
+	}
+	if len(f.Value) == 0 {
+		return f.DefaultValue
+	}
+	return f.Value
+}
+
+// matches reports whether every condition of the rule holds for ectx
+func (r Rule) matches(flagKey string, ectx EvalContext) bool {
+	if len(r.WorkspaceIDs) > 0 && !contains(r.WorkspaceIDs, ectx.WorkspaceID) {
+		return false
+	}
+	if len(r.UserIDs) > 0 && !contains(r.UserIDs, ectx.UserID) {
+		return false
+	}
+	if r.Percentage != nil {
+		subject := ectx.UserID
+		if subject == "" {
+			subject = ectx.WorkspaceID
+		}
+		if subject == "" {
+			return false
+		}
+		return Bucket(flagKey, subject) < *r.Percentage
+	}
+	return true
+}
+
+// Bucket maps a subject to a stable bucket in [0, 100) for a flag.
+// The flag key is part of the hash so that rollouts of different flags reach different users.
+func Bucket(flagKey, subject string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flagKey))
+	_, _ = h.Write([]byte{':'})
+	_, _ = h.Write([]byte(subject))
+	return int(h.Sum32() % MaxPercentage)
+}
+
+func contains(values []string, v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot is every flag of one environment at a point in time
+type Snapshot struct {
+	Environment string          `json:"environment"`
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Flags       map[string]Flag `json:"flags"`
+}
@@ -0,0 +1,144 @@
+package featureflag
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestFlagType_ValidateValue(t *testing.T) {
+	tests := []struct {
+		flagType FlagType
+		value    string
+		wantErr  bool
+	}{
+		{TypeBool, `true`, false},
+		{TypeBool, `"true"`, true},
+		{TypeString, `"blue"`, false},
+		{TypeString, `1`, true},
+		{TypeNumber, `2.5`, false},
+		{TypeNumber, `"2.5"`, true},
+		{TypeJSON, `{"limit":10}`, false},
+		{TypeJSON, `null`, true},
+		{TypeJSON, `{broken`, true},
+		{TypeBool, ``, true},
+		{FlagType("date"), `"2024-01-01"`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.flagType, tt.value), func(t *testing.T) {
+			err := tt.flagType.ValidateValue(json.RawMessage(tt.value))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRule_Validate(t *testing.T) {
+	if err := (Rule{Value: json.RawMessage(`true`)}).Validate(TypeBool); err == nil {
+		t.Error("rule without conditions must be rejected")
+	}
+	if err := (Rule{Percentage: intPtr(101), Value: json.RawMessage(`true`)}).Validate(TypeBool); err == nil {
+		t.Error("percentage above 100 must be rejected")
+	}
+	if err := (Rule{UserIDs: []string{"u1"}, Value: json.RawMessage(`"x"`)}).Validate(TypeBool); err == nil {
+		t.Error("value of the wrong type must be rejected")
+	}
+	if err := (Rule{Percentage: intPtr(0), Value: json.RawMessage(`true`)}).Validate(TypeBool); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestFlag_Evaluate(t *testing.T) {
+	flag := Flag{
+		Key:          "board.new-editor",
+		Type:         TypeString,
+		Enabled:      true,
+		DefaultValue: json.RawMessage(`"off"`),
+		Value:        json.RawMessage(`"on"`),
+		Rules: []Rule{
+			{UserIDs: []string{"beta-user"}, Value: json.RawMessage(`"beta"`)},
+			{WorkspaceIDs: []string{"ws-1"}, Percentage: intPtr(0), Value: json.RawMessage(`"never"`)},
+			{WorkspaceIDs: []string{"ws-1"}, Value: json.RawMessage(`"workspace"`)},
+		},
+	}
+
+	tests := []struct {
+		name string
+		ectx EvalContext
+		want string
+	}{
+		{"user rule", EvalContext{WorkspaceID: "ws-1", UserID: "beta-user"}, `"beta"`},
+		{"0% rollout never matches, next rule applies", EvalContext{WorkspaceID: "ws-1", UserID: "u1"}, `"workspace"`},
+		{"no rule matches", EvalContext{WorkspaceID: "ws-2", UserID: "u1"}, `"on"`},
+		{"empty context", EvalContext{}, `"on"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(flag.Evaluate(tt.ectx)); got != tt.want {
+				t.Errorf("Evaluate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	flag.Enabled = false
+	if got := string(flag.Evaluate(EvalContext{UserID: "beta-user"})); got != `"off"` {
+		t.Errorf("disabled flag Evaluate() = %s, want default", got)
+	}
+}
+
+func TestFlag_EvaluatePercentage(t *testing.T) {
+	flag := Flag{
+		Key:          "chat.typing-indicator",
+		Type:         TypeBool,
+		Enabled:      true,
+		DefaultValue: json.RawMessage(`false`),
+		Value:        json.RawMessage(`false`),
+		Rules:        []Rule{{Percentage: intPtr(30), Value: json.RawMessage(`true`)}},
+	}
+
+	enabled := 0
+	const users = 10000
+	for i := 0; i < users; i++ {
+		ectx := EvalContext{UserID: fmt.Sprintf("user-%d", i)}
+		first := string(flag.Evaluate(ectx))
+		// 같은 사용자는 항상 같은 버킷
+		if again := string(flag.Evaluate(ectx)); again != first {
+			t.Fatalf("Evaluate() not stable for %s", ectx.UserID)
+		}
+		if first == "true" {
+			enabled++
+		}
+	}
+	if enabled < users*25/100 || enabled > users*35/100 {
+		t.Errorf("30%% rollout enabled %d of %d users", enabled, users)
+	}
+
+	if got := string(flag.Evaluate(EvalContext{})); got != "false" {
+		t.Errorf("percentage rule without subject must not match, got %s", got)
+	}
+}
+
+func TestValidateKeyAndEnvironment(t *testing.T) {
+	for _, key := range []string{"board.new-editor", "storage_quota_v2"} {
+		if err := ValidateKey(key); err != nil {
+			t.Errorf("ValidateKey(%q) error = %v", key, err)
+		}
+	}
+	for _, key := range []string{"", "Board", "has space", ".leading-dot"} {
+		if err := ValidateKey(key); err == nil {
+			t.Errorf("ValidateKey(%q) must fail", key)
+		}
+	}
+	if err := ValidateEnvironment("prod"); err != nil {
+		t.Errorf("ValidateEnvironment() error = %v", err)
+	}
+	if err := ValidateEnvironment("Prod"); err == nil {
+		t.Error("ValidateEnvironment(\"Prod\") must fail")
+	}
+}
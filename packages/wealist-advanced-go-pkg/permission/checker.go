@@ -0,0 +1,192 @@
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+const (
+	// WorkspacePermissionsEndpoint is the user-service endpoint that returns the
+	// effective permissions of a workspace member.
+	WorkspacePermissionsEndpoint = "/internal/workspaces/%s/users/%s/permissions"
+
+	// DefaultCheckerCacheTTL is how long resolved permissions are reused.
+	DefaultCheckerCacheTTL = 30 * time.Second
+)
+
+// ErrPermissionDenied is returned by Require when the permission is missing.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// WorkspacePermissions is the response body of the workspace permissions endpoint
+type WorkspacePermissions struct {
+	WorkspaceID  uuid.UUID    `json:"workspaceId"`
+	UserID       uuid.UUID    `json:"userId"`
+	Role         string       `json:"role"`
+	CustomRoleID *uuid.UUID   `json:"customRoleId,omitempty"`
+	Permissions  []Permission `json:"permissions"`
+}
+
+// Checker decides whether a user holds a permission in a workspace
+type Checker interface {
+	Can(ctx context.Context, workspaceID, userID uuid.UUID, p Permission) (bool, error)
+}
+
+// Require returns ErrPermissionDenied if the user does not hold p
+func Require(ctx context.Context, checker Checker, workspaceID, userID uuid.UUID, p Permission) error {
+	ok, err := checker.Can(ctx, workspaceID, userID, p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, p)
+	}
+	return nil
+}
+
+// RemoteCheckerConfig configures a RemoteChecker.
+type RemoteCheckerConfig struct {
+	BaseURL  string        // user-service host (e.g., http://user-service:8081)
+	Timeout  time.Duration // HTTP timeout per request
+	Logger   *zap.Logger
+	CacheTTL time.Duration // defaults to DefaultCheckerCacheTTL
+}
+
+type cacheKey struct {
+	workspaceID uuid.UUID
+	userID      uuid.UUID
+}
+
+type cacheEntry struct {
+	perms     Set
+	expiresAt time.Time
+}
+
+// RemoteChecker resolves workspace permissions, including custom roles, through user-service.
+// Results are cached in memory for a short time.
+type RemoteChecker struct {
+	*client.BaseHTTPClient
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[cacheKey]cacheEntry
+}
+
+// NewRemoteChecker creates a new RemoteChecker.
+func NewRemoteChecker(cfg RemoteCheckerConfig) *RemoteChecker {
+	if cfg.Logger == nil {
+		cfg.Logger = zap.NewNop()
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCheckerCacheTTL
+	}
+	return &RemoteChecker{
+		BaseHTTPClient: client.NewBaseHTTPClient(cfg.BaseURL, cfg.Timeout, cfg.Logger),
+		cacheTTL:       cfg.CacheTTL,
+		cache:          make(map[cacheKey]cacheEntry),
+	}
+}
+
+// Can reports whether the user holds p in the workspace. Non-members hold no permissions.
+func (c *RemoteChecker) Can(ctx context.Context, workspaceID, userID uuid.UUID, p Permission) (bool, error) {
+	perms, err := c.Permissions(ctx, workspaceID, userID)
+	if err != nil {
+		return false, err
+	}
+	return perms.Has(p), nil
+}
+
+// Permissions returns the effective permissions of the user in the workspace
+func (c *RemoteChecker) Permissions(ctx context.Context, workspaceID, userID uuid.UUID) (Set, error) {
+	key := cacheKey{workspaceID: workspaceID, userID: userID}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.perms, nil
+	}
+
+	perms, err := c.fetch(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	// 만료된 항목은 새 항목을 넣을 때 함께 정리
+	for k, e := range c.cache {
+		if !now.Before(e.expiresAt) {
+			delete(c.cache, k)
+		}
+	}
+	c.cache[key] = cacheEntry{perms: perms, expiresAt: now.Add(c.cacheTTL)}
+	c.mu.Unlock()
+	return perms, nil
+}
+
+// Invalidate drops the cached permissions of a user
+func (c *RemoteChecker) Invalidate(workspaceID, userID uuid.UUID) {
+	c.mu.Lock()
+	delete(c.cache, cacheKey{workspaceID: workspaceID, userID: userID})
+	c.mu.Unlock()
+}
+
+// fetch calls the workspace permissions endpoint
+func (c *RemoteChecker) fetch(ctx context.Context, workspaceID, userID uuid.UUID) (Set, error) {
+	url := c.BuildURL(fmt.Sprintf(WorkspacePermissionsEndpoint, workspaceID, userID))
+	log := otel.WithTraceContext(ctx, c.Logger)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	otel.InjectTraceHeaders(ctx, req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		log.Error("Failed to resolve workspace permissions",
+			zap.Error(err),
+			zap.String("http.url", url),
+		)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// 멤버가 아니면 권한 없음
+	if resp.StatusCode == http.StatusNotFound {
+		return Set{}, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Error("User service returned non-success status for workspace permissions",
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(body)),
+		)
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result WorkspacePermissions
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return NewSet(result.Permissions...), nil
+}
@@ -0,0 +1,147 @@
+// Package permission provides the permission model shared by all services.
+//
+// A permission is a named capability such as "board.create" or "storage.delete".
+// Roles bundle permissions: the existing OWNER/ADMIN/MEMBER style roles of each
+// service are defined here as built-in roles, and user-service stores custom
+// workspace roles that bundle any of the permissions below.
+package permission
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Permission is a named capability, written as "<resource>.<action>[.<scope>]"
+type Permission string
+
+// Workspace permissions
+const (
+	WorkspaceUpdate    Permission = "workspace.update"     // 워크스페이스 정보/설정 변경
+	WorkspaceDelete    Permission = "workspace.delete"     // 워크스페이스 삭제
+	MemberInvite       Permission = "member.invite"        // 멤버 초대
+	MemberRemove       Permission = "member.remove"        // 멤버 제거
+	MemberRoleUpdate   Permission = "member.role.update"   // 멤버 역할 변경
+	MemberSuspend      Permission = "member.suspend"       // 멤버 정지/재활성화
+	JoinRequestApprove Permission = "join_request.approve" // 참여 요청 조회/승인
+	RoleManage         Permission = "role.manage"          // 커스텀 역할 관리
+)
+
+// Project and board permissions
+const (
+	ProjectCreate             Permission = "project.create"
+	ProjectUpdate             Permission = "project.update"
+	ProjectDelete             Permission = "project.delete"
+	ProjectMemberManage       Permission = "project.member.manage"
+	ProjectMemberRoleUpdate   Permission = "project.member.role.update"
+	ProjectJoinRequestApprove Permission = "project.join_request.approve"
+	BoardCreate               Permission = "board.create"
+	BoardDeleteAny            Permission = "board.delete.any" // 다른 사람이 작성한 보드 삭제
+)
+
+// Storage permissions
+const (
+	StorageRead   Permission = "storage.read"
+	StorageWrite  Permission = "storage.write"
+	StorageDelete Permission = "storage.delete"
+	StorageManage Permission = "storage.manage" // 프로젝트 설정/멤버 관리
+)
+
+// Definition describes a permission for role editors
+type Definition struct {
+	Name        Permission `json:"name"`
+	Description string     `json:"description"`
+}
+
+// definitions lists every known permission in display order
+var definitions = []Definition{
+	{WorkspaceUpdate, "Update workspace information and settings"},
+	{WorkspaceDelete, "Delete the workspace"},
+	{MemberInvite, "Invite members"},
+	{MemberRemove, "Remove members"},
+	{MemberRoleUpdate, "Change member roles"},
+	{MemberSuspend, "Suspend and reactivate members"},
+	{JoinRequestApprove, "View and process join requests"},
+	{RoleManage, "Create, update and delete custom roles"},
+	{ProjectCreate, "Create projects"},
+	{ProjectUpdate, "Update projects"},
+	{ProjectDelete, "Delete projects"},
+	{ProjectMemberManage, "Remove project members"},
+	{ProjectMemberRoleUpdate, "Change project member roles"},
+	{ProjectJoinRequestApprove, "View and process project join requests"},
+	{BoardCreate, "Create boards"},
+	{BoardDeleteAny, "Delete boards created by others"},
+	{StorageRead, "View and download files"},
+	{StorageWrite, "Upload and edit files and folders"},
+	{StorageDelete, "Delete files and folders"},
+	{StorageManage, "Manage storage projects and their members"},
+}
+
+var known = func() map[Permission]bool {
+	m := make(map[Permission]bool, len(definitions))
+	for _, d := range definitions {
+		m[d.Name] = true
+	}
+	return m
+}()
+
+// Definitions returns every known permission in display order
+func Definitions() []Definition {
+	out := make([]Definition, len(definitions))
+	copy(out, definitions)
+	return out
+}
+
+// All returns every known permission
+func All() []Permission {
+	out := make([]Permission, len(definitions))
+	for i, d := range definitions {
+		out[i] = d.Name
+	}
+	return out
+}
+
+// IsValid reports whether p is a known permission
+func (p Permission) IsValid() bool {
+	return known[p]
+}
+
+// Set is an unordered collection of permissions
+type Set map[Permission]struct{}
+
+// NewSet creates a set containing perms
+func NewSet(perms ...Permission) Set {
+	s := make(Set, len(perms))
+	for _, p := range perms {
+		s[p] = struct{}{}
+	}
+	return s
+}
+
+// Has reports whether the set contains p. A nil set has no permissions.
+func (s Set) Has(p Permission) bool {
+	_, ok := s[p]
+	return ok
+}
+
+// List returns the permissions of the set in sorted order
+func (s Set) List() []Permission {
+	out := make([]Permission, 0, len(s))
+	for p := range s {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Parse converts names to a permission set. Unknown names are rejected.
+func Parse(names []string) (Set, error) {
+	s := make(Set, len(names))
+	for _, name := range names {
+		p := Permission(name)
+		if !p.IsValid() {
+			return nil, fmt.Errorf("unknown permission %q", name)
+		}
+		s[p] = struct{}{}
+	}
+	return s, nil
+}
@@ -0,0 +1,152 @@
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParse(t *testing.T) {
+	set, err := Parse([]string{"board.create", "storage.delete", "board.create"})
+	if err != nil {
+		t.Fatalf("Parse() unexpected error = %v", err)
+	}
+	if len(set) != 2 || !set.Has(BoardCreate) || !set.Has(StorageDelete) {
+		t.Errorf("Parse() = %v, want board.create and storage.delete", set.List())
+	}
+
+	if _, err := Parse([]string{"board.create", "board.fly"}); err == nil {
+		t.Error("Parse() with unknown permission returned nil error")
+	}
+}
+
+func TestBuiltinRoles(t *testing.T) {
+	tests := []struct {
+		scope Scope
+		role  string
+		perm  Permission
+		want  bool
+	}{
+		{ScopeWorkspace, RoleOwner, RoleManage, true},
+		{ScopeWorkspace, RoleAdmin, MemberInvite, true},
+		{ScopeWorkspace, RoleMember, MemberInvite, false},
+		{ScopeWorkspace, RoleMember, BoardCreate, true},
+		{ScopeWorkspace, RoleGuest, BoardCreate, false},
+		{ScopeWorkspace, RoleGuest, StorageRead, false},
+		{ScopeProject, RoleOwner, ProjectDelete, true},
+		{ScopeProject, RoleAdmin, ProjectDelete, false},
+		{ScopeProject, RoleAdmin, BoardDeleteAny, true},
+		{ScopeProject, RoleMember, BoardDeleteAny, false},
+		{ScopeStorage, RoleEditor, StorageDelete, true},
+		{ScopeStorage, RoleEditor, StorageManage, false},
+		{ScopeStorage, RoleViewer, StorageRead, true},
+		{ScopeStorage, "UNKNOWN", StorageRead, false},
+	}
+	for _, tt := range tests {
+		if got := RoleHas(tt.scope, tt.role, tt.perm); got != tt.want {
+			t.Errorf("RoleHas(%s, %s, %s) = %v, want %v", tt.scope, tt.role, tt.perm, got, tt.want)
+		}
+	}
+
+	// Every built-in permission must be a known permission
+	for _, scope := range []Scope{ScopeWorkspace, ScopeProject, ScopeStorage} {
+		for _, role := range BuiltinRoles(scope) {
+			for _, p := range role.Permissions {
+				if !p.IsValid() {
+					t.Errorf("built-in role %s/%s has unknown permission %s", scope, role.Name, p)
+				}
+			}
+		}
+	}
+}
+
+func TestRemoteChecker(t *testing.T) {
+	workspaceID := uuid.New()
+	memberID := uuid.New()
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path != "/api"+fmt.Sprintf(WorkspacePermissionsEndpoint, workspaceID, memberID) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(WorkspacePermissions{
+			WorkspaceID: workspaceID,
+			UserID:      memberID,
+			Role:        RoleMember,
+			Permissions: []Permission{BoardCreate, StorageDelete},
+		})
+	}))
+	defer server.Close()
+
+	checker := NewRemoteChecker(RemoteCheckerConfig{BaseURL: server.URL})
+	ctx := context.Background()
+
+	ok, err := checker.Can(ctx, workspaceID, memberID, StorageDelete)
+	if err != nil || !ok {
+		t.Fatalf("Can(storage.delete) = %v, %v; want true, nil", ok, err)
+	}
+	if err := Require(ctx, checker, workspaceID, memberID, MemberInvite); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("Require(member.invite) error = %v, want ErrPermissionDenied", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected cached permissions to be reused, got %d requests", got)
+	}
+
+	checker.Invalidate(workspaceID, memberID)
+	if _, err := checker.Can(ctx, workspaceID, memberID, BoardCreate); err != nil {
+		t.Fatalf("Can() after Invalidate unexpected error = %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected a new request after Invalidate, got %d requests", got)
+	}
+
+	// Non-members hold no permissions
+	ok, err = checker.Can(ctx, workspaceID, uuid.New(), BoardCreate)
+	if err != nil || ok {
+		t.Errorf("Can() for non-member = %v, %v; want false, nil", ok, err)
+	}
+}
+
+// stubChecker answers every check with the same result
+type stubChecker struct {
+	ok  bool
+	err error
+}
+
+func (s stubChecker) Can(ctx context.Context, workspaceID, userID uuid.UUID, p Permission) (bool, error) {
+	return s.ok, s.err
+}
+
+func TestResolver_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver *Resolver
+		fallback bool
+		want     bool
+	}{
+		{"nil resolver uses fallback", nil, true, true},
+		{"no checker uses fallback", NewResolver(nil, nil), false, false},
+		{"checker grants", NewResolver(stubChecker{ok: true}, nil), false, true},
+		{"checker denies", NewResolver(stubChecker{ok: false}, nil), true, false},
+		{"checker error uses fallback", NewResolver(stubChecker{err: errors.New("unavailable")}, nil), true, true},
+		{"checker error denies by fallback", NewResolver(stubChecker{err: errors.New("unavailable")}, nil), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.resolver.Allows(context.Background(), uuid.New(), uuid.New(), StorageWrite, tt.fallback)
+			if got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Resolver asks a Checker for workspace permissions and degrades gracefully
+// when the checker is unavailable, so a user-service outage does not fail
+// every authorization decision of the calling service.
+type Resolver struct {
+	checker Checker
+	logger  *zap.Logger
+}
+
+// NewResolver creates a new Resolver. A nil checker makes every call return its fallback.
+func NewResolver(checker Checker, logger *zap.Logger) *Resolver {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Resolver{checker: checker, logger: logger}
+}
+
+// Allows reports whether the user holds p in the workspace.
+// When the resolver is nil, has no checker or the checker fails, fallback is returned.
+func (r *Resolver) Allows(ctx context.Context, workspaceID, userID uuid.UUID, p Permission, fallback bool) bool {
+	if r == nil || r.checker == nil {
+		return fallback
+	}
+	ok, err := r.checker.Can(ctx, workspaceID, userID, p)
+	if err != nil {
+		r.logger.Warn("Workspace permission check failed, using fallback",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+			zap.String("permission", string(p)),
+			zap.Bool("fallback", fallback),
+			zap.Error(err))
+		return fallback
+	}
+	return ok
+}
@@ -0,0 +1,104 @@
+package permission
+
+// Scope is where a role is assigned
+type Scope string
+
+const (
+	ScopeWorkspace Scope = "WORKSPACE" // user-service 워크스페이스 멤버 역할
+	ScopeProject   Scope = "PROJECT"   // board-service 프로젝트 멤버 역할
+	ScopeStorage   Scope = "STORAGE"   // storage-service 프로젝트 권한
+)
+
+// BuiltinRole is a role every workspace or project has without configuration
+type BuiltinRole struct {
+	Name        string       `json:"name"`
+	Scope       Scope        `json:"scope"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Built-in role names
+const (
+	RoleOwner  = "OWNER"
+	RoleAdmin  = "ADMIN"
+	RoleMember = "MEMBER"
+	RoleEditor = "EDITOR"
+	RoleViewer = "VIEWER"
+	RoleGuest  = "GUEST" // 외부 협업자, 공유받은 프로젝트에만 접근
+)
+
+var builtinRoles = map[Scope][]BuiltinRole{
+	ScopeWorkspace: {
+		{Name: RoleOwner, Scope: ScopeWorkspace, Permissions: All()},
+		{Name: RoleAdmin, Scope: ScopeWorkspace, Permissions: []Permission{
+			WorkspaceUpdate, WorkspaceDelete,
+			MemberInvite, MemberRemove, MemberRoleUpdate, MemberSuspend, JoinRequestApprove,
+			RoleManage,
+			ProjectCreate, BoardCreate,
+			StorageRead, StorageWrite, StorageDelete,
+		}},
+		{Name: RoleMember, Scope: ScopeWorkspace, Permissions: []Permission{
+			ProjectCreate, BoardCreate,
+			StorageRead, StorageWrite, StorageDelete,
+		}},
+		// Guests hold no workspace-wide permission; access comes only from projects shared with them
+		{Name: RoleGuest, Scope: ScopeWorkspace, Permissions: []Permission{}},
+	},
+	ScopeProject: {
+		{Name: RoleOwner, Scope: ScopeProject, Permissions: []Permission{
+			ProjectUpdate, ProjectDelete,
+			ProjectMemberManage, ProjectMemberRoleUpdate, ProjectJoinRequestApprove,
+			BoardCreate, BoardDeleteAny,
+		}},
+		{Name: RoleAdmin, Scope: ScopeProject, Permissions: []Permission{
+			ProjectMemberManage, ProjectJoinRequestApprove,
+			BoardCreate, BoardDeleteAny,
+		}},
+		{Name: RoleMember, Scope: ScopeProject, Permissions: []Permission{
+			BoardCreate,
+		}},
+	},
+	ScopeStorage: {
+		{Name: RoleOwner, Scope: ScopeStorage, Permissions: []Permission{StorageRead, StorageWrite, StorageDelete, StorageManage}},
+		{Name: RoleEditor, Scope: ScopeStorage, Permissions: []Permission{StorageRead, StorageWrite, StorageDelete}},
+		{Name: RoleViewer, Scope: ScopeStorage, Permissions: []Permission{StorageRead}},
+	},
+}
+
+var builtinSets = func() map[Scope]map[string]Set {
+	m := make(map[Scope]map[string]Set, len(builtinRoles))
+	for scope, roles := range builtinRoles {
+		m[scope] = make(map[string]Set, len(roles))
+		for _, role := range roles {
+			m[scope][role.Name] = NewSet(role.Permissions...)
+		}
+	}
+	return m
+}()
+
+// BuiltinRoles returns the built-in roles of a scope, most privileged first
+func BuiltinRoles(scope Scope) []BuiltinRole {
+	roles := builtinRoles[scope]
+	out := make([]BuiltinRole, len(roles))
+	for i, role := range roles {
+		role.Permissions = append([]Permission(nil), role.Permissions...)
+		out[i] = role
+	}
+	return out
+}
+
+// ForRole returns the permissions of a built-in role. Unknown roles have no permissions.
+// The returned set is shared and must not be modified.
+func ForRole(scope Scope, role string) Set {
+	return builtinSets[scope][role]
+}
+
+// RoleHas reports whether a built-in role grants p
+func RoleHas(scope Scope, role string, p Permission) bool {
+	return ForRole(scope, role).Has(p)
+}
+
+// IsBuiltinRole reports whether name is a built-in role of the scope
+func IsBuiltinRole(scope Scope, name string) bool {
+	_, ok := builtinSets[scope][name]
+	return ok
+}
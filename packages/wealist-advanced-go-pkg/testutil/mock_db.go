@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/google/uuid"
@@ -87,28 +88,39 @@ func SetupTestDB(t *testing.T, config *DBConfig) (*gorm.DB, func()) {
 // gen_random_uuid() like PostgreSQL.
 func RegisterUUIDCallback(db *gorm.DB) {
 	_ = db.Callback().Create().Before("gorm:create").Register("uuid_generate", func(tx *gorm.DB) {
-		if tx.Statement.Schema != nil {
-			for _, field := range tx.Statement.Schema.Fields {
-				if field.Name == "ID" && field.FieldType.String() == "uuid.UUID" {
-					fieldValue := tx.Statement.ReflectValue
-					if fieldValue.IsValid() {
-						idField := fieldValue.FieldByName("ID")
-						if idField.IsValid() && idField.CanSet() {
-							// Check if ID is zero value
-							currentID := idField.Interface()
-							if zeroUUID, ok := currentID.(uuid.UUID); ok && zeroUUID == uuid.Nil {
-								idField.Set(fieldValue.FieldByName("ID").Addr().Elem())
-								newUUID := uuid.New()
-								idField.SetBytes(newUUID[:])
-							}
-						}
-					}
-				}
+		if tx.Statement.Schema == nil {
+			return
+		}
+		for _, field := range tx.Statement.Schema.Fields {
+			if field.Name == "ID" && field.FieldType.String() == "uuid.UUID" {
+				setZeroUUIDs(tx.Statement.ReflectValue)
 			}
 		}
 	})
 }
 
+// setZeroUUIDs assigns a new UUID to the zero ID of a model, or of every model
+// in a batch create such as a has-many association.
+func setZeroUUIDs(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() {
+			setZeroUUIDs(value.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			setZeroUUIDs(value.Index(i))
+		}
+	case reflect.Struct:
+		idField := value.FieldByName("ID")
+		if idField.IsValid() && idField.CanSet() {
+			if currentID, ok := idField.Interface().(uuid.UUID); ok && currentID == uuid.Nil {
+				idField.Set(reflect.ValueOf(uuid.New()))
+			}
+		}
+	}
+}
+
 // SetupTestDBWithModels is a convenience function that creates a test database
 // and auto-migrates the provided models.
 func SetupTestDBWithModels(t *testing.T, models ...interface{}) (*gorm.DB, func()) {
@@ -59,6 +59,33 @@ func TestSetupTestDBWithModels(t *testing.T) {
 	}
 }
 
+func TestRegisterUUIDCallback(t *testing.T) {
+	db, cleanup := SetupTestDBWithModels(t, &TestModel{})
+	defer cleanup()
+
+	// Single model without ID
+	model := &TestModel{Name: "single"}
+	if err := db.Create(model).Error; err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	if model.ID == uuid.Nil {
+		t.Error("Expected generated ID for single model")
+	}
+
+	// Batch create keeps preset IDs and fills the missing ones
+	preset := uuid.New()
+	models := []TestModel{{ID: preset, Name: "preset"}, {Name: "generated"}}
+	if err := db.Create(&models).Error; err != nil {
+		t.Fatalf("Failed to create models: %v", err)
+	}
+	if models[0].ID != preset {
+		t.Errorf("Expected preset ID %s, got %s", preset, models[0].ID)
+	}
+	if models[1].ID == uuid.Nil {
+		t.Error("Expected generated ID for batch model")
+	}
+}
+
 func TestSetupTestDBWithLogging(t *testing.T) {
 	db, cleanup := SetupTestDBWithLogging(t, &TestModel{})
 	defer cleanup()
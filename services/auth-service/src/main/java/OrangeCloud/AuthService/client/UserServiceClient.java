@@ -7,6 +7,7 @@ import org.springframework.http.*;
 import org.springframework.stereotype.Component;
 import org.springframework.web.client.RestTemplate;
 
+import java.util.HashMap;
 import java.util.Map;
 import java.util.UUID;
 
@@ -28,26 +29,40 @@ public class UserServiceClient {
      * OAuth 로그인 시 사용자 조회 또는 생성
      * user-service의 /api/internal/oauth/login 엔드포인트 호출
      *
-     * @param email    사용자 이메일
-     * @param name     사용자 이름
-     * @param provider OAuth 제공자 (google 등)
+     * @param email         사용자 이메일
+     * @param name          사용자 이름
+     * @param provider      OAuth 제공자 (google 등)
+     * @param subject       제공자 계정 식별자 (sub)
+     * @param emailVerified 제공자가 이메일을 검증했는지 여부
+     * @param linkToken     로그인된 계정에 연결할 때 user-service가 발급한 토큰 (없으면 null)
+     * @param linkBinding   link token을 발급받은 브라우저의 바인딩 쿠키 값 (없으면 null)
      * @return 사용자 ID (UUID)
      */
-    public UUID findOrCreateOAuthUser(String email, String name, String provider) {
-        log.debug("Calling user-service to find or create OAuth user: email={}, provider={}", email, provider);
+    public UUID findOrCreateOAuthUser(String email, String name, String provider,
+                                      String subject, boolean emailVerified, String linkToken,
+                                      String linkBinding) {
+        log.debug("Calling user-service to find or create OAuth user: email={}, provider={}, linking={}",
+                email, provider, linkToken != null);
 
         String url = userServiceUrl + "/api/internal/oauth/login";
 
         HttpHeaders headers = new HttpHeaders();
         headers.setContentType(MediaType.APPLICATION_JSON);
 
-        Map<String, String> requestBody = Map.of(
-                "email", email,
-                "name", name,
-                "provider", provider
-        );
+        Map<String, Object> requestBody = new HashMap<>();
+        requestBody.put("email", email);
+        requestBody.put("name", name);
+        requestBody.put("provider", provider);
+        requestBody.put("subject", subject);
+        requestBody.put("emailVerified", emailVerified);
+        if (linkToken != null) {
+            requestBody.put("linkToken", linkToken);
+        }
+        if (linkBinding != null) {
+            requestBody.put("linkBinding", linkBinding);
+        }
 
-        HttpEntity<Map<String, String>> request = new HttpEntity<>(requestBody, headers);
+        HttpEntity<Map<String, Object>> request = new HttpEntity<>(requestBody, headers);
 
         try {
             ResponseEntity<Map> response = restTemplate.exchange(
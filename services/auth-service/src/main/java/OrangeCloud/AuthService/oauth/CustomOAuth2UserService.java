@@ -1,6 +1,7 @@
 package OrangeCloud.AuthService.oauth;
 
 import OrangeCloud.AuthService.client.UserServiceClient;
+import jakarta.servlet.http.HttpSession;
 import lombok.RequiredArgsConstructor;
 import lombok.extern.slf4j.Slf4j;
 import org.springframework.security.oauth2.client.userinfo.DefaultOAuth2UserService;
@@ -8,6 +9,8 @@ import org.springframework.security.oauth2.client.userinfo.OAuth2UserRequest;
 import org.springframework.security.oauth2.core.OAuth2AuthenticationException;
 import org.springframework.security.oauth2.core.user.OAuth2User;
 import org.springframework.stereotype.Service;
+import org.springframework.web.context.request.RequestContextHolder;
+import org.springframework.web.context.request.ServletRequestAttributes;
 
 import java.util.UUID;
 
@@ -25,14 +28,34 @@ public class CustomOAuth2UserService extends DefaultOAuth2UserService {
         String email = oAuth2User.getAttribute("email");
         String name = oAuth2User.getAttribute("name");
         String provider = userRequest.getClientRegistration().getRegistrationId();
+        // user-name-attribute (Google: sub) 값이 제공자 계정 식별자
+        String subject = oAuth2User.getName();
+        boolean emailVerified = Boolean.TRUE.equals(oAuth2User.getAttribute("email_verified"));
+        String linkToken = getSessionAttribute(OAuth2RedirectUriFilter.LINK_TOKEN_SESSION_KEY);
+        String linkBinding = getSessionAttribute(OAuth2RedirectUriFilter.LINK_BINDING_SESSION_KEY);
 
-        log.info("OAuth2 로그인 시도: email={}, provider={}", email, provider);
+        log.info("OAuth2 로그인 시도: email={}, provider={}, linking={}", email, provider, linkToken != null);
 
-        // user-service에 유저 조회/생성 요청
-        UUID userId = userServiceClient.findOrCreateOAuthUser(email, name, provider);
+        // user-service에 유저 조회/생성 요청 (link token이 있으면 로그인된 계정에 연결)
+        UUID userId = userServiceClient.findOrCreateOAuthUser(
+                email, name, provider, subject, emailVerified, linkToken, linkBinding);
 
         log.info("OAuth2 로그인 성공: userId={}, email={}", userId, email);
 
         return new CustomOAuth2User(oAuth2User, userId, email, name);
     }
+
+    /**
+     * Get the identity link token or its binding stored by OAuth2RedirectUriFilter, if any.
+     */
+    private String getSessionAttribute(String key) {
+        if (!(RequestContextHolder.getRequestAttributes() instanceof ServletRequestAttributes attributes)) {
+            return null;
+        }
+        HttpSession session = attributes.getRequest().getSession(false);
+        if (session == null) {
+            return null;
+        }
+        return (String) session.getAttribute(key);
+    }
 }
@@ -2,6 +2,7 @@ package OrangeCloud.AuthService.oauth;
 
 import jakarta.servlet.FilterChain;
 import jakarta.servlet.ServletException;
+import jakarta.servlet.http.Cookie;
 import jakarta.servlet.http.HttpServletRequest;
 import jakarta.servlet.http.HttpServletResponse;
 import lombok.extern.slf4j.Slf4j;
@@ -11,6 +12,7 @@ import org.springframework.util.AntPathMatcher;
 import org.springframework.web.filter.OncePerRequestFilter;
 
 import java.io.IOException;
+import java.util.Arrays;
 import java.util.List;
 
 /**
@@ -22,6 +24,10 @@ import java.util.List;
 public class OAuth2RedirectUriFilter extends OncePerRequestFilter {
 
     public static final String REDIRECT_URI_SESSION_KEY = "oauth2_client_redirect_uri";
+    public static final String LINK_TOKEN_SESSION_KEY = "oauth2_identity_link_token";
+    public static final String LINK_BINDING_SESSION_KEY = "oauth2_identity_link_binding";
+    // user-service가 link_token 발급 시 요청한 브라우저에 설정하는 HttpOnly 쿠키
+    private static final String LINK_BINDING_COOKIE = "wealist_link_binding";
 
     @Value("${oauth2.allowed-redirect-patterns:}")
     private List<String> allowedRedirectPatterns;
@@ -52,10 +58,40 @@ public class OAuth2RedirectUriFilter extends OncePerRequestFilter {
             } else {
                 log.info("No redirect_uri parameter provided, will use default");
             }
+
+            // 로그인된 계정에 새 로그인을 연결하는 흐름 (user-service가 발급한 link_token)
+            // 토큰은 이 브라우저의 바인딩 쿠키와 함께일 때만 user-service가 받아들임 (login CSRF 방지)
+            String linkToken = request.getParameter("link_token");
+            if (linkToken != null && !linkToken.isBlank()) {
+                request.getSession().setAttribute(LINK_TOKEN_SESSION_KEY, linkToken);
+                String linkBinding = getCookieValue(request, LINK_BINDING_COOKIE);
+                if (linkBinding != null) {
+                    request.getSession().setAttribute(LINK_BINDING_SESSION_KEY, linkBinding);
+                } else {
+                    request.getSession().removeAttribute(LINK_BINDING_SESSION_KEY);
+                    log.warn("Identity link token without binding cookie, linking will be rejected");
+                }
+                log.info("Stored identity link token in session");
+            } else if (request.getSession(false) != null) {
+                request.getSession(false).removeAttribute(LINK_TOKEN_SESSION_KEY);
+                request.getSession(false).removeAttribute(LINK_BINDING_SESSION_KEY);
+            }
         }
         filterChain.doFilter(request, response);
     }
 
+    private String getCookieValue(HttpServletRequest request, String name) {
+        Cookie[] cookies = request.getCookies();
+        if (cookies == null) {
+            return null;
+        }
+        return Arrays.stream(cookies)
+                .filter(cookie -> name.equals(cookie.getName()) && !cookie.getValue().isBlank())
+                .map(Cookie::getValue)
+                .findFirst()
+                .orElse(null);
+    }
+
     private boolean isAllowedRedirectUri(String redirectUri) {
         // If no patterns configured, allow all (for backward compatibility)
         if (allowedRedirectPatterns == null || allowedRedirectPatterns.isEmpty()) {
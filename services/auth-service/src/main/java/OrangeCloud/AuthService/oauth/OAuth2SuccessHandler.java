@@ -49,10 +49,12 @@ public class OAuth2SuccessHandler extends SimpleUrlAuthenticationSuccessHandler
 
         log.debug("Redirecting to: {}", targetUrl);
 
-        // 세션에서 redirect_uri 제거 (cleanup)
+        // 세션에서 redirect_uri, link_token 제거 (cleanup)
         HttpSession session = request.getSession(false);
         if (session != null) {
             session.removeAttribute(OAuth2RedirectUriFilter.REDIRECT_URI_SESSION_KEY);
+            session.removeAttribute(OAuth2RedirectUriFilter.LINK_TOKEN_SESSION_KEY);
+            session.removeAttribute(OAuth2RedirectUriFilter.LINK_BINDING_SESSION_KEY);
         }
 
         getRedirectStrategy().sendRedirect(request, response, targetUrl);
@@ -14,8 +14,11 @@ import (
 
 	"github.com/robfig/cron/v3"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	commonlogger "github.com/OrangesCloud/wealist-advanced-go-pkg/logger"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/config"
@@ -215,8 +218,16 @@ func main() {
 		cfg.UserAPI.Timeout,
 		log.Logger,
 		m,
+		commonclient.NewProfileCacheClient(database.GetRedis()), // 배치 프로필 조회 캐시 (user-service가 무효화하는 DB)
 	)
 
+	// 커스텀 역할을 포함한 워크스페이스 권한 (user-service 조회, 짧게 캐시)
+	permissions := permission.NewResolver(permission.NewRemoteChecker(permission.RemoteCheckerConfig{
+		BaseURL: cfg.UserAPI.BaseURL,
+		Timeout: cfg.UserAPI.Timeout,
+		Logger:  log.Logger,
+	}), log.Logger)
+
 	log.Info("User API client initialized successfully",
 		zap.String("user_base_url", cfg.UserAPI.BaseURL),
 		zap.String("auth_base_url", cfg.AuthAPI.BaseURL),
@@ -253,6 +264,44 @@ func main() {
 		log.Warn("Noti API client not initialized - NOTI_SERVICE_URL not configured")
 	}
 
+	// Initialize Storage API client (optional - for linking attachments into workspace storage)
+	var storageClient client.StorageClient
+	if cfg.StorageAPI.BaseURL != "" && cfg.StorageAPI.InternalAPIKey != "" {
+		storageClient = client.NewStorageClient(
+			cfg.StorageAPI.BaseURL,
+			cfg.StorageAPI.InternalAPIKey,
+			cfg.StorageAPI.Timeout,
+			log.Logger,
+			m,
+		)
+		log.Info("Storage API client initialized successfully",
+			zap.String("base_url", cfg.StorageAPI.BaseURL),
+			zap.Duration("timeout", cfg.StorageAPI.Timeout),
+		)
+	} else {
+		log.Warn("Storage API client not initialized - STORAGE_SERVICE_URL or INTERNAL_API_KEY not configured")
+	}
+
+	// Initialize feature flag client (optional - flags serve their defaults without ops-service)
+	var flagClient *featureflag.Client
+	if cfg.Flags.BaseURL != "" {
+		flagClient = featureflag.NewClient(featureflag.Config{
+			BaseURL:         cfg.Flags.BaseURL,
+			Environment:     cfg.Flags.Environment,
+			InternalAPIKey:  cfg.Internal.APIKey,
+			RefreshInterval: cfg.Flags.RefreshInterval,
+			Redis:           database.GetRedis(),
+			Logger:          log.Logger,
+		})
+		flagClient.Start(ctx)
+		log.Info("Feature flag client initialized",
+			zap.String("base_url", cfg.Flags.BaseURL),
+			zap.String("environment", cfg.Flags.Environment),
+		)
+	} else {
+		log.Warn("Feature flag client not initialized - OPS_SERVICE_URL not configured")
+	}
+
 	// Initialize attachment repository for cleanup job
 	attachmentRepo := repository.NewAttachmentRepository(db)
 
@@ -292,9 +341,13 @@ func main() {
 		JWTIssuer:       cfg.AuthAPI.JWTIssuer,
 		UserClient:      userClient,
 		NotiClient:      notiClient,
+		StorageClient:   storageClient,
+		FeatureFlags:    flagClient,
+		Permissions:     permissions,
 		BasePath:        cfg.Server.BasePath,
 		Metrics:         m,
 		S3Client:        s3Client,
+		InternalAPIKey:  cfg.Internal.APIKey,
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
 		ServiceName:     "board-service",
@@ -358,6 +411,11 @@ func main() {
 	<-cronCtx.Done()
 	log.Info("Cleanup job scheduler stopped")
 
+	// Stop following feature flag changes
+	if flagClient != nil {
+		flagClient.Close()
+	}
+
 	// Close database connection.
 	log.Info("Closing database connection")
 	if err := database.Close(db); err != nil {
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"project-board-api/internal/metrics"
+)
+
+// storageSourceBoard identifies board-service objects in storage-service
+const storageSourceBoard = "board"
+
+// LinkObjectRequest registers a board attachment object as a storage file
+type LinkObjectRequest struct {
+	WorkspaceID       uuid.UUID `json:"workspaceId"`
+	SourceProjectID   uuid.UUID `json:"sourceProjectId"`      // Board project; files go into its private storage project
+	SourceProjectName string    `json:"sourceProjectName"`    // Name of the storage project when it is created
+	FolderPath        []string  `json:"folderPath,omitempty"` // Folder names from the project root, created when missing
+	Source            string    `json:"source"`
+	FileKey           string    `json:"fileKey"`
+	FileName          string    `json:"fileName"`
+	FileSize          int64     `json:"fileSize"`
+	ContentType       string    `json:"contentType"`
+	UploadedBy        uuid.UUID `json:"uploadedBy"`
+}
+
+// StorageClient defines the interface for storage-service interactions
+type StorageClient interface {
+	LinkObject(ctx context.Context, req *LinkObjectRequest) error
+	UnlinkObject(ctx context.Context, fileKey string) error
+}
+
+// storageClient implements StorageClient interface
+type storageClient struct {
+	*commonclient.BaseHTTPClient
+	internalAPIKey string
+	metrics        *metrics.Metrics
+}
+
+// NewStorageClient creates a new Storage API client
+func NewStorageClient(baseURL string, internalAPIKey string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics) StorageClient {
+	return &storageClient{
+		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
+		internalAPIKey: internalAPIKey,
+		metrics:        m,
+	}
+}
+
+// LinkObject registers an uploaded attachment as a file in the workspace storage.
+// Linking is idempotent on the file key, so retries are safe.
+func (c *storageClient) LinkObject(ctx context.Context, req *LinkObjectRequest) error {
+	if req.Source == "" {
+		req.Source = storageSourceBoard
+	}
+	return c.doRequest(ctx, c.BuildURL("/internal/storage/files/link"), req)
+}
+
+// UnlinkObject removes the storage file registered for an attachment object
+func (c *storageClient) UnlinkObject(ctx context.Context, fileKey string) error {
+	payload := map[string]string{
+		"source":  storageSourceBoard,
+		"fileKey": fileKey,
+	}
+	return c.doRequest(ctx, c.BuildURL("/internal/storage/files/unlink"), payload)
+}
+
+// doRequest performs the HTTP POST request to storage-service
+func (c *storageClient) doRequest(ctx context.Context, url string, payload interface{}) error {
+	startTime := time.Now()
+	log := commnotel.WithTraceContext(ctx, c.Logger)
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	commnotel.InjectTraceHeaders(ctx, req)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-internal-api-key", c.internalAPIKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	duration := time.Since(startTime)
+
+	// Record metrics
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	if c.metrics != nil {
+		c.metrics.RecordExternalAPICall(url, "POST", statusCode, duration, err)
+	}
+
+	if err != nil {
+		log.Error("Failed to call storage service",
+			zap.Error(err),
+			zap.String("http.url", url),
+			zap.Duration("http.duration", duration),
+		)
+		return fmt.Errorf("failed to call storage service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		log.Warn("Storage service returned error status",
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(respBody)),
+			zap.Duration("http.duration", duration),
+		)
+		return fmt.Errorf("storage service returned status %d", resp.StatusCode)
+	}
+
+	log.Debug("Storage service call succeeded",
+		zap.String("http.url", url),
+		zap.Int("http.status_code", resp.StatusCode),
+		zap.Duration("http.duration", duration),
+	)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestStorageClient_LinkObject(t *testing.T) {
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+
+	var gotPath, gotKey string
+	var gotBody LinkObjectRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotKey = r.Header.Get("x-internal-api-key")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := NewStorageClient(server.URL, "secret", 5*time.Second, zap.NewNop(), testClientMetrics)
+	err := c.LinkObject(context.Background(), &LinkObjectRequest{
+		WorkspaceID:       workspaceID,
+		SourceProjectID:   projectID,
+		SourceProjectName: "Roadmap",
+		FolderPath:        []string{"Board attachments"},
+		FileKey:           "board/boards/ws/2025/01/a_1.png",
+		FileName:          "a.png",
+		FileSize:          10,
+		ContentType:       "image/png",
+		UploadedBy:        uuid.New(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/api/internal/storage/files/link", gotPath)
+	assert.Equal(t, "secret", gotKey)
+	assert.Equal(t, "board", gotBody.Source) // 기본 source
+	assert.Equal(t, workspaceID, gotBody.WorkspaceID)
+	assert.Equal(t, projectID, gotBody.SourceProjectID)
+	assert.Equal(t, []string{"Board attachments"}, gotBody.FolderPath)
+}
+
+func TestStorageClient_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := NewStorageClient(server.URL, "wrong", 5*time.Second, zap.NewNop(), testClientMetrics)
+	assert.Error(t, c.UnlinkObject(context.Background(), "board/boards/ws/a.png"))
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
 	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
@@ -21,6 +22,7 @@ import (
 // Type aliases for backward compatibility with existing code
 type (
 	UserProfile                 = commonclient.UserProfile
+	UserAvailability            = commonclient.UserAvailability
 	WorkspaceProfile            = commonclient.WorkspaceProfile
 	Workspace                   = commonclient.Workspace
 	WorkspaceValidationResponse = commonclient.WorkspaceValidationResponse
@@ -30,9 +32,12 @@ type (
 // UserClient defines the interface for ALL User API and Auth interactions
 type UserClient interface {
 	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+	GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error)
 	GetUserProfile(ctx context.Context, userID uuid.UUID, token string) (*commonclient.UserProfile, error)
 	GetWorkspaceProfile(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceProfile, error)
+	GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*commonclient.WorkspaceProfile, error)
 	GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*commonclient.Workspace, error)
+	GetUserAvailability(ctx context.Context, userID uuid.UUID) (*commonclient.UserAvailability, error)
 	ValidateToken(ctx context.Context, tokenStr string) (uuid.UUID, error)
 }
 
@@ -40,16 +45,24 @@ type UserClient interface {
 type userClient struct {
 	*commonclient.BaseHTTPClient
 	authBaseURL string // Auth service URL for token validation
+	profiles    *commonclient.ProfileResolver
 	metrics     *metrics.Metrics
 }
 
 // NewUserClient creates a new User API client
-// authBaseURL is used for ValidateToken, baseURL is used for user-related APIs
-func NewUserClient(baseURL string, authBaseURL string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics) UserClient {
+// authBaseURL is used for ValidateToken, baseURL is used for user-related APIs.
+// profileCache is optional; when set, batch-resolved profiles are cached in Redis.
+func NewUserClient(baseURL string, authBaseURL string, timeout time.Duration, logger *zap.Logger, m *metrics.Metrics, profileCache *redis.Client) UserClient {
 	return &userClient{
 		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
 		authBaseURL:    authBaseURL,
-		metrics:        m,
+		profiles: commonclient.NewProfileResolver(commonclient.ProfileResolverConfig{
+			BaseURL: baseURL,
+			Timeout: timeout,
+			Logger:  logger,
+			Redis:   profileCache,
+		}),
+		metrics: m,
 	}
 }
 
@@ -115,8 +128,18 @@ func (c *userClient) ValidateToken(ctx context.Context, tokenStr string) (uuid.U
 	return userID, nil
 }
 
-// ValidateWorkspaceMember validates if a user is a member of a workspace
+// ValidateWorkspaceMember validates if a user is a member of a workspace.
+// Guests are not members; use GetWorkspaceAccess for project-scoped checks.
 func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	access, err := c.GetWorkspaceAccess(ctx, workspaceID, userID, token)
+	if err != nil {
+		return false, err
+	}
+	return access.IsWorkspaceMember(), nil
+}
+
+// GetWorkspaceAccess validates workspace access and returns the guest scope along with membership
+func (c *userClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error) {
 	url := c.BuildURL(fmt.Sprintf("/workspaces/%s/validate-member/%s", workspaceID.String(), userID.String()))
 
 	c.Logger.Debug("Validating workspace member",
@@ -132,18 +155,17 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", userID.String()),
 		)
-		return false, err
+		return nil, err
 	}
 
-	isValid := response.IsWorkspaceMember()
-
 	c.Logger.Debug("Workspace member validation result",
-		zap.Bool("is_valid", isValid),
+		zap.Bool("is_valid", response.IsWorkspaceMember()),
+		zap.Bool("is_guest", response.IsGuest),
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("user_id", userID.String()),
 	)
 
-	return isValid, nil
+	return &response, nil
 }
 
 // GetUserProfile retrieves user profile information
@@ -208,6 +230,31 @@ func (c *userClient) GetWorkspaceProfile(ctx context.Context, workspaceID, userI
 	return &profile, nil
 }
 
+// GetWorkspaceProfiles resolves the workspace profiles of many users with one batch request.
+// Users without a profile are missing from the result.
+func (c *userClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*commonclient.WorkspaceProfile, error) {
+	keys := make([]commonclient.ProfileKey, len(userIDs))
+	for i, userID := range userIDs {
+		keys[i] = commonclient.ProfileKey{WorkspaceID: workspaceID, UserID: userID}
+	}
+
+	resolved, err := c.profiles.Resolve(ctx, keys)
+	if err != nil {
+		c.log(ctx).Error("Failed to resolve workspace profiles",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+			zap.Int("user_count", len(userIDs)),
+		)
+		// Graceful degradation: return the profiles resolved so far (cache hits)
+	}
+
+	profiles := make(map[uuid.UUID]*commonclient.WorkspaceProfile, len(resolved))
+	for key, profile := range resolved {
+		profiles[key.UserID] = profile
+	}
+	return profiles, nil
+}
+
 // GetWorkspace retrieves workspace information
 func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*commonclient.Workspace, error) {
 	url := c.BuildURL(fmt.Sprintf("/workspaces/%s", workspaceID.String()))
@@ -235,6 +282,23 @@ func (c *userClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, to
 	return &workspace, nil
 }
 
+// GetUserAvailability retrieves what currently applies of a user's status, do-not-disturb and out-of-office
+// through the internal user-service endpoint
+func (c *userClient) GetUserAvailability(ctx context.Context, userID uuid.UUID) (*commonclient.UserAvailability, error) {
+	url := c.BuildURL(fmt.Sprintf("/internal/users/%s/status", userID.String()))
+
+	var availability commonclient.UserAvailability
+	if err := c.doRequestWithMetrics(ctx, "GET", url, "", &availability); err != nil {
+		c.log(ctx).Warn("Failed to get user availability",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, err
+	}
+
+	return &availability, nil
+}
+
 // log returns a trace-context aware logger
 func (c *userClient) log(ctx context.Context) *zap.Logger {
 	return commnotel.WithTraceContext(ctx, c.Logger)
@@ -30,7 +30,7 @@ func TestUserClient_Timeout(t *testing.T) {
 	defer server.Close()
 
 	logger := zap.NewNop()
-	client := NewUserClient(server.URL, server.URL, 100*time.Millisecond, logger, nil)
+	client := NewUserClient(server.URL, server.URL, 100*time.Millisecond, logger, nil, nil)
 
 	// When
 	ctx := context.Background()
@@ -67,7 +67,7 @@ func TestUserClient_ContextCancellation(t *testing.T) {
 	defer server.Close()
 
 	logger := zap.NewNop()
-	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 	// When: Cancel context before request completes
 	ctx, cancel := context.WithCancel(context.Background())
@@ -97,7 +97,7 @@ func TestUserClient_InvalidJSON(t *testing.T) {
 	defer server.Close()
 
 	logger := zap.NewNop()
-	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 	// When
 	profile, err := client.GetUserProfile(context.Background(), userID, token)
@@ -136,7 +136,7 @@ func TestUserClient_AuthorizationHeader(t *testing.T) {
 	defer server.Close()
 
 	logger := zap.NewNop()
-	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 	// When
 	_, err := client.ValidateWorkspaceMember(context.Background(), workspaceID, userID, token)
@@ -177,7 +177,7 @@ func TestProperty_ExternalAPICallMetricsRecorded(t *testing.T) {
 	// Create real metrics instance (will be registered to default registry)
 	// Note: In production, metrics are shared across the application
 	logger := zap.NewNop()
-	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, testClientMetrics)
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, testClientMetrics, nil)
 
 	// When: Make API call
 	_, err := client.GetUserProfile(context.Background(), userID, token)
@@ -245,7 +245,7 @@ func TestProperty_ExternalAPIErrorCounting(t *testing.T) {
 			defer server.Close()
 
 			logger := zap.NewNop()
-			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, testClientMetrics)
+			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, testClientMetrics, nil)
 
 			// When: Make API call
 			_, _ = client.GetUserProfile(context.Background(), userID, token)
@@ -279,7 +279,7 @@ func TestProperty_ExternalAPIMetricsWithNilMetrics(t *testing.T) {
 
 	logger := zap.NewNop()
 	// Create client with nil metrics
-	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 	// When: Make API call
 	profile, err := client.GetUserProfile(context.Background(), userID, token)
@@ -298,3 +298,71 @@ func TestProperty_ExternalAPIMetricsWithNilMetrics(t *testing.T) {
 		t.Errorf("GetUserProfile() UserID = %v, want %v", profile.UserID, userID)
 	}
 }
+
+func TestUserClient_GetWorkspaceProfiles_Batch(t *testing.T) {
+	workspaceID := uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+
+	// Given: Mock batch endpoint that only knows alice
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/api/internal/profiles/batch" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req struct {
+			Profiles []struct {
+				WorkspaceID uuid.UUID `json:"workspaceId"`
+				UserID      uuid.UUID `json:"userId"`
+			} `json:"profiles"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Profiles) != 2 {
+			t.Errorf("expected 2 deduplicated pairs, got %d", len(req.Profiles))
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"profiles": []WorkspaceProfile{{WorkspaceID: workspaceID, UserID: alice, NickName: "Alice"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, zap.NewNop(), nil, nil)
+
+	// When
+	profiles, err := client.GetWorkspaceProfiles(context.Background(), workspaceID, []uuid.UUID{alice, bob, alice})
+
+	// Then: One request, missing users omitted
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 request, got %d", calls)
+	}
+	if profiles[alice] == nil || profiles[alice].NickName != "Alice" {
+		t.Errorf("expected alice's profile, got %+v", profiles[alice])
+	}
+	if _, ok := profiles[bob]; ok {
+		t.Error("expected bob to be missing")
+	}
+}
+
+func TestUserClient_GetWorkspaceProfiles_ServerError(t *testing.T) {
+	// Given: Batch endpoint failing
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewUserClient(server.URL, server.URL, 5*time.Second, zap.NewNop(), nil, nil)
+
+	// When
+	profiles, err := client.GetWorkspaceProfiles(context.Background(), uuid.New(), []uuid.UUID{uuid.New()})
+
+	// Then: Graceful degradation with an empty result
+	if err != nil {
+		t.Fatalf("expected graceful degradation, got %v", err)
+	}
+	if len(profiles) != 0 {
+		t.Errorf("expected no profiles, got %d", len(profiles))
+	}
+}
@@ -98,7 +98,7 @@ func TestUserClient_ValidateWorkspaceMember(t *testing.T) {
 			defer server.Close()
 
 			logger := zap.NewNop()
-			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 			// When
 			valid, err := client.ValidateWorkspaceMember(context.Background(), workspaceID, userID, token)
@@ -181,7 +181,7 @@ func TestUserClient_GetUserProfile(t *testing.T) {
 			defer server.Close()
 
 			logger := zap.NewNop()
-			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 			// When
 			profile, err := client.GetUserProfile(context.Background(), userID, token)
@@ -285,7 +285,7 @@ func TestUserClient_GetWorkspaceProfile(t *testing.T) {
 			defer server.Close()
 
 			logger := zap.NewNop()
-			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 			// When
 			profile, err := client.GetWorkspaceProfile(context.Background(), workspaceID, userID, token)
@@ -392,7 +392,7 @@ func TestUserClient_GetWorkspace(t *testing.T) {
 			defer server.Close()
 
 			logger := zap.NewNop()
-			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil)
+			client := NewUserClient(server.URL, server.URL, 5*time.Second, logger, nil, nil)
 
 			// When
 			workspace, err := client.GetWorkspace(context.Background(), workspaceID, token)
@@ -15,17 +15,20 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server    ServerConfig    `yaml:"server"`
-	Database  DatabaseConfig  `yaml:"database"`
-	Logger    LoggerConfig    `yaml:"logger"`
-	JWT       JWTConfig       `yaml:"jwt"`
-	AuthAPI   AuthAPIConfig   `yaml:"auth_api"` // ← Auth API 추가 (토큰 검증용)
-	UserAPI   UserAPIConfig   `yaml:"user_api"`
-	NotiAPI   NotiAPIConfig   `yaml:"noti_api"` // ← Noti API 추가 (알림 전송용)
-	CORS      CORSConfig      `yaml:"cors"`
-	Redis     RedisConfig     `mapstructure:"redis" yaml:"redis"` // ← Redis 추가
-	S3        S3Config        `yaml:"s3"`                         // ← S3 추가
-	RateLimit RateLimitConfig `yaml:"rate_limit"`                 // Rate limiting configuration
+	Server     ServerConfig      `yaml:"server"`
+	Database   DatabaseConfig    `yaml:"database"`
+	Logger     LoggerConfig      `yaml:"logger"`
+	JWT        JWTConfig         `yaml:"jwt"`
+	AuthAPI    AuthAPIConfig     `yaml:"auth_api"` // ← Auth API 추가 (토큰 검증용)
+	UserAPI    UserAPIConfig     `yaml:"user_api"`
+	NotiAPI    NotiAPIConfig     `yaml:"noti_api"`      // ← Noti API 추가 (알림 전송용)
+	StorageAPI StorageAPIConfig  `yaml:"storage_api"`   // 첨부파일을 워크스페이스 스토리지에 연결
+	Flags      FeatureFlagConfig `yaml:"feature_flags"` // ops-service 피처 플래그 (미설정 시 기본값 사용)
+	Internal   InternalConfig    `yaml:"internal"`      // 서비스 간 내부 API (미설정 시 비활성화)
+	CORS       CORSConfig        `yaml:"cors"`
+	Redis      RedisConfig       `mapstructure:"redis" yaml:"redis"` // ← Redis 추가
+	S3         S3Config          `yaml:"s3"`                         // ← S3 추가
+	RateLimit  RateLimitConfig   `yaml:"rate_limit"`                 // Rate limiting configuration
 }
 
 // ServerConfig holds server configuration
@@ -84,6 +87,25 @@ type NotiAPIConfig struct {
 	InternalAPIKey string        `yaml:"internal_api_key"`
 }
 
+// StorageAPIConfig holds Storage API configuration
+type StorageAPIConfig struct {
+	BaseURL        string        `yaml:"base_url"`
+	Timeout        time.Duration `yaml:"timeout"`
+	InternalAPIKey string        `yaml:"internal_api_key"`
+}
+
+// FeatureFlagConfig holds the ops-service feature flag client configuration
+type FeatureFlagConfig struct {
+	BaseURL         string        `yaml:"base_url"`
+	Environment     string        `yaml:"environment"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// InternalConfig holds configuration of the service-to-service API
+type InternalConfig struct {
+	APIKey string `yaml:"api_key"`
+}
+
 // CORSConfig holds CORS configuration
 type CORSConfig struct {
 	AllowedOrigins string `yaml:"allowed_origins"`
@@ -330,6 +352,37 @@ func (c *Config) overrideFromEnv() {
 	// Internal API Key for service-to-service authentication
 	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
 		c.NotiAPI.InternalAPIKey = apiKey
+		c.StorageAPI.InternalAPIKey = apiKey
+		c.Internal.APIKey = apiKey
+	}
+
+	// Feature flags - OPS_SERVICE_URL (미설정 시 모든 플래그가 기본값)
+	if baseURL := os.Getenv("OPS_SERVICE_URL"); baseURL != "" {
+		c.Flags.BaseURL = baseURL
+	}
+	if env := os.Getenv("FEATURE_FLAG_ENV"); env != "" {
+		c.Flags.Environment = env
+	}
+	if c.Flags.Environment == "" {
+		c.Flags.Environment = "prod"
+	}
+	if interval := os.Getenv("FEATURE_FLAG_REFRESH_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Flags.RefreshInterval = d
+		}
+	}
+
+	// Storage API - STORAGE_SERVICE_URL (첨부파일 스토리지 연결용, 미설정 시 비활성화)
+	if baseURL := os.Getenv("STORAGE_SERVICE_URL"); baseURL != "" {
+		c.StorageAPI.BaseURL = baseURL
+	}
+	if timeout := os.Getenv("STORAGE_API_TIMEOUT"); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			c.StorageAPI.Timeout = d
+		}
+	}
+	if c.StorageAPI.Timeout == 0 {
+		c.StorageAPI.Timeout = 5 * time.Second
 	}
 
 	// CORS - CORS_ORIGINS alias (original format takes precedence)
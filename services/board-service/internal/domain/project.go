@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 )
 
 // Project represents a project entity within a workspace
@@ -33,6 +35,11 @@ const (
 	ProjectRoleMember ProjectRole = "MEMBER"
 )
 
+// Has reports whether the role grants p, using the built-in project roles of the shared permission model
+func (r ProjectRole) Has(p permission.Permission) bool {
+	return permission.RoleHas(permission.ScopeProject, string(r), p)
+}
+
 // ProjectMember represents a member of a project
 type ProjectMember struct {
 	ID        uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
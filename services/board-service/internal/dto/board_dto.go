@@ -82,6 +82,18 @@ type BoardResponse struct {
 	Attachments    []AttachmentResponse   `json:"attachments"`
 	CreatedAt      time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt      time.Time              `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+
+	// AssigneeOutOfOffice is only set on create/update when the new assignee is currently away
+	AssigneeOutOfOffice *AssigneeOutOfOfficeResponse `json:"assigneeOutOfOffice,omitempty"`
+}
+
+// AssigneeOutOfOfficeResponse warns that a board was assigned to someone who is out of office
+// @Description Out-of-office period and auto-reply of the assignee, returned when assigning a board
+type AssigneeOutOfOfficeResponse struct {
+	UserID  uuid.UUID `json:"userId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartAt time.Time `json:"startAt" example:"2024-07-01T00:00:00Z"`
+	EndAt   time.Time `json:"endAt" example:"2024-07-15T00:00:00Z"`
+	Message string    `json:"message,omitempty" example:"On vacation until July 15th"`
 }
 
 // PaginatedBoardsResponse represents a paginated list of boards with metadata.
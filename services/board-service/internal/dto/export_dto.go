@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// UserDataExportResponse represents the board data of a user for personal data export
+type UserDataExportResponse struct {
+	UserID     uuid.UUID               `json:"userId"`
+	ExportedAt time.Time               `json:"exportedAt"`
+	Projects   []ExportedProjectMember `json:"projects"`
+	Boards     []ExportedBoard         `json:"boards"`
+	Comments   []ExportedComment       `json:"comments"`
+}
+
+// ExportedProjectMember represents a project membership in a data export
+type ExportedProjectMember struct {
+	ProjectID   uuid.UUID `json:"projectId"`
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	Name        string    `json:"name"`
+	Role        string    `json:"role"`
+	IsOwner     bool      `json:"isOwner"`
+	JoinedAt    time.Time `json:"joinedAt"`
+}
+
+// ExportedBoard represents a board authored by the user in a data export
+type ExportedBoard struct {
+	BoardID      uuid.UUID      `json:"boardId"`
+	ProjectID    uuid.UUID      `json:"projectId"`
+	Title        string         `json:"title"`
+	Content      string         `json:"content"`
+	CustomFields datatypes.JSON `json:"customFields,omitempty"`
+	StartDate    *time.Time     `json:"startDate,omitempty"`
+	DueDate      *time.Time     `json:"dueDate,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+}
+
+// ExportedComment represents a comment written by the user in a data export
+type ExportedComment struct {
+	CommentID uuid.UUID `json:"commentId"`
+	BoardID   uuid.UUID `json:"boardId"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -150,3 +150,10 @@ type FieldTypeInfo struct {
 	TypeName    string `json:"typeName"`
 	Description string `json:"description,omitempty"`
 }
+
+// PurgeWorkspaceResponse represents the result of permanently deleting a workspace's board data
+type PurgeWorkspaceResponse struct {
+	WorkspaceID     uuid.UUID `json:"workspaceId"`
+	ProjectsDeleted int64     `json:"projectsDeleted"`
+	ObjectsDeleted  int       `json:"objectsDeleted"`
+}
@@ -12,13 +12,17 @@ import (
 type AttachmentHandler struct {
 	s3Client       client.S3ClientInterface
 	attachmentRepo repository.AttachmentRepository
+	storageClient  client.StorageClient // Optional; unlinks deleted attachments from workspace storage
 }
 
 // NewAttachmentHandler creates a new AttachmentHandler
-func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repository.AttachmentRepository) *AttachmentHandler {
+// storageClient가 nil이면 삭제된 첨부파일을 워크스페이스 스토리지에서 연결 해제하지 않습니다.
+// 스토리지 연결은 첨부파일이 확정될 때 service.AttachmentLinker가 수행합니다.
+func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repository.AttachmentRepository, storageClient client.StorageClient) *AttachmentHandler {
 	return &AttachmentHandler{
 		s3Client:       s3Client,
 		attachmentRepo: attachmentRepo,
+		storageClient:  storageClient,
 	}
 }
 
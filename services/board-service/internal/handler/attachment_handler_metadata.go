@@ -77,6 +77,7 @@ type AttachmentResponse struct {
 // @Description  Creates a temporary attachment record with 1-hour expiration
 // @Description  The attachment will be linked to an entity (board/comment/project) when that entity is created
 // @Description  Supported entity types: BOARD, COMMENT, PROJECT
+// @Description  Once confirmed, the file is also linked into the project's storage under "Board attachments"
 // @Tags         attachments
 // @Accept       json
 // @Produce      json
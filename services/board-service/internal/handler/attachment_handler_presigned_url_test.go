@@ -86,7 +86,7 @@ func setupAttachmentHandler(t *testing.T) (*AttachmentHandler, *gin.Engine) {
 	mockRepo := &mockAttachmentRepository{}
 
 	// Create handler
-	handler := NewAttachmentHandler(mockS3Client, mockRepo)
+	handler := NewAttachmentHandler(mockS3Client, mockRepo, nil)
 
 	// Setup router with auth middleware
 	router := gin.New()
@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/response"
@@ -226,6 +228,18 @@ func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
 		return
 	}
 
+	// 스토리지에 연결된 파일도 제거 (연결되지 않은 키는 storage-service에서 무시)
+	if h.storageClient != nil && fileKey != "" {
+		log := getLogger(c)
+		go func() {
+			if err := h.storageClient.UnlinkObject(context.Background(), fileKey); err != nil {
+				log.Warn("Failed to unlink attachment from storage",
+					zap.String("attachment.id", attachmentID.String()),
+					zap.Error(err))
+			}
+		}()
+	}
+
 	response.SendSuccess(c, http.StatusOK, map[string]string{
 		"message": "Attachment deleted successfully",
 	})
@@ -81,7 +81,7 @@ func setupAttachmentIntegrationRouter(db *gorm.DB, s3Client client.S3ClientInter
 	attachmentRepo := repository.NewAttachmentRepository(db)
 
 	// Initialize handler
-	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo)
+	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo, nil)
 
 	// Setup routes
 	api := router.Group("/api")
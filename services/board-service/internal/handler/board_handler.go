@@ -411,12 +411,13 @@ func (h *BoardHandler) sendBoardNotifications(ctx context.Context, log *zap.Logg
 
 // DeleteBoard godoc
 // @Summary      Board 삭제
-// @Description  Board를 소프트 삭제합니다
+// @Description  Board를 소프트 삭제합니다. 작성자가 아니면 board.delete.any 권한(프로젝트 OWNER/ADMIN)이 필요합니다
 // @Tags         boards
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
 // @Success      200 {object} response.SuccessResponse "Board 삭제 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      403 {object} response.ErrorResponse "작성자가 아니고 board.delete.any 권한 없음"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId} [delete]
@@ -431,6 +432,17 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 		return
 	}
 
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
 	log.Debug("DeleteBoard started", zap.String("board.id", boardID.String()))
 
 	// 💡 [수정] 삭제 전에 보드 정보 가져오기 (projectId 필요)
@@ -441,7 +453,7 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 		return
 	}
 
-	err = h.boardService.DeleteBoard(c.Request.Context(), boardID)
+	err = h.boardService.DeleteBoard(c.Request.Context(), boardID, userUUID)
 	if err != nil {
 		log.Error("DeleteBoard service error", zap.String("board.id", boardID.String()), zap.Error(err))
 		handleServiceError(c, err)
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+// ExportHandler handles internal personal data export requests from user-service
+type ExportHandler struct {
+	exportService service.ExportService
+}
+
+func NewExportHandler(exportService service.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportUserData godoc
+// @Summary      사용자 데이터 내보내기 (내부 API)
+// @Description  사용자의 프로젝트 멤버십, 작성한 보드와 댓글을 반환합니다. 개인 데이터 내보내기 작업에서 호출됩니다.
+// @Tags         internal
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} response.SuccessResponse{data=dto.UserDataExportResponse}
+// @Failure      400 {object} response.ErrorResponse "잘못된 사용자 ID"
+// @Failure      401 {object} response.ErrorResponse "내부 API 키 오류"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /internal/export/users/{userId} [get]
+func (h *ExportHandler) ExportUserData(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid user ID")
+		return
+	}
+
+	result, err := h.exportService.ExportUserData(c.Request.Context(), userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
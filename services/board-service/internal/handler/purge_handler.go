@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+// PurgeHandler handles internal purge requests from user-service
+type PurgeHandler struct {
+	purgeService service.PurgeService
+}
+
+func NewPurgeHandler(purgeService service.PurgeService) *PurgeHandler {
+	return &PurgeHandler{
+		purgeService: purgeService,
+	}
+}
+
+// PurgeWorkspace godoc
+// @Summary      워크스페이스 데이터 영구 삭제 (내부 API)
+// @Description  삭제된 워크스페이스의 프로젝트, 보드, 댓글, 첨부파일을 영구 삭제합니다. 반복 호출해도 안전합니다.
+// @Tags         internal
+// @Produce      json
+// @Param        workspaceId path string true "Workspace ID"
+// @Success      200 {object} response.SuccessResponse{data=dto.PurgeWorkspaceResponse}
+// @Failure      400 {object} response.ErrorResponse "잘못된 워크스페이스 ID"
+// @Failure      401 {object} response.ErrorResponse "내부 API 키 오류"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /internal/purge/workspaces/{workspaceId} [delete]
+func (h *PurgeHandler) PurgeWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid workspace ID")
+		return
+	}
+
+	result, err := h.purgeService.PurgeWorkspace(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// PurgeUser godoc
+// @Summary      사용자 데이터 영구 삭제 (내부 API)
+// @Description  삭제된 계정의 프로젝트 멤버십, 참여 요청, 보드 참여 정보를 영구 삭제합니다. 반복 호출해도 안전합니다.
+// @Tags         internal
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} response.SuccessResponse
+// @Failure      400 {object} response.ErrorResponse "잘못된 사용자 ID"
+// @Failure      401 {object} response.ErrorResponse "내부 API 키 오류"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /internal/purge/users/{userId} [delete]
+func (h *PurgeHandler) PurgeUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid user ID")
+		return
+	}
+
+	if err := h.purgeService.PurgeUser(c.Request.Context(), userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccessMessage(c, http.StatusOK, nil, "User board data purged")
+}
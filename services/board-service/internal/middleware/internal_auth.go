@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"project-board-api/internal/response"
+)
+
+// InternalAuth validates the service-to-service API key in the x-internal-api-key header
+func InternalAuth(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		providedKey := c.GetHeader("x-internal-api-key")
+
+		// Constant-time comparison to avoid timing attacks
+		if apiKey == "" || providedKey == "" || subtle.ConstantTimeCompare([]byte(providedKey), []byte(apiKey)) != 1 {
+			response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid internal API key")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// ExportRepository defines the interface for reading the board data of a user for personal data export
+type ExportRepository interface {
+	FindProjectMemberships(ctx context.Context, userID uuid.UUID) ([]*domain.ProjectMember, error)
+	FindBoardsByAuthor(ctx context.Context, userID uuid.UUID) ([]*domain.Board, error)
+	FindCommentsByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Comment, error)
+}
+
+// exportRepositoryImpl is the GORM implementation of ExportRepository
+type exportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewExportRepository creates a new instance of ExportRepository
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepositoryImpl{db: db}
+}
+
+// FindProjectMemberships finds the project memberships of a user with their projects
+func (r *exportRepositoryImpl) FindProjectMemberships(ctx context.Context, userID uuid.UUID) ([]*domain.ProjectMember, error) {
+	var members []*domain.ProjectMember
+	if err := r.db.WithContext(ctx).
+		Joins("Project").
+		Where("project_members.user_id = ? AND \"Project\".deleted_at IS NULL", userID).
+		Order("project_members.joined_at ASC").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// FindBoardsByAuthor finds the boards created by a user
+func (r *exportRepositoryImpl) FindBoardsByAuthor(ctx context.Context, userID uuid.UUID) ([]*domain.Board, error) {
+	var boards []*domain.Board
+	if err := r.db.WithContext(ctx).
+		Where("author_id = ? AND deleted_at IS NULL", userID).
+		Order("created_at ASC").
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// FindCommentsByUser finds the comments written by a user
+func (r *exportRepositoryImpl) FindCommentsByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Comment, error) {
+	var comments []*domain.Comment
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND deleted_at IS NULL", userID).
+		Order("created_at ASC").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// PurgeRepository defines the interface for permanently deleting data of deleted workspaces and accounts
+type PurgeRepository interface {
+	FindWorkspaceAttachments(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Attachment, error)
+	PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (int64, error)
+	PurgeUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// purgeRepositoryImpl is the GORM implementation of PurgeRepository
+type purgeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPurgeRepository creates a new instance of PurgeRepository
+func NewPurgeRepository(db *gorm.DB) PurgeRepository {
+	return &purgeRepositoryImpl{db: db}
+}
+
+// workspaceScopes returns subqueries selecting the project, board and comment IDs of a workspace.
+// Soft-deleted rows are included.
+func workspaceScopes(db *gorm.DB, workspaceID uuid.UUID) (projectIDs, boardIDs, commentIDs *gorm.DB) {
+	projectIDs = db.Model(&domain.Project{}).Select("id").Where("workspace_id = ?", workspaceID)
+	boardIDs = db.Model(&domain.Board{}).Select("id").Where("project_id IN (?)", projectIDs)
+	commentIDs = db.Model(&domain.Comment{}).Select("id").Where("board_id IN (?)", boardIDs)
+	return projectIDs, boardIDs, commentIDs
+}
+
+// FindWorkspaceAttachments finds all attachments of projects, boards and comments in a workspace
+func (r *purgeRepositoryImpl) FindWorkspaceAttachments(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Attachment, error) {
+	db := r.db.WithContext(ctx)
+	projectIDs, boardIDs, commentIDs := workspaceScopes(db, workspaceID)
+
+	var attachments []*domain.Attachment
+	if err := db.
+		Where("(entity_type = ? AND entity_id IN (?)) OR (entity_type = ? AND entity_id IN (?)) OR (entity_type = ? AND entity_id IN (?))",
+			domain.EntityTypeProject, projectIDs,
+			domain.EntityTypeBoard, boardIDs,
+			domain.EntityTypeComment, commentIDs).
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// PurgeWorkspace deletes all projects of a workspace and everything under them in one transaction.
+// Attachment objects must be removed from S3 before calling this. Returns the number of projects deleted.
+func (r *purgeRepositoryImpl) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	var deleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		projectIDs, boardIDs, commentIDs := workspaceScopes(tx, workspaceID)
+
+		if err := tx.Where("(entity_type = ? AND entity_id IN (?)) OR (entity_type = ? AND entity_id IN (?)) OR (entity_type = ? AND entity_id IN (?))",
+			domain.EntityTypeProject, projectIDs,
+			domain.EntityTypeBoard, boardIDs,
+			domain.EntityTypeComment, commentIDs).
+			Delete(&domain.Attachment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("board_id IN (?)", boardIDs).Delete(&domain.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("board_id IN (?)", boardIDs).Delete(&domain.Participant{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id IN (?)", projectIDs).Delete(&domain.Board{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id IN (?)", projectIDs).Delete(&domain.FieldOption{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id IN (?)", projectIDs).Delete(&domain.ProjectMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("project_id IN (?)", projectIDs).Delete(&domain.ProjectJoinRequest{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("workspace_id = ?", workspaceID).Delete(&domain.Project{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	return deleted, err
+}
+
+// PurgeUser deletes the project memberships, join requests and board participations of a user.
+// Boards and comments the user created belong to the workspace and are kept.
+func (r *purgeRepositoryImpl) PurgeUser(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.Participant{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.ProjectMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&domain.ProjectJoinRequest{}).Error
+	})
+}
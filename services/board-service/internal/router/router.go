@@ -10,8 +10,10 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
 	"project-board-api/internal/client"
 	"project-board-api/internal/config"
@@ -31,11 +33,15 @@ type Config struct {
 	AuthServiceURL     string // auth-service URL for SmartValidator
 	JWTIssuer          string // JWT issuer for JWKS validation
 	UserClient         client.UserClient
-	NotiClient         client.NotiClient // noti-service client for notifications
+	NotiClient         client.NotiClient    // noti-service client for notifications
+	StorageClient      client.StorageClient // storage-service client for linking attachments (optional)
+	FeatureFlags       *featureflag.Client  // ops-service feature flags (nil serves every flag's default)
+	Permissions        *permission.Resolver // workspace permissions incl. custom roles (nil uses project roles only)
 	BasePath           string
 	UserServiceBaseURL string
 	Metrics            *metrics.Metrics
 	S3Client           *client.S3Client
+	InternalAPIKey     string // Service-to-service API key; empty disables /api/internal routes
 	RedisClient        *redis.Client
 	RateLimitConfig    config.RateLimitConfig
 	ServiceName        string // Service name for tracing (default: "board-service")
@@ -92,14 +98,17 @@ func Setup(cfg Config) *gin.Engine {
 	// Initialize converters
 	fieldOptionConverter := converter.NewFieldOptionConverter(fieldOptionRepo)
 
+	// Confirmed attachments are linked into storage-service (nil when storage is not configured)
+	attachmentLinker := service.NewAttachmentLinker(attachmentRepo, projectRepo, cfg.StorageClient, cfg.FeatureFlags, cfg.Logger)
+
 	// Initialize services with repository dependencies
-	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, cfg.UserClient, cfg.Metrics, cfg.Logger)
-	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, cfg.Metrics, cfg.Logger)
+	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, attachmentLinker, cfg.S3Client, cfg.UserClient, cfg.Permissions, cfg.Metrics, cfg.Logger)
+	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, attachmentLinker, cfg.S3Client, fieldOptionConverter, cfg.NotiClient, cfg.UserClient, cfg.Permissions, cfg.Metrics, cfg.Logger)
 	participantService := service.NewParticipantService(participantRepo, boardRepo)
-	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, cfg.S3Client, cfg.NotiClient, cfg.Logger)
+	commentService := service.NewCommentService(commentRepo, boardRepo, projectRepo, attachmentRepo, attachmentLinker, cfg.S3Client, cfg.NotiClient, cfg.Logger)
 	fieldOptionService := service.NewFieldOptionService(fieldOptionRepo)
-	projectMemberService := service.NewProjectMemberService(projectRepo, cfg.UserClient)
-	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, cfg.UserClient)
+	projectMemberService := service.NewProjectMemberService(projectRepo, cfg.UserClient, cfg.Permissions)
+	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, cfg.UserClient, cfg.Permissions)
 
 	// Initialize handlers with service dependencies
 	projectHandler := handler.NewProjectHandler(projectService)
@@ -109,7 +118,15 @@ func Setup(cfg Config) *gin.Engine {
 	fieldOptionHandler := handler.NewFieldOptionHandler(fieldOptionService)
 	projectMemberHandler := handler.NewProjectMemberHandler(projectMemberService)
 	projectJoinRequestHandler := handler.NewProjectJoinRequestHandler(projectJoinRequestService)
-	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo)
+	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo, cfg.StorageClient)
+
+	// S3가 설정되지 않은 경우 typed nil이 인터페이스로 전달되지 않도록 분기
+	var purgeS3 service.S3Client
+	if cfg.S3Client != nil {
+		purgeS3 = cfg.S3Client
+	}
+	purgeHandler := handler.NewPurgeHandler(service.NewPurgeService(repository.NewPurgeRepository(cfg.DB), purgeS3, cfg.Logger))
+	exportHandler := handler.NewExportHandler(service.NewExportService(repository.NewExportRepository(cfg.DB), cfg.Logger))
 
 	// 💡 WebSocket Handler 초기화
 	wsHandler := handler.NewWSHandler(cfg.Logger, cfg.UserClient)
@@ -172,6 +189,20 @@ func Setup(cfg Config) *gin.Engine {
 	// basePath가 /api/boards일 때: /api/boards/ws/project/:projectId
 	baseGroup.GET("/ws/project/:projectId", wsHandler.HandleWebSocket)
 
+	// Internal routes (service-to-service, API key auth)
+	// 삭제된 워크스페이스/계정 영구 삭제, 개인 데이터 내보내기 (user-service 작업에서 호출)
+	if cfg.InternalAPIKey != "" {
+		internal := baseGroup.Group("/api/internal")
+		internal.Use(middleware.InternalAuth(cfg.InternalAPIKey))
+		{
+			internal.DELETE("/purge/workspaces/:workspaceId", purgeHandler.PurgeWorkspace)
+			internal.DELETE("/purge/users/:userId", purgeHandler.PurgeUser)
+			internal.GET("/export/users/:userId", exportHandler.ExportUserData)
+		}
+	} else {
+		cfg.Logger.Warn("Internal API key is not configured, internal routes are disabled")
+	}
+
 	return router
 }
 
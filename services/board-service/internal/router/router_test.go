@@ -49,6 +49,10 @@ func (m *mockUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceI
 	return false, nil
 }
 
+func (m *mockUserClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceValidationResponse, error) {
+	return &client.WorkspaceValidationResponse{}, nil
+}
+
 func (m *mockUserClient) GetUserProfile(ctx context.Context, userID uuid.UUID, token string) (*client.UserProfile, error) {
 	return nil, nil
 }
@@ -57,10 +61,18 @@ func (m *mockUserClient) GetWorkspaceProfile(ctx context.Context, workspaceID, u
 	return nil, nil
 }
 
+func (m *mockUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+	return nil, nil
+}
+
 func (m *mockUserClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error) {
 	return nil, nil
 }
 
+func (m *mockUserClient) GetUserAvailability(ctx context.Context, userID uuid.UUID) (*client.UserAvailability, error) {
+	return &client.UserAvailability{UserID: userID}, nil
+}
+
 func (m *mockUserClient) ValidateToken(ctx context.Context, tokenStr string) (uuid.UUID, error) {
 	return uuid.Nil, nil
 }
@@ -69,9 +69,12 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil,
 			mockS3Client,
 			mockFieldOptionConverter,
 			nil, // notiClient
+			nil, // userClient
+			nil, // permissions
 			nil, // metrics
 			logger,
 		)
@@ -122,9 +125,12 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil,
 			mockS3Client,
 			mockFieldOptionConverter,
 			nil, // notiClient
+			nil, // userClient
+			nil, // permissions
 			nil, // metrics
 			logger,
 		)
@@ -185,9 +191,12 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil,
 			mockS3Client,
 			mockFieldOptionConverter,
 			nil, // notiClient
+			nil, // userClient
+			nil, // permissions
 			nil, // metrics
 			logger,
 		)
@@ -249,9 +258,12 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			mockFieldOptionRepo,
 			mockParticipantRepo,
 			mockAttachmentRepo,
+			nil,
 			mockS3Client,
 			mockFieldOptionConverter,
 			nil, // notiClient
+			nil, // userClient
+			nil, // permissions
 			nil, // metrics
 			logger,
 		)
@@ -321,7 +333,7 @@ func TestCreateCommentWithAttachments(t *testing.T) {
 
 		mockS3Client := &MockS3Client{}
 		mockProjectRepo := &MockProjectRepository{}
-		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, logger)
+		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, nil, mockS3Client, nil, logger)
 
 		req := &dto.CreateCommentRequest{
 			BoardID:       boardID,
@@ -359,7 +371,7 @@ func TestCreateCommentWithAttachments(t *testing.T) {
 
 		mockS3Client := &MockS3Client{}
 		mockProjectRepo := &MockProjectRepository{}
-		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, mockS3Client, nil, logger)
+		service := NewCommentService(mockCommentRepo, mockBoardRepo, mockProjectRepo, mockAttachmentRepo, nil, mockS3Client, nil, logger)
 
 		req := &dto.CreateCommentRequest{
 			BoardID:       boardID,
@@ -426,7 +438,7 @@ func TestCreateProjectWithAttachments(t *testing.T) {
 		}
 
 		mockS3Client := &MockS3Client{}
-		service := NewProjectService(mockProjectRepo, mockFieldOptionRepo, mockAttachmentRepo, mockS3Client, mockUserClient, nil, logger)
+		service := NewProjectService(mockProjectRepo, mockFieldOptionRepo, mockAttachmentRepo, nil, mockS3Client, mockUserClient, nil, nil, logger)
 
 		req := &dto.CreateProjectRequest{
 			WorkspaceID:   workspaceID,
@@ -466,7 +478,7 @@ func TestCreateProjectWithAttachments(t *testing.T) {
 		}
 
 		mockS3Client := &MockS3Client{}
-		service := NewProjectService(mockProjectRepo, mockFieldOptionRepo, mockAttachmentRepo, mockS3Client, mockUserClient, nil, logger)
+		service := NewProjectService(mockProjectRepo, mockFieldOptionRepo, mockAttachmentRepo, nil, mockS3Client, mockUserClient, nil, nil, logger)
 
 		req := &dto.CreateProjectRequest{
 			WorkspaceID:   workspaceID,
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// storageFolderRoot is the folder of a project's storage that holds linked board attachments
+const storageFolderRoot = "Board attachments"
+
+// FlagAttachmentStorageLink switches linking confirmed attachments into storage per workspace (default on)
+const FlagAttachmentStorageLink = "board.attachment-storage-link"
+
+// AttachmentLinker files confirmed attachments into the workspace storage of their project.
+// Temporary attachments are never linked, so abandoned uploads do not show up in storage.
+type AttachmentLinker interface {
+	// LinkConfirmed links the attachments in the background; failures are only logged
+	LinkConfirmed(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID)
+}
+
+// storageAttachmentLinker links attachments through the storage-service internal API
+type storageAttachmentLinker struct {
+	attachmentRepo repository.AttachmentRepository
+	projectRepo    repository.ProjectRepository
+	storageClient  client.StorageClient
+	flags          *featureflag.Client
+	logger         *zap.Logger
+}
+
+// NewAttachmentLinker creates a new AttachmentLinker.
+// It returns nil when storageClient is nil, which disables storage linking.
+// A nil flags client links for every workspace.
+func NewAttachmentLinker(attachmentRepo repository.AttachmentRepository, projectRepo repository.ProjectRepository, storageClient client.StorageClient, flags *featureflag.Client, logger *zap.Logger) AttachmentLinker {
+	if storageClient == nil {
+		return nil
+	}
+	return &storageAttachmentLinker{
+		attachmentRepo: attachmentRepo,
+		projectRepo:    projectRepo,
+		storageClient:  storageClient,
+		flags:          flags,
+		logger:         logger,
+	}
+}
+
+// LinkConfirmed links confirmed attachments into the project's storage in the background
+func (l *storageAttachmentLinker) LinkConfirmed(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	if len(attachmentIDs) == 0 {
+		return
+	}
+	// 요청이 끝나도 취소되지 않도록 분리 (trace context는 유지)
+	go l.link(context.WithoutCancel(ctx), projectID, attachmentIDs)
+}
+
+// link registers each confirmed attachment as a file of the project's private storage project
+func (l *storageAttachmentLinker) link(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	project, err := l.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		l.logger.Warn("Skipping storage link: project not found",
+			zap.String("project.id", projectID.String()),
+			zap.Error(err))
+		return
+	}
+	if !l.flags.Bool(FlagAttachmentStorageLink, featureflag.EvalContext{WorkspaceID: project.WorkspaceID.String()}, true) {
+		l.logger.Debug("Skipping storage link: disabled by feature flag",
+			zap.String("workspace.id", project.WorkspaceID.String()),
+			zap.String("project.id", projectID.String()))
+		return
+	}
+
+	attachments, err := l.attachmentRepo.FindByIDs(ctx, attachmentIDs)
+	if err != nil {
+		l.logger.Warn("Skipping storage link: failed to load attachments",
+			zap.String("project.id", projectID.String()),
+			zap.Error(err))
+		return
+	}
+
+	for _, attachment := range attachments {
+		if attachment.Status != domain.AttachmentStatusConfirmed {
+			continue
+		}
+		// 프로젝트 ID로 연결해야 storage-service가 비공개 프로젝트 범위에 폴더를 만듦
+		req := &client.LinkObjectRequest{
+			WorkspaceID:       project.WorkspaceID,
+			SourceProjectID:   project.ID,
+			SourceProjectName: storageFolderName(project.Name),
+			FolderPath:        []string{storageFolderRoot},
+			FileKey:           attachment.FileURL,
+			FileName:          attachment.FileName,
+			FileSize:          attachment.FileSize,
+			ContentType:       attachment.ContentType,
+			UploadedBy:        attachment.UploadedBy,
+		}
+		if err := l.storageClient.LinkObject(ctx, req); err != nil {
+			l.logger.Warn("Failed to link attachment to storage",
+				zap.String("attachment.id", attachment.ID.String()),
+				zap.String("project.id", project.ID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// storageFolderName makes a project name usable as a storage project name
+func storageFolderName(name string) string {
+	name = strings.TrimSpace(strings.ReplaceAll(name, "/", "-"))
+	if name == "" {
+		return "Untitled project"
+	}
+	return name
+}
+
+// linkAttachments hands confirmed board attachments to the storage linker, if configured
+func (s *boardServiceImpl) linkAttachments(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	if s.attachmentLinker != nil {
+		s.attachmentLinker.LinkConfirmed(ctx, projectID, attachmentIDs)
+	}
+}
+
+// linkAttachments hands confirmed comment attachments to the storage linker, if configured
+func (s *commentServiceImpl) linkAttachments(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	if s.attachmentLinker != nil {
+		s.attachmentLinker.LinkConfirmed(ctx, projectID, attachmentIDs)
+	}
+}
+
+// linkAttachments hands confirmed project attachments to the storage linker, if configured
+func (s *projectServiceImpl) linkAttachments(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	if s.attachmentLinker != nil {
+		s.attachmentLinker.LinkConfirmed(ctx, projectID, attachmentIDs)
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// fakeStorageClient records LinkObject requests
+type fakeStorageClient struct {
+	linked []*client.LinkObjectRequest
+}
+
+func (f *fakeStorageClient) LinkObject(ctx context.Context, req *client.LinkObjectRequest) error {
+	f.linked = append(f.linked, req)
+	return nil
+}
+
+func (f *fakeStorageClient) UnlinkObject(ctx context.Context, fileKey string) error {
+	return nil
+}
+
+// recordingLinker records LinkConfirmed calls without touching storage
+type recordingLinker struct {
+	projectIDs []uuid.UUID
+	ids        [][]uuid.UUID
+}
+
+func (r *recordingLinker) LinkConfirmed(ctx context.Context, projectID uuid.UUID, attachmentIDs []uuid.UUID) {
+	r.projectIDs = append(r.projectIDs, projectID)
+	r.ids = append(r.ids, attachmentIDs)
+}
+
+func TestNewAttachmentLinker_NilStorageClient(t *testing.T) {
+	if linker := NewAttachmentLinker(&MockAttachmentRepository{}, &MockProjectRepository{}, nil, nil, zap.NewNop()); linker != nil {
+		t.Errorf("NewAttachmentLinker() = %v, want nil without a storage client", linker)
+	}
+}
+
+func TestAttachmentLinker_LinksConfirmedIntoProjectScope(t *testing.T) {
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+	uploader := uuid.New()
+	confirmedID := uuid.New()
+	tempID := uuid.New()
+
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			p := &domain.Project{WorkspaceID: workspaceID, Name: "Q1/Q2 Plan"}
+			p.ID = id
+			return p, nil
+		},
+	}
+	attachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			confirmed := &domain.Attachment{Status: domain.AttachmentStatusConfirmed, FileURL: "board/a.png", FileName: "a.png", UploadedBy: uploader}
+			confirmed.ID = confirmedID
+			temp := &domain.Attachment{Status: domain.AttachmentStatusTemp, FileURL: "board/b.png", FileName: "b.png", UploadedBy: uploader}
+			temp.ID = tempID
+			return []*domain.Attachment{confirmed, temp}, nil
+		},
+	}
+	storage := &fakeStorageClient{}
+	linker := NewAttachmentLinker(attachmentRepo, projectRepo, storage, nil, zap.NewNop()).(*storageAttachmentLinker)
+
+	linker.link(context.Background(), projectID, []uuid.UUID{confirmedID, tempID})
+
+	if len(storage.linked) != 1 {
+		t.Fatalf("linked %d attachments, want only the confirmed one", len(storage.linked))
+	}
+	req := storage.linked[0]
+	if req.SourceProjectID != projectID {
+		t.Errorf("SourceProjectID = %v, want %v", req.SourceProjectID, projectID)
+	}
+	if req.WorkspaceID != workspaceID {
+		t.Errorf("WorkspaceID = %v, want %v", req.WorkspaceID, workspaceID)
+	}
+	if req.SourceProjectName != "Q1-Q2 Plan" {
+		t.Errorf("SourceProjectName = %q, want %q", req.SourceProjectName, "Q1-Q2 Plan")
+	}
+	if len(req.FolderPath) != 1 || req.FolderPath[0] != storageFolderRoot {
+		t.Errorf("FolderPath = %v, want [%s]", req.FolderPath, storageFolderRoot)
+	}
+	if req.FileKey != "board/a.png" || req.UploadedBy != uploader {
+		t.Errorf("unexpected link request %+v", req)
+	}
+}
+
+func TestAttachmentLinker_DisabledByFeatureFlag(t *testing.T) {
+	disabledWorkspace := uuid.New()
+	enabledWorkspace := uuid.New()
+	flags := featureflag.NewStaticClient(&featureflag.Snapshot{
+		Environment: "test",
+		Flags: map[string]featureflag.Flag{
+			FlagAttachmentStorageLink: {
+				Key: FlagAttachmentStorageLink, Type: featureflag.TypeBool, Enabled: true,
+				DefaultValue: json.RawMessage(`true`),
+				Rules:        []featureflag.Rule{{WorkspaceIDs: []string{disabledWorkspace.String()}, Value: json.RawMessage(`false`)}},
+			},
+		},
+	})
+
+	workspaces := map[uuid.UUID]uuid.UUID{} // project ID -> workspace ID
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			p := &domain.Project{WorkspaceID: workspaces[id], Name: "Plan"}
+			p.ID = id
+			return p, nil
+		},
+	}
+	attachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			a := &domain.Attachment{Status: domain.AttachmentStatusConfirmed, FileURL: "board/a.png", FileName: "a.png"}
+			a.ID = ids[0]
+			return []*domain.Attachment{a}, nil
+		},
+	}
+	storage := &fakeStorageClient{}
+	linker := NewAttachmentLinker(attachmentRepo, projectRepo, storage, flags, zap.NewNop()).(*storageAttachmentLinker)
+
+	disabledProject, enabledProject := uuid.New(), uuid.New()
+	workspaces[disabledProject] = disabledWorkspace
+	workspaces[enabledProject] = enabledWorkspace
+
+	linker.link(context.Background(), disabledProject, []uuid.UUID{uuid.New()})
+	if len(storage.linked) != 0 {
+		t.Fatalf("linked %d attachments in a workspace with linking disabled", len(storage.linked))
+	}
+	linker.link(context.Background(), enabledProject, []uuid.UUID{uuid.New()})
+	if len(storage.linked) != 1 || storage.linked[0].WorkspaceID != enabledWorkspace {
+		t.Errorf("linked = %+v, want one link in the enabled workspace", storage.linked)
+	}
+}
+
+func TestCommentService_CreateComment_LinksOnConfirm(t *testing.T) {
+	projectID := uuid.New()
+	attachmentID := uuid.New()
+	confirmed := false
+
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{ProjectID: projectID}, nil
+		},
+	}
+	commentRepo := &MockCommentRepository{
+		CreateFunc: func(ctx context.Context, comment *domain.Comment) error {
+			comment.ID = uuid.New()
+			return nil
+		},
+	}
+	attachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			status := domain.AttachmentStatusTemp
+			if confirmed {
+				status = domain.AttachmentStatusConfirmed
+			}
+			return []*domain.Attachment{{EntityType: domain.EntityTypeComment, Status: status}}, nil
+		},
+		ConfirmAttachmentsFunc: func(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error {
+			confirmed = true
+			return nil
+		},
+	}
+	linker := &recordingLinker{}
+	service := NewCommentService(commentRepo, boardRepo, &MockProjectRepository{}, attachmentRepo, linker, &MockS3Client{}, nil, zap.NewNop())
+
+	_, err := service.CreateComment(context.Background(), uuid.New(), &dto.CreateCommentRequest{
+		BoardID:       uuid.New(),
+		Content:       "with file",
+		AttachmentIDs: []uuid.UUID{attachmentID},
+	})
+	if err != nil {
+		t.Fatalf("CreateComment() unexpected error = %v", err)
+	}
+
+	if len(linker.projectIDs) != 1 || linker.projectIDs[0] != projectID {
+		t.Fatalf("LinkConfirmed project IDs = %v, want [%v]", linker.projectIDs, projectID)
+	}
+	if len(linker.ids[0]) != 1 || linker.ids[0][0] != attachmentID {
+		t.Errorf("LinkConfirmed attachment IDs = %v, want [%v]", linker.ids[0], attachmentID)
+	}
+}
+
+func TestStorageFolderName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{" Roadmap ", "Roadmap"},
+		{"Q1/Q2 Plan", "Q1-Q2 Plan"},
+		{"   ", "Untitled project"},
+	}
+	for _, tt := range tests {
+		if got := storageFolderName(tt.in); got != tt.want {
+			t.Errorf("storageFolderName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/datatypes"
@@ -25,7 +26,7 @@ type BoardService interface {
 	GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
 	GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
 	UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
-	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
+	DeleteBoard(ctx context.Context, boardID, userID uuid.UUID) error
 }
 
 // boardServiceImpl is the implementation of BoardService
@@ -35,9 +36,12 @@ type boardServiceImpl struct {
 	fieldOptionRepo      repository.FieldOptionRepository
 	participantRepo      repository.ParticipantRepository
 	attachmentRepo       repository.AttachmentRepository
+	attachmentLinker     AttachmentLinker // links confirmed attachments into storage (nil disables)
 	s3Client             S3Client
 	fieldOptionConverter FieldOptionConverter
-	notiClient           client.NotiClient // for sending notifications
+	notiClient           client.NotiClient    // for sending notifications
+	userClient           client.UserClient    // for assignee availability (nil disables the out-of-office notice)
+	permissions          *permission.Resolver // workspace permissions incl. custom roles (nil uses project roles only)
 	metrics              *metrics.Metrics
 	logger               *zap.Logger
 }
@@ -57,9 +61,12 @@ func NewBoardService(
 	fieldOptionRepo repository.FieldOptionRepository,
 	participantRepo repository.ParticipantRepository,
 	attachmentRepo repository.AttachmentRepository,
+	attachmentLinker AttachmentLinker,
 	s3Client S3Client,
 	fieldOptionConverter FieldOptionConverter,
 	notiClient client.NotiClient,
+	userClient client.UserClient,
+	permissions *permission.Resolver,
 	m *metrics.Metrics,
 	logger *zap.Logger,
 ) BoardService {
@@ -69,9 +76,12 @@ func NewBoardService(
 		fieldOptionRepo:      fieldOptionRepo,
 		participantRepo:      participantRepo,
 		attachmentRepo:       attachmentRepo,
+		attachmentLinker:     attachmentLinker,
 		s3Client:             s3Client,
 		fieldOptionConverter: fieldOptionConverter,
 		notiClient:           notiClient,
+		userClient:           userClient,
+		permissions:          permissions,
 		metrics:              m,
 		logger:               logger,
 	}
@@ -187,6 +197,8 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 				"Please ensure all attachment IDs are valid and not already used")
 		}
 
+		s.linkAttachments(ctx, board.ProjectID, req.AttachmentIDs)
+
 		// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
 		if err != nil {
@@ -238,7 +250,11 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 	}
 
 	// Convert to response DTO
-	return s.toBoardResponseWithWorkspace(ctx, board), nil
+	resp := s.toBoardResponseWithWorkspace(ctx, board)
+	if board.AssigneeID != nil {
+		resp.AssigneeOutOfOffice = s.assigneeOutOfOffice(ctx, *board.AssigneeID)
+	}
+	return resp, nil
 }
 
 // GetBoard retrieves a board by ID with participants and comments
@@ -335,12 +351,12 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 }
 
 // DeleteBoard deletes a board and its associated attachments
-func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
+func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID, userID uuid.UUID) error {
 	log := s.log(ctx)
 	log.Debug("DeleteBoard service started", zap.String("board.id", boardID.String()))
 
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, boardID)
+	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Debug("DeleteBoard board not found", zap.String("board.id", boardID.String()))
@@ -350,6 +366,21 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
 	}
 
+	// Boards created by others require board.delete.any
+	if board.AuthorID != userID {
+		member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, board.ProjectID, userID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error("DeleteBoard failed to check membership", zap.String("board.id", boardID.String()), zap.Error(err))
+			return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+		}
+		if member == nil || !hasProjectPermission(ctx, s.projectRepo, s.permissions, member, permission.BoardDeleteAny) {
+			log.Debug("DeleteBoard permission denied",
+				zap.String("board.id", boardID.String()),
+				zap.String("enduser.id", userID.String()))
+			return response.NewForbiddenError("Only the author or project owner/admin can delete this board", string(permission.BoardDeleteAny))
+		}
+	}
+
 	// Find all attachments associated with this board
 	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, boardID)
 	if err != nil {
@@ -331,6 +331,33 @@ func (s *boardServiceImpl) isAssigneeChanged(original, current *uuid.UUID) bool
 	return *original != *current
 }
 
+// assigneeOutOfOffice returns the out-of-office notice of an assignee who is currently away.
+// Lookup failures only skip the notice so the assignment itself still succeeds.
+func (s *boardServiceImpl) assigneeOutOfOffice(ctx context.Context, assigneeID uuid.UUID) *dto.AssigneeOutOfOfficeResponse {
+	if s.userClient == nil || assigneeID == uuid.Nil {
+		return nil
+	}
+
+	availability, err := s.userClient.GetUserAvailability(ctx, assigneeID)
+	if err != nil {
+		s.log(ctx).Warn("Failed to check assignee availability",
+			zap.String("assignee.id", assigneeID.String()),
+			zap.Error(err))
+		return nil
+	}
+
+	ooo := availability.ActiveOutOfOffice(time.Now())
+	if ooo == nil {
+		return nil
+	}
+	return &dto.AssigneeOutOfOfficeResponse{
+		UserID:  assigneeID,
+		StartAt: ooo.StartAt,
+		EndAt:   ooo.EndAt,
+		Message: ooo.Message,
+	}
+}
+
 // sendAssigneeNotification sends a BOARD_ASSIGNED notification to the assignee
 // This is called asynchronously (in a goroutine) so notification failures don't affect the main business logic
 func (s *boardServiceImpl) sendAssigneeNotification(ctx context.Context, board *domain.Board, actorID uuid.UUID) {
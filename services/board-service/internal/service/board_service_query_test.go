@@ -75,7 +75,7 @@ func TestBoardService_GetBoard(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.GetBoard(context.Background(), tt.boardID)
@@ -263,7 +263,7 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.GetBoardsByProject(context.Background(), projectID, tt.filters)
@@ -300,32 +300,67 @@ func TestBoardService_GetBoardsByProject_CustomFieldsFilter(t *testing.T) {
 
 func TestBoardService_DeleteBoard(t *testing.T) {
 	boardID := uuid.New()
+	projectID := uuid.New()
+	authorID := uuid.New()
+	otherUserID := uuid.New()
+
+	existingBoard := func(m *MockBoardRepository) {
+		m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{
+				BaseModel: domain.BaseModel{ID: boardID},
+				ProjectID: projectID,
+				AuthorID:  authorID,
+			}, nil
+		}
+		m.DeleteFunc = func(ctx context.Context, id uuid.UUID) error {
+			return nil
+		}
+	}
+	memberWithRole := func(role domain.ProjectRole) func(*MockProjectRepository) {
+		return func(m *MockProjectRepository) {
+			m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
+				return &domain.ProjectMember{ProjectID: pID, UserID: uID, RoleName: role}, nil
+			}
+		}
+	}
 
 	tests := []struct {
 		name        string
 		boardID     uuid.UUID
+		userID      uuid.UUID
 		mockBoard   func(*MockBoardRepository)
+		mockProject func(*MockProjectRepository)
 		wantErr     bool
 		wantErrCode string
 	}{
 		{
-			name:    "성공: Board 삭제",
-			boardID: boardID,
-			mockBoard: func(m *MockBoardRepository) {
-				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
-					return &domain.Board{
-						BaseModel: domain.BaseModel{ID: boardID},
-					}, nil
-				}
-				m.DeleteFunc = func(ctx context.Context, id uuid.UUID) error {
-					return nil
-				}
-			},
-			wantErr: false,
+			name:      "성공: 작성자가 Board 삭제",
+			boardID:   boardID,
+			userID:    authorID,
+			mockBoard: existingBoard,
+			wantErr:   false,
+		},
+		{
+			name:        "성공: 프로젝트 ADMIN이 다른 사람의 Board 삭제",
+			boardID:     boardID,
+			userID:      otherUserID,
+			mockBoard:   existingBoard,
+			mockProject: memberWithRole(domain.ProjectRoleAdmin),
+			wantErr:     false,
+		},
+		{
+			name:        "실패: 프로젝트 MEMBER는 다른 사람의 Board 삭제 불가",
+			boardID:     boardID,
+			userID:      otherUserID,
+			mockBoard:   existingBoard,
+			mockProject: memberWithRole(domain.ProjectRoleMember),
+			wantErr:     true,
+			wantErrCode: response.ErrCodeForbidden,
 		},
 		{
 			name:    "실패: Board가 존재하지 않음",
 			boardID: boardID,
+			userID:  authorID,
 			mockBoard: func(m *MockBoardRepository) {
 				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
 					return nil, gorm.ErrRecordNotFound
@@ -344,13 +379,16 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 			mockFieldOptionRepo := &MockFieldOptionRepository{}
 			mockConverter := &MockFieldOptionConverter{}
 			tt.mockBoard(mockBoardRepo)
+			if tt.mockProject != nil {
+				tt.mockProject(mockProjectRepo)
+			}
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			// When
-			err := service.DeleteBoard(context.Background(), tt.boardID)
+			err := service.DeleteBoard(context.Background(), tt.boardID, tt.userID)
 
 			// Then
 			if tt.wantErr {
@@ -491,7 +529,7 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.GetBoardsByProject(context.Background(), projectID, nil)
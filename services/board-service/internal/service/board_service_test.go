@@ -11,6 +11,9 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+
+	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -153,7 +156,7 @@ func TestBoardService_CreateBoard(t *testing.T) {
 
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			// When
 			got, err := service.CreateBoard(tt.ctx, tt.req)
@@ -253,7 +256,7 @@ func TestBoardService_CreateBoard_CustomFields(t *testing.T) {
 			mockConverter := &MockFieldOptionConverter{}
 			mockParticipantRepo := &MockParticipantRepository{}
 			logger, _ := zap.NewDevelopment()
-			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, mockConverter, nil, nil, logger)
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, mockParticipantRepo, &MockAttachmentRepository{}, nil, nil, mockConverter, nil, nil, nil, nil, logger)
 
 			req := &dto.CreateBoardRequest{
 				ProjectID:    projectID,
@@ -307,3 +310,56 @@ func TestBoardService_CreateBoard_CustomFields(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardService_AssigneeOutOfOffice(t *testing.T) {
+	assigneeID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		availability *client.UserAvailability
+		err          error
+		wantNotice   bool
+	}{
+		{
+			name: "away assignee",
+			availability: &client.UserAvailability{UserID: assigneeID, OutOfOffice: &commonclient.OutOfOffice{
+				StartAt: now.Add(-time.Hour), EndAt: now.Add(24 * time.Hour), Message: "On vacation",
+			}},
+			wantNotice: true,
+		},
+		{
+			name: "period not started",
+			availability: &client.UserAvailability{UserID: assigneeID, OutOfOffice: &commonclient.OutOfOffice{
+				StartAt: now.Add(24 * time.Hour), EndAt: now.Add(48 * time.Hour),
+			}},
+		},
+		{name: "no out-of-office", availability: &client.UserAvailability{UserID: assigneeID}},
+		{name: "lookup failure", err: errors.New("user-service unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userClient := &MockUserClient{
+				GetUserAvailabilityFunc: func(ctx context.Context, userID uuid.UUID) (*client.UserAvailability, error) {
+					return tt.availability, tt.err
+				},
+			}
+			service := NewBoardService(&MockBoardRepository{}, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, nil, &MockFieldOptionConverter{}, nil, userClient, nil, nil, zap.NewNop()).(*boardServiceImpl)
+
+			notice := service.assigneeOutOfOffice(context.Background(), assigneeID)
+			if !tt.wantNotice {
+				if notice != nil {
+					t.Errorf("assigneeOutOfOffice() = %+v, want nil", notice)
+				}
+				return
+			}
+			if notice == nil {
+				t.Fatal("assigneeOutOfOffice() = nil, want notice")
+			}
+			if notice.UserID != assigneeID || notice.Message != "On vacation" {
+				t.Errorf("assigneeOutOfOffice() = %+v", notice)
+			}
+		})
+	}
+}
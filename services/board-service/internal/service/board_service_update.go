@@ -132,6 +132,7 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 				"Failed to confirm attachments: "+err.Error(),
 				"Please ensure all attachment IDs are valid and not already used")
 		}
+		s.linkAttachments(ctx, board.ProjectID, req.AttachmentIDs)
 	}
 
 	// ✅ [수정] Participants 업데이트 로직 - board 업데이트 후 처리
@@ -273,7 +274,11 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 	}
 
 	// Convert to response DTO
-	return s.toBoardResponseWithWorkspace(ctx, board), nil
+	resp := s.toBoardResponseWithWorkspace(ctx, board)
+	if s.isAssigneeChanged(originalAssigneeID, board.AssigneeID) && board.AssigneeID != nil {
+		resp.AssigneeOutOfOffice = s.assigneeOutOfOffice(ctx, *board.AssigneeID)
+	}
+	return resp, nil
 }
 
 // DeleteBoard soft deletes a board and its associated attachments
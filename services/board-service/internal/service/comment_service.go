@@ -25,13 +25,14 @@ type CommentService interface {
 
 // commentServiceImpl is the implementation of CommentService
 type commentServiceImpl struct {
-	commentRepo    repository.CommentRepository
-	boardRepo      repository.BoardRepository
-	projectRepo    repository.ProjectRepository
-	attachmentRepo repository.AttachmentRepository
-	s3Client       S3Client
-	notiClient     client.NotiClient
-	logger         *zap.Logger
+	commentRepo      repository.CommentRepository
+	boardRepo        repository.BoardRepository
+	projectRepo      repository.ProjectRepository
+	attachmentRepo   repository.AttachmentRepository
+	attachmentLinker AttachmentLinker
+	s3Client         S3Client
+	notiClient       client.NotiClient
+	logger           *zap.Logger
 }
 
 // NewCommentService creates a new instance of CommentService
@@ -40,18 +41,20 @@ func NewCommentService(
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
 	attachmentRepo repository.AttachmentRepository,
+	attachmentLinker AttachmentLinker,
 	s3Client S3Client,
 	notiClient client.NotiClient,
 	logger *zap.Logger,
 ) CommentService {
 	return &commentServiceImpl{
-		commentRepo:    commentRepo,
-		boardRepo:      boardRepo,
-		projectRepo:    projectRepo,
-		attachmentRepo: attachmentRepo,
-		s3Client:       s3Client,
-		notiClient:     notiClient,
-		logger:         logger,
+		commentRepo:      commentRepo,
+		boardRepo:        boardRepo,
+		projectRepo:      projectRepo,
+		attachmentRepo:   attachmentRepo,
+		attachmentLinker: attachmentLinker,
+		s3Client:         s3Client,
+		notiClient:       notiClient,
+		logger:           logger,
 	}
 }
 
@@ -116,6 +119,8 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 				"Please ensure all attachment IDs are valid and not already used")
 		}
 
+		s.linkAttachments(ctx, board.ProjectID, validAttachmentIDs)
+
 		// Confirm 후 Attachments 메타데이터를 조회하여 comment 객체에 할당
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, validAttachmentIDs)
 		if err != nil {
@@ -218,6 +223,11 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 				"Failed to confirm attachments: "+err.Error(),
 				"Please ensure all attachment IDs are valid and not already used")
 		}
+		if s.attachmentLinker != nil {
+			if board, err := s.boardRepo.FindByID(ctx, comment.BoardID); err == nil {
+				s.linkAttachments(ctx, board.ProjectID, validAttachmentIDs)
+			}
+		}
 	}
 
 	// comment와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
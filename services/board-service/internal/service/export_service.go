@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// ExportService defines the interface for collecting the board data of a user.
+// It is called by the user-service personal data export job.
+type ExportService interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID) (*dto.UserDataExportResponse, error)
+}
+
+// exportServiceImpl is the implementation of ExportService
+type exportServiceImpl struct {
+	exportRepo repository.ExportRepository
+	logger     *zap.Logger
+}
+
+// NewExportService creates a new instance of ExportService
+func NewExportService(exportRepo repository.ExportRepository, logger *zap.Logger) ExportService {
+	return &exportServiceImpl{
+		exportRepo: exportRepo,
+		logger:     logger,
+	}
+}
+
+// ExportUserData collects the project memberships, authored boards and comments of a user
+func (s *exportServiceImpl) ExportUserData(ctx context.Context, userID uuid.UUID) (*dto.UserDataExportResponse, error) {
+	members, err := s.exportRepo.FindProjectMemberships(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find project memberships: %w", err)
+	}
+	boards, err := s.exportRepo.FindBoardsByAuthor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find boards: %w", err)
+	}
+	comments, err := s.exportRepo.FindCommentsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comments: %w", err)
+	}
+
+	result := &dto.UserDataExportResponse{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+		Projects:   make([]dto.ExportedProjectMember, 0, len(members)),
+		Boards:     make([]dto.ExportedBoard, 0, len(boards)),
+		Comments:   make([]dto.ExportedComment, 0, len(comments)),
+	}
+	for _, member := range members {
+		result.Projects = append(result.Projects, dto.ExportedProjectMember{
+			ProjectID:   member.ProjectID,
+			WorkspaceID: member.Project.WorkspaceID,
+			Name:        member.Project.Name,
+			Role:        string(member.RoleName),
+			IsOwner:     member.Project.OwnerID == userID,
+			JoinedAt:    member.JoinedAt,
+		})
+	}
+	for _, board := range boards {
+		result.Boards = append(result.Boards, dto.ExportedBoard{
+			BoardID:      board.ID,
+			ProjectID:    board.ProjectID,
+			Title:        board.Title,
+			Content:      board.Content,
+			CustomFields: board.CustomFields,
+			StartDate:    board.StartDate,
+			DueDate:      board.DueDate,
+			CreatedAt:    board.CreatedAt,
+			UpdatedAt:    board.UpdatedAt,
+		})
+	}
+	for _, comment := range comments {
+		result.Comments = append(result.Comments, dto.ExportedComment{
+			CommentID: comment.ID,
+			BoardID:   comment.BoardID,
+			Content:   comment.Content,
+			CreatedAt: comment.CreatedAt,
+			UpdatedAt: comment.UpdatedAt,
+		})
+	}
+
+	s.logger.Info("User board data exported",
+		zap.String("user_id", userID.String()),
+		zap.Int("projects", len(result.Projects)),
+		zap.Int("boards", len(result.Boards)),
+		zap.Int("comments", len(result.Comments)))
+	return result, nil
+}
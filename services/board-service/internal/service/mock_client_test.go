@@ -53,9 +53,12 @@ func (m *MockFieldOptionConverter) ConvertIDsToValuesBatch(ctx context.Context,
 // MockUserClient is a mock implementation of UserClient
 type MockUserClient struct {
 	ValidateWorkspaceMemberFunc func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+	GetWorkspaceAccessFunc      func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceValidationResponse, error)
 	GetUserProfileFunc          func(ctx context.Context, userID uuid.UUID, token string) (*client.UserProfile, error)
 	GetWorkspaceProfileFunc     func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceProfile, error)
+	GetWorkspaceProfilesFunc    func(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*client.WorkspaceProfile, error)
 	GetWorkspaceFunc            func(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error)
+	GetUserAvailabilityFunc     func(ctx context.Context, userID uuid.UUID) (*client.UserAvailability, error)
 	ValidateTokenFunc           func(ctx context.Context, token string) (uuid.UUID, error)
 }
 
@@ -66,6 +69,18 @@ func (m *MockUserClient) ValidateWorkspaceMember(ctx context.Context, workspaceI
 	return true, nil
 }
 
+// GetWorkspaceAccess falls back to ValidateWorkspaceMember so existing membership mocks keep working
+func (m *MockUserClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceValidationResponse, error) {
+	if m.GetWorkspaceAccessFunc != nil {
+		return m.GetWorkspaceAccessFunc(ctx, workspaceID, userID, token)
+	}
+	isMember, err := m.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+	if err != nil {
+		return nil, err
+	}
+	return &client.WorkspaceValidationResponse{IsMember: isMember}, nil
+}
+
 func (m *MockUserClient) GetUserProfile(ctx context.Context, userID uuid.UUID, token string) (*client.UserProfile, error) {
 	if m.GetUserProfileFunc != nil {
 		return m.GetUserProfileFunc(ctx, userID, token)
@@ -85,6 +100,21 @@ func (m *MockUserClient) GetWorkspaceProfile(ctx context.Context, workspaceID, u
 	}, nil
 }
 
+func (m *MockUserClient) GetWorkspaceProfiles(ctx context.Context, workspaceID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]*client.WorkspaceProfile, error) {
+	if m.GetWorkspaceProfilesFunc != nil {
+		return m.GetWorkspaceProfilesFunc(ctx, workspaceID, userIDs)
+	}
+	// Default: resolve each user through GetWorkspaceProfile so existing expectations keep working
+	profiles := make(map[uuid.UUID]*client.WorkspaceProfile, len(userIDs))
+	for _, userID := range userIDs {
+		profile, err := m.GetWorkspaceProfile(ctx, workspaceID, userID, "")
+		if err == nil && profile != nil {
+			profiles[userID] = profile
+		}
+	}
+	return profiles, nil
+}
+
 func (m *MockUserClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID, token string) (*client.Workspace, error) {
 	if m.GetWorkspaceFunc != nil {
 		return m.GetWorkspaceFunc(ctx, workspaceID, token)
@@ -96,6 +126,13 @@ func (m *MockUserClient) GetWorkspace(ctx context.Context, workspaceID uuid.UUID
 	}, nil
 }
 
+func (m *MockUserClient) GetUserAvailability(ctx context.Context, userID uuid.UUID) (*client.UserAvailability, error) {
+	if m.GetUserAvailabilityFunc != nil {
+		return m.GetUserAvailabilityFunc(ctx, userID)
+	}
+	return &client.UserAvailability{UserID: userID}, nil
+}
+
 func (m *MockUserClient) ValidateToken(ctx context.Context, token string) (uuid.UUID, error) {
 	if m.ValidateTokenFunc != nil {
 		return m.ValidateTokenFunc(ctx, token)
@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
@@ -26,13 +28,15 @@ type ProjectJoinRequestService interface {
 type projectJoinRequestServiceImpl struct {
 	projectRepo repository.ProjectRepository
 	userClient  client.UserClient
+	permissions *permission.Resolver // workspace permissions incl. custom roles (nil uses project roles only)
 }
 
 // NewProjectJoinRequestService creates a new instance of ProjectJoinRequestService
-func NewProjectJoinRequestService(projectRepo repository.ProjectRepository, userClient client.UserClient) ProjectJoinRequestService {
+func NewProjectJoinRequestService(projectRepo repository.ProjectRepository, userClient client.UserClient, permissions *permission.Resolver) ProjectJoinRequestService {
 	return &projectJoinRequestServiceImpl{
 		projectRepo: projectRepo,
 		userClient:  userClient,
+		permissions: permissions,
 	}
 }
 
@@ -108,7 +112,7 @@ func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, pro
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, requesterMember, permission.ProjectJoinRequestApprove) {
 		return nil, response.NewForbiddenError("Only project owner or admin can view join requests", "")
 	}
 
@@ -134,6 +138,14 @@ func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, pro
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch join requests", err.Error())
 	}
 
+	// Fetch workspace profiles for all requesters in one batch request
+	userIDs := make([]uuid.UUID, len(requests))
+	for i, request := range requests {
+		userIDs[i] = request.UserID
+	}
+	// Graceful degradation: requests without a resolved profile are returned without user details
+	profiles, _ := s.userClient.GetWorkspaceProfiles(ctx, project.WorkspaceID, userIDs)
+
 	// Convert to response DTOs with user profile information
 	responses := make([]*dto.ProjectJoinRequestResponse, len(requests))
 	for i, request := range requests {
@@ -146,13 +158,10 @@ func (s *projectJoinRequestServiceImpl) GetJoinRequests(ctx context.Context, pro
 			UpdatedAt:   request.UpdatedAt,
 		}
 
-		// Fetch workspace profile for requester
-		profile, err := s.userClient.GetWorkspaceProfile(ctx, project.WorkspaceID, request.UserID, token)
-		if err == nil && profile != nil {
+		if profile, ok := profiles[request.UserID]; ok && profile != nil {
 			responses[i].UserEmail = profile.Email
 			responses[i].UserName = profile.NickName
 		}
-		// Graceful degradation: if profile fetch fails, continue without user details
 	}
 
 	return responses, nil
@@ -188,7 +197,7 @@ func (s *projectJoinRequestServiceImpl) UpdateJoinRequest(ctx context.Context, r
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, requesterMember, permission.ProjectJoinRequestApprove) {
 		return nil, response.NewForbiddenError("Only project owner or admin can update join requests", "")
 	}
 
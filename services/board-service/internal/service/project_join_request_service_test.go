@@ -187,7 +187,7 @@ func TestProjectJoinRequestService_CreateJoinRequest(t *testing.T) {
 			tt.mockRepo(mockRepo)
 			tt.mockClient(mockClient)
 
-			service := NewProjectJoinRequestService(mockRepo, mockClient)
+			service := NewProjectJoinRequestService(mockRepo, mockClient, nil)
 			got, err := service.CreateJoinRequest(context.Background(), projectID, userID, token)
 
 			if tt.wantErr {
@@ -387,7 +387,7 @@ func TestProjectJoinRequestService_GetJoinRequests(t *testing.T) {
 			tt.mockRepo(mockRepo)
 			tt.mockClient(mockClient)
 
-			service := NewProjectJoinRequestService(mockRepo, mockClient)
+			service := NewProjectJoinRequestService(mockRepo, mockClient, nil)
 			got, err := service.GetJoinRequests(context.Background(), projectID, userID, tt.status, token)
 
 			if tt.wantErr {
@@ -651,7 +651,7 @@ func TestProjectJoinRequestService_UpdateJoinRequest(t *testing.T) {
 				}
 			}
 
-			service := NewProjectJoinRequestService(mockRepo, &MockUserClient{})
+			service := NewProjectJoinRequestService(mockRepo, &MockUserClient{}, nil)
 			got, err := service.UpdateJoinRequest(context.Background(), requestID, requesterID, tt.status, token)
 
 			if tt.wantErr {
@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
@@ -25,13 +27,15 @@ type ProjectMemberService interface {
 type projectMemberServiceImpl struct {
 	projectRepo repository.ProjectRepository
 	userClient  client.UserClient
+	permissions *permission.Resolver // workspace permissions incl. custom roles (nil uses project roles only)
 }
 
 // NewProjectMemberService creates a new instance of ProjectMemberService
-func NewProjectMemberService(projectRepo repository.ProjectRepository, userClient client.UserClient) ProjectMemberService {
+func NewProjectMemberService(projectRepo repository.ProjectRepository, userClient client.UserClient, permissions *permission.Resolver) ProjectMemberService {
 	return &projectMemberServiceImpl{
 		projectRepo: projectRepo,
 		userClient:  userClient,
+		permissions: permissions,
 	}
 }
 
@@ -61,6 +65,14 @@ func (s *projectMemberServiceImpl) GetMembers(ctx context.Context, projectID, us
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch members", err.Error())
 	}
 
+	// Fetch workspace profiles for all members in one batch request
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	// Graceful degradation: members without a resolved profile are returned without user details
+	profiles, _ := s.userClient.GetWorkspaceProfiles(ctx, project.WorkspaceID, userIDs)
+
 	// Convert to response DTOs with user profile information
 	responses := make([]*dto.ProjectMemberResponse, len(members))
 	for i, member := range members {
@@ -72,13 +84,10 @@ func (s *projectMemberServiceImpl) GetMembers(ctx context.Context, projectID, us
 			JoinedAt:  member.JoinedAt,
 		}
 
-		// Fetch workspace profile for member
-		profile, err := s.userClient.GetWorkspaceProfile(ctx, project.WorkspaceID, member.UserID, token)
-		if err == nil && profile != nil {
+		if profile, ok := profiles[member.UserID]; ok && profile != nil {
 			responses[i].UserEmail = profile.Email
 			responses[i].UserName = profile.NickName
 		}
-		// Graceful degradation: if profile fetch fails, continue without user details
 	}
 
 	return responses, nil
@@ -94,7 +103,7 @@ func (s *projectMemberServiceImpl) RemoveMember(ctx context.Context, projectID,
 		}
 		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner && requesterMember.RoleName != domain.ProjectRoleAdmin {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, requesterMember, permission.ProjectMemberManage) {
 		return response.NewForbiddenError("Only project owner or admin can remove members", "")
 	}
 
@@ -135,7 +144,7 @@ func (s *projectMemberServiceImpl) UpdateMemberRole(ctx context.Context, project
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if requesterMember.RoleName != domain.ProjectRoleOwner {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, requesterMember, permission.ProjectMemberRoleUpdate) {
 		return nil, response.NewForbiddenError("Only project owner can change member roles", "")
 	}
 
@@ -43,14 +43,14 @@ func TestProjectMemberService_GetMembers(t *testing.T) {
 				m.FindMembersByProjectIDFunc = func(ctx context.Context, pID uuid.UUID) ([]*domain.ProjectMember, error) {
 					return []*domain.ProjectMember{
 						{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    userID,
 							RoleName:  domain.ProjectRoleOwner,
 							JoinedAt:  time.Now(),
 						},
 						{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uuid.New(),
 							RoleName:  domain.ProjectRoleMember,
@@ -126,7 +126,7 @@ func TestProjectMemberService_GetMembers(t *testing.T) {
 			tt.mockRepo(mockRepo)
 			tt.mockClient(mockClient)
 
-			service := NewProjectMemberService(mockRepo, mockClient)
+			service := NewProjectMemberService(mockRepo, mockClient, nil)
 			got, err := service.GetMembers(context.Background(), projectID, userID, token)
 
 			if tt.wantErr {
@@ -171,14 +171,14 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					if uID == requesterID {
 						return &domain.ProjectMember{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uID,
 							RoleName:  domain.ProjectRoleOwner,
 						}, nil
 					}
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleMember,
@@ -197,14 +197,14 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					if uID == requesterID {
 						return &domain.ProjectMember{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uID,
 							RoleName:  domain.ProjectRoleAdmin,
 						}, nil
 					}
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleMember,
@@ -233,7 +233,7 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 			mockRepo: func(m *MockProjectRepository) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleMember,
@@ -250,14 +250,14 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					if uID == requesterID {
 						return &domain.ProjectMember{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uID,
 							RoleName:  domain.ProjectRoleOwner,
 						}, nil
 					}
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleOwner, // Target is also owner
@@ -273,7 +273,7 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 			mockRepo: func(m *MockProjectRepository) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleOwner,
@@ -290,7 +290,7 @@ func TestProjectMemberService_RemoveMember(t *testing.T) {
 			mockRepo := &MockProjectRepository{}
 			tt.mockRepo(mockRepo)
 
-			service := NewProjectMemberService(mockRepo, &MockUserClient{})
+			service := NewProjectMemberService(mockRepo, &MockUserClient{}, nil)
 			err := service.RemoveMember(context.Background(), projectID, requesterID, tt.memberID)
 
 			if tt.wantErr {
@@ -331,14 +331,14 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					if uID == requesterID {
 						return &domain.ProjectMember{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uID,
 							RoleName:  domain.ProjectRoleOwner,
 						}, nil
 					}
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleMember,
@@ -356,7 +356,7 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 			mockRepo: func(m *MockProjectRepository) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleAdmin, // Not owner
@@ -372,7 +372,7 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 			mockRepo: func(m *MockProjectRepository) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleOwner,
@@ -389,14 +389,14 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 				m.FindMemberByProjectAndUserFunc = func(ctx context.Context, pID, uID uuid.UUID) (*domain.ProjectMember, error) {
 					if uID == requesterID {
 						return &domain.ProjectMember{
-							ID: uuid.New(),
+							ID:        uuid.New(),
 							ProjectID: pID,
 							UserID:    uID,
 							RoleName:  domain.ProjectRoleOwner,
 						}, nil
 					}
 					return &domain.ProjectMember{
-						ID: uuid.New(),
+						ID:        uuid.New(),
 						ProjectID: pID,
 						UserID:    uID,
 						RoleName:  domain.ProjectRoleOwner, // Target is owner
@@ -413,7 +413,7 @@ func TestProjectMemberService_UpdateMemberRole(t *testing.T) {
 			mockRepo := &MockProjectRepository{}
 			tt.mockRepo(mockRepo)
 
-			service := NewProjectMemberService(mockRepo, &MockUserClient{})
+			service := NewProjectMemberService(mockRepo, &MockUserClient{}, nil)
 			_, err := service.UpdateMemberRole(context.Background(), projectID, requesterID, memberID, tt.role)
 
 			if tt.wantErr {
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// hasProjectPermission reports whether a project member holds p.
+// The project role is checked first; otherwise the member's workspace role, which may be
+// a custom role, is resolved through user-service. If user-service is unavailable only
+// the project role counts.
+func hasProjectPermission(ctx context.Context, projectRepo repository.ProjectRepository, permissions *permission.Resolver, member *domain.ProjectMember, p permission.Permission) bool {
+	if member.RoleName.Has(p) {
+		return true
+	}
+	if permissions == nil {
+		return false
+	}
+	project, err := projectRepo.FindByID(ctx, member.ProjectID)
+	if err != nil {
+		return false
+	}
+	return permissions.Allows(ctx, project.WorkspaceID, member.UserID, p, false)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"project-board-api/internal/domain"
+)
+
+// workspacePermissionChecker grants a fixed set of workspace permissions
+type workspacePermissionChecker struct {
+	perms permission.Set
+	err   error
+	calls int
+}
+
+func (c *workspacePermissionChecker) Can(ctx context.Context, workspaceID, userID uuid.UUID, p permission.Permission) (bool, error) {
+	c.calls++
+	if c.err != nil {
+		return false, c.err
+	}
+	return c.perms.Has(p), nil
+}
+
+func TestHasProjectPermission(t *testing.T) {
+	workspaceID := uuid.New()
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{WorkspaceID: workspaceID}, nil
+		},
+	}
+
+	tests := []struct {
+		name      string
+		role      domain.ProjectRole
+		checker   *workspacePermissionChecker
+		perm      permission.Permission
+		want      bool
+		wantCalls int
+	}{
+		{"project role grants without asking user-service", domain.ProjectRoleOwner, &workspacePermissionChecker{}, permission.ProjectUpdate, true, 0},
+		{"custom workspace role grants", domain.ProjectRoleMember, &workspacePermissionChecker{perms: permission.NewSet(permission.ProjectUpdate)}, permission.ProjectUpdate, true, 1},
+		{"neither grants", domain.ProjectRoleMember, &workspacePermissionChecker{perms: permission.NewSet(permission.BoardCreate)}, permission.ProjectUpdate, false, 1},
+		{"user-service down falls back to project role", domain.ProjectRoleMember, &workspacePermissionChecker{err: errors.New("unavailable")}, permission.ProjectDelete, false, 1},
+		{"no resolver uses project role only", domain.ProjectRoleAdmin, nil, permission.ProjectMemberManage, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resolver *permission.Resolver
+			if tt.checker != nil {
+				resolver = permission.NewResolver(tt.checker, zap.NewNop())
+			}
+			member := &domain.ProjectMember{ProjectID: uuid.New(), UserID: uuid.New(), RoleName: tt.role}
+
+			got := hasProjectPermission(context.Background(), projectRepo, resolver, member, tt.perm)
+			if got != tt.want {
+				t.Errorf("hasProjectPermission() = %v, want %v", got, tt.want)
+			}
+			if tt.checker != nil && tt.checker.calls != tt.wantCalls {
+				t.Errorf("checker called %d times, want %d", tt.checker.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
@@ -40,25 +42,29 @@ type ProjectService interface {
 
 // projectServiceImpl is the implementation of ProjectService
 type projectServiceImpl struct {
-	projectRepo     repository.ProjectRepository
-	fieldOptionRepo repository.FieldOptionRepository
-	attachmentRepo  repository.AttachmentRepository
-	s3Client        S3Client // 이 타입 정의가 상단에 추가되었습니다.
-	userClient      client.UserClient
-	metrics         *metrics.Metrics
-	logger          *zap.Logger
+	projectRepo      repository.ProjectRepository
+	fieldOptionRepo  repository.FieldOptionRepository
+	attachmentRepo   repository.AttachmentRepository
+	attachmentLinker AttachmentLinker
+	s3Client         S3Client // 이 타입 정의가 상단에 추가되었습니다.
+	userClient       client.UserClient
+	permissions      *permission.Resolver // workspace permissions incl. custom roles (nil uses project roles only)
+	metrics          *metrics.Metrics
+	logger           *zap.Logger
 }
 
 // NewProjectService creates a new instance of ProjectService
-func NewProjectService(projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, attachmentRepo repository.AttachmentRepository, s3Client S3Client, userClient client.UserClient, m *metrics.Metrics, logger *zap.Logger) ProjectService {
+func NewProjectService(projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, attachmentRepo repository.AttachmentRepository, attachmentLinker AttachmentLinker, s3Client S3Client, userClient client.UserClient, permissions *permission.Resolver, m *metrics.Metrics, logger *zap.Logger) ProjectService {
 	return &projectServiceImpl{
-		projectRepo:     projectRepo,
-		fieldOptionRepo: fieldOptionRepo,
-		attachmentRepo:  attachmentRepo,
-		s3Client:        s3Client,
-		userClient:      userClient,
-		metrics:         m,
-		logger:          logger,
+		projectRepo:      projectRepo,
+		fieldOptionRepo:  fieldOptionRepo,
+		attachmentRepo:   attachmentRepo,
+		attachmentLinker: attachmentLinker,
+		s3Client:         s3Client,
+		userClient:       userClient,
+		permissions:      permissions,
+		metrics:          m,
+		logger:           logger,
 	}
 }
 
@@ -133,6 +139,8 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 				"Please ensure all attachment IDs are valid and not already used")
 		}
 
+		s.linkAttachments(ctx, project.ID, req.AttachmentIDs)
+
 		// 💡 [수정] Confirm 후 Attachments 메타데이터를 조회하여 project 객체에 할당
 		// FindByIDs는 []*domain.Attachment를 반환한다고 가정합니다.
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
@@ -171,16 +179,17 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 	return s.toProjectResponse(project), nil
 }
 
-// GetProjectsByWorkspace retrieves all projects for a workspace
+// GetProjectsByWorkspace retrieves all projects for a workspace.
+// Guests only see the projects shared with them.
 func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]*dto.ProjectResponse, error) {
 	// Validate workspace membership
-	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
+	access, err := s.userClient.GetWorkspaceAccess(ctx, workspaceID, userID, token)
 	if err != nil {
 		// Log error but continue with graceful degradation
 		// Return forbidden error if validation explicitly fails
 		return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
 	}
-	if !isValid {
+	if !access.IsWorkspaceMember() && !access.IsGuest {
 		return nil, response.NewAppError(response.ErrCodeForbidden, "You are not a member of this workspace", "")
 	}
 
@@ -190,6 +199,17 @@ func (s *projectServiceImpl) GetProjectsByWorkspace(ctx context.Context, workspa
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch projects", err.Error())
 	}
 
+	// 게스트는 공유받은 프로젝트만 조회 (공개 프로젝트 포함 나머지는 제외)
+	if !access.IsWorkspaceMember() {
+		shared := make([]*domain.Project, 0, len(access.GuestProjectIDs))
+		for _, project := range projects {
+			if project != nil && access.CanAccessProject(project.ID) {
+				shared = append(shared, project)
+			}
+		}
+		projects = shared
+	}
+
 	// 빈 배열 명시적 처리 - nil이거나 길이가 0이면 빈 배열 반환
 	if projects == nil || len(projects) == 0 {
 		return []*dto.ProjectResponse{}, nil
@@ -283,7 +303,7 @@ func (s *projectServiceImpl) GetProject(ctx context.Context, projectID, userID u
 			zap.String("user_id", userID.String()),
 		)
 
-		isWorkspaceMember, err := s.userClient.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, token)
+		access, err := s.userClient.GetWorkspaceAccess(ctx, project.WorkspaceID, userID, token)
 		if err != nil {
 			s.logger.Error("Failed to validate workspace membership",
 				zap.Error(err),
@@ -294,11 +314,13 @@ func (s *projectServiceImpl) GetProject(ctx context.Context, projectID, userID u
 			return nil, response.NewForbiddenError("You are not a member of this project or workspace", "")
 		}
 
-		if !isWorkspaceMember {
+		// 게스트는 공유받은 프로젝트에만 접근 가능
+		if !access.CanAccessProject(projectID) {
 			s.logger.Warn("Access denied: user is neither project member nor workspace member",
 				zap.String("project_id", projectID.String()),
 				zap.String("workspace_id", project.WorkspaceID.String()),
 				zap.String("user_id", userID.String()),
+				zap.Bool("is_guest", access.IsGuest),
 			)
 			return nil, response.NewForbiddenError("You are not a member of this project or workspace", "")
 		}
@@ -307,7 +329,7 @@ func (s *projectServiceImpl) GetProject(ctx context.Context, projectID, userID u
 		// Note: This allows workspace members to access all projects in their workspace
 		// until project-level permission management is implemented
 		s.logger.Info("Access granted via workspace membership",
-			zap.String("access_type", "workspace_member"),
+			zap.String("access_type", workspaceAccessType(access)),
 			zap.String("project_id", projectID.String()),
 			zap.String("workspace_id", project.WorkspaceID.String()),
 			zap.String("user_id", userID.String()),
@@ -352,7 +374,7 @@ func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID, userI
 		}
 		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if member.RoleName != domain.ProjectRoleOwner {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, member, permission.ProjectDelete) {
 		return response.NewForbiddenError("Only project owner can delete project", "")
 	}
 
@@ -382,7 +404,8 @@ func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID, userI
 	return nil
 }
 
-// SearchProjects searches projects by name or description with workspace membership validation
+// SearchProjects searches projects by name or description with workspace membership validation.
+// Guests are not workspace members and cannot search.
 func (s *projectServiceImpl) SearchProjects(ctx context.Context, workspaceID, userID uuid.UUID, query string, page, limit int, token string) (*dto.PaginatedProjectsResponse, error) {
 	// Validate workspace membership
 	isValid, err := s.userClient.ValidateWorkspaceMember(ctx, workspaceID, userID, token)
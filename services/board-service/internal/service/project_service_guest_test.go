@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// newGuestProjectService builds a project service whose user client reports the caller as a guest
+func newGuestProjectService(projects []*domain.Project, sharedProjectIDs []uuid.UUID) ProjectService {
+	byID := make(map[uuid.UUID]*domain.Project, len(projects))
+	for _, project := range projects {
+		byID[project.ID] = project
+	}
+
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return byID[id], nil
+		},
+		FindByWorkspaceIDFunc: func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
+			return projects, nil
+		},
+	}
+	userClient := &MockUserClient{
+		GetWorkspaceAccessFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*client.WorkspaceValidationResponse, error) {
+			return &client.WorkspaceValidationResponse{IsGuest: true, GuestProjectIDs: sharedProjectIDs}, nil
+		},
+		ValidateWorkspaceMemberFunc: func(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+			return false, nil
+		},
+	}
+	return NewProjectService(projectRepo, &MockFieldOptionRepository{}, &MockAttachmentRepository{}, nil, &MockS3Client{}, userClient, nil, nil, zap.NewNop())
+}
+
+func TestProjectService_GuestAccess(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	guestID := uuid.New()
+	shared := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, Name: "Shared"}
+	public := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: workspaceID, Name: "Public", IsPublic: true}
+
+	service := newGuestProjectService([]*domain.Project{shared, public}, []uuid.UUID{shared.ID})
+
+	t.Run("Listing only contains shared projects", func(t *testing.T) {
+		projects, err := service.GetProjectsByWorkspace(ctx, workspaceID, guestID, "token")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(projects) != 1 || projects[0].ID != shared.ID {
+			t.Fatalf("Expected only the shared project, got %d projects", len(projects))
+		}
+	})
+
+	t.Run("Shared project is accessible", func(t *testing.T) {
+		project, err := service.GetProject(ctx, shared.ID, guestID, "token")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if project.ID != shared.ID {
+			t.Errorf("Expected project %s, got %s", shared.ID, project.ID)
+		}
+	})
+
+	t.Run("Public project is forbidden", func(t *testing.T) {
+		_, err := service.GetProject(ctx, public.ID, guestID, "token")
+		appErr, ok := err.(*response.AppError)
+		if !ok {
+			t.Fatalf("Expected AppError, got %T", err)
+		}
+		if appErr.Code != response.ErrCodeForbidden {
+			t.Errorf("Expected forbidden error, got %s", appErr.Code)
+		}
+	})
+
+	t.Run("Search is forbidden", func(t *testing.T) {
+		_, err := service.SearchProjects(ctx, workspaceID, guestID, "Shared", 1, 10, "token")
+		appErr, ok := err.(*response.AppError)
+		if !ok {
+			t.Fatalf("Expected AppError, got %T", err)
+		}
+		if appErr.Code != response.ErrCodeForbidden {
+			t.Errorf("Expected forbidden error, got %s", appErr.Code)
+		}
+	})
+}
@@ -7,11 +7,20 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
 )
 
+// workspaceAccessType labels how a non-project-member was granted access, for audit logs
+func workspaceAccessType(access *client.WorkspaceValidationResponse) string {
+	if access.IsWorkspaceMember() {
+		return "workspace_member"
+	}
+	return "guest"
+}
+
 func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.ProjectResponse {
 	// Convert attachments to response DTOs
 	attachments := make([]dto.AttachmentResponse, 0, len(project.Attachments))
@@ -39,7 +39,7 @@ func (s *projectServiceImpl) GetProjectInitSettings(ctx context.Context, project
 			zap.String("user_id", userID.String()),
 		)
 
-		isWorkspaceMember, err := s.userClient.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, token)
+		access, err := s.userClient.GetWorkspaceAccess(ctx, project.WorkspaceID, userID, token)
 		if err != nil {
 			s.logger.Error("Failed to validate workspace membership for init settings",
 				zap.Error(err),
@@ -50,11 +50,13 @@ func (s *projectServiceImpl) GetProjectInitSettings(ctx context.Context, project
 			return nil, response.NewForbiddenError("You are not a member of this project or workspace", "")
 		}
 
-		if !isWorkspaceMember {
+		// 게스트는 공유받은 프로젝트에만 접근 가능
+		if !access.CanAccessProject(projectID) {
 			s.logger.Warn("Access denied to init settings: user is neither project member nor workspace member",
 				zap.String("project_id", projectID.String()),
 				zap.String("workspace_id", project.WorkspaceID.String()),
 				zap.String("user_id", userID.String()),
+				zap.Bool("is_guest", access.IsGuest),
 			)
 			return nil, response.NewForbiddenError("You are not a member of this project or workspace", "")
 		}
@@ -63,7 +65,7 @@ func (s *projectServiceImpl) GetProjectInitSettings(ctx context.Context, project
 		// Note: This allows workspace members to access all projects in their workspace
 		// until project-level permission management is implemented
 		s.logger.Info("Access granted to init settings via workspace membership",
-			zap.String("access_type", "workspace_member"),
+			zap.String("access_type", workspaceAccessType(access)),
 			zap.String("project_id", projectID.String()),
 			zap.String("workspace_id", project.WorkspaceID.String()),
 			zap.String("user_id", userID.String()),
@@ -8,6 +8,8 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -31,7 +33,7 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
 	}
-	if member.RoleName != domain.ProjectRoleOwner {
+	if !hasProjectPermission(ctx, s.projectRepo, s.permissions, member, permission.ProjectUpdate) {
 		return nil, response.NewForbiddenError("Only project owner can update project", "")
 	}
 
@@ -108,6 +110,7 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 					"Failed to confirm attachments: "+err.Error(),
 					"Please ensure all attachment IDs are valid and not already used")
 			}
+			s.linkAttachments(ctx, project.ID, req.AttachmentIDs)
 		}
 	}
 
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+)
+
+// PurgeService defines the interface for permanently deleting data of deleted workspaces and accounts.
+// It is called by the user-service deletion job after the restore grace period; every operation is idempotent.
+type PurgeService interface {
+	PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (*dto.PurgeWorkspaceResponse, error)
+	PurgeUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// purgeServiceImpl is the implementation of PurgeService
+type purgeServiceImpl struct {
+	purgeRepo repository.PurgeRepository
+	s3Client  S3Client // nil skips attachment object deletion
+	logger    *zap.Logger
+}
+
+// NewPurgeService creates a new instance of PurgeService
+func NewPurgeService(purgeRepo repository.PurgeRepository, s3Client S3Client, logger *zap.Logger) PurgeService {
+	return &purgeServiceImpl{
+		purgeRepo: purgeRepo,
+		s3Client:  s3Client,
+		logger:    logger,
+	}
+}
+
+// PurgeWorkspace deletes attachment objects from S3, then all project data of the workspace.
+// If an S3 deletion fails the rows are kept so the next attempt can find the object again.
+func (s *purgeServiceImpl) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (*dto.PurgeWorkspaceResponse, error) {
+	attachments, err := s.purgeRepo.FindWorkspaceAttachments(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workspace attachments: %w", err)
+	}
+
+	objectsDeleted := 0
+	if s.s3Client != nil {
+		for _, attachment := range attachments {
+			fileKey := extractS3KeyFromURL(attachment.FileURL)
+			if fileKey == "" {
+				continue
+			}
+			if err := s.s3Client.DeleteFile(ctx, fileKey); err != nil {
+				return nil, fmt.Errorf("failed to delete attachment %s: %w", attachment.ID, err)
+			}
+			objectsDeleted++
+		}
+	}
+
+	projectsDeleted, err := s.purgeRepo.PurgeWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge workspace projects: %w", err)
+	}
+
+	s.logger.Info("Workspace board data purged",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.Int64("projects_deleted", projectsDeleted),
+		zap.Int("objects_deleted", objectsDeleted))
+
+	return &dto.PurgeWorkspaceResponse{
+		WorkspaceID:     workspaceID,
+		ProjectsDeleted: projectsDeleted,
+		ObjectsDeleted:  objectsDeleted,
+	}, nil
+}
+
+// PurgeUser deletes the project memberships, join requests and board participations of a user
+func (s *purgeServiceImpl) PurgeUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.purgeRepo.PurgeUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to purge user board data: %w", err)
+	}
+
+	s.logger.Info("User board data purged", zap.String("user_id", userID.String()))
+	return nil
+}
@@ -31,6 +31,7 @@ import (
 
 	_ "chat-service/docs" // Swagger docs import
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -115,13 +116,33 @@ func main() {
 		defer func() { _ = redisClient.Close() }()
 	}
 
+	// Initialize feature flag client (flags serve their defaults without ops-service)
+	var flagClient *featureflag.Client
+	if cfg.FeatureFlags.BaseURL != "" {
+		flagClient = featureflag.NewClient(featureflag.Config{
+			BaseURL:         cfg.FeatureFlags.BaseURL,
+			Environment:     cfg.FeatureFlags.Environment,
+			InternalAPIKey:  cfg.Internal.APIKey,
+			RefreshInterval: cfg.FeatureFlags.RefreshInterval,
+			Redis:           redisClient,
+			Logger:          logger,
+		})
+		flagClient.Start(ctx)
+		defer flagClient.Close()
+		logger.Info("Feature flag client initialized",
+			zap.String("base_url", cfg.FeatureFlags.BaseURL),
+			zap.String("environment", cfg.FeatureFlags.Environment),
+		)
+	}
+
 	// Setup router
 	r := router.Setup(router.RouterConfig{
-		Config:      cfg,
-		DB:          db,
-		RedisClient: redisClient,
-		Logger:      logger,
-		ServiceName: "chat-service",
+		Config:       cfg,
+		DB:           db,
+		RedisClient:  redisClient,
+		Logger:       logger,
+		ServiceName:  "chat-service",
+		FeatureFlags: flagClient,
 	})
 
 	// Create HTTP server
@@ -224,3 +224,33 @@ func (c *S3Client) GetFileURL(key string) string {
 	// AWS S3 환경인 경우 (기본 - virtual-hosted style)
 	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", c.bucket, c.region, key)
 }
+
+// WorkspacePrefix returns the S3 key prefix under which a workspace's chat files are stored
+func WorkspacePrefix(workspaceID string) string {
+	return fmt.Sprintf("chat/%s/", workspaceID)
+}
+
+// DeletePrefix deletes every object under the given prefix and returns the number deleted
+func (c *S3Client) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+	paginator := s3.NewListObjectsV2Paginator(c.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			if _, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(c.bucket),
+				Key:    obj.Key,
+			}); err != nil {
+				return deleted, fmt.Errorf("failed to delete object %s: %w", aws.ToString(obj.Key), err)
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
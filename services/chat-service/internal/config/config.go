@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	commonconfig "github.com/OrangesCloud/wealist-advanced-go-pkg/config"
 	"gopkg.in/yaml.v3"
@@ -28,9 +29,23 @@ type S3Config struct {
 // Config contains all configuration for chat-service.
 type Config struct {
 	commonconfig.BaseConfig `yaml:",inline"`
-	Services                ServicesConfig  `yaml:"services"`
-	RateLimit               RateLimitConfig `yaml:"rate_limit"`
-	S3                      S3Config        `yaml:"s3"` // S3 configuration
+	Services                ServicesConfig    `yaml:"services"`
+	RateLimit               RateLimitConfig   `yaml:"rate_limit"`
+	S3                      S3Config          `yaml:"s3"`       // S3 configuration
+	Internal                InternalConfig    `yaml:"internal"` // Service-to-service API
+	FeatureFlags            FeatureFlagConfig `yaml:"feature_flags"`
+}
+
+// FeatureFlagConfig holds the ops-service feature flag client configuration
+type FeatureFlagConfig struct {
+	BaseURL         string        `yaml:"base_url"` // Empty serves every flag's default
+	Environment     string        `yaml:"environment"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// InternalConfig holds configuration of the service-to-service API
+type InternalConfig struct {
+	APIKey string `yaml:"api_key"` // Empty disables /internal routes
 }
 
 // ServicesConfig contains service URLs configuration.
@@ -64,6 +79,27 @@ func Load(path string) (*Config, error) {
 		cfg.Services.UserServiceURL = userURL
 	}
 
+	// Internal API
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		cfg.Internal.APIKey = apiKey
+	}
+
+	// Feature flags
+	if opsURL := os.Getenv("OPS_SERVICE_URL"); opsURL != "" {
+		cfg.FeatureFlags.BaseURL = opsURL
+	}
+	if env := os.Getenv("FEATURE_FLAG_ENV"); env != "" {
+		cfg.FeatureFlags.Environment = env
+	}
+	if cfg.FeatureFlags.Environment == "" {
+		cfg.FeatureFlags.Environment = "prod"
+	}
+	if interval := os.Getenv("FEATURE_FLAG_REFRESH_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			cfg.FeatureFlags.RefreshInterval = d
+		}
+	}
+
 	// Rate Limit environment variables
 	if rateLimitEnabled := os.Getenv("RATE_LIMIT_ENABLED"); rateLimitEnabled != "" {
 		cfg.RateLimit.Enabled = rateLimitEnabled == "true"
@@ -133,3 +133,9 @@ type ChatWithUnread struct {
 	Chat
 	UnreadCount int64 `json:"unreadCount"`
 }
+
+// PurgeResult summarizes the permanent deletion of a workspace's chat data
+type PurgeResult struct {
+	ChatsDeleted   int64 `json:"chatsDeleted"`
+	ObjectsDeleted int   `json:"objectsDeleted"`
+}
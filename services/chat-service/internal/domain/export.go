@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserDataExport is the chat data of a user returned for personal data export
+type UserDataExport struct {
+	UserID     uuid.UUID         `json:"userId"`
+	ExportedAt time.Time         `json:"exportedAt"`
+	Chats      []ExportedChat    `json:"chats"`
+	Messages   []ExportedMessage `json:"messages"`
+}
+
+// ExportedChat is a chat the user takes part in
+type ExportedChat struct {
+	ChatID      uuid.UUID `json:"chatId"`
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	ChatType    ChatType  `json:"chatType"`
+	ChatName    string    `json:"chatName"`
+	JoinedAt    time.Time `json:"joinedAt"`
+}
+
+// ExportedMessage is a message sent by the user
+type ExportedMessage struct {
+	MessageID   uuid.UUID   `json:"messageId"`
+	ChatID      uuid.UUID   `json:"chatId"`
+	MessageType MessageType `json:"messageType"`
+	Content     string      `json:"content"`
+	FileName    *string     `json:"fileName,omitempty"`
+	FileSize    *int64      `json:"fileSize,omitempty"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
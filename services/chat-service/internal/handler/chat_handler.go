@@ -2,6 +2,7 @@ package handler
 
 import (
 	"chat-service/internal/domain"
+	"chat-service/internal/middleware"
 	"chat-service/internal/response"
 	"chat-service/internal/service"
 
@@ -53,10 +54,11 @@ func (h *ChatHandler) CreateChat(c *gin.Context) {
 		zap.String("enduser.id", userID.String()),
 		zap.String("workspace.id", req.WorkspaceID.String()))
 
-	chat, err := h.chatService.CreateChat(c.Request.Context(), &req, userID)
+	token, _ := middleware.GetJWTToken(c)
+	chat, err := h.chatService.CreateChat(c.Request.Context(), &req, userID, token)
 	if err != nil {
 		log.Error("CreateChat service error", zap.Error(err))
-		response.InternalError(c, "Failed to create chat")
+		response.HandleServiceError(c, err)
 		return
 	}
 
@@ -91,6 +93,8 @@ func (h *ChatHandler) GetWorkspaceChats(c *gin.Context) {
 	log := h.log(c)
 	log.Debug("GetWorkspaceChats started")
 
+	userID := c.MustGet("user_id").(uuid.UUID)
+
 	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
 	if err != nil {
 		log.Warn("GetWorkspaceChats invalid workspace ID")
@@ -100,10 +104,11 @@ func (h *ChatHandler) GetWorkspaceChats(c *gin.Context) {
 
 	log.Debug("GetWorkspaceChats fetching chats", zap.String("workspace.id", workspaceID.String()))
 
-	chats, err := h.chatService.GetWorkspaceChats(c.Request.Context(), workspaceID)
+	token, _ := middleware.GetJWTToken(c)
+	chats, err := h.chatService.GetWorkspaceChats(c.Request.Context(), workspaceID, userID, token)
 	if err != nil {
 		log.Error("GetWorkspaceChats service error", zap.Error(err))
-		response.InternalError(c, "Failed to get chats")
+		response.HandleServiceError(c, err)
 		return
 	}
 
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"chat-service/internal/response"
+	"chat-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ExportHandler handles internal personal data export requests from user-service
+type ExportHandler struct {
+	exportService *service.ExportService
+	logger        *zap.Logger
+}
+
+func NewExportHandler(exportService *service.ExportService, logger *zap.Logger) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+		logger:        logger,
+	}
+}
+
+// ExportUserData returns the chats and messages of a user (internal API)
+func (h *ExportHandler) ExportUserData(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	result, err := h.exportService.ExportUserData(userID)
+	if err != nil {
+		h.logger.Error("failed to export user chat data",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		response.InternalError(c, "Failed to export user chat data")
+		return
+	}
+
+	response.OK(c, result)
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"chat-service/internal/response"
+	"chat-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PurgeHandler handles internal purge requests from user-service
+type PurgeHandler struct {
+	purgeService *service.PurgeService
+	logger       *zap.Logger
+}
+
+func NewPurgeHandler(purgeService *service.PurgeService, logger *zap.Logger) *PurgeHandler {
+	return &PurgeHandler{
+		purgeService: purgeService,
+		logger:       logger,
+	}
+}
+
+// PurgeWorkspace permanently deletes all chat data of a workspace (internal API)
+func (h *PurgeHandler) PurgeWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	result, err := h.purgeService.PurgeWorkspace(c.Request.Context(), workspaceID)
+	if err != nil {
+		h.logger.Error("failed to purge workspace chats",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.Error(err))
+		response.InternalError(c, "Failed to purge workspace chats")
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// PurgeUser permanently deletes the chat data of a user (internal API)
+func (h *PurgeHandler) PurgeUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.purgeService.PurgeUser(c.Request.Context(), userID); err != nil {
+		h.logger.Error("failed to purge user chat data",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		response.InternalError(c, "Failed to purge user chat data")
+		return
+	}
+
+	response.Success(c, gin.H{"userId": userID})
+}
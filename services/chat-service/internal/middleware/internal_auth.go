@@ -0,0 +1,27 @@
+// 이 파일은 서비스 간 내부 API 인증 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+
+	"chat-service/internal/response"
+)
+
+// InternalAuth는 서비스 간 내부 API 키를 검증하는 미들웨어입니다.
+// x-internal-api-key 헤더를 확인합니다.
+func InternalAuth(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		providedKey := c.GetHeader("x-internal-api-key")
+
+		// 키 비교는 타이밍 공격을 피하기 위해 상수 시간으로 수행
+		if apiKey == "" || providedKey == "" || subtle.ConstantTimeCompare([]byte(providedKey), []byte(apiKey)) != 1 {
+			response.Unauthorized(c, "Invalid internal API key")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"chat-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExportRepository reads the chat data of a user for personal data export
+type ExportRepository struct {
+	db *gorm.DB
+}
+
+func NewExportRepository(db *gorm.DB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+// FindChatsByUser returns the chats the user participates in
+func (r *ExportRepository) FindChatsByUser(userID uuid.UUID) ([]domain.ExportedChat, error) {
+	var chats []domain.ExportedChat
+	err := r.db.Table("chat_participants AS p").
+		Select("c.id AS chat_id, c.workspace_id, c.chat_type, c.chat_name, p.joined_at").
+		Joins("JOIN chats c ON c.id = p.chat_id").
+		Where("p.user_id = ? AND c.deleted_at IS NULL", userID).
+		Order("p.joined_at ASC").
+		Scan(&chats).Error
+	return chats, err
+}
+
+// FindMessagesByUser returns the messages sent by the user, oldest first
+func (r *ExportRepository) FindMessagesByUser(userID uuid.UUID) ([]domain.Message, error) {
+	var messages []domain.Message
+	err := r.db.Where("user_id = ? AND deleted_at IS NULL", userID).
+		Order("created_at ASC").
+		Find(&messages).Error
+	return messages, err
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"chat-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PurgeRepository permanently deletes chat data of deleted workspaces and accounts
+type PurgeRepository struct {
+	db *gorm.DB
+}
+
+func NewPurgeRepository(db *gorm.DB) *PurgeRepository {
+	return &PurgeRepository{db: db}
+}
+
+// PurgeWorkspace deletes chats, participants, messages and read receipts of a workspace.
+// Soft-deleted rows are included. Returns the number of chats removed.
+func (r *PurgeRepository) PurgeWorkspace(workspaceID uuid.UUID) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		chatIDs := tx.Model(&domain.Chat{}).Select("id").Where("workspace_id = ?", workspaceID)
+		messageIDs := tx.Model(&domain.Message{}).Select("id").Where("chat_id IN (?)", chatIDs)
+
+		if err := tx.Where("message_id IN (?)", messageIDs).Delete(&domain.MessageRead{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("chat_id IN (?)", chatIDs).Delete(&domain.Message{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("chat_id IN (?)", chatIDs).Delete(&domain.ChatParticipant{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("workspace_id = ?", workspaceID).Delete(&domain.Chat{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return tx.Where("workspace_id = ?", workspaceID).Delete(&domain.UserPresence{}).Error
+	})
+	return deleted, err
+}
+
+// PurgeUser deletes the chat memberships, read receipts and presence of a user.
+// Messages the user sent belong to the conversation and are kept.
+func (r *PurgeRepository) PurgeUser(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.MessageRead{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&domain.ChatParticipant{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&domain.UserPresence{}).Error
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
@@ -25,11 +26,12 @@ import (
 
 // RouterConfig holds router configuration
 type RouterConfig struct {
-	Config      *config.Config
-	DB          *gorm.DB
-	RedisClient *redis.Client
-	Logger      *zap.Logger
-	ServiceName string // Service name for OTEL tracing
+	Config       *config.Config
+	DB           *gorm.DB
+	RedisClient  *redis.Client
+	Logger       *zap.Logger
+	ServiceName  string              // Service name for OTEL tracing
+	FeatureFlags *featureflag.Client // ops-service feature flags (nil serves every flag's default)
 }
 
 func Setup(routerCfg RouterConfig) *gin.Engine {
@@ -89,7 +91,7 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	}
 
 	// Initialize services (메트릭 연동)
-	chatService := service.NewChatService(chatRepo, messageRepo, userClient, redisClient, logger, m)
+	chatService := service.NewChatService(chatRepo, messageRepo, userClient, redisClient, routerCfg.FeatureFlags, logger, m)
 	presenceService := service.NewPresenceService(presenceRepo, redisClient, logger, m)
 
 	// Initialize auth middleware based on ISTIO_JWT_MODE
@@ -119,9 +121,12 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 
 	// Initialize S3 client (optional, for file uploads)
 	var fileHandler *handler.FileHandler
+	var s3Client *client.S3Client
 	if cfg.S3.Bucket != "" && cfg.S3.Region != "" {
-		s3Client, err := client.NewS3Client(&cfg.S3)
+		var err error
+		s3Client, err = client.NewS3Client(&cfg.S3)
 		if err != nil {
+			s3Client = nil
 			logger.Warn("Failed to initialize S3 client, file upload will be disabled", zap.Error(err))
 		} else {
 			fileHandler = handler.NewFileHandler(s3Client, logger)
@@ -135,6 +140,10 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 	chatHandler := handler.NewChatHandler(chatService, presenceService, logger)
 	messageHandler := handler.NewMessageHandler(chatService, logger)
 	presenceHandler := handler.NewPresenceHandler(presenceService, logger)
+	purgeHandler := handler.NewPurgeHandler(
+		service.NewPurgeService(repository.NewPurgeRepository(db), s3Client, logger), logger)
+	exportHandler := handler.NewExportHandler(
+		service.NewExportService(repository.NewExportRepository(db), logger), logger)
 
 	// Health check routes (using common package)
 	healthChecker := commonhealth.NewHealthChecker(db, redisClient)
@@ -194,6 +203,20 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 				})
 			}
 		}
+
+		// Internal routes (service-to-service, API key auth)
+		// 삭제된 워크스페이스/계정 영구 삭제, 개인 데이터 내보내기 (user-service 작업에서 호출)
+		if cfg.Internal.APIKey != "" {
+			internal := api.Group("/internal")
+			internal.Use(middleware.InternalAuth(cfg.Internal.APIKey))
+			{
+				internal.DELETE("/purge/workspaces/:workspaceId", purgeHandler.PurgeWorkspace)
+				internal.DELETE("/purge/users/:userId", purgeHandler.PurgeUser)
+				internal.GET("/export/users/:userId", exportHandler.ExportUserData)
+			}
+		} else {
+			logger.Warn("Internal API key is not configured, internal routes are disabled")
+		}
 	}
 
 	return r
@@ -16,17 +16,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
+// FlagFileMessages는 워크스페이스/사용자별 파일·이미지 메시지 허용 여부 플래그입니다 (기본값: 허용).
+const FlagFileMessages = "chat.file-messages"
+
 // ChatService는 채팅 관련 비즈니스 로직을 처리합니다.
 type ChatService struct {
 	chatRepo    *repository.ChatRepository
 	messageRepo *repository.MessageRepository
 	userClient  client.UserClient
 	redis       *redis.Client
+	flags       *featureflag.Client // nil이면 모든 플래그 기본값
 	logger      *zap.Logger
 	metrics     *metrics.Metrics
 }
@@ -37,6 +42,7 @@ func NewChatService(
 	messageRepo *repository.MessageRepository,
 	userClient client.UserClient,
 	redis *redis.Client,
+	flags *featureflag.Client,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *ChatService {
@@ -45,6 +51,7 @@ func NewChatService(
 		messageRepo: messageRepo,
 		userClient:  userClient,
 		redis:       redis,
+		flags:       flags,
 		logger:      logger,
 		metrics:     m,
 	}
@@ -55,8 +62,7 @@ func NewChatService(
 // ============================================================
 
 // validateWorkspaceMember는 사용자가 워크스페이스 멤버인지 검증합니다.
-//
-//nolint:unused // Reserved for future workspace validation implementation
+// 게스트는 멤버가 아니므로 워크스페이스 채팅에 접근할 수 없습니다.
 func (s *ChatService) validateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) error {
 	if s.userClient == nil {
 		s.logger.Warn("UserClient가 설정되지 않음, 워크스페이스 검증 건너뜀")
@@ -91,6 +97,18 @@ func (s *ChatService) validateChatParticipant(chatID, userID uuid.UUID) error {
 	return nil
 }
 
+// validateMessageType은 파일·이미지 메시지가 채팅방의 워크스페이스에서 허용되는지 검증합니다.
+func (s *ChatService) validateMessageType(chat *domain.Chat, userID uuid.UUID, messageType domain.MessageType) error {
+	if messageType == domain.MessageTypeText {
+		return nil
+	}
+	ectx := featureflag.EvalContext{WorkspaceID: chat.WorkspaceID.String(), UserID: userID.String()}
+	if !s.flags.Bool(FlagFileMessages, ectx, true) {
+		return response.NewForbiddenError("File messages are disabled", chat.ID.String())
+	}
+	return nil
+}
+
 // validateChatCreator는 사용자가 채팅방 생성자인지 검증합니다.
 func (s *ChatService) validateChatCreator(chat *domain.Chat, userID uuid.UUID) error {
 	if chat.CreatedBy != userID {
@@ -101,7 +119,11 @@ func (s *ChatService) validateChatCreator(chat *domain.Chat, userID uuid.UUID) e
 
 // CreateChat은 새 채팅방을 생성합니다.
 // 워크스페이스 멤버십 검증 후 생성자를 자동으로 참가자 목록에 추가합니다.
-func (s *ChatService) CreateChat(ctx context.Context, req *domain.CreateChatRequest, createdBy uuid.UUID) (*domain.Chat, error) {
+func (s *ChatService) CreateChat(ctx context.Context, req *domain.CreateChatRequest, createdBy uuid.UUID, token string) (*domain.Chat, error) {
+	if err := s.validateWorkspaceMember(ctx, req.WorkspaceID, createdBy, token); err != nil {
+		return nil, err
+	}
+
 	chat := &domain.Chat{
 		ID:          uuid.New(),
 		WorkspaceID: req.WorkspaceID,
@@ -168,7 +190,11 @@ func (s *ChatService) GetUserChats(ctx context.Context, userID uuid.UUID) ([]dom
 }
 
 // GetWorkspaceChats는 워크스페이스의 채팅방 목록을 조회합니다.
-func (s *ChatService) GetWorkspaceChats(ctx context.Context, workspaceID uuid.UUID) ([]domain.Chat, error) {
+// 워크스페이스 멤버만 조회할 수 있습니다.
+func (s *ChatService) GetWorkspaceChats(ctx context.Context, workspaceID, userID uuid.UUID, token string) ([]domain.Chat, error) {
+	if err := s.validateWorkspaceMember(ctx, workspaceID, userID, token); err != nil {
+		return nil, err
+	}
 	return s.chatRepo.GetWorkspaceChats(workspaceID)
 }
 
@@ -302,6 +328,17 @@ func (s *ChatService) SendMessage(ctx context.Context, chatID, userID uuid.UUID,
 		return nil, response.ErrEmptyMessage
 	}
 
+	// 📋 기능 플래그: 파일·이미지 메시지는 워크스페이스별로 끌 수 있음
+	if messageType != domain.MessageTypeText && s.flags != nil {
+		chat, err := s.chatRepo.GetByID(chatID)
+		if err != nil {
+			return nil, response.ErrChatNotFound
+		}
+		if err := s.validateMessageType(chat, userID, messageType); err != nil {
+			return nil, err
+		}
+	}
+
 	message := &domain.Message{
 		ID:          uuid.New(),
 		ChatID:      chatID,
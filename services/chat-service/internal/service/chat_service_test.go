@@ -5,9 +5,11 @@ import (
 	"chat-service/internal/domain"
 	"chat-service/internal/metrics"
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -291,6 +293,49 @@ func TestChatService_SendMessage_CustomMessageType(t *testing.T) {
 	assert.Equal(t, int64(1024), *req.FileSize)
 }
 
+func TestChatService_SendMessage_FileMessagesFlag(t *testing.T) {
+	disabledWorkspace := uuid.New()
+	flags := featureflag.NewStaticClient(&featureflag.Snapshot{
+		Environment: "test",
+		Flags: map[string]featureflag.Flag{
+			FlagFileMessages: {
+				Key: FlagFileMessages, Type: featureflag.TypeBool, Enabled: true,
+				DefaultValue: json.RawMessage(`true`),
+				Rules:        []featureflag.Rule{{WorkspaceIDs: []string{disabledWorkspace.String()}, Value: json.RawMessage(`false`)}},
+			},
+		},
+	})
+	disabledChat := &domain.Chat{ID: uuid.New(), WorkspaceID: disabledWorkspace}
+	enabledChat := &domain.Chat{ID: uuid.New(), WorkspaceID: uuid.New()}
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		flags       *featureflag.Client
+		chat        *domain.Chat
+		messageType domain.MessageType
+		wantErr     bool
+	}{
+		{"텍스트는 항상 허용", flags, disabledChat, domain.MessageTypeText, false},
+		{"비활성 워크스페이스의 파일 거부", flags, disabledChat, domain.MessageTypeFile, true},
+		{"비활성 워크스페이스의 이미지 거부", flags, disabledChat, domain.MessageTypeImage, true},
+		{"다른 워크스페이스의 파일 허용", flags, enabledChat, domain.MessageTypeFile, false},
+		{"플래그 클라이언트 없으면 허용", nil, disabledChat, domain.MessageTypeFile, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ChatService{flags: tt.flags, logger: zap.NewNop()}
+			err := s.validateMessageType(tt.chat, userID, tt.messageType)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // ============================================================
 // GetMessages 테스트
 // ============================================================
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"chat-service/internal/domain"
+	"chat-service/internal/repository"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ExportService는 개인 데이터 내보내기를 위해 사용자의 채팅 데이터를 수집합니다.
+// user-service의 내보내기 작업(export job)이 내부 API로 호출합니다.
+type ExportService struct {
+	repo   *repository.ExportRepository
+	logger *zap.Logger
+}
+
+// NewExportService는 새 ExportService를 생성합니다.
+func NewExportService(repo *repository.ExportRepository, logger *zap.Logger) *ExportService {
+	return &ExportService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ExportUserData는 사용자가 참여한 채팅과 보낸 메시지를 반환합니다.
+func (s *ExportService) ExportUserData(userID uuid.UUID) (*domain.UserDataExport, error) {
+	chats, err := s.repo.FindChatsByUser(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find chats: %w", err)
+	}
+	messages, err := s.repo.FindMessagesByUser(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find messages: %w", err)
+	}
+
+	result := &domain.UserDataExport{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+		Chats:      chats,
+		Messages:   make([]domain.ExportedMessage, 0, len(messages)),
+	}
+	if result.Chats == nil {
+		result.Chats = []domain.ExportedChat{}
+	}
+	for _, message := range messages {
+		result.Messages = append(result.Messages, domain.ExportedMessage{
+			MessageID:   message.ID,
+			ChatID:      message.ChatID,
+			MessageType: message.MessageType,
+			Content:     message.Content,
+			FileName:    message.FileName,
+			FileSize:    message.FileSize,
+			CreatedAt:   message.CreatedAt,
+		})
+	}
+
+	s.logger.Info("user chat data exported",
+		zap.String("user_id", userID.String()),
+		zap.Int("chats", len(result.Chats)),
+		zap.Int("messages", len(result.Messages)))
+	return result, nil
+}
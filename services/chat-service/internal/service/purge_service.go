@@ -0,0 +1,66 @@
+// Package service는 chat-service의 비즈니스 로직을 구현합니다.
+package service
+
+import (
+	"chat-service/internal/client"
+	"chat-service/internal/domain"
+	"chat-service/internal/repository"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PurgeService는 삭제된 워크스페이스와 계정의 채팅 데이터를 영구 삭제합니다.
+// user-service의 삭제 작업(deletion job)이 유예 기간 후 내부 API로 호출하며, 모든 작업은 멱등적입니다.
+type PurgeService struct {
+	repo     *repository.PurgeRepository
+	s3Client *client.S3Client // nil이면 S3 객체 삭제를 건너뜀
+	logger   *zap.Logger
+}
+
+// NewPurgeService는 새 PurgeService를 생성합니다.
+func NewPurgeService(repo *repository.PurgeRepository, s3Client *client.S3Client, logger *zap.Logger) *PurgeService {
+	return &PurgeService{
+		repo:     repo,
+		s3Client: s3Client,
+		logger:   logger,
+	}
+}
+
+// PurgeWorkspace는 워크스페이스의 채팅, 메시지, 첨부 파일을 영구 삭제합니다.
+// S3 삭제가 실패하면 DB 행을 남겨 재시도할 수 있게 합니다.
+func (s *PurgeService) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.PurgeResult, error) {
+	result := &domain.PurgeResult{}
+
+	if s.s3Client != nil {
+		objects, err := s.s3Client.DeletePrefix(ctx, client.WorkspacePrefix(workspaceID.String()))
+		result.ObjectsDeleted = objects
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete chat files: %w", err)
+		}
+	}
+
+	chats, err := s.repo.PurgeWorkspace(workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge chats: %w", err)
+	}
+	result.ChatsDeleted = chats
+
+	s.logger.Info("워크스페이스 채팅 영구 삭제 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.Int64("chats_deleted", result.ChatsDeleted),
+		zap.Int("objects_deleted", result.ObjectsDeleted))
+	return result, nil
+}
+
+// PurgeUser는 사용자의 채팅 참여, 읽음 기록, 온라인 상태를 영구 삭제합니다.
+func (s *PurgeService) PurgeUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.PurgeUser(userID); err != nil {
+		return fmt.Errorf("failed to purge user chat data: %w", err)
+	}
+
+	s.logger.Info("사용자 채팅 데이터 영구 삭제 완료", zap.String("user_id", userID.String()))
+	return nil
+}
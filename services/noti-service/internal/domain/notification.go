@@ -27,6 +27,9 @@ const (
 	NotificationTypeWorkspaceRoleChanged NotificationType = "WORKSPACE_ROLE_CHANGED"
 	NotificationTypeWorkspaceRemoved     NotificationType = "WORKSPACE_REMOVED"
 
+	// Workspace ownership events
+	NotificationTypeWorkspaceOwnershipTransferRequested NotificationType = "WORKSPACE_OWNERSHIP_TRANSFER_REQUESTED"
+
 	// Project events
 	NotificationTypeProjectInvited     NotificationType = "PROJECT_INVITED"
 	NotificationTypeProjectRoleChanged NotificationType = "PROJECT_ROLE_CHANGED"
@@ -41,6 +44,9 @@ const (
 	NotificationTypeBoardCommentAdded    NotificationType = "BOARD_COMMENT_ADDED"
 	NotificationTypeBoardDueSoon         NotificationType = "BOARD_DUE_SOON"
 	NotificationTypeBoardOverdue         NotificationType = "BOARD_OVERDUE"
+
+	// Account events
+	NotificationTypeExportReady NotificationType = "EXPORT_READY"
 )
 
 // ResourceType defines the type of resource
@@ -52,6 +58,7 @@ const (
 	ResourceTypeWorkspace ResourceType = "workspace"
 	ResourceTypeProject   ResourceType = "project"
 	ResourceTypeBoard     ResourceType = "board"
+	ResourceTypeExport    ResourceType = "export"
 )
 
 // Notification represents a notification entity
@@ -116,3 +123,12 @@ type UnreadCount struct {
 	Count       int64     `json:"count"`
 	WorkspaceID uuid.UUID `json:"workspaceId"`
 }
+
+// UserNotificationsExport represents the notifications and preferences of a user
+// collected for a personal data export
+type UserNotificationsExport struct {
+	UserID        uuid.UUID                `json:"userId"`
+	Notifications []Notification           `json:"notifications"`
+	Preferences   []NotificationPreference `json:"preferences"`
+	ExportedAt    time.Time                `json:"exportedAt"`
+}
@@ -273,3 +273,63 @@ func (h *NotificationHandler) CreateBulkNotifications(c *gin.Context) {
 		"notifications": notifications,
 	})
 }
+
+// PurgeWorkspace permanently deletes all notifications of a workspace (internal API)
+func (h *NotificationHandler) PurgeWorkspace(c *gin.Context) {
+	log := h.log(c)
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		log.Warn("PurgeWorkspace invalid workspace ID")
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	count, err := h.service.PurgeWorkspace(c.Request.Context(), workspaceID)
+	if err != nil {
+		response.InternalError(c, "Failed to purge workspace notifications")
+		return
+	}
+
+	c.JSON(200, gin.H{"deleted": count})
+}
+
+// PurgeUser permanently deletes all notifications of a user (internal API)
+func (h *NotificationHandler) PurgeUser(c *gin.Context) {
+	log := h.log(c)
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		log.Warn("PurgeUser invalid user ID")
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	count, err := h.service.PurgeUser(c.Request.Context(), userID)
+	if err != nil {
+		response.InternalError(c, "Failed to purge user notifications")
+		return
+	}
+
+	c.JSON(200, gin.H{"deleted": count})
+}
+
+// ExportUserData returns all notifications and preferences of a user (internal API)
+func (h *NotificationHandler) ExportUserData(c *gin.Context) {
+	log := h.log(c)
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		log.Warn("ExportUserData invalid user ID")
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	export, err := h.service.ExportUser(c.Request.Context(), userID)
+	if err != nil {
+		response.InternalError(c, "Failed to export user notifications")
+		return
+	}
+
+	c.JSON(200, export)
+}
@@ -152,3 +152,47 @@ func (r *NotificationRepository) CleanupOld(daysOld int) (int64, error) {
 		Delete(&domain.Notification{})
 	return result.RowsAffected, result.Error
 }
+
+// DeleteByWorkspace permanently removes all notifications and preferences of a workspace.
+func (r *NotificationRepository) DeleteByWorkspace(workspaceID uuid.UUID) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("workspace_id = ?", workspaceID).Delete(&domain.Notification{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return tx.Where("workspace_id = ?", workspaceID).Delete(&domain.NotificationPreference{}).Error
+	})
+	return deleted, err
+}
+
+// FindAllByUser returns every notification addressed to a user, newest first
+func (r *NotificationRepository) FindAllByUser(userID uuid.UUID) ([]domain.Notification, error) {
+	var notifications []domain.Notification
+	err := r.db.Where("target_user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&notifications).Error
+	return notifications, err
+}
+
+// FindPreferencesByUser returns the notification preferences of a user across all workspaces
+func (r *NotificationRepository) FindPreferencesByUser(userID uuid.UUID) ([]domain.NotificationPreference, error) {
+	var prefs []domain.NotificationPreference
+	err := r.db.Where("user_id = ?", userID).Find(&prefs).Error
+	return prefs, err
+}
+
+// DeleteByUser permanently removes all notifications addressed to a user and the user's preferences.
+func (r *NotificationRepository) DeleteByUser(userID uuid.UUID) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("target_user_id = ?", userID).Delete(&domain.Notification{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return tx.Where("user_id = ?", userID).Delete(&domain.NotificationPreference{}).Error
+	})
+	return deleted, err
+}
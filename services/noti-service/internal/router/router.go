@@ -143,6 +143,13 @@ func Setup(routerCfg RouterConfig) *gin.Engine {
 		{
 			internal.POST("/notifications", notificationHandler.CreateNotification)
 			internal.POST("/notifications/bulk", notificationHandler.CreateBulkNotifications)
+
+			// 삭제된 워크스페이스/계정 영구 삭제 (user-service 삭제 작업에서 호출)
+			internal.DELETE("/purge/workspaces/:workspaceId", notificationHandler.PurgeWorkspace)
+			internal.DELETE("/purge/users/:userId", notificationHandler.PurgeUser)
+
+			// 개인 데이터 내보내기 (user-service 내보내기 작업에서 호출)
+			internal.GET("/export/users/:userId", notificationHandler.ExportUserData)
 		}
 	}
 
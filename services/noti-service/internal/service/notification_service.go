@@ -19,6 +19,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
 )
 
@@ -344,13 +345,92 @@ func (s *NotificationService) CleanupOldNotifications(ctx context.Context) (int6
 	return count, err
 }
 
+// PurgeWorkspace permanently removes all notifications of a deleted workspace.
+// user-service의 삭제 작업에서 호출되며 반복 호출해도 안전합니다.
+func (s *NotificationService) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	log := s.log(ctx)
+
+	count, err := s.repo.DeleteByWorkspace(workspaceID)
+	if err != nil {
+		log.Error("PurgeWorkspace failed",
+			zap.String("workspace.id", workspaceID.String()),
+			zap.Error(err))
+		return 0, err
+	}
+
+	s.invalidateUnreadCountCaches(ctx, fmt.Sprintf("unread:*:%s", workspaceID.String()))
+
+	log.Info("Workspace notifications purged",
+		zap.String("workspace.id", workspaceID.String()),
+		zap.Int64("deleted.count", count))
+	return count, nil
+}
+
+// PurgeUser permanently removes all notifications and preferences of a deleted account.
+func (s *NotificationService) PurgeUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	log := s.log(ctx)
+
+	count, err := s.repo.DeleteByUser(userID)
+	if err != nil {
+		log.Error("PurgeUser failed",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return 0, err
+	}
+
+	s.invalidateUnreadCountCaches(ctx, fmt.Sprintf("unread:%s:*", userID.String()))
+
+	log.Info("User notifications purged",
+		zap.String("enduser.id", userID.String()),
+		zap.Int64("deleted.count", count))
+	return count, nil
+}
+
+// ExportUser collects the notifications and preferences of a user for a personal data export
+func (s *NotificationService) ExportUser(ctx context.Context, userID uuid.UUID) (*domain.UserNotificationsExport, error) {
+	log := s.log(ctx)
+
+	notifications, err := s.repo.FindAllByUser(userID)
+	if err != nil {
+		log.Error("ExportUser failed to find notifications",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	prefs, err := s.repo.FindPreferencesByUser(userID)
+	if err != nil {
+		log.Error("ExportUser failed to find preferences",
+			zap.String("enduser.id", userID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("User notifications exported",
+		zap.String("enduser.id", userID.String()),
+		zap.Int("notification.count", len(notifications)))
+	return &domain.UserNotificationsExport{
+		UserID:        userID,
+		Notifications: notifications,
+		Preferences:   prefs,
+		ExportedAt:    time.Now(),
+	}, nil
+}
+
 // publishNotification publishes a notification to Redis for SSE delivery.
+// Users in do-not-disturb get no real-time push; the notification still waits in their inbox.
 func (s *NotificationService) publishNotification(ctx context.Context, notification *domain.Notification) {
 	log := s.log(ctx)
 	if s.redis == nil {
 		return
 	}
 
+	if s.isDoNotDisturb(ctx, notification.TargetUserID) {
+		log.Debug("Notification push skipped for do-not-disturb",
+			zap.String("notification.id", notification.ID.String()),
+			zap.String("target.user.id", notification.TargetUserID.String()))
+		return
+	}
+
 	channel := fmt.Sprintf("notifications:user:%s", notification.TargetUserID.String())
 	data, err := json.Marshal(notification)
 	if err != nil {
@@ -367,6 +447,17 @@ func (s *NotificationService) publishNotification(ctx context.Context, notificat
 	}
 }
 
+// isDoNotDisturb reports whether the user has do-not-disturb on, using the availability user-service mirrors to Redis.
+// Lookup errors fail open so notifications are still pushed.
+func (s *NotificationService) isDoNotDisturb(ctx context.Context, userID uuid.UUID) bool {
+	availability, err := commonclient.LoadAvailability(ctx, s.redis, userID)
+	if err != nil {
+		s.log(ctx).Warn("Failed to load user availability", zap.Error(err), zap.String("target.user.id", userID.String()))
+		return false
+	}
+	return availability.IsDoNotDisturb(time.Now())
+}
+
 // invalidateUnreadCountCache removes the cached unread count for a user/workspace.
 func (s *NotificationService) invalidateUnreadCountCache(ctx context.Context, userID, workspaceID uuid.UUID) {
 	log := s.log(ctx)
@@ -381,3 +472,21 @@ func (s *NotificationService) invalidateUnreadCountCache(ctx context.Context, us
 		log.Debug("Unread count cache invalidated", zap.String("cache.key", cacheKey))
 	}
 }
+
+// invalidateUnreadCountCaches removes every cached unread count matching the pattern.
+func (s *NotificationService) invalidateUnreadCountCaches(ctx context.Context, pattern string) {
+	log := s.log(ctx)
+	if s.redis == nil {
+		return
+	}
+
+	iter := s.redis.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		if err := s.redis.Del(ctx, iter.Val()).Err(); err != nil {
+			log.Error("invalidateUnreadCountCaches failed", zap.Error(err))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		log.Error("invalidateUnreadCountCaches scan failed", zap.Error(err))
+	}
+}
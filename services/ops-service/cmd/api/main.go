@@ -18,6 +18,10 @@
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// @securityDefinitions.apikey InternalAPIKey
+// @in header
+// @name x-internal-api-key
+
 package main
 
 import (
@@ -38,7 +42,9 @@ import (
 	"ops-service/internal/config"
 	"ops-service/internal/database"
 	"ops-service/internal/middleware"
+	"ops-service/internal/repository"
 	"ops-service/internal/router"
+	"ops-service/internal/service"
 )
 
 func main() {
@@ -126,6 +132,11 @@ func main() {
 		logger.Info("Database auto-migration disabled (DB_AUTO_MIGRATE=false)")
 	}
 
+	// Seed default SLO definitions and alert rules (independent of auto-migration)
+	if err := database.SeedDefaults(db); err != nil {
+		logger.Warn("Failed to seed default data", zap.Error(err))
+	}
+
 	// Initialize Redis
 	if err := database.InitRedis(logger); err != nil {
 		logger.Warn("Failed to initialize Redis, rate limiting will be disabled", zap.Error(err))
@@ -235,8 +246,32 @@ func main() {
 		PrometheusNS:     cfg.Prometheus.Namespace,
 		LokiClient:       lokiClient,
 		LokiNS:           cfg.Loki.Namespace,
+
+		ConfigRequireApproval: cfg.AppConfig.RequireApproval,
+		InternalAPIKey:        cfg.Internal.APIKey,
 	})
 
+	// Start alert evaluator
+	var alertEvaluator *service.AlertEvaluator
+	if cfg.Alerting.Enabled {
+		alertRepo := repository.NewAlertRepository(db)
+		alertEvaluator = service.NewAlertEvaluator(service.AlertEvaluatorConfig{
+			AlertRepo:        alertRepo,
+			SLORepo:          repository.NewSLODefinitionRepository(db),
+			PrometheusClient: prometheusClient,
+			PrometheusNS:     cfg.Prometheus.Namespace,
+			ArgoCDClient:     argoCDClient,
+			RedisClient:      database.GetRedis(),
+			Senders:          service.DefaultAlertSenders(alertRepo),
+			Interval:         cfg.Alerting.Interval,
+			RepeatInterval:   cfg.Alerting.RepeatInterval,
+			Logger:           logger,
+		})
+		alertEvaluator.Start(context.Background())
+	} else {
+		logger.Info("Alerting disabled (ALERTING_ENABLED=false)")
+	}
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.Server.Port),
@@ -269,6 +304,10 @@ func main() {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	if alertEvaluator != nil {
+		alertEvaluator.Stop()
+	}
+
 	logger.Info("Server exited gracefully")
 }
 
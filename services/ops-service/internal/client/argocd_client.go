@@ -47,9 +47,10 @@ func NewArgoCDClient(cfg ArgoCDConfig, logger *zap.Logger) *ArgoCDClient {
 
 // Application represents an ArgoCD application
 type Application struct {
-	Metadata ApplicationMetadata `json:"metadata"`
-	Spec     ApplicationSpec     `json:"spec"`
-	Status   ApplicationStatus   `json:"status"`
+	Metadata  ApplicationMetadata   `json:"metadata"`
+	Spec      ApplicationSpec       `json:"spec"`
+	Status    ApplicationStatus     `json:"status"`
+	Operation *ApplicationOperation `json:"operation,omitempty"` // Set while a sync or rollback is running
 }
 
 // ApplicationMetadata holds application metadata
@@ -60,7 +61,15 @@ type ApplicationMetadata struct {
 
 // ApplicationSpec holds application spec
 type ApplicationSpec struct {
-	Project string `json:"project"`
+	Project string            `json:"project"`
+	Source  ApplicationSource `json:"source"`
+}
+
+// ApplicationSource holds the Git source an application is deployed from
+type ApplicationSource struct {
+	RepoURL        string `json:"repoURL"`
+	Path           string `json:"path"`
+	TargetRevision string `json:"targetRevision"`
 }
 
 // ApplicationStatus holds application status
@@ -71,7 +80,8 @@ type ApplicationStatus struct {
 
 // SyncStatus holds sync status
 type SyncStatus struct {
-	Status string `json:"status"`
+	Status   string `json:"status"`
+	Revision string `json:"revision"` // Revision the live state is synced to
 }
 
 // HealthStatus holds health status
@@ -123,8 +133,7 @@ func (c *ArgoCDClient) GetApplication(name string) (*Application, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get application: %s", string(body))
+		return nil, apiError(resp)
 	}
 
 	var app Application
@@ -137,18 +146,8 @@ func (c *ArgoCDClient) GetApplication(name string) (*Application, error) {
 
 // SyncApplication syncs an application
 func (c *ArgoCDClient) SyncApplication(name string) error {
-	resp, err := c.doRequest("POST", "/api/v1/applications/"+name+"/sync", strings.NewReader("{}"))
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to sync application: %s", string(body))
-	}
-
-	return nil
+	_, err := c.SyncApplicationWithOptions(name, SyncOptions{})
+	return err
 }
 
 // GetRBACConfigMap gets the RBAC ConfigMap (argocd-rbac-cm)
@@ -178,6 +177,7 @@ type RevisionHistory struct {
 
 // ApplicationHistoryEntry represents a deployment in the history
 type ApplicationHistoryEntry struct {
+	ID            int64     `json:"id"` // History ID used for rollback
 	AppName       string    `json:"appName"`
 	Revision      string    `json:"revision"`
 	DeployedAt    time.Time `json:"deployedAt"`
@@ -243,6 +243,7 @@ func (c *ArgoCDClient) GetApplicationHistory(name string) ([]ApplicationHistoryE
 	for _, h := range app.Status.History {
 		deployedAt, _ := time.Parse(time.RFC3339, h.DeployedAt)
 		history = append(history, ApplicationHistoryEntry{
+			ID:           h.ID,
 			AppName:      name,
 			Revision:     h.Revision,
 			DeployedAt:   deployedAt,
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// =============================================================================
+// Sync, Rollback and Diff Types and Methods
+// =============================================================================
+
+// ArgoCDAPIError is returned when the ArgoCD API rejects a request
+type ArgoCDAPIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ArgoCDAPIError) Error() string {
+	return fmt.Sprintf("argocd returned %d: %s", e.StatusCode, e.Message)
+}
+
+// SyncResource selects one resource of an application to sync
+type SyncResource struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SyncOptions holds the options of a sync request. The zero value syncs every resource to the target revision.
+type SyncOptions struct {
+	Revision  string         `json:"revision,omitempty"`  // Git revision to sync to instead of the target revision
+	Prune     bool           `json:"prune,omitempty"`     // Delete resources that are no longer in Git
+	DryRun    bool           `json:"dryRun,omitempty"`    // Validate the sync without applying it
+	Resources []SyncResource `json:"resources,omitempty"` // Only sync these resources
+}
+
+// rollbackRequest is the body of a rollback request
+type rollbackRequest struct {
+	ID     int64 `json:"id"`
+	DryRun bool  `json:"dryRun,omitempty"`
+	Prune  bool  `json:"prune,omitempty"`
+}
+
+// ManagedResource is a resource of an application with its live and desired state.
+// States are JSON documents as returned by ArgoCD.
+type ManagedResource struct {
+	Group               string `json:"group"`
+	Kind                string `json:"kind"`
+	Namespace           string `json:"namespace"`
+	Name                string `json:"name"`
+	TargetState         string `json:"targetState"`
+	LiveState           string `json:"liveState"`
+	NormalizedLiveState string `json:"normalizedLiveState"`
+	PredictedLiveState  string `json:"predictedLiveState"`
+	Hook                bool   `json:"hook"`
+	Modified            bool   `json:"modified"`
+}
+
+// managedResourcesResponse holds the managed resources of an application
+type managedResourcesResponse struct {
+	Items []ManagedResource `json:"items"`
+}
+
+// ApplicationOperation is the operation ArgoCD started for an application
+type ApplicationOperation struct {
+	Sync *SyncOperation `json:"sync,omitempty"`
+}
+
+// SyncOperation is a sync (or rollback) operation and the revision it syncs to
+type SyncOperation struct {
+	Revision string `json:"revision"`
+	Prune    bool   `json:"prune"`
+	DryRun   bool   `json:"dryRun"`
+}
+
+// SyncApplicationWithOptions syncs an application with options and returns the application as ArgoCD accepted it
+func (c *ArgoCDClient) SyncApplicationWithOptions(name string, opts SyncOptions) (*Application, error) {
+	return c.postOperation(name, "sync", opts)
+}
+
+// RollbackApplication rolls an application back to the deployment with the given history ID.
+// ArgoCD rejects rollbacks of applications with automated sync enabled.
+func (c *ArgoCDClient) RollbackApplication(name string, historyID int64, dryRun, prune bool) (*Application, error) {
+	return c.postOperation(name, "rollback", rollbackRequest{ID: historyID, DryRun: dryRun, Prune: prune})
+}
+
+// GetManagedResources gets the resources of an application with their live and desired state
+func (c *ArgoCDClient) GetManagedResources(name string) ([]ManagedResource, error) {
+	resp, err := c.doRequest("GET", "/api/v1/applications/"+url.PathEscape(name)+"/managed-resources", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+
+	var result managedResourcesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode managed resources: %w", err)
+	}
+
+	return result.Items, nil
+}
+
+func (c *ArgoCDClient) postOperation(name, operation string, body interface{}) (*Application, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s request: %w", operation, err)
+	}
+
+	resp, err := c.doRequest("POST", "/api/v1/applications/"+url.PathEscape(name)+"/"+operation, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+
+	var app Application
+	if err := json.NewDecoder(resp.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("failed to decode application: %w", err)
+	}
+
+	return &app, nil
+}
+
+// apiError reads an ArgoCD error response ({"error": ..., "message": ...})
+func apiError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	var payload struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	message := string(body)
+	if json.Unmarshal(body, &payload) == nil {
+		if payload.Message != "" {
+			message = payload.Message
+		} else if payload.Error != "" {
+			message = payload.Error
+		}
+	}
+
+	return &ArgoCDAPIError{StatusCode: resp.StatusCode, Message: message}
+}
@@ -417,9 +417,11 @@ func (c *PrometheusClient) GetRecentErrors(ctx context.Context, namespace string
 // GetErrorTrend returns error rate trend over the last hour with 5-minute intervals
 func (c *PrometheusClient) GetErrorTrend(ctx context.Context, namespace string) ([]ErrorTrendPoint, error) {
 	end := time.Now()
-	start := end.Add(-1 * time.Hour)
-	step := 5 * time.Minute
+	return c.GetErrorTrendRange(ctx, namespace, end.Add(-1*time.Hour), end, 5*time.Minute)
+}
 
+// GetErrorTrendRange returns the error rate trend between start and end at the given step
+func (c *PrometheusClient) GetErrorTrendRange(ctx context.Context, namespace string, start, end time.Time, step time.Duration) ([]ErrorTrendPoint, error) {
 	// Error rate query
 	rateQuery := fmt.Sprintf(`sum(rate(istio_requests_total{
 		reporter="destination",
@@ -626,251 +628,3 @@ func (c *PrometheusClient) extractValueFromRange(value []interface{}) float64 {
 		return 0
 	}
 }
-
-// =============================================================================
-// SLO Dashboard Types and Methods
-// =============================================================================
-
-// SLOTarget defines SLO target configuration
-type SLOTarget struct {
-	Availability float64 // e.g., 99.9 for 99.9%
-	LatencyP50   float64 // milliseconds
-	LatencyP99   float64 // milliseconds
-}
-
-// DefaultSLOTarget returns default SLO targets
-func DefaultSLOTarget() SLOTarget {
-	return SLOTarget{
-		Availability: 99.9,
-		LatencyP50:   100,  // 100ms
-		LatencyP99:   500,  // 500ms
-	}
-}
-
-// ServiceSLO represents SLO metrics for a single service
-type ServiceSLO struct {
-	ServiceName       string  `json:"serviceName"`
-	Availability      float64 `json:"availability"`      // current availability %
-	AvailabilityTarget float64 `json:"availabilityTarget"` // target %
-	AvailabilityMet   bool    `json:"availabilityMet"`
-	LatencyP50        float64 `json:"latencyP50"`        // current P50 latency ms
-	LatencyP99        float64 `json:"latencyP99"`        // current P99 latency ms
-	LatencyTarget     float64 `json:"latencyTarget"`     // target P99 latency ms
-	LatencyMet        bool    `json:"latencyMet"`
-	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"` // percentage
-	ErrorBudgetConsumed  float64 `json:"errorBudgetConsumed"`  // percentage
-}
-
-// SLOOverview represents overall SLO status
-type SLOOverview struct {
-	Services       []ServiceSLO `json:"services"`
-	OverallHealth  string       `json:"overallHealth"`  // healthy, degraded, critical
-	ServicesAtRisk int          `json:"servicesAtRisk"`
-	TotalServices  int          `json:"totalServices"`
-}
-
-// BurnRate represents error budget burn rate
-type BurnRate struct {
-	ServiceName string  `json:"serviceName"`
-	Rate1h      float64 `json:"rate1h"`
-	Rate6h      float64 `json:"rate6h"`
-	Rate24h     float64 `json:"rate24h"`
-	Alerting    bool    `json:"alerting"` // true if burn rate is critical
-}
-
-// GetSLOOverview returns SLO metrics for all services
-func (c *PrometheusClient) GetSLOOverview(ctx context.Context, namespace string) (*SLOOverview, error) {
-	services := []string{
-		"auth-service",
-		"user-service",
-		"board-service",
-		"chat-service",
-		"noti-service",
-		"storage-service",
-	}
-
-	target := DefaultSLOTarget()
-	overview := &SLOOverview{
-		Services:      make([]ServiceSLO, 0),
-		TotalServices: len(services),
-	}
-
-	for _, svc := range services {
-		slo, err := c.getServiceSLO(ctx, svc, namespace, target)
-		if err != nil {
-			c.logger.Warn("Failed to get SLO for service",
-				zap.String("service", svc),
-				zap.Error(err))
-			slo = &ServiceSLO{
-				ServiceName:        svc,
-				AvailabilityTarget: target.Availability,
-				LatencyTarget:      target.LatencyP99,
-			}
-		}
-		overview.Services = append(overview.Services, *slo)
-
-		// Count services at risk (error budget < 20% or burn rate high)
-		if slo.ErrorBudgetRemaining < 20 {
-			overview.ServicesAtRisk++
-		}
-	}
-
-	// Determine overall health
-	if overview.ServicesAtRisk == 0 {
-		overview.OverallHealth = "healthy"
-	} else if overview.ServicesAtRisk <= len(services)/3 {
-		overview.OverallHealth = "degraded"
-	} else {
-		overview.OverallHealth = "critical"
-	}
-
-	return overview, nil
-}
-
-func (c *PrometheusClient) getServiceSLO(ctx context.Context, serviceName, namespace string, target SLOTarget) (*ServiceSLO, error) {
-	slo := &ServiceSLO{
-		ServiceName:        serviceName,
-		AvailabilityTarget: target.Availability,
-		LatencyTarget:      target.LatencyP99,
-	}
-
-	// Calculate availability (30 day window for SLO)
-	availQuery := fmt.Sprintf(`100 * (1 - sum(rate(istio_requests_total{
-		destination_service_name="%s",
-		reporter="destination",
-		response_code=~"5.."
-	}[24h])) / sum(rate(istio_requests_total{
-		destination_service_name="%s",
-		reporter="destination"
-	}[24h])))`, serviceName, serviceName)
-
-	if result, err := c.Query(ctx, availQuery); err == nil && len(result.Data.Result) > 0 {
-		slo.Availability = c.extractValue(result.Data.Result[0].Value)
-		if slo.Availability == 0 || slo.Availability != slo.Availability {
-			slo.Availability = 100 // Default to 100% if no data
-		}
-	} else {
-		slo.Availability = 100
-	}
-
-	slo.AvailabilityMet = slo.Availability >= target.Availability
-
-	// P50 latency
-	p50Query := fmt.Sprintf(`histogram_quantile(0.50, sum(rate(istio_request_duration_milliseconds_bucket{
-		destination_service_name="%s",
-		reporter="destination"
-	}[5m])) by (le))`, serviceName)
-
-	if result, err := c.Query(ctx, p50Query); err == nil && len(result.Data.Result) > 0 {
-		slo.LatencyP50 = c.extractValue(result.Data.Result[0].Value)
-	}
-
-	// P99 latency
-	p99Query := fmt.Sprintf(`histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket{
-		destination_service_name="%s",
-		reporter="destination"
-	}[5m])) by (le))`, serviceName)
-
-	if result, err := c.Query(ctx, p99Query); err == nil && len(result.Data.Result) > 0 {
-		slo.LatencyP99 = c.extractValue(result.Data.Result[0].Value)
-	}
-
-	slo.LatencyMet = slo.LatencyP99 <= target.LatencyP99 || slo.LatencyP99 == 0
-
-	// Error budget calculation
-	// Error budget = (100 - target) / 100
-	// e.g., for 99.9% target, budget = 0.1% = 0.001
-	errorBudget := (100 - target.Availability) / 100
-	actualErrorRate := (100 - slo.Availability) / 100
-
-	if errorBudget > 0 {
-		slo.ErrorBudgetConsumed = (actualErrorRate / errorBudget) * 100
-		slo.ErrorBudgetRemaining = 100 - slo.ErrorBudgetConsumed
-		if slo.ErrorBudgetRemaining < 0 {
-			slo.ErrorBudgetRemaining = 0
-		}
-	} else {
-		slo.ErrorBudgetRemaining = 100
-	}
-
-	return slo, nil
-}
-
-// GetBurnRates returns error budget burn rates for all services
-func (c *PrometheusClient) GetBurnRates(ctx context.Context, namespace string) ([]BurnRate, error) {
-	services := []string{
-		"auth-service",
-		"user-service",
-		"board-service",
-		"chat-service",
-		"noti-service",
-		"storage-service",
-	}
-
-	target := DefaultSLOTarget()
-	errorBudget := (100 - target.Availability) / 100 // 0.001 for 99.9%
-
-	var burnRates []BurnRate
-	for _, svc := range services {
-		br := BurnRate{ServiceName: svc}
-
-		// 1h burn rate
-		rate1hQuery := fmt.Sprintf(`sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination",
-			response_code=~"5.."
-		}[1h])) / sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination"
-		}[1h]))`, svc, svc)
-
-		if result, err := c.Query(ctx, rate1hQuery); err == nil && len(result.Data.Result) > 0 {
-			errRate := c.extractValue(result.Data.Result[0].Value)
-			if errorBudget > 0 && errRate == errRate { // NaN check
-				br.Rate1h = errRate / errorBudget
-			}
-		}
-
-		// 6h burn rate
-		rate6hQuery := fmt.Sprintf(`sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination",
-			response_code=~"5.."
-		}[6h])) / sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination"
-		}[6h]))`, svc, svc)
-
-		if result, err := c.Query(ctx, rate6hQuery); err == nil && len(result.Data.Result) > 0 {
-			errRate := c.extractValue(result.Data.Result[0].Value)
-			if errorBudget > 0 && errRate == errRate {
-				br.Rate6h = errRate / errorBudget
-			}
-		}
-
-		// 24h burn rate
-		rate24hQuery := fmt.Sprintf(`sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination",
-			response_code=~"5.."
-		}[24h])) / sum(rate(istio_requests_total{
-			destination_service_name="%s",
-			reporter="destination"
-		}[24h]))`, svc, svc)
-
-		if result, err := c.Query(ctx, rate24hQuery); err == nil && len(result.Data.Result) > 0 {
-			errRate := c.extractValue(result.Data.Result[0].Value)
-			if errorBudget > 0 && errRate == errRate {
-				br.Rate24h = errRate / errorBudget
-			}
-		}
-
-		// Alert if 1h burn rate > 14.4 (Google SRE book recommendation)
-		// This would consume 100% error budget in ~7 hours
-		br.Alerting = br.Rate1h > 14.4 || br.Rate6h > 6
-
-		burnRates = append(burnRates, br)
-	}
-
-	return burnRates, nil
-}
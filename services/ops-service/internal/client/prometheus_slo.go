@@ -0,0 +1,508 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// SLO Dashboard Types and Methods
+// =============================================================================
+
+// DefaultSLOWindow is the SLO window used when a definition does not set one
+const DefaultSLOWindow = "28d"
+
+// DefaultErrorRatioQuery is the default SLI template for the ratio of failed requests (0-1) over {{.Window}}
+const DefaultErrorRatioQuery = `sum(rate(istio_requests_total{
+	destination_service_name="{{.Service}}",
+	reporter="destination",
+	response_code=~"5.."
+}[{{.Window}}])) / sum(rate(istio_requests_total{
+	destination_service_name="{{.Service}}",
+	reporter="destination"
+}[{{.Window}}]))`
+
+// DefaultRequestCountQuery is the number of requests served over {{.Window}}; it weighs the
+// error ratio of each bucket when the error budget history is summed up
+const DefaultRequestCountQuery = `sum(increase(istio_requests_total{
+	destination_service_name="{{.Service}}",
+	reporter="destination"
+}[{{.Window}}]))`
+
+// DefaultLatencyQuery is the default SLI template for the {{.Quantile}} request latency in milliseconds
+const DefaultLatencyQuery = `histogram_quantile({{.Quantile}}, sum(rate(istio_request_duration_milliseconds_bucket{
+	destination_service_name="{{.Service}}",
+	reporter="destination"
+}[5m])) by (le))`
+
+// burnRateWindows are the burn rate windows always reported
+var burnRateWindows = []string{"1h", "6h", "24h"}
+
+// windowPattern matches the PromQL durations accepted for SLO and burn rate windows
+var windowPattern = regexp.MustCompile(`^([1-9][0-9]*)([mhdw])$`)
+
+// errorBudgetHistoryPoints is the number of buckets an error budget history is split into
+const errorBudgetHistoryPoints = 112
+
+// BurnRateThreshold alerts when the burn rate over Window exceeds Threshold
+type BurnRateThreshold struct {
+	Window    string  `json:"window"`    // PromQL duration, e.g. 1h
+	Threshold float64 `json:"threshold"` // multiple of the sustainable burn rate
+}
+
+// DefaultBurnRateThresholds returns the multi-window thresholds from the Google SRE workbook
+func DefaultBurnRateThresholds() []BurnRateThreshold {
+	return []BurnRateThreshold{
+		{Window: "1h", Threshold: 14.4}, // 2% of a 30 day budget in 1 hour
+		{Window: "6h", Threshold: 6},    // 5% of a 30 day budget in 6 hours
+	}
+}
+
+// SLOTarget defines SLO target configuration
+type SLOTarget struct {
+	ServiceName        string
+	Availability       float64 // e.g., 99.9 for 99.9%
+	LatencyP50         float64 // milliseconds
+	LatencyP99         float64 // milliseconds
+	Window             string  // PromQL duration, e.g. 28d
+	ErrorRatioQuery    string  // SLI template; empty uses DefaultErrorRatioQuery
+	LatencyQuery       string  // SLI template; empty uses DefaultLatencyQuery
+	BurnRateThresholds []BurnRateThreshold
+}
+
+// DefaultSLOTarget returns default SLO targets
+func DefaultSLOTarget() SLOTarget {
+	return SLOTarget{
+		Availability:       99.9,
+		LatencyP50:         100, // 100ms
+		LatencyP99:         500, // 500ms
+		Window:             DefaultSLOWindow,
+		BurnRateThresholds: DefaultBurnRateThresholds(),
+	}
+}
+
+// errorBudget returns the allowed error ratio, e.g. 0.001 for 99.9%
+func (t SLOTarget) errorBudget() float64 {
+	return (100 - t.Availability) / 100
+}
+
+func (t SLOTarget) errorRatioQuery() string {
+	if t.ErrorRatioQuery != "" {
+		return t.ErrorRatioQuery
+	}
+	return DefaultErrorRatioQuery
+}
+
+func (t SLOTarget) latencyQuery() string {
+	if t.LatencyQuery != "" {
+		return t.LatencyQuery
+	}
+	return DefaultLatencyQuery
+}
+
+func (t SLOTarget) window() string {
+	if t.Window != "" {
+		return t.Window
+	}
+	return DefaultSLOWindow
+}
+
+// SLIQueryData holds the values available to SLI query templates
+type SLIQueryData struct {
+	Service   string
+	Namespace string
+	Window    string
+	Quantile  float64
+}
+
+// RenderSLIQuery renders an SLI query template such as DefaultErrorRatioQuery
+func RenderSLIQuery(query string, data SLIQueryData) (string, error) {
+	tmpl, err := template.New("sli").Option("missingkey=error").Parse(query)
+	if err != nil {
+		return "", fmt.Errorf("invalid SLI query template: %w", err)
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render SLI query: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// ParseWindow parses a PromQL duration such as 1h, 7d or 4w
+func ParseWindow(window string) (time.Duration, error) {
+	m := windowPattern.FindStringSubmatch(window)
+	if m == nil {
+		return 0, fmt.Errorf("invalid window %q: expected a number followed by m, h, d or w", window)
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid window %q: %w", window, err)
+	}
+	unit := map[string]time.Duration{
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}[m[2]]
+	return time.Duration(n) * unit, nil
+}
+
+// ServiceSLO represents SLO metrics for a single service
+type ServiceSLO struct {
+	ServiceName          string  `json:"serviceName"`
+	Window               string  `json:"window"`
+	Availability         float64 `json:"availability"`       // current availability %
+	AvailabilityTarget   float64 `json:"availabilityTarget"` // target %
+	AvailabilityMet      bool    `json:"availabilityMet"`
+	LatencyP50           float64 `json:"latencyP50"`       // current P50 latency ms
+	LatencyP99           float64 `json:"latencyP99"`       // current P99 latency ms
+	LatencyP50Target     float64 `json:"latencyP50Target"` // target P50 latency ms
+	LatencyTarget        float64 `json:"latencyTarget"`    // target P99 latency ms
+	LatencyMet           bool    `json:"latencyMet"`
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"` // percentage
+	ErrorBudgetConsumed  float64 `json:"errorBudgetConsumed"`  // percentage
+}
+
+// SLOOverview represents overall SLO status
+type SLOOverview struct {
+	Services       []ServiceSLO `json:"services"`
+	OverallHealth  string       `json:"overallHealth"` // healthy, degraded, critical
+	ServicesAtRisk int          `json:"servicesAtRisk"`
+	TotalServices  int          `json:"totalServices"`
+}
+
+// BurnRate represents error budget burn rate
+type BurnRate struct {
+	ServiceName string              `json:"serviceName"`
+	Rate1h      float64             `json:"rate1h"`
+	Rate6h      float64             `json:"rate6h"`
+	Rate24h     float64             `json:"rate24h"`
+	Rates       map[string]float64  `json:"rates"` // burn rate per window, including threshold windows
+	Thresholds  []BurnRateThreshold `json:"thresholds"`
+	Alerting    bool                `json:"alerting"` // true if any threshold is exceeded
+}
+
+// ErrorBudgetPoint is one bucket of an error budget history
+type ErrorBudgetPoint struct {
+	Timestamp            int64   `json:"timestamp"`
+	ErrorRatio           float64 `json:"errorRatio"`           // failed request ratio within the bucket
+	BurnRate             float64 `json:"burnRate"`             // bucket error ratio / error budget
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"` // percentage left since the window start
+}
+
+// ErrorBudgetHistory is the error budget of one service over its SLO window
+type ErrorBudgetHistory struct {
+	ServiceName        string             `json:"serviceName"`
+	Window             string             `json:"window"`
+	AvailabilityTarget float64            `json:"availabilityTarget"`
+	Step               string             `json:"step"`
+	Points             []ErrorBudgetPoint `json:"points"`
+}
+
+// GetSLOOverview returns SLO metrics for the services with a target
+func (c *PrometheusClient) GetSLOOverview(ctx context.Context, namespace string, targets []SLOTarget) (*SLOOverview, error) {
+	overview := &SLOOverview{
+		Services:      make([]ServiceSLO, 0, len(targets)),
+		TotalServices: len(targets),
+	}
+
+	for _, target := range targets {
+		slo, err := c.getServiceSLO(ctx, namespace, target)
+		if err != nil {
+			c.logger.Warn("Failed to get SLO for service",
+				zap.String("service", target.ServiceName),
+				zap.Error(err))
+			slo = &ServiceSLO{
+				ServiceName:        target.ServiceName,
+				Window:             target.window(),
+				AvailabilityTarget: target.Availability,
+				LatencyP50Target:   target.LatencyP50,
+				LatencyTarget:      target.LatencyP99,
+			}
+		}
+		overview.Services = append(overview.Services, *slo)
+
+		// Count services at risk (error budget < 20% or burn rate high)
+		if slo.ErrorBudgetRemaining < 20 {
+			overview.ServicesAtRisk++
+		}
+	}
+
+	// Determine overall health
+	if overview.ServicesAtRisk == 0 {
+		overview.OverallHealth = "healthy"
+	} else if overview.ServicesAtRisk <= len(targets)/3 {
+		overview.OverallHealth = "degraded"
+	} else {
+		overview.OverallHealth = "critical"
+	}
+
+	return overview, nil
+}
+
+func (c *PrometheusClient) getServiceSLO(ctx context.Context, namespace string, target SLOTarget) (*ServiceSLO, error) {
+	slo := &ServiceSLO{
+		ServiceName:        target.ServiceName,
+		Window:             target.window(),
+		AvailabilityTarget: target.Availability,
+		LatencyP50Target:   target.LatencyP50,
+		LatencyTarget:      target.LatencyP99,
+	}
+	data := SLIQueryData{Service: target.ServiceName, Namespace: namespace, Window: target.window()}
+
+	// Availability over the SLO window
+	availQuery, err := RenderSLIQuery(target.errorRatioQuery(), data)
+	if err != nil {
+		return nil, err
+	}
+	slo.Availability = 100 // Default to 100% if no data
+	if result, err := c.Query(ctx, availQuery); err == nil && len(result.Data.Result) > 0 {
+		if ratio, ok := ratioValue(c.extractValue(result.Data.Result[0].Value)); ok {
+			slo.Availability = 100 * (1 - ratio)
+		}
+	}
+
+	slo.AvailabilityMet = slo.Availability >= target.Availability
+
+	// P50 / P99 latency
+	for _, q := range []struct {
+		quantile float64
+		dest     *float64
+	}{{0.50, &slo.LatencyP50}, {0.99, &slo.LatencyP99}} {
+		data.Quantile = q.quantile
+		latencyQuery, err := RenderSLIQuery(target.latencyQuery(), data)
+		if err != nil {
+			return nil, err
+		}
+		if result, err := c.Query(ctx, latencyQuery); err == nil && len(result.Data.Result) > 0 {
+			*q.dest = c.extractValue(result.Data.Result[0].Value)
+		}
+	}
+
+	slo.LatencyMet = (slo.LatencyP99 <= target.LatencyP99 || slo.LatencyP99 == 0) &&
+		(target.LatencyP50 == 0 || slo.LatencyP50 <= target.LatencyP50)
+
+	// Error budget calculation
+	// Error budget = (100 - target) / 100
+	// e.g., for 99.9% target, budget = 0.1% = 0.001
+	errorBudget := target.errorBudget()
+	actualErrorRate := (100 - slo.Availability) / 100
+
+	if errorBudget > 0 {
+		slo.ErrorBudgetConsumed = (actualErrorRate / errorBudget) * 100
+		slo.ErrorBudgetRemaining = 100 - slo.ErrorBudgetConsumed
+		if slo.ErrorBudgetRemaining < 0 {
+			slo.ErrorBudgetRemaining = 0
+		}
+	} else {
+		slo.ErrorBudgetRemaining = 100
+	}
+
+	return slo, nil
+}
+
+// GetBurnRates returns error budget burn rates for the services with a target
+func (c *PrometheusClient) GetBurnRates(ctx context.Context, namespace string, targets []SLOTarget) ([]BurnRate, error) {
+	burnRates := make([]BurnRate, 0, len(targets))
+	for _, target := range targets {
+		br, err := c.getBurnRate(ctx, namespace, target)
+		if err != nil {
+			c.logger.Warn("Failed to get burn rate for service",
+				zap.String("service", target.ServiceName),
+				zap.Error(err))
+			br = &BurnRate{ServiceName: target.ServiceName, Rates: map[string]float64{}, Thresholds: target.BurnRateThresholds}
+		}
+		burnRates = append(burnRates, *br)
+	}
+
+	return burnRates, nil
+}
+
+func (c *PrometheusClient) getBurnRate(ctx context.Context, namespace string, target SLOTarget) (*BurnRate, error) {
+	br := &BurnRate{
+		ServiceName: target.ServiceName,
+		Rates:       make(map[string]float64),
+		Thresholds:  target.BurnRateThresholds,
+	}
+	if br.Thresholds == nil {
+		br.Thresholds = []BurnRateThreshold{}
+	}
+
+	windows := append([]string{}, burnRateWindows...)
+	for _, t := range target.BurnRateThresholds {
+		windows = append(windows, t.Window)
+	}
+
+	errorBudget := target.errorBudget()
+	for _, window := range windows {
+		if _, done := br.Rates[window]; done {
+			continue
+		}
+		query, err := RenderSLIQuery(target.errorRatioQuery(), SLIQueryData{
+			Service:   target.ServiceName,
+			Namespace: namespace,
+			Window:    window,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		br.Rates[window] = 0
+		if result, err := c.Query(ctx, query); err == nil && len(result.Data.Result) > 0 {
+			if ratio, ok := ratioValue(c.extractValue(result.Data.Result[0].Value)); ok && errorBudget > 0 {
+				br.Rates[window] = ratio / errorBudget
+			}
+		}
+	}
+
+	br.Rate1h = br.Rates["1h"]
+	br.Rate6h = br.Rates["6h"]
+	br.Rate24h = br.Rates["24h"]
+
+	// Alert if any window burns faster than its threshold
+	// e.g. 1h burn rate > 14.4 consumes 100% of a 30 day error budget in ~2 days
+	for _, t := range target.BurnRateThresholds {
+		if br.Rates[t.Window] > t.Threshold {
+			br.Alerting = true
+		}
+	}
+
+	return br, nil
+}
+
+// GetErrorBudgetHistory returns how the error budget of a service was spent over its SLO window.
+// The window is split into buckets; the remaining budget at each point is the error ratio
+// since the window start (failed requests over requests, summed across buckets), relative to the budget.
+func (c *PrometheusClient) GetErrorBudgetHistory(ctx context.Context, namespace string, target SLOTarget) (*ErrorBudgetHistory, error) {
+	window, err := ParseWindow(target.window())
+	if err != nil {
+		return nil, err
+	}
+
+	step := (window / errorBudgetHistoryPoints).Truncate(time.Minute)
+	if step < 5*time.Minute {
+		step = 5 * time.Minute
+	}
+	stepWindow := fmt.Sprintf("%dm", int(step.Minutes()))
+
+	// 각 bucket의 오류율과 요청 수를 bucket 길이 window로 조회
+	data := SLIQueryData{
+		Service:   target.ServiceName,
+		Namespace: namespace,
+		Window:    stepWindow,
+	}
+	ratioQuery, err := RenderSLIQuery(target.errorRatioQuery(), data)
+	if err != nil {
+		return nil, err
+	}
+	requestQuery, err := RenderSLIQuery(DefaultRequestCountQuery, data)
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	start := end.Add(-window)
+	ratios, err := c.QueryRange(ctx, ratioQuery, start, end, step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query error budget history: %w", err)
+	}
+	requests, err := c.QueryRange(ctx, requestQuery, start, end, step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request volume: %w", err)
+	}
+
+	history := &ErrorBudgetHistory{
+		ServiceName:        target.ServiceName,
+		Window:             target.window(),
+		AvailabilityTarget: target.Availability,
+		Step:               stepWindow,
+		Points:             []ErrorBudgetPoint{},
+	}
+	if len(ratios.Data.Result) == 0 {
+		return history, nil
+	}
+	var requestValues [][]interface{}
+	if len(requests.Data.Result) > 0 {
+		requestValues = requests.Data.Result[0].Values
+	}
+	history.Points = c.errorBudgetPoints(ratios.Data.Result[0].Values, requestValues, target.errorBudget())
+	return history, nil
+}
+
+// errorBudgetPoints builds the error budget history from per-bucket error ratios and request counts.
+// Errors and requests are summed separately, so a bucket weighs as much as the traffic it served.
+func (c *PrometheusClient) errorBudgetPoints(ratios, requests [][]interface{}, errorBudget float64) []ErrorBudgetPoint {
+	counts := make(map[float64]float64, len(requests))
+	for _, v := range requests {
+		if ts, ok := v[0].(float64); ok {
+			if n, ok := ratioValue(c.extractValueFromRange(v)); ok {
+				counts[ts] = n
+			}
+		}
+	}
+
+	points := make([]ErrorBudgetPoint, 0, len(ratios))
+	var errorSum, requestSum float64
+	for _, v := range ratios {
+		ts, ok := v[0].(float64)
+		if !ok {
+			continue
+		}
+		ratio, _ := ratioValue(c.extractValueFromRange(v))
+		errorSum += ratio * counts[ts]
+		requestSum += counts[ts]
+
+		point := ErrorBudgetPoint{
+			Timestamp:            int64(ts),
+			ErrorRatio:           ratio,
+			ErrorBudgetRemaining: 100,
+		}
+		if errorBudget > 0 {
+			point.BurnRate = ratio / errorBudget
+			if requestSum > 0 {
+				consumed := (errorSum / requestSum) / errorBudget * 100
+				point.ErrorBudgetRemaining = math.Max(0, 100-consumed)
+			}
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// GetLatency returns the current latency quantile of the service of target in milliseconds
+func (c *PrometheusClient) GetLatency(ctx context.Context, namespace string, target SLOTarget, quantile float64) (float64, error) {
+	query, err := RenderSLIQuery(target.latencyQuery(), SLIQueryData{
+		Service:   target.ServiceName,
+		Namespace: namespace,
+		Window:    target.window(),
+		Quantile:  quantile,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := c.Query(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Data.Result) == 0 {
+		return 0, nil
+	}
+	return c.extractValue(result.Data.Result[0].Value), nil
+}
+
+// ratioValue returns v if it is a usable ratio; no traffic yields NaN, which extractValue maps to 0
+func ratioValue(v float64) (float64, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		window  string
+		want    time.Duration
+		wantErr bool
+	}{
+		{window: "5m", want: 5 * time.Minute},
+		{window: "1h", want: time.Hour},
+		{window: "28d", want: 28 * 24 * time.Hour},
+		{window: "4w", want: 4 * 7 * 24 * time.Hour},
+		{window: "", wantErr: true},
+		{window: "0h", wantErr: true},
+		{window: "07d", wantErr: true},
+		{window: "1.5h", wantErr: true},
+		{window: "30s", wantErr: true},
+		{window: "1y", wantErr: true},
+		{window: "h", wantErr: true},
+		{window: "1h ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			got, err := ParseWindow(tt.window)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderSLIQuery(t *testing.T) {
+	query, err := RenderSLIQuery(`rate(x{svc="{{.Service}}",ns="{{.Namespace}}"}[{{.Window}}])`, SLIQueryData{
+		Service:   "board-service",
+		Namespace: "wealist-prod",
+		Window:    "28d",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `rate(x{svc="board-service",ns="wealist-prod"}[28d])`, query)
+
+	_, err = RenderSLIQuery(`{{.Unknown}}`, SLIQueryData{})
+	assert.Error(t, err)
+	_, err = RenderSLIQuery(`{{.Service`, SLIQueryData{})
+	assert.Error(t, err)
+}
+
+// TestErrorBudgetPoints verifies the remaining budget is summed errors over summed requests,
+// so a failing bucket with little traffic does not outweigh busy healthy buckets
+func TestErrorBudgetPoints(t *testing.T) {
+	c := &PrometheusClient{}
+	ratios := [][]interface{}{
+		{float64(100), "0"},
+		{float64(200), "0.5"},
+		{float64(300), "NaN"}, // 트래픽 없음
+	}
+	requests := [][]interface{}{
+		{float64(100), "9990"},
+		{float64(200), "10"},
+		{float64(300), "0"},
+	}
+
+	points := c.errorBudgetPoints(ratios, requests, 0.001)
+	require.Len(t, points, 3)
+
+	assert.Equal(t, int64(100), points[0].Timestamp)
+	assert.InDelta(t, 100, points[0].ErrorBudgetRemaining, 1e-9)
+
+	// 5 errors / 10000 requests = 0.0005 -> 50% of a 0.1% budget consumed
+	assert.InDelta(t, 0.5, points[1].ErrorRatio, 1e-9)
+	assert.InDelta(t, 500, points[1].BurnRate, 1e-9)
+	assert.InDelta(t, 50, points[1].ErrorBudgetRemaining, 1e-9)
+
+	assert.Equal(t, float64(0), points[2].ErrorRatio)
+	assert.InDelta(t, 50, points[2].ErrorBudgetRemaining, 1e-9)
+}
+
+func TestErrorBudgetPoints_NoTraffic(t *testing.T) {
+	c := &PrometheusClient{}
+	points := c.errorBudgetPoints([][]interface{}{{float64(100), "0.2"}}, nil, 0.001)
+	require.Len(t, points, 1)
+	assert.Equal(t, float64(100), points[0].ErrorBudgetRemaining)
+
+	points = c.errorBudgetPoints([][]interface{}{{float64(100), "0.2"}}, [][]interface{}{{float64(100), "1000"}}, 0.001)
+	require.Len(t, points, 1)
+	assert.Equal(t, float64(0), points[0].ErrorBudgetRemaining)
+}
@@ -24,6 +24,26 @@ type Config struct {
 	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
 	Prometheus PrometheusConfig `yaml:"prometheus"`
 	Loki       LokiConfig       `yaml:"loki"`
+	AppConfig  AppConfigConfig  `yaml:"app_config"`
+	Alerting   AlertingConfig   `yaml:"alerting"`
+	Internal   InternalConfig   `yaml:"internal"`
+}
+
+// InternalConfig holds service-to-service API settings
+type InternalConfig struct {
+	APIKey string `yaml:"api_key"` // Required by /flags/snapshot; empty rejects every internal request
+}
+
+// AppConfigConfig holds remote config (app config) management settings
+type AppConfigConfig struct {
+	RequireApproval bool `yaml:"require_approval"` // Sensitive keys need a second approver
+}
+
+// AlertingConfig holds alert evaluator settings
+type AlertingConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	Interval       time.Duration `yaml:"interval"`        // How often alert rules are evaluated
+	RepeatInterval time.Duration `yaml:"repeat_interval"` // How long before a still-firing alert is sent again
 }
 
 // PrometheusConfig holds Prometheus API configuration
@@ -191,6 +211,11 @@ func getDefaultConfig() Config {
 			Timeout:   30 * time.Second,
 			Namespace: "wealist-prod",
 		},
+		Alerting: AlertingConfig{
+			Enabled:        true,
+			Interval:       time.Minute,
+			RepeatInterval: 4 * time.Hour,
+		},
 	}
 }
 
@@ -330,6 +355,31 @@ func (c *Config) overrideFromEnv() {
 	if lokiNS := os.Getenv("LOKI_NAMESPACE"); lokiNS != "" {
 		c.Loki.Namespace = lokiNS
 	}
+
+	// Internal API
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		c.Internal.APIKey = apiKey
+	}
+
+	// App Config
+	if requireApproval := os.Getenv("CONFIG_REQUIRE_APPROVAL"); requireApproval != "" {
+		c.AppConfig.RequireApproval = requireApproval == "true"
+	}
+
+	// Alerting
+	if alertingEnabled := os.Getenv("ALERTING_ENABLED"); alertingEnabled != "" {
+		c.Alerting.Enabled = alertingEnabled == "true"
+	}
+	if interval := os.Getenv("ALERT_EVAL_INTERVAL"); interval != "" {
+		if v, err := time.ParseDuration(interval); err == nil {
+			c.Alerting.Interval = v
+		}
+	}
+	if repeat := os.Getenv("ALERT_REPEAT_INTERVAL"); repeat != "" {
+		if v, err := time.ParseDuration(repeat); err == nil {
+			c.Alerting.RepeatInterval = v
+		}
+	}
 }
 
 func (c *Config) validate() error {
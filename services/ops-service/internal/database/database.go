@@ -69,5 +69,68 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.PortalUser{},
 		&domain.AuditLog{},
 		&domain.AppConfig{},
+		&domain.AppConfigRevision{},
+		&domain.AppConfigChangeRequest{},
+		&domain.FeatureFlag{},
+		&domain.FeatureFlagEnvironment{},
+		&domain.SLODefinition{},
+		&domain.AlertRule{},
+		&domain.AlertReceiver{},
+		&domain.Alert{},
+		&domain.AlertSilence{},
+		&domain.PortalNotification{},
+		&domain.Incident{},
+		&domain.IncidentTimelineEntry{},
+		&domain.IncidentEvidence{},
 	)
 }
+
+// SeedDefaults creates the default SLO definitions and alert rules.
+// It runs on every start regardless of DB_AUTO_MIGRATE, so a schema managed
+// outside the service is seeded as well; seeds already present are left untouched.
+func SeedDefaults(db *gorm.DB) error {
+	if err := seedSLODefinitions(db); err != nil {
+		return fmt.Errorf("seed SLO definitions: %w", err)
+	}
+	if err := seedAlertRules(db); err != nil {
+		return fmt.Errorf("seed alert rules: %w", err)
+	}
+	return nil
+}
+
+// seedSLODefinitions creates the default SLO definitions once.
+// Deleted definitions are soft deleted, so they are not seeded again.
+func seedSLODefinitions(db *gorm.DB) error {
+	var count int64
+	if err := db.Unscoped().Model(&domain.SLODefinition{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	defs := domain.DefaultSLODefinitions()
+	return db.Create(&defs).Error
+}
+
+// seedAlertRules creates the default alert rules and an in-app portal receiver once
+func seedAlertRules(db *gorm.DB) error {
+	var count int64
+	if err := db.Unscoped().Model(&domain.AlertRule{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		rules := domain.DefaultAlertRules()
+		if err := tx.Create(&rules).Error; err != nil {
+			return err
+		}
+		return tx.Create(&domain.AlertReceiver{
+			Name:        "ops-portal",
+			Type:        domain.ReceiverPortal,
+			MinSeverity: domain.SeverityWarning,
+			Enabled:     true,
+		}).Error
+	})
+}
@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlertRuleType represents what an alert rule evaluates
+type AlertRuleType string
+
+const (
+	// AlertRuleBurnRate fires when an SLO burns its error budget faster than its burn rate thresholds
+	AlertRuleBurnRate AlertRuleType = "burn_rate"
+	// AlertRuleErrorRate fires when a service's 5xx rate over the last hour exceeds Threshold (%)
+	AlertRuleErrorRate AlertRuleType = "error_rate"
+	// AlertRuleLatency fires when a service's P99 latency exceeds Threshold (ms) or its SLO target
+	AlertRuleLatency AlertRuleType = "latency"
+	// AlertRuleArgoCDApp fires when an ArgoCD application is Degraded or OutOfSync
+	AlertRuleArgoCDApp AlertRuleType = "argocd_app"
+)
+
+// IsValid checks if the alert rule type is valid
+func (t AlertRuleType) IsValid() bool {
+	switch t {
+	case AlertRuleBurnRate, AlertRuleErrorRate, AlertRuleLatency, AlertRuleArgoCDApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// AlertSeverity represents how urgent an alert is
+type AlertSeverity string
+
+const (
+	SeverityInfo     AlertSeverity = "info"
+	SeverityWarning  AlertSeverity = "warning"
+	SeverityCritical AlertSeverity = "critical"
+)
+
+// Rank orders severities; it is 0 for invalid values
+func (s AlertSeverity) Rank() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityWarning:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsValid checks if the severity is valid
+func (s AlertSeverity) IsValid() bool {
+	return s.Rank() > 0
+}
+
+// AlertRule is evaluated periodically by the alert evaluator
+type AlertRule struct {
+	BaseModel
+	Name        string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_alert_rule_name,where:deleted_at IS NULL" json:"name"`
+	Description string        `gorm:"type:text" json:"description,omitempty"`
+	Type        AlertRuleType `gorm:"type:varchar(20);not null" json:"type"`
+	Severity    AlertSeverity `gorm:"type:varchar(20);not null" json:"severity"`
+	Target      string        `gorm:"type:varchar(100)" json:"target,omitempty"` // service or ArgoCD app; empty = all
+	Threshold   float64       `json:"threshold"`                                 // meaning depends on Type; 0 = SLO defaults
+	Enabled     bool          `gorm:"not null;default:true" json:"enabled"`
+	ReceiverIDs []uuid.UUID   `gorm:"type:jsonb;serializer:json" json:"receiverIds"` // empty = route by severity
+	UpdatedBy   uuid.UUID     `gorm:"type:uuid" json:"updatedBy"`
+}
+
+// TableName returns the table name for GORM
+func (AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// DefaultAlertRules returns the rules seeded on first migration
+func DefaultAlertRules() []AlertRule {
+	return []AlertRule{
+		{Name: "slo-burn-rate", Description: "Error budget burning faster than the SLO thresholds",
+			Type: AlertRuleBurnRate, Severity: SeverityCritical, Enabled: true},
+		{Name: "high-error-rate", Description: "More than 5% of requests failed with 5xx in the last hour",
+			Type: AlertRuleErrorRate, Severity: SeverityWarning, Threshold: 5, Enabled: true},
+		{Name: "slow-responses", Description: "P99 latency above the SLO target",
+			Type: AlertRuleLatency, Severity: SeverityWarning, Enabled: true},
+		{Name: "argocd-app-unhealthy", Description: "ArgoCD application Degraded or OutOfSync",
+			Type: AlertRuleArgoCDApp, Severity: SeverityWarning, Enabled: true},
+	}
+}
+
+// ReceiverType represents how a receiver delivers alerts
+type ReceiverType string
+
+const (
+	// ReceiverWebhook posts the alert group as JSON
+	ReceiverWebhook ReceiverType = "webhook"
+	// ReceiverSlack posts a Slack-compatible {"text": ...} message
+	ReceiverSlack ReceiverType = "slack"
+	// ReceiverPortal stores an in-app notification for the ops portal
+	ReceiverPortal ReceiverType = "portal"
+)
+
+// AlertReceiver is a destination alerts are routed to
+type AlertReceiver struct {
+	BaseModel
+	Name        string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_alert_receiver_name,where:deleted_at IS NULL" json:"name"`
+	Type        ReceiverType  `gorm:"type:varchar(20);not null" json:"type"`
+	URL         string        `gorm:"type:text" json:"url,omitempty"`
+	MinSeverity AlertSeverity `gorm:"type:varchar(20);not null" json:"minSeverity"` // severity routing for rules without receivers
+	Enabled     bool          `gorm:"not null;default:true" json:"enabled"`
+	UpdatedBy   uuid.UUID     `gorm:"type:uuid" json:"updatedBy"`
+}
+
+// TableName returns the table name for GORM
+func (AlertReceiver) TableName() string {
+	return "alert_receivers"
+}
+
+// AlertStatus represents the state of an alert
+type AlertStatus string
+
+const (
+	AlertFiring   AlertStatus = "firing"
+	AlertResolved AlertStatus = "resolved"
+)
+
+// Alert is the persisted state of one firing (or resolved) alert.
+// Only one firing alert exists per fingerprint, so restarts do not fire it again.
+type Alert struct {
+	ID              uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Fingerprint     string            `gorm:"type:varchar(64);not null;uniqueIndex:idx_alert_firing,where:status = 'firing'" json:"fingerprint"`
+	RuleID          uuid.UUID         `gorm:"type:uuid;not null;index" json:"ruleId"`
+	RuleName        string            `gorm:"not null" json:"ruleName"`
+	Severity        AlertSeverity     `gorm:"type:varchar(20);not null" json:"severity"`
+	Labels          map[string]string `gorm:"type:jsonb;serializer:json" json:"labels"`
+	Summary         string            `gorm:"type:text" json:"summary"`
+	Value           float64           `json:"value"`
+	Status          AlertStatus       `gorm:"type:varchar(20);not null;index" json:"status"`
+	Silenced        bool              `json:"silenced"`
+	StartsAt        time.Time         `gorm:"not null;index" json:"startsAt"`
+	EndsAt          *time.Time        `json:"endsAt,omitempty"`
+	LastEvaluatedAt time.Time         `json:"lastEvaluatedAt"`
+	LastNotifiedAt  *time.Time        `json:"lastNotifiedAt,omitempty"`
+	CreatedAt       time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time         `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName returns the table name for GORM
+func (Alert) TableName() string {
+	return "alerts"
+}
+
+// MatchLabels returns the labels silences are matched against
+func (a *Alert) MatchLabels() map[string]string {
+	labels := make(map[string]string, len(a.Labels)+2)
+	for k, v := range a.Labels {
+		labels[k] = v
+	}
+	labels["alertname"] = a.RuleName
+	labels["severity"] = string(a.Severity)
+	return labels
+}
+
+// AlertSilence mutes notifications for alerts whose labels match every matcher
+type AlertSilence struct {
+	ID             uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Matchers       map[string]string `gorm:"type:jsonb;serializer:json;not null" json:"matchers"`
+	StartsAt       time.Time         `gorm:"not null" json:"startsAt"`
+	EndsAt         time.Time         `gorm:"not null;index" json:"endsAt"`
+	Comment        string            `gorm:"type:text" json:"comment"`
+	CreatedByID    uuid.UUID         `gorm:"type:uuid;not null" json:"createdById"`
+	CreatedByEmail string            `gorm:"not null" json:"createdByEmail"`
+	CreatedAt      time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (AlertSilence) TableName() string {
+	return "alert_silences"
+}
+
+// IsActive reports whether the silence applies at t
+func (s *AlertSilence) IsActive(t time.Time) bool {
+	return !t.Before(s.StartsAt) && t.Before(s.EndsAt)
+}
+
+// Matches reports whether every matcher equals the corresponding label
+func (s *AlertSilence) Matches(labels map[string]string) bool {
+	for k, v := range s.Matchers {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return len(s.Matchers) > 0
+}
+
+// PortalNotification is an in-app notification shown in the ops portal
+type PortalNotification struct {
+	ID        uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RuleName  string        `gorm:"not null" json:"ruleName"`
+	Severity  AlertSeverity `gorm:"type:varchar(20);not null" json:"severity"`
+	Status    AlertStatus   `gorm:"type:varchar(20);not null" json:"status"`
+	Title     string        `gorm:"not null" json:"title"`
+	Body      string        `gorm:"type:text" json:"body"`
+	CreatedAt time.Time     `gorm:"autoCreateTime;index" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (PortalNotification) TableName() string {
+	return "portal_notifications"
+}
+
+// CreateAlertRuleRequest is the request DTO for creating an alert rule
+type CreateAlertRuleRequest struct {
+	Name        string        `json:"name" binding:"required"`
+	Description string        `json:"description"`
+	Type        AlertRuleType `json:"type" binding:"required"`
+	Severity    AlertSeverity `json:"severity" binding:"required"`
+	Target      string        `json:"target"`
+	Threshold   float64       `json:"threshold"`
+	ReceiverIDs []uuid.UUID   `json:"receiverIds"`
+}
+
+// UpdateAlertRuleRequest is the request DTO for updating an alert rule
+type UpdateAlertRuleRequest struct {
+	Description string        `json:"description"`
+	Severity    AlertSeverity `json:"severity" binding:"required"`
+	Target      string        `json:"target"`
+	Threshold   float64       `json:"threshold"`
+	Enabled     *bool         `json:"enabled"`
+	ReceiverIDs []uuid.UUID   `json:"receiverIds"`
+}
+
+// CreateAlertReceiverRequest is the request DTO for creating an alert receiver
+type CreateAlertReceiverRequest struct {
+	Name        string        `json:"name" binding:"required"`
+	Type        ReceiverType  `json:"type" binding:"required"`
+	URL         string        `json:"url"`
+	MinSeverity AlertSeverity `json:"minSeverity"`
+}
+
+// UpdateAlertReceiverRequest is the request DTO for updating an alert receiver
+type UpdateAlertReceiverRequest struct {
+	URL         string        `json:"url"`
+	MinSeverity AlertSeverity `json:"minSeverity" binding:"required"`
+	Enabled     *bool         `json:"enabled"`
+}
+
+// CreateSilenceRequest is the request DTO for silencing alerts
+type CreateSilenceRequest struct {
+	Matchers map[string]string `json:"matchers" binding:"required"`
+	StartsAt *time.Time        `json:"startsAt"` // default now
+	EndsAt   time.Time         `json:"endsAt" binding:"required"`
+	Comment  string            `json:"comment" binding:"required"`
+}
@@ -13,6 +13,7 @@ type AppConfig struct {
 	Value       string    `gorm:"type:text;not null" json:"value"`
 	Description string    `gorm:"type:text" json:"description,omitempty"`
 	IsActive    bool      `gorm:"default:true" json:"isActive"`
+	IsSensitive bool      `gorm:"default:false" json:"isSensitive"` // 승인 모드에서 변경 시 두 번째 승인자 필요
 	UpdatedBy   uuid.UUID `gorm:"type:uuid" json:"updatedBy"`
 }
 
@@ -28,6 +29,7 @@ type AppConfigResponse struct {
 	Value       string    `json:"value"`
 	Description string    `json:"description,omitempty"`
 	IsActive    bool      `json:"isActive"`
+	IsSensitive bool      `json:"isSensitive"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
@@ -39,6 +41,7 @@ func (c *AppConfig) ToResponse() AppConfigResponse {
 		Value:       c.Value,
 		Description: c.Description,
 		IsActive:    c.IsActive,
+		IsSensitive: c.IsSensitive,
 		UpdatedAt:   c.UpdatedAt,
 	}
 }
@@ -48,6 +51,8 @@ type CreateAppConfigRequest struct {
 	Key         string `json:"key" binding:"required"`
 	Value       string `json:"value" binding:"required"`
 	Description string `json:"description"`
+	IsSensitive bool   `json:"isSensitive"`
+	Reason      string `json:"reason"`
 }
 
 // UpdateAppConfigRequest is the request DTO for updating an app config
@@ -55,4 +60,6 @@ type UpdateAppConfigRequest struct {
 	Value       string `json:"value" binding:"required"`
 	Description string `json:"description"`
 	IsActive    *bool  `json:"isActive"`
+	IsSensitive *bool  `json:"isSensitive"`
+	Reason      string `json:"reason"`
 }
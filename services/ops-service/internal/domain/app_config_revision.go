@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevisionAction represents how an app config revision was produced
+type RevisionAction string
+
+const (
+	RevisionCreate   RevisionAction = "create"
+	RevisionUpdate   RevisionAction = "update"
+	RevisionRollback RevisionAction = "rollback"
+)
+
+// AppConfigRevision is an append-only record of one change to an app config.
+// Rows are never updated or deleted, so the full history survives deletion of the config.
+type AppConfigRevision struct {
+	ID              uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ConfigID        uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex:idx_config_revision" json:"configId"`
+	Revision        int            `gorm:"not null;uniqueIndex:idx_config_revision" json:"revision"`
+	ConfigKey       string         `gorm:"not null" json:"configKey"`
+	Action          RevisionAction `gorm:"type:varchar(20);not null" json:"action"`
+	OldValue        string         `gorm:"type:text" json:"oldValue"`
+	NewValue        string         `gorm:"type:text;not null" json:"newValue"`
+	OldIsActive     bool           `json:"oldIsActive"`
+	NewIsActive     bool           `json:"newIsActive"`
+	Reason          string         `gorm:"type:text" json:"reason,omitempty"`
+	RollbackOf      *int           `json:"rollbackOf,omitempty"` // revision restored by a rollback
+	AuthorID        uuid.UUID      `gorm:"type:uuid;not null" json:"authorId"`
+	AuthorEmail     string         `gorm:"not null" json:"authorEmail"`
+	ApprovedByID    *uuid.UUID     `gorm:"type:uuid" json:"approvedById,omitempty"`
+	ApprovedByEmail string         `json:"approvedByEmail,omitempty"`
+	ChangeRequestID *uuid.UUID     `gorm:"type:uuid" json:"changeRequestId,omitempty"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime;index" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (AppConfigRevision) TableName() string {
+	return "app_config_revisions"
+}
+
+// ChangeRequestStatus represents the state of a pending app config change
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestPending  ChangeRequestStatus = "pending"
+	ChangeRequestApproved ChangeRequestStatus = "approved"
+	ChangeRequestRejected ChangeRequestStatus = "rejected"
+)
+
+// AppConfigChangeRequest is a change to a sensitive app config waiting for a second approver.
+// It is applied as a new revision once someone other than the requester approves it.
+type AppConfigChangeRequest struct {
+	ID               uuid.UUID           `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ConfigID         uuid.UUID           `gorm:"type:uuid;not null;index" json:"configId"`
+	ConfigKey        string              `gorm:"not null" json:"configKey"`
+	BaseRevision     int                 `gorm:"not null" json:"baseRevision"` // latest revision when requested
+	Value            string              `gorm:"type:text;not null" json:"value"`
+	Description      string              `gorm:"type:text" json:"description,omitempty"`
+	IsActive         *bool               `json:"isActive,omitempty"`
+	IsSensitive      *bool               `json:"isSensitive,omitempty"`
+	RollbackTo       *int                `json:"rollbackTo,omitempty"`
+	Reason           string              `gorm:"type:text" json:"reason,omitempty"`
+	Status           ChangeRequestStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	RequestedByID    uuid.UUID           `gorm:"type:uuid;not null" json:"requestedById"`
+	RequestedByEmail string              `gorm:"not null" json:"requestedByEmail"`
+	ReviewedByID     *uuid.UUID          `gorm:"type:uuid" json:"reviewedById,omitempty"`
+	ReviewedByEmail  string              `json:"reviewedByEmail,omitempty"`
+	ReviewComment    string              `gorm:"type:text" json:"reviewComment,omitempty"`
+	ReviewedAt       *time.Time          `json:"reviewedAt,omitempty"`
+	CreatedAt        time.Time           `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (AppConfigChangeRequest) TableName() string {
+	return "app_config_change_requests"
+}
+
+// DiffOp is the kind of a line in a revision diff
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine is one line of a revision diff
+type DiffLine struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// AppConfigDiffResponse is the response DTO for comparing two revisions of an app config
+type AppConfigDiffResponse struct {
+	ConfigID uuid.UUID         `json:"configId"`
+	Key      string            `json:"key"`
+	From     AppConfigRevision `json:"from"`
+	To       AppConfigRevision `json:"to"`
+	Lines    []DiffLine        `json:"lines"`
+}
+
+// RollbackAppConfigRequest is the request DTO for rolling an app config back to a revision
+type RollbackAppConfigRequest struct {
+	Revision int    `json:"revision" binding:"required,min=1"`
+	Reason   string `json:"reason"`
+}
+
+// ReviewChangeRequest is the request DTO for approving or rejecting a pending change
+type ReviewChangeRequest struct {
+	Comment string `json:"comment"`
+}
+
+// AppConfigChangeResult is returned by writes to an app config.
+// Exactly one of Config and Pending is set; Pending means the change waits for a second approver.
+type AppConfigChangeResult struct {
+	Config  *AppConfigResponse      `json:"config,omitempty"`
+	Pending *AppConfigChangeRequest `json:"pending,omitempty"`
+}
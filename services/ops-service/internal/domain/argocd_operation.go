@@ -0,0 +1,53 @@
+package domain
+
+// ArgoCDResourceRef identifies a resource of an ArgoCD application
+type ArgoCDResourceRef struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// ArgoCDSyncRequest is the request DTO for syncing an ArgoCD application with options
+type ArgoCDSyncRequest struct {
+	Revision  string              `json:"revision"`  // default: the application's target revision
+	Prune     bool                `json:"prune"`     // admin only unless dryRun
+	DryRun    bool                `json:"dryRun"`    // validate without applying
+	Resources []ArgoCDResourceRef `json:"resources"` // default: all resources
+	Reason    string              `json:"reason" binding:"required"`
+}
+
+// ArgoCDRollbackRequest is the request DTO for rolling an ArgoCD application back to a history entry
+type ArgoCDRollbackRequest struct {
+	HistoryID int64  `json:"historyId" binding:"required"`
+	Prune     bool   `json:"prune"` // admin only unless dryRun
+	DryRun    bool   `json:"dryRun"`
+	Reason    string `json:"reason" binding:"required"`
+}
+
+// ArgoCDOperationResult is the response DTO of a sync or rollback
+type ArgoCDOperationResult struct {
+	Application    string `json:"application"`
+	Operation      string `json:"operation"` // sync or rollback
+	DryRun         bool   `json:"dryRun"`
+	BeforeRevision string `json:"beforeRevision"`
+	AfterRevision  string `json:"afterRevision"`
+}
+
+// ResourceDiffStatus describes how the live state of a resource differs from Git
+type ResourceDiffStatus string
+
+const (
+	ResourceSynced   ResourceDiffStatus = "synced"
+	ResourceModified ResourceDiffStatus = "modified"
+	ResourceMissing  ResourceDiffStatus = "missing" // in Git but not in the cluster
+	ResourceExtra    ResourceDiffStatus = "extra"   // in the cluster but no longer in Git (pruned by sync with prune)
+)
+
+// ArgoCDResourceDiff is the line diff between the live and desired state of one managed resource
+type ArgoCDResourceDiff struct {
+	ArgoCDResourceRef
+	Status ResourceDiffStatus `json:"status"`
+	Hook   bool               `json:"hook,omitempty"`
+	Lines  []DiffLine         `json:"lines,omitempty"` // live state (delete) to desired state (insert)
+}
@@ -10,21 +10,30 @@ import (
 type ActionType string
 
 const (
-	ActionCreate ActionType = "create"
-	ActionUpdate ActionType = "update"
-	ActionDelete ActionType = "delete"
-	ActionLogin  ActionType = "login"
-	ActionLogout ActionType = "logout"
+	ActionCreate   ActionType = "create"
+	ActionUpdate   ActionType = "update"
+	ActionDelete   ActionType = "delete"
+	ActionLogin    ActionType = "login"
+	ActionLogout   ActionType = "logout"
+	ActionRollback ActionType = "rollback"
+	ActionApprove  ActionType = "approve"
+	ActionReject   ActionType = "reject"
+	ActionSync     ActionType = "sync"
 )
 
 // ResourceType represents the type of resource affected
 type ResourceType string
 
 const (
-	ResourceUser        ResourceType = "portal_user"
-	ResourceArgoCD      ResourceType = "argocd_rbac"
-	ResourceFeatureFlag ResourceType = "feature_flag"
-	ResourceAppConfig   ResourceType = "app_config"
+	ResourceUser          ResourceType = "portal_user"
+	ResourceArgoCD        ResourceType = "argocd_rbac"
+	ResourceFeatureFlag   ResourceType = "feature_flag"
+	ResourceAppConfig     ResourceType = "app_config"
+	ResourceSLO           ResourceType = "slo_definition"
+	ResourceAlertRule     ResourceType = "alert_rule"
+	ResourceAlertReceiver ResourceType = "alert_receiver"
+	ResourceAlertSilence  ResourceType = "alert_silence"
+	ResourceIncident      ResourceType = "incident"
 )
 
 // AuditLog represents an audit log entry
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+)
+
+// FeatureFlag represents a typed feature flag with per-environment values
+type FeatureFlag struct {
+	BaseModel
+	Key          string                   `gorm:"type:varchar(100);uniqueIndex;not null" json:"key"`
+	Type         featureflag.FlagType     `gorm:"type:varchar(20);not null" json:"type"`
+	Description  string                   `gorm:"type:text" json:"description,omitempty"`
+	DefaultValue string                   `gorm:"type:text;not null" json:"defaultValue"` // JSON, served when the flag is off
+	UpdatedBy    uuid.UUID                `gorm:"type:uuid" json:"updatedBy"`
+	Environments []FeatureFlagEnvironment `gorm:"foreignKey:FlagID" json:"environments,omitempty"`
+}
+
+// TableName returns the table name for GORM
+func (FeatureFlag) TableName() string {
+	return "feature_flags"
+}
+
+// FeatureFlagEnvironment holds the state of a flag in one environment
+type FeatureFlagEnvironment struct {
+	ID          uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	FlagID      uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_flag_environment" json:"flagId"`
+	Environment string             `gorm:"type:varchar(32);not null;uniqueIndex:idx_flag_environment" json:"environment"`
+	Enabled     bool               `gorm:"not null;default:false" json:"enabled"`
+	Value       string             `gorm:"type:text" json:"value,omitempty"` // JSON, served when no rule matches
+	Rules       []featureflag.Rule `gorm:"type:jsonb;serializer:json" json:"rules,omitempty"`
+	UpdatedBy   uuid.UUID          `gorm:"type:uuid" json:"updatedBy"`
+	CreatedAt   time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt   time.Time          `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName returns the table name for GORM
+func (FeatureFlagEnvironment) TableName() string {
+	return "feature_flag_environments"
+}
+
+// ForEnvironment returns the flag as served to env. Environments without settings serve the default value.
+func (f *FeatureFlag) ForEnvironment(env string) featureflag.Flag {
+	flag := featureflag.Flag{
+		Key:          f.Key,
+		Type:         f.Type,
+		DefaultValue: json.RawMessage(f.DefaultValue),
+	}
+	for _, e := range f.Environments {
+		if e.Environment != env {
+			continue
+		}
+		flag.Enabled = e.Enabled
+		flag.Rules = e.Rules
+		if e.Value != "" {
+			flag.Value = json.RawMessage(e.Value)
+		}
+	}
+	return flag
+}
+
+// FeatureFlagEnvironmentResponse is the response DTO for the state of a flag in one environment
+type FeatureFlagEnvironmentResponse struct {
+	Environment string             `json:"environment"`
+	Enabled     bool               `json:"enabled"`
+	Value       json.RawMessage    `json:"value,omitempty"`
+	Rules       []featureflag.Rule `json:"rules"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// FeatureFlagResponse is the response DTO for feature flag
+type FeatureFlagResponse struct {
+	ID           uuid.UUID                        `json:"id"`
+	Key          string                           `json:"key"`
+	Type         featureflag.FlagType             `json:"type"`
+	Description  string                           `json:"description,omitempty"`
+	DefaultValue json.RawMessage                  `json:"defaultValue"`
+	Environments []FeatureFlagEnvironmentResponse `json:"environments"`
+	UpdatedAt    time.Time                        `json:"updatedAt"`
+}
+
+// ToResponse converts FeatureFlag to FeatureFlagResponse
+func (f *FeatureFlag) ToResponse() FeatureFlagResponse {
+	envs := make([]FeatureFlagEnvironmentResponse, len(f.Environments))
+	for i, e := range f.Environments {
+		envs[i] = FeatureFlagEnvironmentResponse{
+			Environment: e.Environment,
+			Enabled:     e.Enabled,
+			Rules:       e.Rules,
+			UpdatedAt:   e.UpdatedAt,
+		}
+		if e.Value != "" {
+			envs[i].Value = json.RawMessage(e.Value)
+		}
+		if envs[i].Rules == nil {
+			envs[i].Rules = []featureflag.Rule{}
+		}
+	}
+	return FeatureFlagResponse{
+		ID:           f.ID,
+		Key:          f.Key,
+		Type:         f.Type,
+		Description:  f.Description,
+		DefaultValue: json.RawMessage(f.DefaultValue),
+		Environments: envs,
+		UpdatedAt:    f.UpdatedAt,
+	}
+}
+
+// CreateFeatureFlagRequest is the request DTO for creating a feature flag
+type CreateFeatureFlagRequest struct {
+	Key          string               `json:"key" binding:"required"`
+	Type         featureflag.FlagType `json:"type" binding:"required"`
+	Description  string               `json:"description"`
+	DefaultValue json.RawMessage      `json:"defaultValue" binding:"required"`
+}
+
+// UpdateFeatureFlagRequest is the request DTO for updating a feature flag.
+// The type cannot change because the values of every environment depend on it.
+type UpdateFeatureFlagRequest struct {
+	Description  string          `json:"description"`
+	DefaultValue json.RawMessage `json:"defaultValue" binding:"required"`
+}
+
+// UpdateFlagEnvironmentRequest is the request DTO for setting a flag in one environment.
+// Rules are evaluated in order; the first match wins.
+type UpdateFlagEnvironmentRequest struct {
+	Enabled bool               `json:"enabled"`
+	Value   json.RawMessage    `json:"value"`
+	Rules   []featureflag.Rule `json:"rules"`
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IncidentSeverity represents the impact of an incident
+type IncidentSeverity string
+
+const (
+	// IncidentSev1 is a full outage or data loss
+	IncidentSev1 IncidentSeverity = "sev1"
+	// IncidentSev2 is a major feature unavailable for many users
+	IncidentSev2 IncidentSeverity = "sev2"
+	// IncidentSev3 is a degraded or partially unavailable feature
+	IncidentSev3 IncidentSeverity = "sev3"
+)
+
+// IsValid checks if the incident severity is valid
+func (s IncidentSeverity) IsValid() bool {
+	switch s {
+	case IncidentSev1, IncidentSev2, IncidentSev3:
+		return true
+	default:
+		return false
+	}
+}
+
+// IncidentStatus represents the lifecycle stage of an incident
+type IncidentStatus string
+
+const (
+	IncidentInvestigating IncidentStatus = "investigating"
+	IncidentIdentified    IncidentStatus = "identified"
+	IncidentMonitoring    IncidentStatus = "monitoring"
+	IncidentResolved      IncidentStatus = "resolved"
+)
+
+// IsValid checks if the incident status is valid
+func (s IncidentStatus) IsValid() bool {
+	switch s {
+	case IncidentInvestigating, IncidentIdentified, IncidentMonitoring, IncidentResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Incident is an outage or degradation being coordinated in the ops portal
+type Incident struct {
+	BaseModel
+	Title            string           `gorm:"type:varchar(200);not null" json:"title"`
+	Summary          string           `gorm:"type:text" json:"summary,omitempty"`
+	Severity         IncidentSeverity `gorm:"type:varchar(10);not null;index" json:"severity"`
+	Status           IncidentStatus   `gorm:"type:varchar(20);not null;index" json:"status"`
+	AffectedServices []string         `gorm:"type:jsonb;serializer:json" json:"affectedServices"`
+	CommanderID      *uuid.UUID       `gorm:"type:uuid" json:"commanderId,omitempty"`
+	CommanderEmail   string           `json:"commanderEmail,omitempty"`
+	StartedAt        time.Time        `gorm:"not null;index" json:"startedAt"`
+	IdentifiedAt     *time.Time       `json:"identifiedAt,omitempty"`
+	MonitoringAt     *time.Time       `json:"monitoringAt,omitempty"`
+	ResolvedAt       *time.Time       `json:"resolvedAt,omitempty"`
+	CreatedByID      uuid.UUID        `gorm:"type:uuid;not null" json:"createdById"`
+	CreatedByEmail   string           `gorm:"not null" json:"createdByEmail"`
+
+	Timeline []IncidentTimelineEntry `gorm:"foreignKey:IncidentID" json:"timeline,omitempty"`
+	Evidence []IncidentEvidence      `gorm:"foreignKey:IncidentID" json:"evidence,omitempty"`
+}
+
+// TableName returns the table name for GORM
+func (Incident) TableName() string {
+	return "incidents"
+}
+
+// TimelineEntryType represents what a timeline entry records
+type TimelineEntryType string
+
+const (
+	TimelineNote            TimelineEntryType = "note"
+	TimelineStatusChange    TimelineEntryType = "status_change"
+	TimelineSeverityChange  TimelineEntryType = "severity_change"
+	TimelineCommanderChange TimelineEntryType = "commander_change"
+	TimelineUpdate          TimelineEntryType = "update"
+)
+
+// IncidentTimelineEntry is an append-only note or state change of an incident
+type IncidentTimelineEntry struct {
+	ID          uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	IncidentID  uuid.UUID         `gorm:"type:uuid;not null;index" json:"incidentId"`
+	Type        TimelineEntryType `gorm:"type:varchar(20);not null" json:"type"`
+	Message     string            `gorm:"type:text;not null" json:"message"`
+	AuthorID    uuid.UUID         `gorm:"type:uuid;not null" json:"authorId"`
+	AuthorEmail string            `gorm:"not null" json:"authorEmail"`
+	CreatedAt   time.Time         `gorm:"autoCreateTime;index" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (IncidentTimelineEntry) TableName() string {
+	return "incident_timeline_entries"
+}
+
+// EvidenceKind represents where a piece of evidence came from
+type EvidenceKind string
+
+const (
+	// EvidenceAlert is an alert that fired during the incident window
+	EvidenceAlert EvidenceKind = "alert"
+	// EvidenceDeployment is an ArgoCD sync during the incident window
+	EvidenceDeployment EvidenceKind = "deployment"
+	// EvidenceErrorSpike is a period of elevated 5xx rate during the incident window
+	EvidenceErrorSpike EvidenceKind = "error_spike"
+)
+
+// IncidentEvidence is data attached automatically from alerts, ArgoCD and Prometheus.
+// SourceKey identifies the source record so collecting again does not duplicate it.
+type IncidentEvidence struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	IncidentID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_incident_evidence_source" json:"incidentId"`
+	Kind       EvidenceKind `gorm:"type:varchar(20);not null;uniqueIndex:idx_incident_evidence_source" json:"kind"`
+	SourceKey  string       `gorm:"type:varchar(200);not null;uniqueIndex:idx_incident_evidence_source" json:"sourceKey"`
+	Service    string       `gorm:"type:varchar(100)" json:"service,omitempty"`
+	Title      string       `gorm:"not null" json:"title"`
+	Detail     string       `gorm:"type:text" json:"detail,omitempty"`
+	OccurredAt time.Time    `gorm:"not null" json:"occurredAt"`
+	CreatedAt  time.Time    `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName returns the table name for GORM
+func (IncidentEvidence) TableName() string {
+	return "incident_evidence"
+}
+
+// CreateIncidentRequest is the request DTO for declaring an incident
+type CreateIncidentRequest struct {
+	Title            string           `json:"title" binding:"required"`
+	Summary          string           `json:"summary"`
+	Severity         IncidentSeverity `json:"severity" binding:"required"`
+	AffectedServices []string         `json:"affectedServices"`
+	CommanderID      *uuid.UUID       `json:"commanderId"` // default: the declaring user
+	StartedAt        *time.Time       `json:"startedAt"`   // default: now
+}
+
+// UpdateIncidentRequest is the request DTO for updating incident details
+type UpdateIncidentRequest struct {
+	Title            string           `json:"title" binding:"required"`
+	Summary          string           `json:"summary"`
+	Severity         IncidentSeverity `json:"severity" binding:"required"`
+	AffectedServices []string         `json:"affectedServices"`
+	CommanderID      *uuid.UUID       `json:"commanderId"`
+}
+
+// UpdateIncidentStatusRequest is the request DTO for moving an incident to another status
+type UpdateIncidentStatusRequest struct {
+	Status  IncidentStatus `json:"status" binding:"required"`
+	Message string         `json:"message"`
+}
+
+// AddIncidentNoteRequest is the request DTO for adding a note to the timeline
+type AddIncidentNoteRequest struct {
+	Message string `json:"message" binding:"required"`
+}
@@ -46,3 +46,18 @@ func (r Role) CanManageFeatureFlags() bool {
 func (r Role) CanSearchUsers() bool {
 	return r == RoleAdmin || r == RolePM
 }
+
+// CanSyncApplications checks if the role can sync ArgoCD applications
+func (r Role) CanSyncApplications() bool {
+	return r == RoleAdmin || r == RolePM
+}
+
+// CanRollbackApplications checks if the role can roll back ArgoCD applications
+func (r Role) CanRollbackApplications() bool {
+	return r == RoleAdmin || r == RolePM
+}
+
+// CanPruneResources checks if the role can delete resources by syncing with prune
+func (r Role) CanPruneResources() bool {
+	return r == RoleAdmin
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// SLOWindow is the rolling window an SLO is measured over
+type SLOWindow string
+
+const (
+	SLOWindow7d  SLOWindow = "7d"
+	SLOWindow28d SLOWindow = "28d"
+)
+
+// IsValid checks if the SLO window is supported
+func (w SLOWindow) IsValid() bool {
+	return w == SLOWindow7d || w == SLOWindow28d
+}
+
+// BurnRateThreshold alerts when the error budget burn rate over Window exceeds Threshold
+type BurnRateThreshold struct {
+	Window    string  `json:"window"`    // PromQL duration, e.g. 1h
+	Threshold float64 `json:"threshold"` // multiple of the sustainable burn rate
+}
+
+// SLODefinition defines the availability and latency objectives of one service.
+// Empty SLI queries use the templated defaults of the Prometheus client.
+type SLODefinition struct {
+	BaseModel
+	ServiceName        string              `gorm:"type:varchar(100);not null;uniqueIndex:idx_slo_service,where:deleted_at IS NULL" json:"serviceName"`
+	Description        string              `gorm:"type:text" json:"description,omitempty"`
+	Enabled            bool                `gorm:"not null;default:true" json:"enabled"`
+	AvailabilityTarget float64             `gorm:"not null" json:"availabilityTarget"` // e.g. 99.9
+	LatencyP50Target   float64             `json:"latencyP50Target"`                   // ms, 0 = no objective
+	LatencyP99Target   float64             `json:"latencyP99Target"`                   // ms, 0 = no objective
+	Window             SLOWindow           `gorm:"type:varchar(10);not null" json:"window"`
+	ErrorRatioQuery    string              `gorm:"type:text" json:"errorRatioQuery,omitempty"`
+	LatencyQuery       string              `gorm:"type:text" json:"latencyQuery,omitempty"`
+	BurnRateThresholds []BurnRateThreshold `gorm:"type:jsonb;serializer:json" json:"burnRateThresholds"`
+	UpdatedBy          uuid.UUID           `gorm:"type:uuid" json:"updatedBy"`
+}
+
+// TableName returns the table name for GORM
+func (SLODefinition) TableName() string {
+	return "slo_definitions"
+}
+
+// DefaultSLODefinitions returns the definitions seeded for the core services
+func DefaultSLODefinitions() []SLODefinition {
+	services := []string{
+		"auth-service",
+		"user-service",
+		"board-service",
+		"chat-service",
+		"noti-service",
+		"storage-service",
+	}
+
+	defs := make([]SLODefinition, len(services))
+	for i, svc := range services {
+		defs[i] = SLODefinition{
+			ServiceName:        svc,
+			Enabled:            true,
+			AvailabilityTarget: 99.9,
+			LatencyP50Target:   100,
+			LatencyP99Target:   500,
+			Window:             SLOWindow28d,
+			BurnRateThresholds: []BurnRateThreshold{
+				{Window: "1h", Threshold: 14.4},
+				{Window: "6h", Threshold: 6},
+			},
+		}
+	}
+	return defs
+}
+
+// CreateSLODefinitionRequest is the request DTO for creating an SLO definition
+type CreateSLODefinitionRequest struct {
+	ServiceName        string              `json:"serviceName" binding:"required"`
+	Description        string              `json:"description"`
+	AvailabilityTarget float64             `json:"availabilityTarget" binding:"required"`
+	LatencyP50Target   float64             `json:"latencyP50Target"`
+	LatencyP99Target   float64             `json:"latencyP99Target"`
+	Window             SLOWindow           `json:"window"`
+	ErrorRatioQuery    string              `json:"errorRatioQuery"`
+	LatencyQuery       string              `json:"latencyQuery"`
+	BurnRateThresholds []BurnRateThreshold `json:"burnRateThresholds"`
+}
+
+// UpdateSLODefinitionRequest is the request DTO for updating an SLO definition
+type UpdateSLODefinitionRequest struct {
+	Description        string              `json:"description"`
+	Enabled            *bool               `json:"enabled"`
+	AvailabilityTarget float64             `json:"availabilityTarget" binding:"required"`
+	LatencyP50Target   float64             `json:"latencyP50Target"`
+	LatencyP99Target   float64             `json:"latencyP99Target"`
+	Window             SLOWindow           `json:"window" binding:"required"`
+	ErrorRatioQuery    string              `json:"errorRatioQuery"`
+	LatencyQuery       string              `json:"latencyQuery"`
+	BurnRateThresholds []BurnRateThreshold `json:"burnRateThresholds"`
+}
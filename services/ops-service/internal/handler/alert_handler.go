@@ -0,0 +1,417 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/middleware"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+	"ops-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AlertHandler handles alert, alert rule, receiver and silence requests
+type AlertHandler struct {
+	alertService *service.AlertService
+}
+
+// NewAlertHandler creates a new alert handler
+func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+	return &AlertHandler{alertService: alertService}
+}
+
+// ListAlerts lists firing and resolved alerts
+// @Summary List alerts
+// @Tags Alerts
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param status query string false "Filter by status (firing, resolved)"
+// @Param rule_id query string false "Filter by rule ID"
+// @Param since query string false "Alerts active at or after (RFC3339)"
+// @Param until query string false "Alerts started at or before (RFC3339)"
+// @Success 200 {object} response.PaginatedResponse
+// @Router /api/monitoring/alerts [get]
+func (h *AlertHandler) ListAlerts(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	opts := repository.AlertListOptions{
+		Page:  page,
+		Limit: limit,
+	}
+
+	if status := c.Query("status"); status != "" {
+		s := domain.AlertStatus(status)
+		opts.Status = &s
+	}
+	if ruleIDStr := c.Query("rule_id"); ruleIDStr != "" {
+		ruleID, err := uuid.Parse(ruleIDStr)
+		if err == nil {
+			opts.RuleID = &ruleID
+		}
+	}
+	if sinceStr := c.Query("since"); sinceStr != "" {
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err == nil {
+			opts.Since = &since
+		}
+	}
+	if untilStr := c.Query("until"); untilStr != "" {
+		until, err := time.Parse(time.RFC3339, untilStr)
+		if err == nil {
+			opts.Until = &until
+		}
+	}
+
+	alerts, total, err := h.alertService.ListAlerts(opts)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	response.Paginated(c, alerts, page, limit, total)
+}
+
+// ListNotifications lists the latest in-app alert notifications
+// @Summary List portal notifications
+// @Tags Alerts
+// @Security BearerAuth
+// @Param limit query int false "Max notifications" default(50)
+// @Success 200 {array} domain.PortalNotification
+// @Router /api/monitoring/alerts/notifications [get]
+func (h *AlertHandler) ListNotifications(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	notifications, err := h.alertService.ListNotifications(limit)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, notifications)
+}
+
+// GetRules returns all alert rules
+// @Summary Get alert rules
+// @Tags Alerts
+// @Security BearerAuth
+// @Success 200 {array} domain.AlertRule
+// @Router /api/monitoring/alerts/rules [get]
+func (h *AlertHandler) GetRules(c *gin.Context) {
+	rules, err := h.alertService.GetRules()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, rules)
+}
+
+// CreateRule creates an alert rule
+// @Summary Create alert rule
+// @Tags Alerts
+// @Security BearerAuth
+// @Param body body domain.CreateAlertRuleRequest true "Create request"
+// @Success 201 {object} domain.AlertRule
+// @Router /api/admin/alerts/rules [post]
+func (h *AlertHandler) CreateRule(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	rule, err := h.alertService.CreateRule(portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, rule)
+}
+
+// UpdateRule updates an alert rule
+// @Summary Update alert rule
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Alert rule ID"
+// @Param body body domain.UpdateAlertRuleRequest true "Update request"
+// @Success 200 {object} domain.AlertRule
+// @Router /api/admin/alerts/rules/{id} [put]
+func (h *AlertHandler) UpdateRule(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid alert rule ID")
+		return
+	}
+
+	var req domain.UpdateAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	rule, err := h.alertService.UpdateRule(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// DeleteRule deletes an alert rule
+// @Summary Delete alert rule
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Alert rule ID"
+// @Success 204
+// @Router /api/admin/alerts/rules/{id} [delete]
+func (h *AlertHandler) DeleteRule(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid alert rule ID")
+		return
+	}
+
+	if err := h.alertService.DeleteRule(portalUser.ID, portalUser.Email, id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetReceivers returns all alert receivers
+// @Summary Get alert receivers
+// @Tags Alerts
+// @Security BearerAuth
+// @Success 200 {array} domain.AlertReceiver
+// @Router /api/admin/alerts/receivers [get]
+func (h *AlertHandler) GetReceivers(c *gin.Context) {
+	receivers, err := h.alertService.GetReceivers()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, receivers)
+}
+
+// CreateReceiver creates an alert receiver
+// @Summary Create alert receiver
+// @Tags Alerts
+// @Security BearerAuth
+// @Param body body domain.CreateAlertReceiverRequest true "Create request"
+// @Success 201 {object} domain.AlertReceiver
+// @Router /api/admin/alerts/receivers [post]
+func (h *AlertHandler) CreateReceiver(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateAlertReceiverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	receiver, err := h.alertService.CreateReceiver(portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, receiver)
+}
+
+// UpdateReceiver updates an alert receiver
+// @Summary Update alert receiver
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Receiver ID"
+// @Param body body domain.UpdateAlertReceiverRequest true "Update request"
+// @Success 200 {object} domain.AlertReceiver
+// @Router /api/admin/alerts/receivers/{id} [put]
+func (h *AlertHandler) UpdateReceiver(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid receiver ID")
+		return
+	}
+
+	var req domain.UpdateAlertReceiverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	receiver, err := h.alertService.UpdateReceiver(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, receiver)
+}
+
+// DeleteReceiver deletes an alert receiver
+// @Summary Delete alert receiver
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Receiver ID"
+// @Success 204
+// @Router /api/admin/alerts/receivers/{id} [delete]
+func (h *AlertHandler) DeleteReceiver(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid receiver ID")
+		return
+	}
+
+	if err := h.alertService.DeleteReceiver(portalUser.ID, portalUser.Email, id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// TestReceiver sends a test notification to a receiver
+// @Summary Test alert receiver
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Receiver ID"
+// @Success 204
+// @Router /api/admin/alerts/receivers/{id}/test [post]
+func (h *AlertHandler) TestReceiver(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid receiver ID")
+		return
+	}
+
+	if err := h.alertService.TestReceiver(c.Request.Context(), id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetSilences returns silences that have not ended
+// @Summary Get active silences
+// @Tags Alerts
+// @Security BearerAuth
+// @Success 200 {array} domain.AlertSilence
+// @Router /api/monitoring/alerts/silences [get]
+func (h *AlertHandler) GetSilences(c *gin.Context) {
+	silences, err := h.alertService.GetActiveSilences()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, silences)
+}
+
+// CreateSilence silences alerts matching every matcher until endsAt
+// @Summary Create silence
+// @Description Matchers are compared with alert labels plus alertname and severity
+// @Tags Alerts
+// @Security BearerAuth
+// @Param body body domain.CreateSilenceRequest true "Silence request"
+// @Success 201 {object} domain.AlertSilence
+// @Router /api/monitoring/alerts/silences [post]
+func (h *AlertHandler) CreateSilence(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateSilenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	silence, err := h.alertService.CreateSilence(portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, silence)
+}
+
+// ExpireSilence ends a silence immediately
+// @Summary Expire silence
+// @Tags Alerts
+// @Security BearerAuth
+// @Param id path string true "Silence ID"
+// @Success 204
+// @Router /api/monitoring/alerts/silences/{id} [delete]
+func (h *AlertHandler) ExpireSilence(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid silence ID")
+		return
+	}
+
+	if err := h.alertService.ExpireSilence(portalUser.ID, portalUser.Email, id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"ops-service/internal/domain"
+	"ops-service/internal/middleware"
+	"ops-service/internal/response"
+	"ops-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ArgoCDDeployHandler handles ArgoCD sync, rollback and diff requests
+type ArgoCDDeployHandler struct {
+	deployService *service.ArgoCDDeployService
+}
+
+// NewArgoCDDeployHandler creates a new ArgoCD deploy handler
+func NewArgoCDDeployHandler(deployService *service.ArgoCDDeployService) *ArgoCDDeployHandler {
+	return &ArgoCDDeployHandler{deployService: deployService}
+}
+
+// Sync syncs an ArgoCD application with options
+// @Summary Sync ArgoCD application with options
+// @Description Syncs to a specific revision, selected resources, with prune (admin only) or as a dry run. A reason is required and audited.
+// @Tags ArgoCD
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param name path string true "Application name"
+// @Param body body domain.ArgoCDSyncRequest true "Sync options"
+// @Success 200 {object} domain.ArgoCDOperationResult
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/monitoring/applications/{name}/sync [post]
+func (h *ArgoCDDeployHandler) Sync(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.ArgoCDSyncRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	result, err := h.deployService.Sync(portalUser, c.Param("name"), req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// Rollback rolls an ArgoCD application back to a deployment history entry
+// @Summary Roll back ArgoCD application
+// @Description Rolls back to the history ID from the deployment history. ArgoCD rejects rollbacks while automated sync is enabled.
+// @Tags ArgoCD
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param name path string true "Application name"
+// @Param body body domain.ArgoCDRollbackRequest true "Rollback request"
+// @Success 200 {object} domain.ArgoCDOperationResult
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/monitoring/applications/{name}/rollback [post]
+func (h *ArgoCDDeployHandler) Rollback(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.ArgoCDRollbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	result, err := h.deployService.Rollback(portalUser, c.Param("name"), req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// Diff returns the managed-resources diff of an ArgoCD application
+// @Summary Get ArgoCD application diff
+// @Description Returns the line diff between live and desired state for each out-of-sync resource
+// @Tags ArgoCD
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Application name"
+// @Param all query bool false "Include resources without differences"
+// @Success 200 {array} domain.ArgoCDResourceDiff
+// @Router /api/monitoring/applications/{name}/diff [get]
+func (h *ArgoCDDeployHandler) Diff(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	diffs, err := h.deployService.Diff(portalUser, c.Param("name"), c.Query("all") == "true")
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, diffs)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
@@ -113,13 +115,15 @@ func (h *ConfigHandler) Create(c *gin.Context) {
 	response.Created(c, config.ToResponse())
 }
 
-// Update updates an app config
+// Update updates an app config.
+// Changes to sensitive configs return 202 with the pending change when a second approver is required.
 // @Summary Update app config
 // @Tags config
 // @Security BearerAuth
 // @Param id path string true "Config ID"
 // @Param body body domain.UpdateAppConfigRequest true "Update request"
-// @Success 200 {object} domain.AppConfigResponse
+// @Success 200 {object} domain.AppConfigChangeResult
+// @Success 202 {object} domain.AppConfigChangeResult
 // @Router /api/admin/config/{id} [put]
 func (h *ConfigHandler) Update(c *gin.Context) {
 	portalUser := middleware.GetPortalUser(c)
@@ -141,13 +145,13 @@ func (h *ConfigHandler) Update(c *gin.Context) {
 		return
 	}
 
-	config, err := h.configService.Update(portalUser.ID, portalUser.Email, id, req)
+	result, err := h.configService.Update(portalUser.ID, portalUser.Email, id, req)
 	if err != nil {
 		response.HandleServiceError(c, err)
 		return
 	}
 
-	response.Success(c, config.ToResponse())
+	respondChangeResult(c, result)
 }
 
 // Delete deletes an app config
@@ -178,3 +182,206 @@ func (h *ConfigHandler) Delete(c *gin.Context) {
 
 	response.NoContent(c)
 }
+
+// ListRevisions returns the revisions of an app config, newest first
+// @Summary List app config revisions
+// @Tags config
+// @Security BearerAuth
+// @Param id path string true "Config ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} response.PaginatedResponse
+// @Router /api/admin/config/{id}/revisions [get]
+func (h *ConfigHandler) ListRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid config ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	revisions, total, err := h.configService.ListRevisions(id, page, limit)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Paginated(c, revisions, page, limit, total)
+}
+
+// DiffRevisions compares the values of two revisions of an app config
+// @Summary Diff app config revisions
+// @Tags config
+// @Security BearerAuth
+// @Param id path string true "Config ID"
+// @Param from query int true "Base revision"
+// @Param to query int true "Target revision"
+// @Success 200 {object} domain.AppConfigDiffResponse
+// @Router /api/admin/config/{id}/revisions/diff [get]
+func (h *ConfigHandler) DiffRevisions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid config ID")
+		return
+	}
+
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil || from < 1 {
+		response.BadRequest(c, "Invalid from revision")
+		return
+	}
+	to, err := strconv.Atoi(c.Query("to"))
+	if err != nil || to < 1 {
+		response.BadRequest(c, "Invalid to revision")
+		return
+	}
+
+	diff, err := h.configService.Diff(id, from, to)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, diff)
+}
+
+// Rollback restores an app config to a revision
+// @Summary Roll back app config
+// @Tags config
+// @Security BearerAuth
+// @Param id path string true "Config ID"
+// @Param body body domain.RollbackAppConfigRequest true "Rollback request"
+// @Success 200 {object} domain.AppConfigChangeResult
+// @Success 202 {object} domain.AppConfigChangeResult
+// @Router /api/admin/config/{id}/rollback [post]
+func (h *ConfigHandler) Rollback(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid config ID")
+		return
+	}
+
+	var req domain.RollbackAppConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	result, err := h.configService.Rollback(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	respondChangeResult(c, result)
+}
+
+// ListPendingChanges returns changes to sensitive configs waiting for a second approver
+// @Summary List pending app config changes
+// @Tags config
+// @Security BearerAuth
+// @Success 200 {array} domain.AppConfigChangeRequest
+// @Router /api/admin/config/changes [get]
+func (h *ConfigHandler) ListPendingChanges(c *gin.Context) {
+	changes, err := h.configService.ListPendingChanges()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, changes)
+}
+
+// ApproveChange applies a pending change as the second approver
+// @Summary Approve app config change
+// @Tags config
+// @Security BearerAuth
+// @Param changeId path string true "Change request ID"
+// @Param body body domain.ReviewChangeRequest false "Review comment"
+// @Success 200 {object} domain.AppConfigResponse
+// @Router /api/admin/config/changes/{changeId}/approve [post]
+func (h *ConfigHandler) ApproveChange(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	changeID, req, ok := bindReview(c)
+	if !ok {
+		return
+	}
+
+	config, err := h.configService.ApproveChange(portalUser.ID, portalUser.Email, changeID, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, config.ToResponse())
+}
+
+// RejectChange rejects a pending change
+// @Summary Reject app config change
+// @Tags config
+// @Security BearerAuth
+// @Param changeId path string true "Change request ID"
+// @Param body body domain.ReviewChangeRequest false "Review comment"
+// @Success 200 {object} domain.AppConfigChangeRequest
+// @Router /api/admin/config/changes/{changeId}/reject [post]
+func (h *ConfigHandler) RejectChange(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	changeID, req, ok := bindReview(c)
+	if !ok {
+		return
+	}
+
+	change, err := h.configService.RejectChange(portalUser.ID, portalUser.Email, changeID, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, change)
+}
+
+// bindReview parses the change request ID and the optional review body
+func bindReview(c *gin.Context) (uuid.UUID, domain.ReviewChangeRequest, bool) {
+	var req domain.ReviewChangeRequest
+
+	changeID, err := uuid.Parse(c.Param("changeId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid change request ID")
+		return uuid.Nil, req, false
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "Invalid request body")
+			return uuid.Nil, req, false
+		}
+	}
+	return changeID, req, true
+}
+
+// respondChangeResult responds 202 when the change waits for approval, 200 otherwise
+func respondChangeResult(c *gin.Context, result *domain.AppConfigChangeResult) {
+	if result.Pending != nil {
+		response.Accepted(c, result)
+		return
+	}
+	response.Success(c, result)
+}
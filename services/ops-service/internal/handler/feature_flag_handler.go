@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/middleware"
+	"ops-service/internal/response"
+	"ops-service/internal/service"
+)
+
+// FeatureFlagHandler handles feature flag HTTP requests
+type FeatureFlagHandler struct {
+	flagService *service.FeatureFlagService
+}
+
+// NewFeatureFlagHandler creates a new feature flag handler
+func NewFeatureFlagHandler(flagService *service.FeatureFlagService) *FeatureFlagHandler {
+	return &FeatureFlagHandler{flagService: flagService}
+}
+
+// GetSnapshot returns every flag as served to one environment (internal endpoint for the featureflag client)
+// @Summary Get feature flag snapshot
+// @Tags feature-flags
+// @Security InternalAPIKey
+// @Param env query string true "Environment (e.g. prod)"
+// @Success 200 {object} featureflag.Snapshot
+// @Failure 401 {object} response.Response
+// @Router /api/flags/snapshot [get]
+func (h *FeatureFlagHandler) GetSnapshot(c *gin.Context) {
+	env := c.Query("env")
+	if env == "" {
+		response.BadRequest(c, "env query parameter is required")
+		return
+	}
+
+	snapshot, err := h.flagService.Snapshot(env)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, snapshot)
+}
+
+// GetAll returns all feature flags with their per-environment state
+// @Summary Get all feature flags
+// @Tags feature-flags
+// @Security BearerAuth
+// @Success 200 {array} domain.FeatureFlagResponse
+// @Router /api/admin/flags [get]
+func (h *FeatureFlagHandler) GetAll(c *gin.Context) {
+	flags, err := h.flagService.GetAll()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	responses := make([]domain.FeatureFlagResponse, len(flags))
+	for i := range flags {
+		responses[i] = flags[i].ToResponse()
+	}
+
+	response.Success(c, responses)
+}
+
+// GetByID returns a feature flag
+// @Summary Get feature flag
+// @Tags feature-flags
+// @Security BearerAuth
+// @Param id path string true "Flag ID"
+// @Success 200 {object} domain.FeatureFlagResponse
+// @Router /api/admin/flags/{id} [get]
+func (h *FeatureFlagHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid flag ID")
+		return
+	}
+
+	flag, err := h.flagService.GetByID(id)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, flag.ToResponse())
+}
+
+// Create creates a new feature flag
+// @Summary Create feature flag
+// @Tags feature-flags
+// @Security BearerAuth
+// @Param body body domain.CreateFeatureFlagRequest true "Create request"
+// @Success 201 {object} domain.FeatureFlagResponse
+// @Router /api/admin/flags [post]
+func (h *FeatureFlagHandler) Create(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateFeatureFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	flag, err := h.flagService.Create(portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, flag.ToResponse())
+}
+
+// Update updates the description and default value of a feature flag
+// @Summary Update feature flag
+// @Tags feature-flags
+// @Security BearerAuth
+// @Param id path string true "Flag ID"
+// @Param body body domain.UpdateFeatureFlagRequest true "Update request"
+// @Success 200 {object} domain.FeatureFlagResponse
+// @Router /api/admin/flags/{id} [put]
+func (h *FeatureFlagHandler) Update(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid flag ID")
+		return
+	}
+
+	var req domain.UpdateFeatureFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	flag, err := h.flagService.Update(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, flag.ToResponse())
+}
+
+// UpdateEnvironment sets a feature flag in one environment
+// @Summary Update feature flag environment
+// @Tags feature-flags
+// @Security BearerAuth
+// @Param id path string true "Flag ID"
+// @Param env path string true "Environment"
+// @Param body body domain.UpdateFlagEnvironmentRequest true "Environment state"
+// @Success 200 {object} domain.FeatureFlagResponse
+// @Router /api/admin/flags/{id}/environments/{env} [put]
+func (h *FeatureFlagHandler) UpdateEnvironment(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid flag ID")
+		return
+	}
+
+	var req domain.UpdateFlagEnvironmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	flag, err := h.flagService.UpdateEnvironment(portalUser.ID, portalUser.Email, id, c.Param("env"), req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, flag.ToResponse())
+}
+
+// Delete deletes a feature flag
+// @Summary Delete feature flag
+// @Tags feature-flags
+// @Security BearerAuth
+// @Param id path string true "Flag ID"
+// @Success 204
+// @Router /api/admin/flags/{id} [delete]
+func (h *FeatureFlagHandler) Delete(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid flag ID")
+		return
+	}
+
+	if err := h.flagService.Delete(portalUser.ID, portalUser.Email, id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/middleware"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+	"ops-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// IncidentHandler handles incident requests
+type IncidentHandler struct {
+	incidentService *service.IncidentService
+}
+
+// NewIncidentHandler creates a new incident handler
+func NewIncidentHandler(incidentService *service.IncidentService) *IncidentHandler {
+	return &IncidentHandler{incidentService: incidentService}
+}
+
+// List lists incidents
+// @Summary List incidents
+// @Tags Incidents
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param status query string false "Filter by status"
+// @Param severity query string false "Filter by severity"
+// @Param open query bool false "Only unresolved incidents"
+// @Success 200 {object} response.PaginatedResponse
+// @Router /api/monitoring/incidents [get]
+func (h *IncidentHandler) List(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	opts := repository.IncidentListOptions{
+		Page:  page,
+		Limit: limit,
+		Open:  c.Query("open") == "true",
+	}
+	if status := c.Query("status"); status != "" {
+		s := domain.IncidentStatus(status)
+		opts.Status = &s
+	}
+	if severity := c.Query("severity"); severity != "" {
+		s := domain.IncidentSeverity(severity)
+		opts.Severity = &s
+	}
+
+	incidents, total, err := h.incidentService.List(opts)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	response.Paginated(c, incidents, page, limit, total)
+}
+
+// GetByID returns an incident with its timeline and evidence
+// @Summary Get incident
+// @Tags Incidents
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} domain.Incident
+// @Router /api/monitoring/incidents/{id} [get]
+func (h *IncidentHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	incident, err := h.incidentService.GetByID(id)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, incident)
+}
+
+// Create declares an incident
+// @Summary Declare incident
+// @Description Creates an incident and attaches alerts, ArgoCD syncs and error spikes from its window
+// @Tags Incidents
+// @Security BearerAuth
+// @Param body body domain.CreateIncidentRequest true "Create request"
+// @Success 201 {object} domain.Incident
+// @Router /api/monitoring/incidents [post]
+func (h *IncidentHandler) Create(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	incident, err := h.incidentService.Create(c.Request.Context(), portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, incident)
+}
+
+// Update updates incident details
+// @Summary Update incident
+// @Tags Incidents
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Param body body domain.UpdateIncidentRequest true "Update request"
+// @Success 200 {object} domain.Incident
+// @Router /api/monitoring/incidents/{id} [put]
+func (h *IncidentHandler) Update(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	var req domain.UpdateIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	incident, err := h.incidentService.Update(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, incident)
+}
+
+// UpdateStatus moves an incident to another status
+// @Summary Update incident status
+// @Tags Incidents
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Param body body domain.UpdateIncidentStatusRequest true "Status request"
+// @Success 200 {object} domain.Incident
+// @Router /api/monitoring/incidents/{id}/status [put]
+func (h *IncidentHandler) UpdateStatus(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	var req domain.UpdateIncidentStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	incident, err := h.incidentService.UpdateStatus(c.Request.Context(), portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, incident)
+}
+
+// AddNote adds a note to the incident timeline
+// @Summary Add incident note
+// @Tags Incidents
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Param body body domain.AddIncidentNoteRequest true "Note"
+// @Success 201 {object} domain.IncidentTimelineEntry
+// @Router /api/monitoring/incidents/{id}/notes [post]
+func (h *IncidentHandler) AddNote(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	var req domain.AddIncidentNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	entry, err := h.incidentService.AddNote(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, entry)
+}
+
+// CollectEvidence attaches evidence from the incident window again
+// @Summary Refresh incident evidence
+// @Tags Incidents
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} domain.Incident
+// @Router /api/monitoring/incidents/{id}/evidence [post]
+func (h *IncidentHandler) CollectEvidence(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	incident, err := h.incidentService.CollectEvidence(c.Request.Context(), portalUser.ID, portalUser.Email, id)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, incident)
+}
+
+// PostMortem exports the incident as a Markdown post-mortem
+// @Summary Export post-mortem
+// @Tags Incidents
+// @Security BearerAuth
+// @Produce text/markdown
+// @Param id path string true "Incident ID"
+// @Success 200 {string} string "Markdown document"
+// @Router /api/monitoring/incidents/{id}/postmortem [get]
+func (h *IncidentHandler) PostMortem(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid incident ID")
+		return
+	}
+
+	markdown, err := h.incidentService.PostMortem(id)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="incident-%s.md"`, id))
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(markdown))
+}
@@ -1,30 +1,33 @@
 package handler
 
 import (
-	"ops-service/internal/client"
+	"errors"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/middleware"
 	"ops-service/internal/response"
+	"ops-service/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-// SLOHandler handles SLO dashboard requests
+// SLOHandler handles SLO dashboard and SLO definition requests
 type SLOHandler struct {
-	prometheusClient *client.PrometheusClient
-	namespace        string
-	logger           *zap.Logger
+	sloService *service.SLOService
+	logger     *zap.Logger
 }
 
 // NewSLOHandler creates a new SLO handler
-func NewSLOHandler(prometheusClient *client.PrometheusClient, namespace string, logger *zap.Logger) *SLOHandler {
+func NewSLOHandler(sloService *service.SLOService, logger *zap.Logger) *SLOHandler {
 	return &SLOHandler{
-		prometheusClient: prometheusClient,
-		namespace:        namespace,
-		logger:           logger,
+		sloService: sloService,
+		logger:     logger,
 	}
 }
 
-// GetSLOOverview returns SLO metrics for all services
+// GetSLOOverview returns SLO metrics for all services with an enabled SLO definition
 // @Summary Get SLO overview
 // @Description Returns SLO metrics for all services including availability, latency, and error budget
 // @Tags SLO
@@ -33,41 +36,195 @@ func NewSLOHandler(prometheusClient *client.PrometheusClient, namespace string,
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/monitoring/slo/overview [get]
 func (h *SLOHandler) GetSLOOverview(c *gin.Context) {
-	if h.prometheusClient == nil {
-		response.InternalError(c, "Prometheus client not configured")
-		return
-	}
-
-	overview, err := h.prometheusClient.GetSLOOverview(c.Request.Context(), h.namespace)
+	overview, err := h.sloService.Overview(c.Request.Context())
 	if err != nil {
-		h.logger.Error("Failed to get SLO overview", zap.Error(err))
-		response.InternalError(c, "Failed to get SLO overview")
+		h.handleMonitoringError(c, err, "Failed to get SLO overview")
 		return
 	}
 
 	response.Success(c, overview)
 }
 
-// GetBurnRates returns error budget burn rates for all services
+// GetBurnRates returns error budget burn rates for all services with an enabled SLO definition
 // @Summary Get burn rates
-// @Description Returns error budget burn rates (1h, 6h, 24h) for all services
+// @Description Returns error budget burn rates (1h, 6h, 24h and threshold windows) for all services
 // @Tags SLO
 // @Produce json
 // @Success 200 {array} client.BurnRate
 // @Failure 500 {object} response.ErrorResponse
 // @Router /api/monitoring/slo/burn-rates [get]
 func (h *SLOHandler) GetBurnRates(c *gin.Context) {
-	if h.prometheusClient == nil {
-		response.InternalError(c, "Prometheus client not configured")
+	burnRates, err := h.sloService.BurnRates(c.Request.Context())
+	if err != nil {
+		h.handleMonitoringError(c, err, "Failed to get burn rates")
 		return
 	}
 
-	burnRates, err := h.prometheusClient.GetBurnRates(c.Request.Context(), h.namespace)
+	response.Success(c, burnRates)
+}
+
+// GetErrorBudgetHistory returns how an SLO's error budget was spent over its window
+// @Summary Get error budget history
+// @Tags SLO
+// @Produce json
+// @Param id path string true "SLO definition ID"
+// @Success 200 {object} client.ErrorBudgetHistory
+// @Failure 500 {object} response.ErrorResponse
+// @Router /api/monitoring/slo/definitions/{id}/error-budget [get]
+func (h *SLOHandler) GetErrorBudgetHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		h.logger.Error("Failed to get burn rates", zap.Error(err))
-		response.InternalError(c, "Failed to get burn rates")
+		response.BadRequest(c, "Invalid SLO definition ID")
 		return
 	}
 
-	response.Success(c, burnRates)
+	history, err := h.sloService.ErrorBudgetHistory(c.Request.Context(), id)
+	if err != nil {
+		h.handleMonitoringError(c, err, "Failed to get error budget history")
+		return
+	}
+
+	response.Success(c, history)
+}
+
+// GetDefinitions returns all SLO definitions
+// @Summary Get SLO definitions
+// @Tags SLO
+// @Security BearerAuth
+// @Success 200 {array} domain.SLODefinition
+// @Router /api/monitoring/slo/definitions [get]
+func (h *SLOHandler) GetDefinitions(c *gin.Context) {
+	defs, err := h.sloService.GetAll()
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, defs)
+}
+
+// GetDefinition returns an SLO definition
+// @Summary Get SLO definition
+// @Tags SLO
+// @Security BearerAuth
+// @Param id path string true "SLO definition ID"
+// @Success 200 {object} domain.SLODefinition
+// @Router /api/monitoring/slo/definitions/{id} [get]
+func (h *SLOHandler) GetDefinition(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid SLO definition ID")
+		return
+	}
+
+	def, err := h.sloService.GetByID(id)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, def)
+}
+
+// CreateDefinition creates an SLO definition
+// @Summary Create SLO definition
+// @Tags SLO
+// @Security BearerAuth
+// @Param body body domain.CreateSLODefinitionRequest true "Create request"
+// @Success 201 {object} domain.SLODefinition
+// @Router /api/admin/slo/definitions [post]
+func (h *SLOHandler) CreateDefinition(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req domain.CreateSLODefinitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	def, err := h.sloService.Create(portalUser.ID, portalUser.Email, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Created(c, def)
+}
+
+// UpdateDefinition updates an SLO definition
+// @Summary Update SLO definition
+// @Tags SLO
+// @Security BearerAuth
+// @Param id path string true "SLO definition ID"
+// @Param body body domain.UpdateSLODefinitionRequest true "Update request"
+// @Success 200 {object} domain.SLODefinition
+// @Router /api/admin/slo/definitions/{id} [put]
+func (h *SLOHandler) UpdateDefinition(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid SLO definition ID")
+		return
+	}
+
+	var req domain.UpdateSLODefinitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	def, err := h.sloService.Update(portalUser.ID, portalUser.Email, id, req)
+	if err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, def)
+}
+
+// DeleteDefinition deletes an SLO definition
+// @Summary Delete SLO definition
+// @Tags SLO
+// @Security BearerAuth
+// @Param id path string true "SLO definition ID"
+// @Success 204
+// @Router /api/admin/slo/definitions/{id} [delete]
+func (h *SLOHandler) DeleteDefinition(c *gin.Context) {
+	portalUser := middleware.GetPortalUser(c)
+	if portalUser == nil {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid SLO definition ID")
+		return
+	}
+
+	if err := h.sloService.Delete(portalUser.ID, portalUser.Email, id); err != nil {
+		response.HandleServiceError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// handleMonitoringError maps known service errors and hides Prometheus failures behind message
+func (h *SLOHandler) handleMonitoringError(c *gin.Context, err error, message string) {
+	if errors.Is(err, response.ErrNoPrometheus) || errors.Is(err, response.ErrSLONotFound) {
+		response.HandleServiceError(c, err)
+		return
+	}
+	h.logger.Error(message, zap.Error(err))
+	response.InternalError(c, message)
 }
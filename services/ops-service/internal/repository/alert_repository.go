@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"ops-service/internal/domain"
+)
+
+// AlertRepository handles alert rule, receiver, alert, silence and portal notification database operations
+type AlertRepository struct {
+	db *gorm.DB
+}
+
+// NewAlertRepository creates a new alert repository
+func NewAlertRepository(db *gorm.DB) *AlertRepository {
+	return &AlertRepository{db: db}
+}
+
+// CreateRule creates a new alert rule
+func (r *AlertRepository) CreateRule(rule *domain.AlertRule) error {
+	return r.db.Create(rule).Error
+}
+
+// GetRuleByID gets an alert rule by ID
+func (r *AlertRepository) GetRuleByID(id uuid.UUID) (*domain.AlertRule, error) {
+	var rule domain.AlertRule
+	if err := r.db.Where("id = ?", id).First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// GetRules gets all alert rules
+func (r *AlertRepository) GetRules() ([]domain.AlertRule, error) {
+	var rules []domain.AlertRule
+	if err := r.db.Order("name").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetEnabledRules gets all enabled alert rules
+func (r *AlertRepository) GetEnabledRules() ([]domain.AlertRule, error) {
+	var rules []domain.AlertRule
+	if err := r.db.Where("enabled = ?", true).Order("name").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// RuleExistsByName checks if an alert rule exists by name
+func (r *AlertRepository) RuleExistsByName(name string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.AlertRule{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UpdateRule updates an alert rule
+func (r *AlertRepository) UpdateRule(rule *domain.AlertRule) error {
+	return r.db.Save(rule).Error
+}
+
+// DeleteRule soft deletes an alert rule
+func (r *AlertRepository) DeleteRule(id uuid.UUID) error {
+	return r.db.Delete(&domain.AlertRule{}, id).Error
+}
+
+// CreateReceiver creates a new alert receiver
+func (r *AlertRepository) CreateReceiver(receiver *domain.AlertReceiver) error {
+	return r.db.Create(receiver).Error
+}
+
+// GetReceiverByID gets an alert receiver by ID
+func (r *AlertRepository) GetReceiverByID(id uuid.UUID) (*domain.AlertReceiver, error) {
+	var receiver domain.AlertReceiver
+	if err := r.db.Where("id = ?", id).First(&receiver).Error; err != nil {
+		return nil, err
+	}
+	return &receiver, nil
+}
+
+// GetReceivers gets all alert receivers
+func (r *AlertRepository) GetReceivers() ([]domain.AlertReceiver, error) {
+	var receivers []domain.AlertReceiver
+	if err := r.db.Order("name").Find(&receivers).Error; err != nil {
+		return nil, err
+	}
+	return receivers, nil
+}
+
+// GetEnabledReceivers gets all enabled alert receivers
+func (r *AlertRepository) GetEnabledReceivers() ([]domain.AlertReceiver, error) {
+	var receivers []domain.AlertReceiver
+	if err := r.db.Where("enabled = ?", true).Order("name").Find(&receivers).Error; err != nil {
+		return nil, err
+	}
+	return receivers, nil
+}
+
+// ReceiverExistsByName checks if an alert receiver exists by name
+func (r *AlertRepository) ReceiverExistsByName(name string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.AlertReceiver{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UpdateReceiver updates an alert receiver
+func (r *AlertRepository) UpdateReceiver(receiver *domain.AlertReceiver) error {
+	return r.db.Save(receiver).Error
+}
+
+// DeleteReceiver soft deletes an alert receiver
+func (r *AlertRepository) DeleteReceiver(id uuid.UUID) error {
+	return r.db.Delete(&domain.AlertReceiver{}, id).Error
+}
+
+// GetFiringAlerts gets all firing alerts
+func (r *AlertRepository) GetFiringAlerts() ([]domain.Alert, error) {
+	var alerts []domain.Alert
+	if err := r.db.Where("status = ?", domain.AlertFiring).Order("starts_at").Find(&alerts).Error; err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// AlertListOptions holds options for listing alerts
+type AlertListOptions struct {
+	Status *domain.AlertStatus
+	RuleID *uuid.UUID
+	Since  *time.Time // alerts active at or after Since
+	Until  *time.Time // alerts started at or before Until
+	Page   int
+	Limit  int
+}
+
+// ListAlerts lists alerts with filtering and pagination, newest first
+func (r *AlertRepository) ListAlerts(opts AlertListOptions) ([]domain.Alert, int64, error) {
+	var alerts []domain.Alert
+	var total int64
+
+	query := r.db.Model(&domain.Alert{})
+	if opts.Status != nil {
+		query = query.Where("status = ?", *opts.Status)
+	}
+	if opts.RuleID != nil {
+		query = query.Where("rule_id = ?", *opts.RuleID)
+	}
+	if opts.Since != nil {
+		query = query.Where("ends_at IS NULL OR ends_at >= ?", *opts.Since)
+	}
+	if opts.Until != nil {
+		query = query.Where("starts_at <= ?", *opts.Until)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.Limit < 1 {
+		opts.Limit = 20
+	}
+	offset := (opts.Page - 1) * opts.Limit
+
+	if err := query.Order("starts_at DESC").Offset(offset).Limit(opts.Limit).Find(&alerts).Error; err != nil {
+		return nil, 0, err
+	}
+	return alerts, total, nil
+}
+
+// CreateAlert creates a new alert
+func (r *AlertRepository) CreateAlert(alert *domain.Alert) error {
+	return r.db.Create(alert).Error
+}
+
+// UpdateAlert updates an alert
+func (r *AlertRepository) UpdateAlert(alert *domain.Alert) error {
+	return r.db.Save(alert).Error
+}
+
+// CreateSilence creates a new silence
+func (r *AlertRepository) CreateSilence(silence *domain.AlertSilence) error {
+	return r.db.Create(silence).Error
+}
+
+// GetSilenceByID gets a silence by ID
+func (r *AlertRepository) GetSilenceByID(id uuid.UUID) (*domain.AlertSilence, error) {
+	var silence domain.AlertSilence
+	if err := r.db.Where("id = ?", id).First(&silence).Error; err != nil {
+		return nil, err
+	}
+	return &silence, nil
+}
+
+// GetActiveSilences gets silences that have not ended at t
+func (r *AlertRepository) GetActiveSilences(t time.Time) ([]domain.AlertSilence, error) {
+	var silences []domain.AlertSilence
+	if err := r.db.Where("ends_at > ?", t).Order("starts_at").Find(&silences).Error; err != nil {
+		return nil, err
+	}
+	return silences, nil
+}
+
+// UpdateSilence updates a silence
+func (r *AlertRepository) UpdateSilence(silence *domain.AlertSilence) error {
+	return r.db.Save(silence).Error
+}
+
+// CreateNotification creates a portal notification
+func (r *AlertRepository) CreateNotification(n *domain.PortalNotification) error {
+	return r.db.Create(n).Error
+}
+
+// ListNotifications lists the latest portal notifications
+func (r *AlertRepository) ListNotifications(limit int) ([]domain.PortalNotification, error) {
+	if limit < 1 {
+		limit = 50
+	}
+	var notifications []domain.PortalNotification
+	if err := r.db.Order("created_at DESC").Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
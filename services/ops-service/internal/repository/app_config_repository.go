@@ -1,12 +1,18 @@
 package repository
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"ops-service/internal/domain"
 )
 
+// ErrStaleChangeRequest is returned when a config changed after a change request was made
+var ErrStaleChangeRequest = errors.New("config changed since the change was requested")
+
 // AppConfigRepository handles app config database operations
 type AppConfigRepository struct {
 	db *gorm.DB
@@ -22,6 +28,116 @@ func (r *AppConfigRepository) Create(config *domain.AppConfig) error {
 	return r.db.Create(config).Error
 }
 
+// CreateWithRevision creates a new app config together with its first revision
+func (r *AppConfigRepository) CreateWithRevision(config *domain.AppConfig, rev *domain.AppConfigRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(config).Error; err != nil {
+			return err
+		}
+		rev.ConfigID = config.ID
+		rev.Revision = 1
+		return tx.Create(rev).Error
+	})
+}
+
+// ApplyChange saves config and appends rev as its next revision in one transaction.
+// When change is set it must still be based on the latest revision, and is saved alongside.
+func (r *AppConfigRepository) ApplyChange(config *domain.AppConfig, rev *domain.AppConfigRevision, change *domain.AppConfigChangeRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// config 행을 잠가 동시 변경 시 revision 번호가 겹치지 않도록 함
+		var locked domain.AppConfig
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", config.ID).First(&locked).Error; err != nil {
+			return err
+		}
+
+		latest, err := latestRevision(tx, config.ID)
+		if err != nil {
+			return err
+		}
+		if change != nil {
+			if change.BaseRevision != latest {
+				return ErrStaleChangeRequest
+			}
+			if err := tx.Save(change).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Save(config).Error; err != nil {
+			return err
+		}
+		rev.ConfigID = config.ID
+		rev.Revision = latest + 1
+		return tx.Create(rev).Error
+	})
+}
+
+// LatestRevision returns the latest revision number of a config, or 0 if it has none
+func (r *AppConfigRepository) LatestRevision(configID uuid.UUID) (int, error) {
+	return latestRevision(r.db, configID)
+}
+
+// GetRevision gets one revision of a config
+func (r *AppConfigRepository) GetRevision(configID uuid.UUID, revision int) (*domain.AppConfigRevision, error) {
+	var rev domain.AppConfigRevision
+	if err := r.db.Where("config_id = ? AND revision = ?", configID, revision).First(&rev).Error; err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// ListRevisions lists the revisions of a config, newest first
+func (r *AppConfigRepository) ListRevisions(configID uuid.UUID, page, limit int) ([]domain.AppConfigRevision, int64, error) {
+	var revs []domain.AppConfigRevision
+	var total int64
+
+	query := r.db.Model(&domain.AppConfigRevision{}).Where("config_id = ?", configID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	if err := query.Order("revision DESC").Offset(offset).Limit(limit).Find(&revs).Error; err != nil {
+		return nil, 0, err
+	}
+	return revs, total, nil
+}
+
+// CreateChangeRequest creates a pending change request
+func (r *AppConfigRepository) CreateChangeRequest(change *domain.AppConfigChangeRequest) error {
+	return r.db.Create(change).Error
+}
+
+// GetChangeRequest gets a change request by ID
+func (r *AppConfigRepository) GetChangeRequest(id uuid.UUID) (*domain.AppConfigChangeRequest, error) {
+	var change domain.AppConfigChangeRequest
+	if err := r.db.Where("id = ?", id).First(&change).Error; err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// ListChangeRequests lists change requests with the given status, oldest first
+func (r *AppConfigRepository) ListChangeRequests(status domain.ChangeRequestStatus) ([]domain.AppConfigChangeRequest, error) {
+	var changes []domain.AppConfigChangeRequest
+	if err := r.db.Where("status = ?", status).Order("created_at").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// UpdateChangeRequest updates a change request
+func (r *AppConfigRepository) UpdateChangeRequest(change *domain.AppConfigChangeRequest) error {
+	return r.db.Save(change).Error
+}
+
 // GetByID gets an app config by ID
 func (r *AppConfigRepository) GetByID(id uuid.UUID) (*domain.AppConfig, error) {
 	var config domain.AppConfig
@@ -76,3 +192,12 @@ func (r *AppConfigRepository) ExistsByKey(key string) (bool, error) {
 	}
 	return count > 0, nil
 }
+
+func latestRevision(db *gorm.DB, configID uuid.UUID) (int, error) {
+	var latest int
+	err := db.Model(&domain.AppConfigRevision{}).
+		Where("config_id = ?", configID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error
+	return latest, err
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ops-service/internal/domain"
+)
+
+// FeatureFlagRepository handles feature flag database operations
+type FeatureFlagRepository struct {
+	db *gorm.DB
+}
+
+// NewFeatureFlagRepository creates a new feature flag repository
+func NewFeatureFlagRepository(db *gorm.DB) *FeatureFlagRepository {
+	return &FeatureFlagRepository{db: db}
+}
+
+// Create creates a new feature flag
+func (r *FeatureFlagRepository) Create(flag *domain.FeatureFlag) error {
+	return r.db.Omit("Environments").Create(flag).Error
+}
+
+// GetByID gets a feature flag with its environments by ID
+func (r *FeatureFlagRepository) GetByID(id uuid.UUID) (*domain.FeatureFlag, error) {
+	var flag domain.FeatureFlag
+	if err := r.db.Preload("Environments", orderByEnvironment).Where("id = ?", id).First(&flag).Error; err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// GetAll gets all feature flags with their environments
+func (r *FeatureFlagRepository) GetAll() ([]domain.FeatureFlag, error) {
+	var flags []domain.FeatureFlag
+	if err := r.db.Preload("Environments", orderByEnvironment).Order("key").Find(&flags).Error; err != nil {
+		return nil, err
+	}
+	return flags, nil
+}
+
+// ExistsByKey checks if a feature flag exists by key
+func (r *FeatureFlagRepository) ExistsByKey(key string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.FeatureFlag{}).Where("key = ?", key).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update updates a feature flag without touching its environments
+func (r *FeatureFlagRepository) Update(flag *domain.FeatureFlag) error {
+	return r.db.Omit("Environments").Save(flag).Error
+}
+
+// UpsertEnvironment creates or replaces the state of a flag in one environment
+func (r *FeatureFlagRepository) UpsertEnvironment(env *domain.FeatureFlagEnvironment) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "flag_id"}, {Name: "environment"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "value", "rules", "updated_by", "updated_at"}),
+	}).Create(env).Error
+}
+
+// Delete permanently deletes a feature flag and its environments so the key can be reused
+func (r *FeatureFlagRepository) Delete(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("flag_id = ?", id).Delete(&domain.FeatureFlagEnvironment{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.FeatureFlag{}, id).Error
+	})
+}
+
+// ListEnvironments lists the environments any flag has settings for
+func (r *FeatureFlagRepository) ListEnvironments() ([]string, error) {
+	var envs []string
+	err := r.db.Model(&domain.FeatureFlagEnvironment{}).
+		Distinct("environment").
+		Order("environment").
+		Pluck("environment", &envs).Error
+	return envs, err
+}
+
+func orderByEnvironment(db *gorm.DB) *gorm.DB {
+	return db.Order("environment")
+}
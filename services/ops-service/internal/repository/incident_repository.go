@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ops-service/internal/domain"
+)
+
+// IncidentRepository handles incident, timeline and evidence database operations
+type IncidentRepository struct {
+	db *gorm.DB
+}
+
+// NewIncidentRepository creates a new incident repository
+func NewIncidentRepository(db *gorm.DB) *IncidentRepository {
+	return &IncidentRepository{db: db}
+}
+
+// Create creates an incident together with its first timeline entry
+func (r *IncidentRepository) Create(incident *domain.Incident, entry *domain.IncidentTimelineEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(incident).Error; err != nil {
+			return err
+		}
+		entry.IncidentID = incident.ID
+		return tx.Create(entry).Error
+	})
+}
+
+// GetByID gets an incident by ID without its timeline and evidence
+func (r *IncidentRepository) GetByID(id uuid.UUID) (*domain.Incident, error) {
+	var incident domain.Incident
+	if err := r.db.Where("id = ?", id).First(&incident).Error; err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// GetByIDWithDetails gets an incident with its timeline and evidence in chronological order
+func (r *IncidentRepository) GetByIDWithDetails(id uuid.UUID) (*domain.Incident, error) {
+	var incident domain.Incident
+	err := r.db.
+		Preload("Timeline", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+		Preload("Evidence", func(db *gorm.DB) *gorm.DB { return db.Order("occurred_at") }).
+		Where("id = ?", id).
+		First(&incident).Error
+	if err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// IncidentListOptions holds options for listing incidents
+type IncidentListOptions struct {
+	Status   *domain.IncidentStatus
+	Severity *domain.IncidentSeverity
+	Open     bool // only incidents that are not resolved
+	Page     int
+	Limit    int
+}
+
+// List lists incidents with filtering and pagination, newest first
+func (r *IncidentRepository) List(opts IncidentListOptions) ([]domain.Incident, int64, error) {
+	var incidents []domain.Incident
+	var total int64
+
+	query := r.db.Model(&domain.Incident{})
+	if opts.Status != nil {
+		query = query.Where("status = ?", *opts.Status)
+	}
+	if opts.Severity != nil {
+		query = query.Where("severity = ?", *opts.Severity)
+	}
+	if opts.Open {
+		query = query.Where("status <> ?", domain.IncidentResolved)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.Limit < 1 {
+		opts.Limit = 20
+	}
+	offset := (opts.Page - 1) * opts.Limit
+
+	if err := query.Order("started_at DESC").Offset(offset).Limit(opts.Limit).Find(&incidents).Error; err != nil {
+		return nil, 0, err
+	}
+	return incidents, total, nil
+}
+
+// Update saves an incident and appends timeline entries in one transaction
+func (r *IncidentRepository) Update(incident *domain.Incident, entries []domain.IncidentTimelineEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(incident).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		for i := range entries {
+			entries[i].IncidentID = incident.ID
+		}
+		return tx.Create(&entries).Error
+	})
+}
+
+// AddTimelineEntry appends a timeline entry
+func (r *IncidentRepository) AddTimelineEntry(entry *domain.IncidentTimelineEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// AddEvidence attaches evidence, skipping items already attached from the same source.
+// It returns how many items were newly attached.
+func (r *IncidentRepository) AddEvidence(evidence []domain.IncidentEvidence) (int64, error) {
+	if len(evidence) == 0 {
+		return 0, nil
+	}
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&evidence)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"ops-service/internal/domain"
+)
+
+// SLODefinitionRepository handles SLO definition database operations
+type SLODefinitionRepository struct {
+	db *gorm.DB
+}
+
+// NewSLODefinitionRepository creates a new SLO definition repository
+func NewSLODefinitionRepository(db *gorm.DB) *SLODefinitionRepository {
+	return &SLODefinitionRepository{db: db}
+}
+
+// Create creates a new SLO definition
+func (r *SLODefinitionRepository) Create(def *domain.SLODefinition) error {
+	return r.db.Create(def).Error
+}
+
+// GetByID gets an SLO definition by ID
+func (r *SLODefinitionRepository) GetByID(id uuid.UUID) (*domain.SLODefinition, error) {
+	var def domain.SLODefinition
+	if err := r.db.Where("id = ?", id).First(&def).Error; err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// GetAll gets all SLO definitions
+func (r *SLODefinitionRepository) GetAll() ([]domain.SLODefinition, error) {
+	var defs []domain.SLODefinition
+	if err := r.db.Order("service_name").Find(&defs).Error; err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// GetEnabled gets all enabled SLO definitions
+func (r *SLODefinitionRepository) GetEnabled() ([]domain.SLODefinition, error) {
+	var defs []domain.SLODefinition
+	if err := r.db.Where("enabled = ?", true).Order("service_name").Find(&defs).Error; err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// ExistsByServiceName checks if an SLO definition exists for a service
+func (r *SLODefinitionRepository) ExistsByServiceName(serviceName string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.SLODefinition{}).Where("service_name = ?", serviceName).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update updates an SLO definition
+func (r *SLODefinitionRepository) Update(def *domain.SLODefinition) error {
+	return r.db.Save(def).Error
+}
+
+// Delete soft deletes an SLO definition
+func (r *SLODefinitionRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.SLODefinition{}, id).Error
+}
@@ -21,6 +21,24 @@ var (
 	ErrConfigNotFound    = errors.New("config not found")
 	ErrConfigExists      = errors.New("config key already exists")
 	ErrAuditLogNotFound  = errors.New("audit log not found")
+	ErrFlagNotFound      = errors.New("feature flag not found")
+	ErrFlagExists        = errors.New("feature flag key already exists")
+	ErrRevisionNotFound  = errors.New("config revision not found")
+	ErrChangeNotFound    = errors.New("config change request not found")
+	ErrChangeNotPending  = errors.New("config change request is not pending")
+	ErrSelfApproval      = errors.New("cannot approve own change")
+	ErrSLONotFound       = errors.New("slo definition not found")
+	ErrSLOExists         = errors.New("slo definition already exists for service")
+	ErrNoPrometheus      = errors.New("prometheus client not configured")
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+	ErrAlertRuleExists   = errors.New("alert rule name already exists")
+	ErrReceiverNotFound  = errors.New("alert receiver not found")
+	ErrReceiverExists    = errors.New("alert receiver name already exists")
+	ErrSilenceNotFound   = errors.New("silence not found")
+	ErrIncidentNotFound  = errors.New("incident not found")
+	ErrSameStatus        = errors.New("incident already has this status")
+	ErrNoArgoCD          = errors.New("argocd client not configured")
+	ErrHistoryNotFound   = errors.New("deployment history entry not found")
 )
 
 // NewNotFoundError creates a not found error
@@ -77,6 +95,42 @@ func HandleServiceError(c *gin.Context, err error) {
 		Conflict(c, "Config key already exists")
 	case errors.Is(err, ErrAuditLogNotFound):
 		NotFound(c, "Audit log not found")
+	case errors.Is(err, ErrFlagNotFound):
+		NotFound(c, "Feature flag not found")
+	case errors.Is(err, ErrFlagExists):
+		Conflict(c, "Feature flag key already exists")
+	case errors.Is(err, ErrRevisionNotFound):
+		NotFound(c, "Config revision not found")
+	case errors.Is(err, ErrChangeNotFound):
+		NotFound(c, "Config change request not found")
+	case errors.Is(err, ErrChangeNotPending):
+		Conflict(c, "Config change request is not pending")
+	case errors.Is(err, ErrSelfApproval):
+		Forbidden(c, "Changes to sensitive configs must be approved by someone else")
+	case errors.Is(err, ErrSLONotFound):
+		NotFound(c, "SLO definition not found")
+	case errors.Is(err, ErrSLOExists):
+		Conflict(c, "SLO definition already exists for service")
+	case errors.Is(err, ErrNoPrometheus):
+		InternalError(c, "Prometheus client not configured")
+	case errors.Is(err, ErrAlertRuleNotFound):
+		NotFound(c, "Alert rule not found")
+	case errors.Is(err, ErrAlertRuleExists):
+		Conflict(c, "Alert rule name already exists")
+	case errors.Is(err, ErrReceiverNotFound):
+		NotFound(c, "Alert receiver not found")
+	case errors.Is(err, ErrReceiverExists):
+		Conflict(c, "Alert receiver name already exists")
+	case errors.Is(err, ErrSilenceNotFound):
+		NotFound(c, "Silence not found")
+	case errors.Is(err, ErrIncidentNotFound):
+		NotFound(c, "Incident not found")
+	case errors.Is(err, ErrSameStatus):
+		Conflict(c, "Incident already has this status")
+	case errors.Is(err, ErrNoArgoCD):
+		InternalError(c, "ArgoCD client not configured")
+	case errors.Is(err, ErrHistoryNotFound):
+		NotFound(c, "Deployment history entry not found")
 	default:
 		if appErr := apperrors.AsAppError(err); appErr != nil {
 			Error(c, appErr)
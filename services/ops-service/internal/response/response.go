@@ -48,6 +48,14 @@ func Created(c *gin.Context, data interface{}) {
 	})
 }
 
+// Accepted sends an accepted response for requests that take effect later
+func Accepted(c *gin.Context, data interface{}) {
+	c.JSON(http.StatusAccepted, Response{
+		Success: true,
+		Data:    data,
+	})
+}
+
 // NoContent sends a no content response
 func NoContent(c *gin.Context) {
 	c.Status(http.StatusNoContent)
@@ -41,6 +41,12 @@ type Config struct {
 	PrometheusNS     string
 	LokiClient       *client.LokiClient
 	LokiNS           string
+
+	// ConfigRequireApproval requires a second approver for changes to sensitive app configs
+	ConfigRequireApproval bool
+
+	// InternalAPIKey guards the service-to-service routes; empty rejects every internal request
+	InternalAPIKey string
 }
 
 // Setup sets up the router with all routes
@@ -90,11 +96,29 @@ func Setup(cfg Config) *gin.Engine {
 	userRepo := repository.NewPortalUserRepository(cfg.DB)
 	auditRepo := repository.NewAuditLogRepository(cfg.DB)
 	configRepo := repository.NewAppConfigRepository(cfg.DB)
+	flagRepo := repository.NewFeatureFlagRepository(cfg.DB)
+	sloRepo := repository.NewSLODefinitionRepository(cfg.DB)
+	alertRepo := repository.NewAlertRepository(cfg.DB)
+	incidentRepo := repository.NewIncidentRepository(cfg.DB)
 
 	// Initialize services
 	auditService := service.NewAuditLogService(auditRepo, cfg.Logger)
 	userService := service.NewPortalUserService(userRepo, auditService, cfg.Logger)
-	configService := service.NewAppConfigService(configRepo, auditService, cfg.Logger)
+	configService := service.NewAppConfigService(configRepo, auditService, cfg.ConfigRequireApproval, cfg.Logger)
+	flagService := service.NewFeatureFlagService(flagRepo, auditService, cfg.RedisClient, cfg.Logger)
+	sloService := service.NewSLOService(sloRepo, auditService, cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
+	alertService := service.NewAlertService(alertRepo, auditService, service.DefaultAlertSenders(alertRepo), cfg.Logger)
+	incidentService := service.NewIncidentService(service.IncidentServiceConfig{
+		IncidentRepo:     incidentRepo,
+		AlertRepo:        alertRepo,
+		UserRepo:         userRepo,
+		AuditSvc:         auditService,
+		ArgoCDClient:     cfg.ArgoCDClient,
+		PrometheusClient: cfg.PrometheusClient,
+		PrometheusNS:     cfg.PrometheusNS,
+		Logger:           cfg.Logger,
+	})
+	deployService := service.NewArgoCDDeployService(cfg.ArgoCDClient, auditService, cfg.Logger)
 
 	// Initialize ArgoCD RBAC service
 	var argoCDService *service.ArgoCDRBACService
@@ -114,10 +138,14 @@ func Setup(cfg Config) *gin.Engine {
 	userHandler := handler.NewUserHandler(userService)
 	auditHandler := handler.NewAuditHandler(auditService)
 	configHandler := handler.NewConfigHandler(configService)
+	flagHandler := handler.NewFeatureFlagHandler(flagService)
 	argoCDHandler := handler.NewArgoCDHandler(argoCDService, cfg.Logger)
+	deployHandler := handler.NewArgoCDDeployHandler(deployService)
 	metricsHandler := handler.NewMetricsHandler(cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
 	errorTrackerHandler := handler.NewErrorTrackerHandler(cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
-	sloHandler := handler.NewSLOHandler(cfg.PrometheusClient, cfg.PrometheusNS, cfg.Logger)
+	sloHandler := handler.NewSLOHandler(sloService, cfg.Logger)
+	alertHandler := handler.NewAlertHandler(alertService)
+	incidentHandler := handler.NewIncidentHandler(incidentService)
 	logsHandler := handler.NewLogsHandler(cfg.LokiClient, cfg.LokiNS, cfg.Logger)
 
 	// API routes group
@@ -151,6 +179,12 @@ func Setup(cfg Config) *gin.Engine {
 		public.GET("/config/:key", configHandler.GetByKey)
 	}
 
+	// ============================================================
+	// Internal routes (service-to-service, requires internal API key)
+	// ============================================================
+	// Feature flag snapshot for the featureflag client; it exposes every rule incl. targeted IDs
+	api.GET("/flags/snapshot", middleware.InternalAuth(cfg.InternalAPIKey), flagHandler.GetSnapshot)
+
 	// ============================================================
 	// Auth routes (requires JWT auth)
 	// ============================================================
@@ -195,11 +229,40 @@ func Setup(cfg Config) *gin.Engine {
 		admin.POST("/config", configHandler.Create)
 		admin.PUT("/config/:id", configHandler.Update)
 		admin.DELETE("/config/:id", configHandler.Delete)
+		admin.GET("/config/:id/revisions", configHandler.ListRevisions)
+		admin.GET("/config/:id/revisions/diff", configHandler.DiffRevisions)
+		admin.POST("/config/:id/rollback", configHandler.Rollback)
+		admin.GET("/config/changes", configHandler.ListPendingChanges)
+		admin.POST("/config/changes/:changeId/approve", configHandler.ApproveChange)
+		admin.POST("/config/changes/:changeId/reject", configHandler.RejectChange)
+
+		// Feature flag management
+		admin.GET("/flags", flagHandler.GetAll)
+		admin.POST("/flags", flagHandler.Create)
+		admin.GET("/flags/:id", flagHandler.GetByID)
+		admin.PUT("/flags/:id", flagHandler.Update)
+		admin.PUT("/flags/:id/environments/:env", flagHandler.UpdateEnvironment)
+		admin.DELETE("/flags/:id", flagHandler.Delete)
 
 		// ArgoCD RBAC management (admin only)
 		admin.GET("/argocd/rbac", argoCDHandler.GetRBAC)
 		admin.POST("/argocd/rbac/admins", argoCDHandler.AddAdmin)
 		admin.DELETE("/argocd/rbac/admins/:email", argoCDHandler.RemoveAdmin)
+
+		// SLO definitions
+		admin.POST("/slo/definitions", sloHandler.CreateDefinition)
+		admin.PUT("/slo/definitions/:id", sloHandler.UpdateDefinition)
+		admin.DELETE("/slo/definitions/:id", sloHandler.DeleteDefinition)
+
+		// Alert rules and receivers
+		admin.POST("/alerts/rules", alertHandler.CreateRule)
+		admin.PUT("/alerts/rules/:id", alertHandler.UpdateRule)
+		admin.DELETE("/alerts/rules/:id", alertHandler.DeleteRule)
+		admin.GET("/alerts/receivers", alertHandler.GetReceivers)
+		admin.POST("/alerts/receivers", alertHandler.CreateReceiver)
+		admin.PUT("/alerts/receivers/:id", alertHandler.UpdateReceiver)
+		admin.DELETE("/alerts/receivers/:id", alertHandler.DeleteReceiver)
+		admin.POST("/alerts/receivers/:id/test", alertHandler.TestReceiver)
 	}
 
 	// ============================================================
@@ -213,6 +276,19 @@ func Setup(cfg Config) *gin.Engine {
 		pm.GET("/config", configHandler.GetAll)
 		pm.POST("/config", configHandler.Create)
 		pm.PUT("/config/:id", configHandler.Update)
+		pm.GET("/config/:id/revisions", configHandler.ListRevisions)
+		pm.GET("/config/:id/revisions/diff", configHandler.DiffRevisions)
+		pm.POST("/config/:id/rollback", configHandler.Rollback)
+		pm.GET("/config/changes", configHandler.ListPendingChanges)
+		pm.POST("/config/changes/:changeId/approve", configHandler.ApproveChange)
+		pm.POST("/config/changes/:changeId/reject", configHandler.RejectChange)
+
+		// PM can manage flags and roll them out
+		pm.GET("/flags", flagHandler.GetAll)
+		pm.POST("/flags", flagHandler.Create)
+		pm.GET("/flags/:id", flagHandler.GetByID)
+		pm.PUT("/flags/:id", flagHandler.Update)
+		pm.PUT("/flags/:id/environments/:env", flagHandler.UpdateEnvironment)
 	}
 
 	// ============================================================
@@ -224,6 +300,7 @@ func Setup(cfg Config) *gin.Engine {
 	{
 		// ArgoCD applications
 		monitoring.GET("/applications", argoCDHandler.GetApplications)
+		monitoring.GET("/applications/:name/diff", deployHandler.Diff)
 
 		// Prometheus metrics
 		monitoring.GET("/metrics/overview", metricsHandler.GetSystemOverview)
@@ -240,6 +317,20 @@ func Setup(cfg Config) *gin.Engine {
 		// SLO Dashboard
 		monitoring.GET("/slo/overview", sloHandler.GetSLOOverview)
 		monitoring.GET("/slo/burn-rates", sloHandler.GetBurnRates)
+		monitoring.GET("/slo/definitions", sloHandler.GetDefinitions)
+		monitoring.GET("/slo/definitions/:id", sloHandler.GetDefinition)
+		monitoring.GET("/slo/definitions/:id/error-budget", sloHandler.GetErrorBudgetHistory)
+
+		// Alerts
+		monitoring.GET("/alerts", alertHandler.ListAlerts)
+		monitoring.GET("/alerts/notifications", alertHandler.ListNotifications)
+		monitoring.GET("/alerts/rules", alertHandler.GetRules)
+		monitoring.GET("/alerts/silences", alertHandler.GetSilences)
+
+		// Incidents
+		monitoring.GET("/incidents", incidentHandler.List)
+		monitoring.GET("/incidents/:id", incidentHandler.GetByID)
+		monitoring.GET("/incidents/:id/postmortem", incidentHandler.PostMortem)
 
 		// Deployment History
 		monitoring.GET("/deployments/history", argoCDHandler.GetDeploymentHistory)
@@ -259,6 +350,19 @@ func Setup(cfg Config) *gin.Engine {
 	{
 		// Sync operations require admin or PM role
 		pmMonitoring.POST("/applications/sync", argoCDHandler.SyncApplication)
+		pmMonitoring.POST("/applications/:name/sync", deployHandler.Sync)
+		pmMonitoring.POST("/applications/:name/rollback", deployHandler.Rollback)
+
+		// Silencing alerts requires admin or PM role
+		pmMonitoring.POST("/alerts/silences", alertHandler.CreateSilence)
+		pmMonitoring.DELETE("/alerts/silences/:id", alertHandler.ExpireSilence)
+
+		// Incident response requires admin or PM role
+		pmMonitoring.POST("/incidents", incidentHandler.Create)
+		pmMonitoring.PUT("/incidents/:id", incidentHandler.Update)
+		pmMonitoring.PUT("/incidents/:id/status", incidentHandler.UpdateStatus)
+		pmMonitoring.POST("/incidents/:id/notes", incidentHandler.AddNote)
+		pmMonitoring.POST("/incidents/:id/evidence", incidentHandler.CollectEvidence)
 	}
 
 	// RBAC middleware for role-based routes
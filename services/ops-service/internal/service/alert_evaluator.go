@@ -0,0 +1,556 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+)
+
+const (
+	// DefaultAlertEvalInterval is how often alert rules are evaluated by default
+	DefaultAlertEvalInterval = time.Minute
+	// DefaultAlertRepeatInterval is how long a still-firing alert waits before it is sent again
+	DefaultAlertRepeatInterval = 4 * time.Hour
+
+	// defaultErrorRateThreshold is the 5xx rate (%) error_rate rules use without a threshold
+	defaultErrorRateThreshold = 5
+
+	alertEvaluatorLockKey = "ops:alert-evaluator:lock"
+)
+
+// AlertEvaluatorConfig holds alert evaluator configuration
+type AlertEvaluatorConfig struct {
+	AlertRepo        *repository.AlertRepository
+	SLORepo          *repository.SLODefinitionRepository
+	PrometheusClient *client.PrometheusClient
+	PrometheusNS     string
+	ArgoCDClient     *client.ArgoCDClient
+	RedisClient      *redis.Client // 여러 replica 중 하나만 평가하도록 lock에 사용
+	Senders          map[domain.ReceiverType]AlertSender
+	Interval         time.Duration
+	RepeatInterval   time.Duration
+	Logger           *zap.Logger
+}
+
+// AlertEvaluator periodically evaluates alert rules, persists alert state and routes notifications.
+// Firing alerts are deduplicated by fingerprint and grouped per rule; silenced alerts are not sent.
+type AlertEvaluator struct {
+	cfg      AlertEvaluatorConfig
+	instance string
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// alertObservation is one condition a rule found to be true
+type alertObservation struct {
+	labels  map[string]string
+	value   float64
+	summary string
+}
+
+// NewAlertEvaluator creates a new alert evaluator
+func NewAlertEvaluator(cfg AlertEvaluatorConfig) *AlertEvaluator {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultAlertEvalInterval
+	}
+	if cfg.RepeatInterval <= 0 {
+		cfg.RepeatInterval = DefaultAlertRepeatInterval
+	}
+	if cfg.Senders == nil {
+		cfg.Senders = DefaultAlertSenders(cfg.AlertRepo)
+	}
+
+	instance, _ := os.Hostname()
+	return &AlertEvaluator{
+		cfg:      cfg,
+		instance: instance + "-" + uuid.NewString()[:8],
+	}
+}
+
+// Start runs the evaluation loop in the background until Stop is called
+func (e *AlertEvaluator) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := e.Evaluate(ctx); err != nil {
+				e.cfg.Logger.Warn("Alert evaluation failed", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	e.cfg.Logger.Info("Alert evaluator started",
+		zap.Duration("interval", e.cfg.Interval),
+		zap.Duration("repeatInterval", e.cfg.RepeatInterval))
+}
+
+// Stop stops the evaluation loop and waits for the current evaluation to finish
+func (e *AlertEvaluator) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	e.wg.Wait()
+}
+
+// Evaluate runs every enabled rule once, updates alert state and sends notifications
+func (e *AlertEvaluator) Evaluate(ctx context.Context) error {
+	if !e.acquireLock(ctx) {
+		return nil
+	}
+
+	now := time.Now()
+	rules, err := e.cfg.AlertRepo.GetEnabledRules()
+	if err != nil {
+		return fmt.Errorf("failed to load alert rules: %w", err)
+	}
+	firingAlerts, err := e.cfg.AlertRepo.GetFiringAlerts()
+	if err != nil {
+		return fmt.Errorf("failed to load firing alerts: %w", err)
+	}
+	silences, err := e.cfg.AlertRepo.GetActiveSilences(now)
+	if err != nil {
+		return fmt.Errorf("failed to load silences: %w", err)
+	}
+
+	firing := make(map[string]*domain.Alert, len(firingAlerts))
+	for i := range firingAlerts {
+		firing[firingAlerts[i].Fingerprint] = &firingAlerts[i]
+	}
+
+	var targets []client.SLOTarget
+	var targetsErr error
+	var targetsLoaded bool
+	sloTargets := func() ([]client.SLOTarget, error) {
+		if !targetsLoaded {
+			targets, targetsErr = e.sloTargets()
+			targetsLoaded = true
+		}
+		return targets, targetsErr
+	}
+
+	ruleByID := make(map[uuid.UUID]*domain.AlertRule, len(rules))
+	groups := make(map[string]*AlertGroup)
+	addToGroup := func(rule *domain.AlertRule, status domain.AlertStatus, alert *domain.Alert) {
+		key := rule.ID.String() + "/" + string(status)
+		g, ok := groups[key]
+		if !ok {
+			g = &AlertGroup{Status: status, GroupKey: key, RuleName: rule.Name, Severity: rule.Severity}
+			groups[key] = g
+		}
+		g.Alerts = append(g.Alerts, alert)
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		ruleByID[rule.ID] = rule
+
+		observations, err := e.evaluateRule(ctx, rule, sloTargets)
+		if err != nil {
+			// 평가 실패 시 기존 알림 상태를 유지 (flapping 방지)
+			e.cfg.Logger.Warn("Failed to evaluate alert rule",
+				zap.String("rule", rule.Name),
+				zap.Error(err))
+			continue
+		}
+
+		seen := make(map[string]bool, len(observations))
+		for _, o := range observations {
+			fp := alertFingerprint(rule.ID, o.labels)
+			seen[fp] = true
+
+			alert, exists := firing[fp]
+			if !exists {
+				alert = &domain.Alert{
+					Fingerprint: fp,
+					RuleID:      rule.ID,
+					RuleName:    rule.Name,
+					Status:      domain.AlertFiring,
+					StartsAt:    now,
+				}
+				firing[fp] = alert
+			}
+			alert.Severity = rule.Severity
+			alert.Labels = o.labels
+			alert.Summary = o.summary
+			alert.Value = o.value
+			alert.LastEvaluatedAt = now
+			alert.Silenced = isSilenced(alert, silences, now)
+
+			if err := e.saveAlert(alert, !exists); err != nil {
+				continue
+			}
+			if !alert.Silenced && (alert.LastNotifiedAt == nil || now.Sub(*alert.LastNotifiedAt) >= e.cfg.RepeatInterval) {
+				addToGroup(rule, domain.AlertFiring, alert)
+			}
+		}
+
+		for fp, alert := range firing {
+			if alert.RuleID != rule.ID || seen[fp] {
+				continue
+			}
+			notified := alert.LastNotifiedAt != nil && !isSilenced(alert, silences, now)
+			e.resolve(alert, now)
+			if notified {
+				addToGroup(rule, domain.AlertResolved, alert)
+			}
+		}
+	}
+
+	// 비활성화되거나 삭제된 rule의 알림은 조용히 해제
+	for _, alert := range firing {
+		if _, ok := ruleByID[alert.RuleID]; !ok && alert.Status == domain.AlertFiring {
+			e.resolve(alert, now)
+		}
+	}
+
+	if len(groups) > 0 {
+		e.notify(ctx, groups, ruleByID, now)
+	}
+	return nil
+}
+
+// evaluateRule returns the conditions of rule that currently hold
+func (e *AlertEvaluator) evaluateRule(ctx context.Context, rule *domain.AlertRule, sloTargets func() ([]client.SLOTarget, error)) ([]alertObservation, error) {
+	switch rule.Type {
+	case domain.AlertRuleBurnRate:
+		targets, err := sloTargets()
+		if err != nil {
+			return nil, err
+		}
+		return e.evaluateBurnRate(ctx, rule, filterTargets(targets, rule.Target))
+	case domain.AlertRuleErrorRate:
+		return e.evaluateErrorRate(ctx, rule)
+	case domain.AlertRuleLatency:
+		targets, err := sloTargets()
+		if err != nil {
+			return nil, err
+		}
+		return e.evaluateLatency(ctx, rule, filterTargets(targets, rule.Target))
+	case domain.AlertRuleArgoCDApp:
+		return e.evaluateArgoCDApps(rule)
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", rule.Type)
+	}
+}
+
+func (e *AlertEvaluator) evaluateBurnRate(ctx context.Context, rule *domain.AlertRule, targets []client.SLOTarget) ([]alertObservation, error) {
+	if e.cfg.PrometheusClient == nil {
+		return nil, fmt.Errorf("prometheus client not configured")
+	}
+	// rule에 threshold가 있으면 SLO별 threshold 대신 1h 기준으로 사용
+	if rule.Threshold > 0 {
+		for i := range targets {
+			targets[i].BurnRateThresholds = []client.BurnRateThreshold{{Window: "1h", Threshold: rule.Threshold}}
+		}
+	}
+
+	burnRates, err := e.cfg.PrometheusClient.GetBurnRates(ctx, e.cfg.PrometheusNS, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	var observations []alertObservation
+	for _, br := range burnRates {
+		if !br.Alerting {
+			continue
+		}
+		// 가장 크게 초과한 window를 요약에 사용
+		var worst client.BurnRateThreshold
+		var worstRatio float64
+		for _, t := range br.Thresholds {
+			if ratio := br.Rates[t.Window] / t.Threshold; ratio > worstRatio {
+				worst, worstRatio = t, ratio
+			}
+		}
+		rate := br.Rates[worst.Window]
+		observations = append(observations, alertObservation{
+			labels: map[string]string{"service": br.ServiceName},
+			value:  rate,
+			summary: fmt.Sprintf("%s is burning its error budget at %.1fx over %s (threshold %.1fx)",
+				br.ServiceName, rate, worst.Window, worst.Threshold),
+		})
+	}
+	return observations, nil
+}
+
+func (e *AlertEvaluator) evaluateErrorRate(ctx context.Context, rule *domain.AlertRule) ([]alertObservation, error) {
+	if e.cfg.PrometheusClient == nil {
+		return nil, fmt.Errorf("prometheus client not configured")
+	}
+	threshold := rule.Threshold
+	if threshold <= 0 {
+		threshold = defaultErrorRateThreshold
+	}
+
+	summaries, err := e.cfg.PrometheusClient.GetErrorsByService(ctx, e.cfg.PrometheusNS)
+	if err != nil {
+		return nil, err
+	}
+
+	var observations []alertObservation
+	for _, s := range summaries {
+		if rule.Target != "" && s.ServiceName != rule.Target {
+			continue
+		}
+		if s.TotalErrors <= 0 {
+			continue
+		}
+		// ErrorRate는 4xx를 포함하므로 5xx 비율만 계산
+		rate5xx := s.ErrorRate * s.Error5xxCount / s.TotalErrors
+		if rate5xx <= threshold {
+			continue
+		}
+		observations = append(observations, alertObservation{
+			labels:  map[string]string{"service": s.ServiceName},
+			value:   rate5xx,
+			summary: fmt.Sprintf("%s 5xx rate is %.2f%% over the last hour (threshold %.2f%%)", s.ServiceName, rate5xx, threshold),
+		})
+	}
+	return observations, nil
+}
+
+func (e *AlertEvaluator) evaluateLatency(ctx context.Context, rule *domain.AlertRule, targets []client.SLOTarget) ([]alertObservation, error) {
+	if e.cfg.PrometheusClient == nil {
+		return nil, fmt.Errorf("prometheus client not configured")
+	}
+
+	var observations []alertObservation
+	for _, target := range targets {
+		threshold := rule.Threshold
+		if threshold <= 0 {
+			threshold = target.LatencyP99
+		}
+		if threshold <= 0 {
+			continue
+		}
+
+		p99, err := e.cfg.PrometheusClient.GetLatency(ctx, e.cfg.PrometheusNS, target, 0.99)
+		if err != nil {
+			return nil, err
+		}
+		if p99 <= threshold {
+			continue
+		}
+		observations = append(observations, alertObservation{
+			labels:  map[string]string{"service": target.ServiceName},
+			value:   p99,
+			summary: fmt.Sprintf("%s P99 latency is %.0fms (threshold %.0fms)", target.ServiceName, p99, threshold),
+		})
+	}
+	return observations, nil
+}
+
+func (e *AlertEvaluator) evaluateArgoCDApps(rule *domain.AlertRule) ([]alertObservation, error) {
+	if e.cfg.ArgoCDClient == nil {
+		return nil, fmt.Errorf("argocd client not configured")
+	}
+
+	apps, err := e.cfg.ArgoCDClient.GetApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	var observations []alertObservation
+	for _, app := range apps {
+		name := app.Metadata.Name
+		if rule.Target != "" && name != rule.Target {
+			continue
+		}
+		if app.Status.Health.Status == "Degraded" {
+			observations = append(observations, alertObservation{
+				labels:  map[string]string{"app": name, "condition": "degraded"},
+				summary: fmt.Sprintf("ArgoCD application %s is Degraded", name),
+			})
+		}
+		if app.Status.Sync.Status == "OutOfSync" {
+			observations = append(observations, alertObservation{
+				labels:  map[string]string{"app": name, "condition": "out_of_sync"},
+				summary: fmt.Sprintf("ArgoCD application %s is OutOfSync", name),
+			})
+		}
+	}
+	return observations, nil
+}
+
+// notify sends every group to the receivers its rule routes to
+func (e *AlertEvaluator) notify(ctx context.Context, groups map[string]*AlertGroup, ruleByID map[uuid.UUID]*domain.AlertRule, now time.Time) {
+	receivers, err := e.cfg.AlertRepo.GetEnabledReceivers()
+	if err != nil {
+		e.cfg.Logger.Warn("Failed to load alert receivers", zap.Error(err))
+		return
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		group := groups[key]
+		rule := ruleByID[group.Alerts[0].RuleID]
+
+		routed := routeReceivers(rule, receivers)
+		delivered := len(routed) == 0
+		for _, r := range routed {
+			sender, ok := e.cfg.Senders[r.Type]
+			if !ok {
+				e.cfg.Logger.Warn("No sender for alert receiver type",
+					zap.String("receiver", r.Name),
+					zap.String("type", string(r.Type)))
+				continue
+			}
+			if err := sender.Send(ctx, r, group); err != nil {
+				e.cfg.Logger.Warn("Failed to send alert notification",
+					zap.String("receiver", r.Name),
+					zap.String("rule", group.RuleName),
+					zap.Error(err))
+				continue
+			}
+			delivered = true
+		}
+
+		// 전송에 모두 실패하면 다음 평가에서 다시 시도
+		if !delivered || group.Status != domain.AlertFiring {
+			continue
+		}
+		for _, alert := range group.Alerts {
+			alert.LastNotifiedAt = &now
+			_ = e.saveAlert(alert, false)
+		}
+	}
+}
+
+// routeReceivers returns the rule's receivers, or every receiver accepting its severity
+func routeReceivers(rule *domain.AlertRule, receivers []domain.AlertReceiver) []*domain.AlertReceiver {
+	var routed []*domain.AlertReceiver
+	for i := range receivers {
+		r := &receivers[i]
+		if len(rule.ReceiverIDs) > 0 {
+			for _, id := range rule.ReceiverIDs {
+				if id == r.ID {
+					routed = append(routed, r)
+					break
+				}
+			}
+			continue
+		}
+		if rule.Severity.Rank() >= r.MinSeverity.Rank() {
+			routed = append(routed, r)
+		}
+	}
+	return routed
+}
+
+func (e *AlertEvaluator) resolve(alert *domain.Alert, now time.Time) {
+	alert.Status = domain.AlertResolved
+	alert.EndsAt = &now
+	_ = e.saveAlert(alert, false)
+}
+
+func (e *AlertEvaluator) saveAlert(alert *domain.Alert, create bool) error {
+	var err error
+	if create {
+		err = e.cfg.AlertRepo.CreateAlert(alert)
+	} else {
+		err = e.cfg.AlertRepo.UpdateAlert(alert)
+	}
+	if err != nil {
+		e.cfg.Logger.Warn("Failed to save alert",
+			zap.String("rule", alert.RuleName),
+			zap.String("fingerprint", alert.Fingerprint),
+			zap.Error(err))
+	}
+	return err
+}
+
+func (e *AlertEvaluator) sloTargets() ([]client.SLOTarget, error) {
+	defs, err := e.cfg.SLORepo.GetEnabled()
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]client.SLOTarget, len(defs))
+	for i := range defs {
+		targets[i] = toSLOTarget(&defs[i])
+	}
+	return targets, nil
+}
+
+// acquireLock makes sure only one replica evaluates per interval. Without Redis every replica evaluates.
+func (e *AlertEvaluator) acquireLock(ctx context.Context) bool {
+	if e.cfg.RedisClient == nil {
+		return true
+	}
+	ttl := e.cfg.Interval * 9 / 10
+	ok, err := e.cfg.RedisClient.SetNX(ctx, alertEvaluatorLockKey, e.instance, ttl).Result()
+	if err != nil {
+		e.cfg.Logger.Warn("Failed to acquire alert evaluator lock, evaluating anyway", zap.Error(err))
+		return true
+	}
+	return ok
+}
+
+// filterTargets keeps the target of service, or all targets when service is empty
+func filterTargets(targets []client.SLOTarget, service string) []client.SLOTarget {
+	filtered := make([]client.SLOTarget, 0, len(targets))
+	for _, t := range targets {
+		if service == "" || t.ServiceName == service {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// isSilenced reports whether an active silence matches the alert
+func isSilenced(alert *domain.Alert, silences []domain.AlertSilence, now time.Time) bool {
+	labels := alert.MatchLabels()
+	for i := range silences {
+		if silences[i].IsActive(now) && silences[i].Matches(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// alertFingerprint identifies an alert by its rule and labels
+func alertFingerprint(ruleID uuid.UUID, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(ruleID.String())
+	for _, k := range keys {
+		sb.WriteString("\x00" + k + "=" + labels[k])
+	}
+	sum := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+)
+
+func TestRouteReceivers(t *testing.T) {
+	info := domain.AlertReceiver{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "info", MinSeverity: domain.SeverityInfo}
+	warning := domain.AlertReceiver{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "warning", MinSeverity: domain.SeverityWarning}
+	critical := domain.AlertReceiver{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "critical", MinSeverity: domain.SeverityCritical}
+	receivers := []domain.AlertReceiver{info, warning, critical}
+
+	tests := []struct {
+		name string
+		rule domain.AlertRule
+		want []string
+	}{
+		{"info by severity", domain.AlertRule{Severity: domain.SeverityInfo}, []string{"info"}},
+		{"warning by severity", domain.AlertRule{Severity: domain.SeverityWarning}, []string{"info", "warning"}},
+		{"critical by severity", domain.AlertRule{Severity: domain.SeverityCritical}, []string{"info", "warning", "critical"}},
+		{"explicit receivers ignore severity", domain.AlertRule{Severity: domain.SeverityInfo, ReceiverIDs: []uuid.UUID{critical.ID}}, []string{"critical"}},
+		{"unknown receiver", domain.AlertRule{Severity: domain.SeverityCritical, ReceiverIDs: []uuid.UUID{uuid.New()}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range routeReceivers(&tt.rule, receivers) {
+				got = append(got, r.Name)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsSilenced(t *testing.T) {
+	now := time.Now()
+	alert := &domain.Alert{
+		RuleName: "high-error-rate",
+		Severity: domain.SeverityWarning,
+		Labels:   map[string]string{"service": "board-service"},
+	}
+	active := func(matchers map[string]string) domain.AlertSilence {
+		return domain.AlertSilence{Matchers: matchers, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+	}
+
+	tests := []struct {
+		name    string
+		silence domain.AlertSilence
+		want    bool
+	}{
+		{"label", active(map[string]string{"service": "board-service"}), true},
+		{"alert name and severity", active(map[string]string{"alertname": "high-error-rate", "severity": "warning"}), true},
+		{"other service", active(map[string]string{"service": "chat-service"}), false},
+		{"partial match", active(map[string]string{"service": "board-service", "severity": "critical"}), false},
+		{"no matchers", active(map[string]string{}), false},
+		{"expired", domain.AlertSilence{Matchers: map[string]string{"service": "board-service"}, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}, false},
+		{"not started", domain.AlertSilence{Matchers: map[string]string{"service": "board-service"}, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isSilenced(alert, []domain.AlertSilence{tt.silence}, now))
+		})
+	}
+}
+
+func TestAlertFingerprint(t *testing.T) {
+	ruleID := uuid.New()
+	a := alertFingerprint(ruleID, map[string]string{"app": "board", "condition": "degraded"})
+	b := alertFingerprint(ruleID, map[string]string{"condition": "degraded", "app": "board"})
+	assert.Equal(t, a, b, "label order must not matter")
+	assert.Len(t, a, 64)
+	assert.NotEqual(t, a, alertFingerprint(ruleID, map[string]string{"app": "board", "condition": "out_of_sync"}))
+	assert.NotEqual(t, a, alertFingerprint(uuid.New(), map[string]string{"app": "board", "condition": "degraded"}))
+}
+
+// recordingSender records the groups sent to each receiver
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []sentGroup
+}
+
+type sentGroup struct {
+	receiver string
+	status   domain.AlertStatus
+	alerts   int
+}
+
+func (s *recordingSender) Send(_ context.Context, receiver *domain.AlertReceiver, group *AlertGroup) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, sentGroup{receiver: receiver.Name, status: group.Status, alerts: len(group.Alerts)})
+	return nil
+}
+
+// take returns and clears the recorded groups
+func (s *recordingSender) take() []sentGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sent := s.sent
+	s.sent = nil
+	return sent
+}
+
+// alertEvaluatorTestFixture runs the evaluator against SQLite and a fake ArgoCD server
+type alertEvaluatorTestFixture struct {
+	evaluator *AlertEvaluator
+	db        *gorm.DB
+	repo      *repository.AlertRepository
+	sender    *recordingSender
+	rule      *domain.AlertRule
+
+	mu   sync.Mutex
+	apps []client.Application
+}
+
+func newAlertEvaluatorTestFixture(t *testing.T) *alertEvaluatorTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE alert_rules (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, description TEXT, type TEXT NOT NULL, severity TEXT NOT NULL,
+			target TEXT, threshold REAL, enabled INTEGER NOT NULL DEFAULT 1, receiver_ids TEXT, updated_by TEXT,
+			created_at DATETIME, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE alert_receivers (
+			id TEXT PRIMARY KEY, name TEXT NOT NULL, type TEXT NOT NULL, url TEXT, min_severity TEXT NOT NULL,
+			enabled INTEGER NOT NULL DEFAULT 1, updated_by TEXT, created_at DATETIME, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE alerts (
+			id TEXT PRIMARY KEY, fingerprint TEXT NOT NULL, rule_id TEXT NOT NULL, rule_name TEXT NOT NULL,
+			severity TEXT NOT NULL, labels TEXT, summary TEXT, value REAL, status TEXT NOT NULL, silenced INTEGER,
+			starts_at DATETIME NOT NULL, ends_at DATETIME, last_evaluated_at DATETIME, last_notified_at DATETIME,
+			created_at DATETIME, updated_at DATETIME
+		)`,
+		`CREATE UNIQUE INDEX idx_alert_firing ON alerts (fingerprint) WHERE status = 'firing'`,
+		`CREATE TABLE alert_silences (
+			id TEXT PRIMARY KEY, matchers TEXT NOT NULL, starts_at DATETIME NOT NULL, ends_at DATETIME NOT NULL,
+			comment TEXT, created_by_id TEXT NOT NULL, created_by_email TEXT NOT NULL, created_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	f := &alertEvaluatorTestFixture{
+		db:     db,
+		repo:   repository.NewAlertRepository(db),
+		sender: &recordingSender{},
+	}
+	argocd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(client.ApplicationList{Items: f.apps})
+	}))
+	t.Cleanup(argocd.Close)
+
+	f.rule = &domain.AlertRule{Name: "argocd-app-unhealthy", Type: domain.AlertRuleArgoCDApp, Severity: domain.SeverityWarning, Enabled: true}
+	require.NoError(t, f.repo.CreateRule(f.rule))
+	for _, r := range []domain.AlertReceiver{
+		{Name: "oncall", Type: domain.ReceiverWebhook, MinSeverity: domain.SeverityCritical, Enabled: true},
+		{Name: "ops-portal", Type: domain.ReceiverPortal, MinSeverity: domain.SeverityWarning, Enabled: true},
+	} {
+		require.NoError(t, f.repo.CreateReceiver(&r))
+	}
+
+	logger := zap.NewNop()
+	f.evaluator = NewAlertEvaluator(AlertEvaluatorConfig{
+		AlertRepo:    f.repo,
+		ArgoCDClient: client.NewArgoCDClient(client.ArgoCDConfig{ServerURL: argocd.URL}, logger),
+		Senders: map[domain.ReceiverType]AlertSender{
+			domain.ReceiverWebhook: f.sender,
+			domain.ReceiverPortal:  f.sender,
+		},
+		Logger: logger,
+	})
+	return f
+}
+
+// setHealth sets the health of the ArgoCD application served by the fake server
+func (f *alertEvaluatorTestFixture) setHealth(name, health string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	app := client.Application{}
+	app.Metadata.Name = name
+	app.Status.Health.Status = health
+	app.Status.Sync.Status = "Synced"
+	f.apps = []client.Application{app}
+}
+
+func (f *alertEvaluatorTestFixture) alerts(t *testing.T) []domain.Alert {
+	t.Helper()
+	var alerts []domain.Alert
+	require.NoError(t, f.db.Order("starts_at").Find(&alerts).Error)
+	return alerts
+}
+
+// TestAlertEvaluator_Evaluate verifies dedupe, repeat interval, resolution and re-firing of one alert
+func TestAlertEvaluator_Evaluate(t *testing.T) {
+	f := newAlertEvaluatorTestFixture(t)
+	ctx := context.Background()
+
+	f.setHealth("board", "Degraded")
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts := f.alerts(t)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, domain.AlertFiring, alerts[0].Status)
+	assert.Equal(t, map[string]string{"app": "board", "condition": "degraded"}, alerts[0].Labels)
+	require.NotNil(t, alerts[0].LastNotifiedAt)
+	// warning rule은 severity 기준으로 portal receiver에만 전달
+	assert.Equal(t, []sentGroup{{receiver: "ops-portal", status: domain.AlertFiring, alerts: 1}}, f.sender.take())
+
+	// 계속 firing이면 같은 알림을 갱신하고 repeat interval 전에는 다시 보내지 않음
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts = f.alerts(t)
+	require.Len(t, alerts, 1)
+	assert.Empty(t, f.sender.take())
+
+	// repeat interval이 지나면 다시 전송
+	notifiedAt := time.Now().Add(-DefaultAlertRepeatInterval - time.Minute)
+	require.NoError(t, f.db.Model(&domain.Alert{}).Where("id = ?", alerts[0].ID).Update("last_notified_at", notifiedAt).Error)
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	assert.Equal(t, []sentGroup{{receiver: "ops-portal", status: domain.AlertFiring, alerts: 1}}, f.sender.take())
+
+	// 조건이 해소되면 resolved로 전환하고 해제 알림 전송
+	f.setHealth("board", "Healthy")
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts = f.alerts(t)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, domain.AlertResolved, alerts[0].Status)
+	assert.NotNil(t, alerts[0].EndsAt)
+	assert.Equal(t, []sentGroup{{receiver: "ops-portal", status: domain.AlertResolved, alerts: 1}}, f.sender.take())
+
+	// 다시 발생하면 새 알림으로 기록
+	f.setHealth("board", "Degraded")
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts = f.alerts(t)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, alerts[0].Fingerprint, alerts[1].Fingerprint)
+	assert.Equal(t, domain.AlertResolved, alerts[0].Status)
+	assert.Equal(t, domain.AlertFiring, alerts[1].Status)
+	assert.Equal(t, []sentGroup{{receiver: "ops-portal", status: domain.AlertFiring, alerts: 1}}, f.sender.take())
+}
+
+// TestAlertEvaluator_Evaluate_Silenced verifies silenced alerts are tracked but not sent
+func TestAlertEvaluator_Evaluate_Silenced(t *testing.T) {
+	f := newAlertEvaluatorTestFixture(t)
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, f.repo.CreateSilence(&domain.AlertSilence{
+		Matchers:       map[string]string{"app": "board"},
+		StartsAt:       now.Add(-time.Minute),
+		EndsAt:         now.Add(time.Hour),
+		Comment:        "maintenance",
+		CreatedByID:    uuid.New(),
+		CreatedByEmail: "ops@wealist.co.kr",
+	}))
+
+	f.setHealth("board", "Degraded")
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts := f.alerts(t)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, domain.AlertFiring, alerts[0].Status)
+	assert.True(t, alerts[0].Silenced)
+	assert.Nil(t, alerts[0].LastNotifiedAt)
+	assert.Empty(t, f.sender.take())
+
+	// 알림을 받은 적 없는 알림은 해제 알림도 보내지 않음
+	f.setHealth("board", "Healthy")
+	require.NoError(t, f.evaluator.Evaluate(ctx))
+	alerts = f.alerts(t)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, domain.AlertResolved, alerts[0].Status)
+	assert.Empty(t, f.sender.take())
+}
+
+// TestAlertEvaluator_Evaluate_ExplicitReceivers verifies rules with receivers bypass severity routing
+func TestAlertEvaluator_Evaluate_ExplicitReceivers(t *testing.T) {
+	f := newAlertEvaluatorTestFixture(t)
+	receivers, err := f.repo.GetReceivers()
+	require.NoError(t, err)
+	for _, r := range receivers {
+		if r.Name == "oncall" {
+			f.rule.ReceiverIDs = []uuid.UUID{r.ID}
+		}
+	}
+	require.NoError(t, f.repo.UpdateRule(f.rule))
+
+	f.setHealth("board", "Degraded")
+	require.NoError(t, f.evaluator.Evaluate(context.Background()))
+	assert.Equal(t, []sentGroup{{receiver: "oncall", status: domain.AlertFiring, alerts: 1}}, f.sender.take())
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+)
+
+// senderTimeout bounds one delivery to a webhook receiver
+const senderTimeout = 10 * time.Second
+
+// AlertGroup is a set of alerts of one rule that changed state in the same evaluation
+type AlertGroup struct {
+	Status   domain.AlertStatus   `json:"status"`
+	GroupKey string               `json:"groupKey"`
+	RuleName string               `json:"ruleName"`
+	Severity domain.AlertSeverity `json:"severity"`
+	Alerts   []*domain.Alert      `json:"alerts"`
+}
+
+// Title returns a one-line summary such as "[FIRING:2] high-error-rate (warning)"
+func (g *AlertGroup) Title() string {
+	return fmt.Sprintf("[%s:%d] %s (%s)", strings.ToUpper(string(g.Status)), len(g.Alerts), g.RuleName, g.Severity)
+}
+
+// Text returns one line per alert
+func (g *AlertGroup) Text() string {
+	lines := make([]string, len(g.Alerts))
+	for i, a := range g.Alerts {
+		lines[i] = "• " + a.Summary
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AlertSender delivers an alert group to one receiver.
+// Register implementations per domain.ReceiverType to add new kinds of receivers.
+type AlertSender interface {
+	Send(ctx context.Context, receiver *domain.AlertReceiver, group *AlertGroup) error
+}
+
+// DefaultAlertSenders returns the senders for the built-in receiver types
+func DefaultAlertSenders(repo *repository.AlertRepository) map[domain.ReceiverType]AlertSender {
+	httpClient := &http.Client{Timeout: senderTimeout}
+	return map[domain.ReceiverType]AlertSender{
+		domain.ReceiverWebhook: &webhookSender{client: httpClient},
+		domain.ReceiverSlack:   &slackSender{client: httpClient},
+		domain.ReceiverPortal:  &portalSender{repo: repo},
+	}
+}
+
+// webhookPayload is the JSON body posted to generic webhook receivers
+type webhookPayload struct {
+	Receiver string `json:"receiver"`
+	*AlertGroup
+}
+
+// webhookSender posts the alert group as JSON
+type webhookSender struct {
+	client *http.Client
+}
+
+func (s *webhookSender) Send(ctx context.Context, receiver *domain.AlertReceiver, group *AlertGroup) error {
+	return postJSON(ctx, s.client, receiver.URL, webhookPayload{Receiver: receiver.Name, AlertGroup: group})
+}
+
+// slackSender posts a Slack-compatible incoming webhook message
+type slackSender struct {
+	client *http.Client
+}
+
+func (s *slackSender) Send(ctx context.Context, receiver *domain.AlertReceiver, group *AlertGroup) error {
+	text := "*" + group.Title() + "*\n" + group.Text()
+	return postJSON(ctx, s.client, receiver.URL, map[string]string{"text": text})
+}
+
+// portalSender stores an in-app notification for the ops portal
+type portalSender struct {
+	repo *repository.AlertRepository
+}
+
+func (s *portalSender) Send(_ context.Context, _ *domain.AlertReceiver, group *AlertGroup) error {
+	return s.repo.CreateNotification(&domain.PortalNotification{
+		RuleName: group.RuleName,
+		Severity: group.Severity,
+		Status:   group.Status,
+		Title:    group.Title(),
+		Body:     group.Text(),
+	})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body interface{}) error {
+	if url == "" {
+		return fmt.Errorf("receiver URL is empty")
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("receiver returned %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
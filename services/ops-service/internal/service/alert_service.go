@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+// maxSilenceDuration bounds how long a silence may last
+const maxSilenceDuration = 30 * 24 * time.Hour
+
+// AlertService handles alert rules, receivers, silences and alert history
+type AlertService struct {
+	repo     *repository.AlertRepository
+	auditSvc *AuditLogService
+	senders  map[domain.ReceiverType]AlertSender
+	logger   *zap.Logger
+}
+
+// NewAlertService creates a new alert service
+func NewAlertService(
+	repo *repository.AlertRepository,
+	auditSvc *AuditLogService,
+	senders map[domain.ReceiverType]AlertSender,
+	logger *zap.Logger,
+) *AlertService {
+	return &AlertService{
+		repo:     repo,
+		auditSvc: auditSvc,
+		senders:  senders,
+		logger:   logger,
+	}
+}
+
+// GetRules gets all alert rules
+func (s *AlertService) GetRules() ([]domain.AlertRule, error) {
+	return s.repo.GetRules()
+}
+
+// GetRule gets an alert rule by ID
+func (s *AlertService) GetRule(id uuid.UUID) (*domain.AlertRule, error) {
+	rule, err := s.repo.GetRuleByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrAlertRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+// CreateRule creates a new alert rule
+func (s *AlertService) CreateRule(userID uuid.UUID, userEmail string, req domain.CreateAlertRuleRequest) (*domain.AlertRule, error) {
+	rule := &domain.AlertRule{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Type:        req.Type,
+		Severity:    req.Severity,
+		Target:      strings.TrimSpace(req.Target),
+		Threshold:   req.Threshold,
+		Enabled:     true,
+		ReceiverIDs: req.ReceiverIDs,
+		UpdatedBy:   userID,
+	}
+	if err := s.validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.RuleExistsByName(rule.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, response.ErrAlertRuleExists
+	}
+
+	if err := s.repo.CreateRule(rule); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceAlertRule, rule.ID.String(),
+		fmt.Sprintf("Created alert rule %s (%s, %s)", rule.Name, rule.Type, rule.Severity))
+
+	s.logger.Info("Alert rule created",
+		zap.String("name", rule.Name),
+		zap.String("createdBy", userEmail),
+	)
+
+	return rule, nil
+}
+
+// UpdateRule updates an alert rule
+func (s *AlertService) UpdateRule(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateAlertRuleRequest) (*domain.AlertRule, error) {
+	rule, err := s.GetRule(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Description = req.Description
+	rule.Severity = req.Severity
+	rule.Target = strings.TrimSpace(req.Target)
+	rule.Threshold = req.Threshold
+	rule.ReceiverIDs = req.ReceiverIDs
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	rule.UpdatedBy = userID
+
+	if err := s.validateRule(rule); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateRule(rule); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceAlertRule, rule.ID.String(),
+		fmt.Sprintf("Updated alert rule %s (severity=%s, threshold=%g, enabled=%t)", rule.Name, rule.Severity, rule.Threshold, rule.Enabled))
+
+	s.logger.Info("Alert rule updated",
+		zap.String("name", rule.Name),
+		zap.String("updatedBy", userEmail),
+	)
+
+	return rule, nil
+}
+
+// DeleteRule deletes an alert rule. Its firing alerts are resolved on the next evaluation.
+func (s *AlertService) DeleteRule(userID uuid.UUID, userEmail string, id uuid.UUID) error {
+	rule, err := s.GetRule(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteRule(id); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionDelete, domain.ResourceAlertRule, id.String(), "Deleted alert rule "+rule.Name)
+
+	s.logger.Info("Alert rule deleted",
+		zap.String("name", rule.Name),
+		zap.String("deletedBy", userEmail),
+	)
+
+	return nil
+}
+
+// GetReceivers gets all alert receivers
+func (s *AlertService) GetReceivers() ([]domain.AlertReceiver, error) {
+	return s.repo.GetReceivers()
+}
+
+// GetReceiver gets an alert receiver by ID
+func (s *AlertService) GetReceiver(id uuid.UUID) (*domain.AlertReceiver, error) {
+	receiver, err := s.repo.GetReceiverByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrReceiverNotFound
+		}
+		return nil, err
+	}
+	return receiver, nil
+}
+
+// CreateReceiver creates a new alert receiver
+func (s *AlertService) CreateReceiver(userID uuid.UUID, userEmail string, req domain.CreateAlertReceiverRequest) (*domain.AlertReceiver, error) {
+	if req.MinSeverity == "" {
+		req.MinSeverity = domain.SeverityWarning
+	}
+
+	receiver := &domain.AlertReceiver{
+		Name:        strings.TrimSpace(req.Name),
+		Type:        req.Type,
+		URL:         strings.TrimSpace(req.URL),
+		MinSeverity: req.MinSeverity,
+		Enabled:     true,
+		UpdatedBy:   userID,
+	}
+	if err := s.validateReceiver(receiver); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ReceiverExistsByName(receiver.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, response.ErrReceiverExists
+	}
+
+	if err := s.repo.CreateReceiver(receiver); err != nil {
+		return nil, err
+	}
+
+	// URL은 토큰을 포함할 수 있으므로 감사 로그에 남기지 않음
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceAlertReceiver, receiver.ID.String(),
+		fmt.Sprintf("Created %s receiver %s (min severity %s)", receiver.Type, receiver.Name, receiver.MinSeverity))
+
+	s.logger.Info("Alert receiver created",
+		zap.String("name", receiver.Name),
+		zap.String("type", string(receiver.Type)),
+		zap.String("createdBy", userEmail),
+	)
+
+	return receiver, nil
+}
+
+// UpdateReceiver updates an alert receiver
+func (s *AlertService) UpdateReceiver(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateAlertReceiverRequest) (*domain.AlertReceiver, error) {
+	receiver, err := s.GetReceiver(id)
+	if err != nil {
+		return nil, err
+	}
+
+	urlChanged := false
+	if u := strings.TrimSpace(req.URL); u != "" && u != receiver.URL {
+		receiver.URL = u
+		urlChanged = true
+	}
+	receiver.MinSeverity = req.MinSeverity
+	if req.Enabled != nil {
+		receiver.Enabled = *req.Enabled
+	}
+	receiver.UpdatedBy = userID
+
+	if err := s.validateReceiver(receiver); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateReceiver(receiver); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceAlertReceiver, receiver.ID.String(),
+		fmt.Sprintf("Updated receiver %s (min severity %s, enabled=%t, url changed=%t)", receiver.Name, receiver.MinSeverity, receiver.Enabled, urlChanged))
+
+	s.logger.Info("Alert receiver updated",
+		zap.String("name", receiver.Name),
+		zap.String("updatedBy", userEmail),
+	)
+
+	return receiver, nil
+}
+
+// DeleteReceiver deletes an alert receiver
+func (s *AlertService) DeleteReceiver(userID uuid.UUID, userEmail string, id uuid.UUID) error {
+	receiver, err := s.GetReceiver(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteReceiver(id); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionDelete, domain.ResourceAlertReceiver, id.String(), "Deleted receiver "+receiver.Name)
+
+	s.logger.Info("Alert receiver deleted",
+		zap.String("name", receiver.Name),
+		zap.String("deletedBy", userEmail),
+	)
+
+	return nil
+}
+
+// TestReceiver sends a test notification to a receiver
+func (s *AlertService) TestReceiver(ctx context.Context, id uuid.UUID) error {
+	receiver, err := s.GetReceiver(id)
+	if err != nil {
+		return err
+	}
+
+	sender, ok := s.senders[receiver.Type]
+	if !ok {
+		return response.NewValidationError("Unsupported receiver type", string(receiver.Type))
+	}
+
+	now := time.Now()
+	group := &AlertGroup{
+		Status:   domain.AlertFiring,
+		GroupKey: "test",
+		RuleName: "test-notification",
+		Severity: domain.SeverityInfo,
+		Alerts: []*domain.Alert{{
+			RuleName: "test-notification",
+			Severity: domain.SeverityInfo,
+			Status:   domain.AlertFiring,
+			Summary:  "Test notification from ops-service",
+			StartsAt: now,
+		}},
+	}
+	if err := sender.Send(ctx, receiver, group); err != nil {
+		return response.NewValidationError("Test notification failed", err.Error())
+	}
+	return nil
+}
+
+// ListAlerts lists alert history
+func (s *AlertService) ListAlerts(opts repository.AlertListOptions) ([]domain.Alert, int64, error) {
+	return s.repo.ListAlerts(opts)
+}
+
+// ListNotifications lists the latest portal notifications
+func (s *AlertService) ListNotifications(limit int) ([]domain.PortalNotification, error) {
+	return s.repo.ListNotifications(limit)
+}
+
+// GetActiveSilences gets silences that have not ended
+func (s *AlertService) GetActiveSilences() ([]domain.AlertSilence, error) {
+	return s.repo.GetActiveSilences(time.Now())
+}
+
+// CreateSilence creates a silence
+func (s *AlertService) CreateSilence(userID uuid.UUID, userEmail string, req domain.CreateSilenceRequest) (*domain.AlertSilence, error) {
+	startsAt := time.Now()
+	if req.StartsAt != nil && req.StartsAt.After(startsAt) {
+		startsAt = *req.StartsAt
+	}
+
+	if len(req.Matchers) == 0 {
+		return nil, response.NewValidationError("Invalid matchers", "at least one matcher is required")
+	}
+	for k, v := range req.Matchers {
+		if strings.TrimSpace(k) == "" || strings.TrimSpace(v) == "" {
+			return nil, response.NewValidationError("Invalid matchers", "matcher names and values must not be empty")
+		}
+	}
+	if !req.EndsAt.After(startsAt) {
+		return nil, response.NewValidationError("Invalid silence window", "endsAt must be after startsAt")
+	}
+	if req.EndsAt.Sub(startsAt) > maxSilenceDuration {
+		return nil, response.NewValidationError("Invalid silence window", "silences may last at most 30 days")
+	}
+
+	silence := &domain.AlertSilence{
+		Matchers:       req.Matchers,
+		StartsAt:       startsAt,
+		EndsAt:         req.EndsAt,
+		Comment:        req.Comment,
+		CreatedByID:    userID,
+		CreatedByEmail: userEmail,
+	}
+	if err := s.repo.CreateSilence(silence); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceAlertSilence, silence.ID.String(),
+		fmt.Sprintf("Silenced %s until %s: %s", formatMatchers(silence.Matchers), silence.EndsAt.Format(time.RFC3339), silence.Comment))
+
+	s.logger.Info("Alert silence created",
+		zap.String("matchers", formatMatchers(silence.Matchers)),
+		zap.Time("endsAt", silence.EndsAt),
+		zap.String("createdBy", userEmail),
+	)
+
+	return silence, nil
+}
+
+// ExpireSilence ends a silence immediately
+func (s *AlertService) ExpireSilence(userID uuid.UUID, userEmail string, id uuid.UUID) error {
+	silence, err := s.repo.GetSilenceByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return response.ErrSilenceNotFound
+		}
+		return err
+	}
+
+	now := time.Now()
+	if !silence.EndsAt.After(now) {
+		return nil
+	}
+	silence.EndsAt = now
+	if err := s.repo.UpdateSilence(silence); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionDelete, domain.ResourceAlertSilence, id.String(),
+		"Expired silence "+formatMatchers(silence.Matchers))
+
+	return nil
+}
+
+// validateRule checks type, severity, threshold and receivers of a rule
+func (s *AlertService) validateRule(rule *domain.AlertRule) error {
+	if rule.Name == "" {
+		return response.NewValidationError("Rule name is required", "")
+	}
+	if !rule.Type.IsValid() {
+		return response.NewValidationError("Invalid rule type", "must be burn_rate, error_rate, latency or argocd_app")
+	}
+	if !rule.Severity.IsValid() {
+		return response.NewValidationError("Invalid severity", "must be info, warning or critical")
+	}
+	if rule.Threshold < 0 {
+		return response.NewValidationError("Invalid threshold", "must not be negative")
+	}
+	if rule.Type == domain.AlertRuleErrorRate && rule.Threshold > 100 {
+		return response.NewValidationError("Invalid threshold", "error rate threshold must not exceed 100")
+	}
+	for _, id := range rule.ReceiverIDs {
+		if _, err := s.GetReceiver(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateReceiver checks type, URL and minimum severity of a receiver
+func (s *AlertService) validateReceiver(receiver *domain.AlertReceiver) error {
+	if receiver.Name == "" {
+		return response.NewValidationError("Receiver name is required", "")
+	}
+	if _, ok := s.senders[receiver.Type]; !ok {
+		return response.NewValidationError("Invalid receiver type", "must be webhook, slack or portal")
+	}
+	if !receiver.MinSeverity.IsValid() {
+		return response.NewValidationError("Invalid minimum severity", "must be info, warning or critical")
+	}
+	if receiver.Type != domain.ReceiverPortal {
+		u, err := url.Parse(receiver.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return response.NewValidationError("Invalid receiver URL", "must be an http(s) URL")
+		}
+	}
+	return nil
+}
+
+// formatMatchers renders matchers as {k="v", ...} for logs and audit details
+func formatMatchers(matchers map[string]string) string {
+	pairs := make([]string, 0, len(matchers))
+	for k, v := range matchers {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, v))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
@@ -1,6 +1,10 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -10,26 +14,41 @@ import (
 	"ops-service/internal/response"
 )
 
-// AppConfigService handles app config business logic
+// AppConfigService handles app config business logic.
+// Every change is recorded as an append-only revision so it can be diffed and rolled back.
 type AppConfigService struct {
-	repo     *repository.AppConfigRepository
-	auditSvc *AuditLogService
-	logger   *zap.Logger
+	repo            *repository.AppConfigRepository
+	auditSvc        *AuditLogService
+	requireApproval bool // true이면 sensitive 키 변경은 두 번째 승인자가 필요
+	logger          *zap.Logger
 }
 
 // NewAppConfigService creates a new app config service
 func NewAppConfigService(
 	repo *repository.AppConfigRepository,
 	auditSvc *AuditLogService,
+	requireApproval bool,
 	logger *zap.Logger,
 ) *AppConfigService {
 	return &AppConfigService{
-		repo:     repo,
-		auditSvc: auditSvc,
-		logger:   logger,
+		repo:            repo,
+		auditSvc:        auditSvc,
+		requireApproval: requireApproval,
+		logger:          logger,
 	}
 }
 
+// configChange describes a change to apply to an app config
+type configChange struct {
+	value       string
+	description *string // nil이면 기존 설명 유지
+	isActive    *bool
+	isSensitive *bool
+	action      domain.RevisionAction
+	rollbackOf  *int
+	reason      string
+}
+
 // GetByKey gets an app config by key
 func (s *AppConfigService) GetByKey(key string) (*domain.AppConfig, error) {
 	config, err := s.repo.GetByKey(key)
@@ -64,7 +83,7 @@ func (s *AppConfigService) GetActive() ([]domain.AppConfig, error) {
 	return s.repo.GetActive()
 }
 
-// Create creates a new app config
+// Create creates a new app config and records it as revision 1
 func (s *AppConfigService) Create(userID uuid.UUID, userEmail string, req domain.CreateAppConfigRequest) (*domain.AppConfig, error) {
 	exists, err := s.repo.ExistsByKey(req.Key)
 	if err != nil {
@@ -79,10 +98,20 @@ func (s *AppConfigService) Create(userID uuid.UUID, userEmail string, req domain
 		Value:       req.Value,
 		Description: req.Description,
 		IsActive:    true,
+		IsSensitive: req.IsSensitive,
 		UpdatedBy:   userID,
 	}
+	rev := &domain.AppConfigRevision{
+		ConfigKey:   req.Key,
+		Action:      domain.RevisionCreate,
+		NewValue:    req.Value,
+		NewIsActive: true,
+		Reason:      req.Reason,
+		AuthorID:    userID,
+		AuthorEmail: userEmail,
+	}
 
-	if err := s.repo.Create(config); err != nil {
+	if err := s.repo.CreateWithRevision(config, rev); err != nil {
 		return nil, err
 	}
 
@@ -97,26 +126,37 @@ func (s *AppConfigService) Create(userID uuid.UUID, userEmail string, req domain
 	return config, nil
 }
 
-// Update updates an app config
-func (s *AppConfigService) Update(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateAppConfigRequest) (*domain.AppConfig, error) {
-	config, err := s.repo.GetByID(id)
+// Update updates an app config. Changes to sensitive configs wait for a second approver when approval is required.
+func (s *AppConfigService) Update(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateAppConfigRequest) (*domain.AppConfigChangeResult, error) {
+	config, err := s.GetByID(id)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, response.ErrConfigNotFound
-		}
 		return nil, err
 	}
 
-	oldValue := config.Value
-	config.Value = req.Value
-	config.Description = req.Description
-	config.UpdatedBy = userID
-
-	if req.IsActive != nil {
-		config.IsActive = *req.IsActive
+	if s.needsApproval(config) {
+		change := &domain.AppConfigChangeRequest{
+			Value:       req.Value,
+			Description: req.Description,
+			IsActive:    req.IsActive,
+			IsSensitive: req.IsSensitive,
+			Reason:      req.Reason,
+		}
+		if err := s.requestChange(userID, userEmail, config, change); err != nil {
+			return nil, err
+		}
+		return &domain.AppConfigChangeResult{Pending: change}, nil
 	}
 
-	if err := s.repo.Update(config); err != nil {
+	oldValue := config.Value
+	err = s.apply(config, configChange{
+		value:       req.Value,
+		description: &req.Description,
+		isActive:    req.IsActive,
+		isSensitive: req.IsSensitive,
+		action:      domain.RevisionUpdate,
+		reason:      req.Reason,
+	}, userID, userEmail, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -132,19 +172,22 @@ func (s *AppConfigService) Update(userID uuid.UUID, userEmail string, id uuid.UU
 		zap.String("updatedBy", userEmail),
 	)
 
-	return config, nil
+	resp := config.ToResponse()
+	return &domain.AppConfigChangeResult{Config: &resp}, nil
 }
 
-// Delete deletes an app config
+// Delete deletes an app config. Its revisions are kept.
 func (s *AppConfigService) Delete(userID uuid.UUID, userEmail string, id uuid.UUID) error {
-	config, err := s.repo.GetByID(id)
+	config, err := s.GetByID(id)
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return response.ErrConfigNotFound
-		}
 		return err
 	}
 
+	// 승인 절차 우회 방지: sensitive 해제(승인 필요) 후에만 삭제 가능
+	if s.needsApproval(config) {
+		return response.NewConflictError("Sensitive config cannot be deleted", "mark the config as not sensitive first")
+	}
+
 	if err := s.repo.Delete(id); err != nil {
 		return err
 	}
@@ -159,3 +202,266 @@ func (s *AppConfigService) Delete(userID uuid.UUID, userEmail string, id uuid.UU
 
 	return nil
 }
+
+// ListRevisions lists the revisions of an app config, newest first
+func (s *AppConfigService) ListRevisions(id uuid.UUID, page, limit int) ([]domain.AppConfigRevision, int64, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListRevisions(id, page, limit)
+}
+
+// GetRevision gets one revision of an app config
+func (s *AppConfigService) GetRevision(id uuid.UUID, revision int) (*domain.AppConfigRevision, error) {
+	rev, err := s.repo.GetRevision(id, revision)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// Diff compares the values two revisions of an app config set
+func (s *AppConfigService) Diff(id uuid.UUID, from, to int) (*domain.AppConfigDiffResponse, error) {
+	fromRev, err := s.GetRevision(id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.GetRevision(id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AppConfigDiffResponse{
+		ConfigID: id,
+		Key:      toRev.ConfigKey,
+		From:     *fromRev,
+		To:       *toRev,
+		Lines:    diffLines(formatForDiff(fromRev.NewValue), formatForDiff(toRev.NewValue)),
+	}, nil
+}
+
+// Rollback restores the value and active state an app config had at a revision, as a new revision
+func (s *AppConfigService) Rollback(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.RollbackAppConfigRequest) (*domain.AppConfigChangeResult, error) {
+	config, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.GetRevision(id, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.needsApproval(config) {
+		change := &domain.AppConfigChangeRequest{
+			Value:      target.NewValue,
+			IsActive:   &target.NewIsActive,
+			RollbackTo: &target.Revision,
+			Reason:     req.Reason,
+		}
+		if err := s.requestChange(userID, userEmail, config, change); err != nil {
+			return nil, err
+		}
+		return &domain.AppConfigChangeResult{Pending: change}, nil
+	}
+
+	err = s.apply(config, configChange{
+		value:      target.NewValue,
+		isActive:   &target.NewIsActive,
+		action:     domain.RevisionRollback,
+		rollbackOf: &target.Revision,
+		reason:     req.Reason,
+	}, userID, userEmail, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionRollback, domain.ResourceAppConfig, config.ID.String(),
+		fmt.Sprintf("Rolled back config %s to revision %d", config.Key, target.Revision))
+
+	s.logger.Info("App config rolled back",
+		zap.String("key", config.Key),
+		zap.Int("revision", target.Revision),
+		zap.String("rolledBackBy", userEmail),
+	)
+
+	resp := config.ToResponse()
+	return &domain.AppConfigChangeResult{Config: &resp}, nil
+}
+
+// ListPendingChanges lists changes waiting for a second approver
+func (s *AppConfigService) ListPendingChanges() ([]domain.AppConfigChangeRequest, error) {
+	return s.repo.ListChangeRequests(domain.ChangeRequestPending)
+}
+
+// ApproveChange applies a pending change. The approver must not be the requester.
+func (s *AppConfigService) ApproveChange(userID uuid.UUID, userEmail string, changeID uuid.UUID, req domain.ReviewChangeRequest) (*domain.AppConfig, error) {
+	change, err := s.getPendingChange(changeID)
+	if err != nil {
+		return nil, err
+	}
+	if change.RequestedByID == userID {
+		return nil, response.ErrSelfApproval
+	}
+
+	config, err := s.GetByID(change.ConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.markReviewed(change, domain.ChangeRequestApproved, userID, userEmail, req.Comment)
+
+	ch := configChange{
+		value:       change.Value,
+		isActive:    change.IsActive,
+		isSensitive: change.IsSensitive,
+		action:      domain.RevisionUpdate,
+		reason:      change.Reason,
+	}
+	if change.RollbackTo != nil {
+		ch.action = domain.RevisionRollback
+		ch.rollbackOf = change.RollbackTo
+	} else {
+		ch.description = &change.Description
+	}
+	if err := s.apply(config, ch, change.RequestedByID, change.RequestedByEmail, change); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionApprove, domain.ResourceAppConfig, config.ID.String(),
+		fmt.Sprintf("Approved change to config %s requested by %s", config.Key, change.RequestedByEmail))
+
+	s.logger.Info("App config change approved",
+		zap.String("key", config.Key),
+		zap.String("changeID", change.ID.String()),
+		zap.String("approvedBy", userEmail),
+	)
+
+	return config, nil
+}
+
+// RejectChange rejects a pending change. Requesters may withdraw their own changes.
+func (s *AppConfigService) RejectChange(userID uuid.UUID, userEmail string, changeID uuid.UUID, req domain.ReviewChangeRequest) (*domain.AppConfigChangeRequest, error) {
+	change, err := s.getPendingChange(changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.markReviewed(change, domain.ChangeRequestRejected, userID, userEmail, req.Comment)
+	if err := s.repo.UpdateChangeRequest(change); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionReject, domain.ResourceAppConfig, change.ConfigID.String(),
+		fmt.Sprintf("Rejected change to config %s requested by %s", change.ConfigKey, change.RequestedByEmail))
+
+	s.logger.Info("App config change rejected",
+		zap.String("key", change.ConfigKey),
+		zap.String("changeID", change.ID.String()),
+		zap.String("rejectedBy", userEmail),
+	)
+
+	return change, nil
+}
+
+// needsApproval reports whether changes to config must wait for a second approver
+func (s *AppConfigService) needsApproval(config *domain.AppConfig) bool {
+	return s.requireApproval && config.IsSensitive
+}
+
+// requestChange stores change as pending against the latest revision of config
+func (s *AppConfigService) requestChange(userID uuid.UUID, userEmail string, config *domain.AppConfig, change *domain.AppConfigChangeRequest) error {
+	base, err := s.repo.LatestRevision(config.ID)
+	if err != nil {
+		return err
+	}
+
+	change.ConfigID = config.ID
+	change.ConfigKey = config.Key
+	change.BaseRevision = base
+	change.Status = domain.ChangeRequestPending
+	change.RequestedByID = userID
+	change.RequestedByEmail = userEmail
+	if err := s.repo.CreateChangeRequest(change); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceAppConfig, config.ID.String(),
+		"Requested change to sensitive config: "+config.Key+" (pending approval)")
+
+	s.logger.Info("App config change pending approval",
+		zap.String("key", config.Key),
+		zap.String("changeID", change.ID.String()),
+		zap.String("requestedBy", userEmail),
+	)
+
+	return nil
+}
+
+// apply applies ch to config and records it as a new revision authored by the given user.
+// approval is the change request being approved, if any.
+func (s *AppConfigService) apply(config *domain.AppConfig, ch configChange, authorID uuid.UUID, authorEmail string, approval *domain.AppConfigChangeRequest) error {
+	rev := &domain.AppConfigRevision{
+		ConfigKey:   config.Key,
+		Action:      ch.action,
+		OldValue:    config.Value,
+		NewValue:    ch.value,
+		OldIsActive: config.IsActive,
+		Reason:      ch.reason,
+		RollbackOf:  ch.rollbackOf,
+		AuthorID:    authorID,
+		AuthorEmail: authorEmail,
+	}
+
+	config.Value = ch.value
+	if ch.description != nil {
+		config.Description = *ch.description
+	}
+	if ch.isActive != nil {
+		config.IsActive = *ch.isActive
+	}
+	if ch.isSensitive != nil {
+		config.IsSensitive = *ch.isSensitive
+	}
+	config.UpdatedBy = authorID
+	rev.NewIsActive = config.IsActive
+
+	if approval != nil {
+		rev.ApprovedByID = approval.ReviewedByID
+		rev.ApprovedByEmail = approval.ReviewedByEmail
+		rev.ChangeRequestID = &approval.ID
+	}
+
+	if err := s.repo.ApplyChange(config, rev, approval); err != nil {
+		if errors.Is(err, repository.ErrStaleChangeRequest) {
+			return response.NewConflictError("Config changed since the change was requested", "reject this change and request it again")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *AppConfigService) getPendingChange(id uuid.UUID) (*domain.AppConfigChangeRequest, error) {
+	change, err := s.repo.GetChangeRequest(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrChangeNotFound
+		}
+		return nil, err
+	}
+	if change.Status != domain.ChangeRequestPending {
+		return nil, response.ErrChangeNotPending
+	}
+	return change, nil
+}
+
+func (s *AppConfigService) markReviewed(change *domain.AppConfigChangeRequest, status domain.ChangeRequestStatus, userID uuid.UUID, userEmail, comment string) {
+	now := time.Now()
+	change.Status = status
+	change.ReviewedByID = &userID
+	change.ReviewedByEmail = userEmail
+	change.ReviewComment = comment
+	change.ReviewedAt = &now
+}
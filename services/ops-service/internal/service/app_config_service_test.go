@@ -0,0 +1,202 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+// appConfigTestFixture prepares an app config service in approval mode
+type appConfigTestFixture struct {
+	svc       *AppConfigService
+	db        *gorm.DB
+	requester uuid.UUID
+	approver  uuid.UUID
+}
+
+func newAppConfigTestFixture(t *testing.T) *appConfigTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE app_configs (
+			id TEXT PRIMARY KEY, key TEXT NOT NULL UNIQUE, value TEXT NOT NULL, description TEXT,
+			is_active INTEGER DEFAULT 1, is_sensitive INTEGER DEFAULT 0, updated_by TEXT,
+			created_at DATETIME, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE app_config_revisions (
+			id TEXT PRIMARY KEY, config_id TEXT NOT NULL, revision INTEGER NOT NULL, config_key TEXT NOT NULL,
+			action TEXT NOT NULL, old_value TEXT, new_value TEXT NOT NULL, old_is_active INTEGER, new_is_active INTEGER,
+			reason TEXT, rollback_of INTEGER, author_id TEXT NOT NULL, author_email TEXT NOT NULL,
+			approved_by_id TEXT, approved_by_email TEXT, change_request_id TEXT, created_at DATETIME,
+			UNIQUE (config_id, revision)
+		)`,
+		`CREATE TABLE app_config_change_requests (
+			id TEXT PRIMARY KEY, config_id TEXT NOT NULL, config_key TEXT NOT NULL, base_revision INTEGER NOT NULL,
+			value TEXT NOT NULL, description TEXT, is_active INTEGER, is_sensitive INTEGER, rollback_to INTEGER,
+			reason TEXT, status TEXT NOT NULL, requested_by_id TEXT NOT NULL, requested_by_email TEXT NOT NULL,
+			reviewed_by_id TEXT, reviewed_by_email TEXT, review_comment TEXT, reviewed_at DATETIME, created_at DATETIME
+		)`,
+		`CREATE TABLE audit_logs (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, user_email TEXT NOT NULL, action TEXT NOT NULL,
+			resource_type TEXT NOT NULL, resource_id TEXT NOT NULL, details TEXT, ip_address TEXT, user_agent TEXT,
+			created_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	logger := zap.NewNop()
+	return &appConfigTestFixture{
+		svc: NewAppConfigService(
+			repository.NewAppConfigRepository(db),
+			NewAuditLogService(repository.NewAuditLogRepository(db), logger),
+			true,
+			logger,
+		),
+		db:        db,
+		requester: uuid.New(),
+		approver:  uuid.New(),
+	}
+}
+
+// createSensitive creates a sensitive config with the given value as revision 1
+func (f *appConfigTestFixture) createSensitive(t *testing.T, key, value string) *domain.AppConfig {
+	t.Helper()
+	config, err := f.svc.Create(f.requester, "requester@wealist.co.kr", domain.CreateAppConfigRequest{
+		Key:         key,
+		Value:       value,
+		IsSensitive: true,
+	})
+	require.NoError(t, err)
+	return config
+}
+
+// requestUpdate requests a change to a sensitive config and returns the pending change
+func (f *appConfigTestFixture) requestUpdate(t *testing.T, config *domain.AppConfig, value string) *domain.AppConfigChangeRequest {
+	t.Helper()
+	result, err := f.svc.Update(f.requester, "requester@wealist.co.kr", config.ID, domain.UpdateAppConfigRequest{Value: value})
+	require.NoError(t, err)
+	require.Nil(t, result.Config, "sensitive config must not change before approval")
+	require.NotNil(t, result.Pending)
+	return result.Pending
+}
+
+// TestAppConfigService_ApproveChange verifies who may approve a pending change and that it is applied once
+func TestAppConfigService_ApproveChange(t *testing.T) {
+	f := newAppConfigTestFixture(t)
+	config := f.createSensitive(t, "payment.api_key", "v1")
+	change := f.requestUpdate(t, config, "v2")
+	assert.Equal(t, 1, change.BaseRevision)
+
+	current, err := f.svc.GetByID(config.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", current.Value)
+
+	_, err = f.svc.ApproveChange(f.requester, "requester@wealist.co.kr", change.ID, domain.ReviewChangeRequest{})
+	assert.ErrorIs(t, err, response.ErrSelfApproval)
+
+	approved, err := f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", change.ID, domain.ReviewChangeRequest{Comment: "lgtm"})
+	require.NoError(t, err)
+	assert.Equal(t, "v2", approved.Value)
+
+	rev, err := f.svc.GetRevision(config.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RevisionUpdate, rev.Action)
+	assert.Equal(t, "v1", rev.OldValue)
+	assert.Equal(t, "v2", rev.NewValue)
+	assert.Equal(t, f.requester, rev.AuthorID)
+	require.NotNil(t, rev.ApprovedByID)
+	assert.Equal(t, f.approver, *rev.ApprovedByID)
+	require.NotNil(t, rev.ChangeRequestID)
+	assert.Equal(t, change.ID, *rev.ChangeRequestID)
+
+	_, err = f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", change.ID, domain.ReviewChangeRequest{})
+	assert.ErrorIs(t, err, response.ErrChangeNotPending)
+}
+
+// TestAppConfigService_ApproveChange_StaleBaseRevision verifies a change is not applied over a newer revision
+func TestAppConfigService_ApproveChange_StaleBaseRevision(t *testing.T) {
+	f := newAppConfigTestFixture(t)
+	config := f.createSensitive(t, "payment.api_key", "v1")
+	first := f.requestUpdate(t, config, "v2")
+	second := f.requestUpdate(t, config, "v3")
+
+	_, err := f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", first.ID, domain.ReviewChangeRequest{})
+	require.NoError(t, err)
+
+	_, err = f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", second.ID, domain.ReviewChangeRequest{})
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok)
+	assert.Equal(t, apperrors.ErrCodeConflict, appErr.Code)
+
+	current, err := f.svc.GetByID(config.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", current.Value)
+	latest, err := repository.NewAppConfigRepository(f.db).LatestRevision(config.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, latest)
+
+	// 오래된 요청은 대기 상태로 남아 거절할 수 있음
+	pending, err := f.svc.ListPendingChanges()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, second.ID, pending[0].ID)
+	_, err = f.svc.RejectChange(f.requester, "requester@wealist.co.kr", second.ID, domain.ReviewChangeRequest{})
+	require.NoError(t, err)
+}
+
+// TestAppConfigService_Rollback_SensitiveKey verifies rolling back a sensitive key waits for approval
+func TestAppConfigService_Rollback_SensitiveKey(t *testing.T) {
+	f := newAppConfigTestFixture(t)
+	config := f.createSensitive(t, "payment.api_key", "v1")
+	change := f.requestUpdate(t, config, "v2")
+	_, err := f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", change.ID, domain.ReviewChangeRequest{})
+	require.NoError(t, err)
+
+	result, err := f.svc.Rollback(f.requester, "requester@wealist.co.kr", config.ID, domain.RollbackAppConfigRequest{Revision: 1, Reason: "bad key"})
+	require.NoError(t, err)
+	require.Nil(t, result.Config)
+	require.NotNil(t, result.Pending)
+	require.NotNil(t, result.Pending.RollbackTo)
+	assert.Equal(t, 1, *result.Pending.RollbackTo)
+	assert.Equal(t, "v1", result.Pending.Value)
+
+	current, err := f.svc.GetByID(config.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", current.Value)
+
+	_, err = f.svc.ApproveChange(f.requester, "requester@wealist.co.kr", result.Pending.ID, domain.ReviewChangeRequest{})
+	assert.ErrorIs(t, err, response.ErrSelfApproval)
+
+	rolledBack, err := f.svc.ApproveChange(f.approver, "approver@wealist.co.kr", result.Pending.ID, domain.ReviewChangeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", rolledBack.Value)
+	assert.True(t, rolledBack.IsSensitive)
+
+	rev, err := f.svc.GetRevision(config.ID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RevisionRollback, rev.Action)
+	require.NotNil(t, rev.RollbackOf)
+	assert.Equal(t, 1, *rev.RollbackOf)
+	assert.Equal(t, "v2", rev.OldValue)
+	assert.Equal(t, "v1", rev.NewValue)
+	assert.Equal(t, "bad key", rev.Reason)
+
+	_, err = f.svc.Rollback(f.requester, "requester@wealist.co.kr", config.ID, domain.RollbackAppConfigRequest{Revision: 9})
+	assert.ErrorIs(t, err, response.ErrRevisionNotFound)
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/response"
+)
+
+// ArgoCDDeployService handles syncs, rollbacks and diffs of ArgoCD applications.
+// Every operation is gated by the caller's Role and audited with the revision before and after it.
+type ArgoCDDeployService struct {
+	argoCDClient *client.ArgoCDClient
+	auditSvc     *AuditLogService
+	logger       *zap.Logger
+}
+
+// NewArgoCDDeployService creates a new ArgoCD deploy service
+func NewArgoCDDeployService(argoCDClient *client.ArgoCDClient, auditSvc *AuditLogService, logger *zap.Logger) *ArgoCDDeployService {
+	return &ArgoCDDeployService{
+		argoCDClient: argoCDClient,
+		auditSvc:     auditSvc,
+		logger:       logger,
+	}
+}
+
+// Sync syncs an application with options
+func (s *ArgoCDDeployService) Sync(user *domain.PortalUser, name string, req domain.ArgoCDSyncRequest) (*domain.ArgoCDOperationResult, error) {
+	if s.argoCDClient == nil {
+		return nil, response.ErrNoArgoCD
+	}
+	if !user.Role.CanSyncApplications() {
+		return nil, response.NewForbiddenError("Insufficient permissions", "syncing applications requires admin or pm role")
+	}
+	if req.Prune && !req.DryRun && !user.Role.CanPruneResources() {
+		return nil, response.NewForbiddenError("Insufficient permissions", "sync with prune requires admin role")
+	}
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]client.SyncResource, len(req.Resources))
+	for i, r := range req.Resources {
+		if r.Kind == "" || r.Name == "" {
+			return nil, response.NewValidationError("Invalid resource", fmt.Sprintf("resource %d: kind and name are required", i+1))
+		}
+		resources[i] = client.SyncResource{Group: r.Group, Kind: r.Kind, Name: r.Name, Namespace: r.Namespace}
+	}
+
+	app, err := s.argoCDClient.GetApplication(name)
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "get application", name)
+	}
+	before := app.Status.Sync.Revision
+
+	synced, err := s.argoCDClient.SyncApplicationWithOptions(name, client.SyncOptions{
+		Revision:  strings.TrimSpace(req.Revision),
+		Prune:     req.Prune,
+		DryRun:    req.DryRun,
+		Resources: resources,
+	})
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "sync application", name)
+	}
+
+	after := operationRevision(synced, req.Revision, app.Spec.Source.TargetRevision)
+	result := &domain.ArgoCDOperationResult{
+		Application:    name,
+		Operation:      "sync",
+		DryRun:         req.DryRun,
+		BeforeRevision: before,
+		AfterRevision:  after,
+	}
+
+	var options []string
+	if req.Prune {
+		options = append(options, "prune")
+	}
+	if len(resources) > 0 {
+		options = append(options, fmt.Sprintf("%d resources", len(resources)))
+	}
+	details := fmt.Sprintf("%s %s: %s -> %s", operationVerb("Synced", req.DryRun), name, orNone(before), orNone(after))
+	if len(options) > 0 {
+		details += " (" + strings.Join(options, ", ") + ")"
+	}
+	s.auditSvc.Log(user.ID, user.Email, domain.ActionSync, domain.ResourceArgoCD, name, details+". Reason: "+reason)
+
+	s.logger.Info("ArgoCD application synced",
+		zap.String("name", name),
+		zap.String("before", before),
+		zap.String("after", after),
+		zap.Bool("prune", req.Prune),
+		zap.Bool("dryRun", req.DryRun),
+		zap.String("performedBy", user.Email))
+
+	return result, nil
+}
+
+// Rollback rolls an application back to a deployment history entry
+func (s *ArgoCDDeployService) Rollback(user *domain.PortalUser, name string, req domain.ArgoCDRollbackRequest) (*domain.ArgoCDOperationResult, error) {
+	if s.argoCDClient == nil {
+		return nil, response.ErrNoArgoCD
+	}
+	if !user.Role.CanRollbackApplications() {
+		return nil, response.NewForbiddenError("Insufficient permissions", "rolling back applications requires admin or pm role")
+	}
+	if req.Prune && !req.DryRun && !user.Role.CanPruneResources() {
+		return nil, response.NewForbiddenError("Insufficient permissions", "rollback with prune requires admin role")
+	}
+	reason, err := requireReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.argoCDClient.GetApplication(name)
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "get application", name)
+	}
+	before := app.Status.Sync.Revision
+
+	history, err := s.argoCDClient.GetApplicationHistory(name)
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "get application history", name)
+	}
+	var target *client.ApplicationHistoryEntry
+	for i := range history {
+		if history[i].ID == req.HistoryID {
+			target = &history[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, response.ErrHistoryNotFound
+	}
+
+	rolledBack, err := s.argoCDClient.RollbackApplication(name, req.HistoryID, req.DryRun, req.Prune)
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "roll back application", name)
+	}
+
+	after := operationRevision(rolledBack, target.Revision, "")
+	result := &domain.ArgoCDOperationResult{
+		Application:    name,
+		Operation:      "rollback",
+		DryRun:         req.DryRun,
+		BeforeRevision: before,
+		AfterRevision:  after,
+	}
+
+	details := fmt.Sprintf("%s %s to history %d: %s -> %s",
+		operationVerb("Rolled back", req.DryRun), name, req.HistoryID, orNone(before), orNone(after))
+	if req.Prune {
+		details += " (prune)"
+	}
+	s.auditSvc.Log(user.ID, user.Email, domain.ActionRollback, domain.ResourceArgoCD, name, details+". Reason: "+reason)
+
+	s.logger.Info("ArgoCD application rolled back",
+		zap.String("name", name),
+		zap.Int64("historyId", req.HistoryID),
+		zap.String("before", before),
+		zap.String("after", after),
+		zap.Bool("dryRun", req.DryRun),
+		zap.String("performedBy", user.Email))
+
+	return result, nil
+}
+
+// Diff returns the line diff between the live and desired state of an application's resources.
+// Resources without differences are only included when includeSynced is set.
+func (s *ArgoCDDeployService) Diff(user *domain.PortalUser, name string, includeSynced bool) ([]domain.ArgoCDResourceDiff, error) {
+	if s.argoCDClient == nil {
+		return nil, response.ErrNoArgoCD
+	}
+	if !user.Role.CanViewMonitoring() {
+		return nil, response.NewForbiddenError("Insufficient permissions", "")
+	}
+
+	resources, err := s.argoCDClient.GetManagedResources(name)
+	if err != nil {
+		return nil, s.mapArgoCDError(err, "get managed resources", name)
+	}
+
+	diffs := make([]domain.ArgoCDResourceDiff, 0, len(resources))
+	for _, r := range resources {
+		// ArgoCD UI와 동일하게 정규화된 live state와 예측된 state를 비교
+		live := firstState(r.NormalizedLiveState, r.LiveState)
+		desired := firstState(r.PredictedLiveState, r.TargetState)
+
+		diff := domain.ArgoCDResourceDiff{
+			ArgoCDResourceRef: domain.ArgoCDResourceRef{Group: r.Group, Kind: r.Kind, Name: r.Name, Namespace: r.Namespace},
+			Hook:              r.Hook,
+		}
+		switch {
+		case live == "" && desired != "":
+			diff.Status = domain.ResourceMissing
+		case live != "" && desired == "":
+			diff.Status = domain.ResourceExtra
+		case r.Modified:
+			diff.Status = domain.ResourceModified
+		default:
+			diff.Status = domain.ResourceSynced
+		}
+
+		if diff.Status == domain.ResourceSynced {
+			if includeSynced {
+				diffs = append(diffs, diff)
+			}
+			continue
+		}
+		switch diff.Status {
+		case domain.ResourceMissing:
+			diff.Lines = stateLines(domain.DiffInsert, desired)
+		case domain.ResourceExtra:
+			diff.Lines = stateLines(domain.DiffDelete, live)
+		default:
+			diff.Lines = diffLines(formatForDiff(live), formatForDiff(desired))
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// mapArgoCDError turns ArgoCD API rejections into client errors and logs everything else
+func (s *ArgoCDDeployService) mapArgoCDError(err error, action, name string) error {
+	var apiErr *client.ArgoCDAPIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusNotFound:
+			return response.NewNotFoundError("Application not found", apiErr.Message)
+		case http.StatusForbidden:
+			return response.NewForbiddenError("ArgoCD denied the request", apiErr.Message)
+		case http.StatusBadRequest, http.StatusConflict, http.StatusPreconditionFailed:
+			// 예: auto-sync가 켜진 앱의 rollback, 이미 진행 중인 operation
+			return response.NewConflictError("ArgoCD rejected the request", apiErr.Message)
+		}
+	}
+
+	s.logger.Error("Failed to "+action,
+		zap.String("name", name),
+		zap.Error(err))
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// requireReason returns the trimmed reason or a validation error when it is empty
+func requireReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", response.NewValidationError("Reason is required", "")
+	}
+	return reason, nil
+}
+
+// operationRevision returns the revision ArgoCD is syncing to, falling back to the requested revisions
+func operationRevision(app *client.Application, fallbacks ...string) string {
+	if app != nil && app.Operation != nil && app.Operation.Sync != nil && app.Operation.Sync.Revision != "" {
+		return app.Operation.Sync.Revision
+	}
+	for _, r := range fallbacks {
+		if r = strings.TrimSpace(r); r != "" {
+			return r
+		}
+	}
+	return ""
+}
+
+func operationVerb(verb string, dryRun bool) string {
+	if dryRun {
+		return "Dry run: " + strings.ToLower(verb[:1]) + verb[1:]
+	}
+	return verb
+}
+
+// stateLines renders a whole state as inserted or deleted lines
+func stateLines(op domain.DiffOp, state string) []domain.DiffLine {
+	text := strings.Split(formatForDiff(state), "\n")
+	lines := make([]domain.DiffLine, len(text))
+	for i, t := range text {
+		lines[i] = domain.DiffLine{Op: op, Text: t}
+	}
+	return lines
+}
+
+// firstState returns the first state that is present; ArgoCD reports absent states as "" or "null"
+func firstState(states ...string) string {
+	for _, st := range states {
+		if st != "" && st != "null" {
+			return st
+		}
+	}
+	return ""
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+// argoCDDeployTestFixture runs the deploy service against a fake ArgoCD server.
+// The board-service application is synced to abc111 and has two history entries.
+type argoCDDeployTestFixture struct {
+	svc *ArgoCDDeployService
+	db  *gorm.DB
+
+	mu       sync.Mutex
+	requests map[string]json.RawMessage // operation -> request body
+	syncedTo string                     // revision reported by the sync operation
+	rejectAs int                        // status code of rejected operations
+}
+
+func newArgoCDDeployTestFixture(t *testing.T) *argoCDDeployTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	require.NoError(t, db.Exec(`CREATE TABLE audit_logs (
+		id TEXT PRIMARY KEY, user_id TEXT NOT NULL, user_email TEXT NOT NULL, action TEXT NOT NULL,
+		resource_type TEXT NOT NULL, resource_id TEXT NOT NULL, details TEXT, ip_address TEXT, user_agent TEXT,
+		created_at DATETIME
+	)`).Error)
+
+	f := &argoCDDeployTestFixture{db: db, requests: map[string]json.RawMessage{}}
+	argocd := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/applications/board-service":
+			_, _ = w.Write([]byte(`{
+				"metadata": {"name": "board-service"},
+				"spec": {"source": {"targetRevision": "main"}},
+				"status": {
+					"sync": {"status": "Synced", "revision": "abc111"},
+					"health": {"status": "Healthy"},
+					"history": [{"id": 1, "revision": "aaa000"}, {"id": 2, "revision": "abc111"}]
+				}
+			}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/applications/board-service/sync",
+			r.Method == http.MethodPost && r.URL.Path == "/api/v1/applications/board-service/rollback":
+			if f.rejectAs != 0 {
+				w.WriteHeader(f.rejectAs)
+				_, _ = w.Write([]byte(`{"error": "rejected", "message": "rollback cannot be initiated when auto-sync is enabled"}`))
+				return
+			}
+			var body json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			operation := r.URL.Path[len("/api/v1/applications/board-service/"):]
+			f.requests[operation] = body
+
+			app := client.Application{}
+			app.Metadata.Name = "board-service"
+			if operation == "sync" && f.syncedTo != "" {
+				app.Operation = &client.ApplicationOperation{Sync: &client.SyncOperation{Revision: f.syncedTo}}
+			}
+			_ = json.NewEncoder(w).Encode(app)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "application not found"}`))
+		}
+	}))
+	t.Cleanup(argocd.Close)
+
+	logger := zap.NewNop()
+	f.svc = NewArgoCDDeployService(
+		client.NewArgoCDClient(client.ArgoCDConfig{ServerURL: argocd.URL}, logger),
+		NewAuditLogService(repository.NewAuditLogRepository(db), logger),
+		logger,
+	)
+	return f
+}
+
+// request returns the body ArgoCD received for an operation
+func (f *argoCDDeployTestFixture) request(t *testing.T, operation string) map[string]interface{} {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	body, ok := f.requests[operation]
+	if !ok {
+		return nil
+	}
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	return decoded
+}
+
+func (f *argoCDDeployTestFixture) auditDetails(t *testing.T) []string {
+	t.Helper()
+	var details []string
+	require.NoError(t, f.db.Model(&domain.AuditLog{}).Order("created_at").Pluck("details", &details).Error)
+	return details
+}
+
+func portalUser(role domain.Role) *domain.PortalUser {
+	user := &domain.PortalUser{Email: string(role) + "@wealist.co.kr", Role: role}
+	user.ID = uuid.New()
+	return user
+}
+
+func assertAppErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+// TestArgoCDDeployService_Gates verifies role, prune and reason checks run before ArgoCD is called
+func TestArgoCDDeployService_Gates(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     domain.Role
+		sync     domain.ArgoCDSyncRequest
+		rollback domain.ArgoCDRollbackRequest
+		wantCode string // empty: allowed
+	}{
+		{name: "viewer", role: domain.RoleViewer, sync: domain.ArgoCDSyncRequest{Reason: "deploy"},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Reason: "revert"}, wantCode: apperrors.ErrCodeForbidden},
+		{name: "pm", role: domain.RolePM, sync: domain.ArgoCDSyncRequest{Reason: "deploy"},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Reason: "revert"}},
+		{name: "pm with prune", role: domain.RolePM, sync: domain.ArgoCDSyncRequest{Prune: true, Reason: "deploy"},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Prune: true, Reason: "revert"}, wantCode: apperrors.ErrCodeForbidden},
+		{name: "pm with prune dry run", role: domain.RolePM, sync: domain.ArgoCDSyncRequest{Prune: true, DryRun: true, Reason: "check"},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Prune: true, DryRun: true, Reason: "check"}},
+		{name: "admin with prune", role: domain.RoleAdmin, sync: domain.ArgoCDSyncRequest{Prune: true, Reason: "deploy"},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Prune: true, Reason: "revert"}},
+		{name: "blank reason", role: domain.RoleAdmin, sync: domain.ArgoCDSyncRequest{Reason: "  "},
+			rollback: domain.ArgoCDRollbackRequest{HistoryID: 1, Reason: "  "}, wantCode: apperrors.ErrCodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newArgoCDDeployTestFixture(t)
+			user := portalUser(tt.role)
+
+			_, syncErr := f.svc.Sync(user, "board-service", tt.sync)
+			_, rollbackErr := f.svc.Rollback(user, "board-service", tt.rollback)
+			if tt.wantCode == "" {
+				require.NoError(t, syncErr)
+				require.NoError(t, rollbackErr)
+				assert.NotNil(t, f.request(t, "sync"))
+				assert.NotNil(t, f.request(t, "rollback"))
+				assert.Len(t, f.auditDetails(t), 2)
+				return
+			}
+			assertAppErrorCode(t, syncErr, tt.wantCode)
+			assertAppErrorCode(t, rollbackErr, tt.wantCode)
+			// 거부된 요청은 ArgoCD에 전달되지 않고 감사 로그도 남지 않음
+			assert.Nil(t, f.request(t, "sync"))
+			assert.Nil(t, f.request(t, "rollback"))
+			assert.Empty(t, f.auditDetails(t))
+		})
+	}
+}
+
+// TestArgoCDDeployService_Sync verifies the revisions before and after a sync are reported and audited
+func TestArgoCDDeployService_Sync(t *testing.T) {
+	f := newArgoCDDeployTestFixture(t)
+	f.syncedTo = "def222"
+
+	result, err := f.svc.Sync(portalUser(domain.RoleAdmin), "board-service", domain.ArgoCDSyncRequest{
+		Revision:  "release-1.2",
+		Prune:     true,
+		Resources: []domain.ArgoCDResourceRef{{Group: "apps", Kind: "Deployment", Name: "board-service"}},
+		Reason:    " hotfix ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ArgoCDOperationResult{
+		Application:    "board-service",
+		Operation:      "sync",
+		BeforeRevision: "abc111",
+		AfterRevision:  "def222",
+	}, result)
+
+	body := f.request(t, "sync")
+	assert.Equal(t, "release-1.2", body["revision"])
+	assert.Equal(t, true, body["prune"])
+	assert.Len(t, body["resources"], 1)
+	assert.Equal(t, []string{
+		"Synced board-service: abc111 -> def222 (prune, 1 resources). Reason: hotfix",
+	}, f.auditDetails(t))
+}
+
+// TestArgoCDDeployService_Sync_FallbackRevision verifies the requested or target revision is reported
+// when ArgoCD does not return the operation revision
+func TestArgoCDDeployService_Sync_FallbackRevision(t *testing.T) {
+	f := newArgoCDDeployTestFixture(t)
+	user := portalUser(domain.RolePM)
+
+	result, err := f.svc.Sync(user, "board-service", domain.ArgoCDSyncRequest{Revision: "release-1.2", DryRun: true, Reason: "check"})
+	require.NoError(t, err)
+	assert.Equal(t, "release-1.2", result.AfterRevision)
+	assert.True(t, result.DryRun)
+
+	result, err = f.svc.Sync(user, "board-service", domain.ArgoCDSyncRequest{Reason: "deploy"})
+	require.NoError(t, err)
+	assert.Equal(t, "main", result.AfterRevision)
+
+	assert.Equal(t, []string{
+		"Dry run: synced board-service: abc111 -> release-1.2. Reason: check",
+		"Synced board-service: abc111 -> main. Reason: deploy",
+	}, f.auditDetails(t))
+}
+
+func TestArgoCDDeployService_Sync_InvalidResource(t *testing.T) {
+	f := newArgoCDDeployTestFixture(t)
+
+	_, err := f.svc.Sync(portalUser(domain.RoleAdmin), "board-service", domain.ArgoCDSyncRequest{
+		Resources: []domain.ArgoCDResourceRef{{Kind: "Deployment"}},
+		Reason:    "deploy",
+	})
+	assertAppErrorCode(t, err, apperrors.ErrCodeValidation)
+	assert.Nil(t, f.request(t, "sync"))
+}
+
+// TestArgoCDDeployService_Rollback verifies a rollback reports the history entry's revision as the revision after it
+func TestArgoCDDeployService_Rollback(t *testing.T) {
+	f := newArgoCDDeployTestFixture(t)
+	user := portalUser(domain.RolePM)
+
+	result, err := f.svc.Rollback(user, "board-service", domain.ArgoCDRollbackRequest{HistoryID: 1, Reason: "bad release"})
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ArgoCDOperationResult{
+		Application:    "board-service",
+		Operation:      "rollback",
+		BeforeRevision: "abc111",
+		AfterRevision:  "aaa000",
+	}, result)
+	assert.Equal(t, float64(1), f.request(t, "rollback")["id"])
+	assert.Equal(t, []string{
+		"Rolled back board-service to history 1: abc111 -> aaa000. Reason: bad release",
+	}, f.auditDetails(t))
+
+	_, err = f.svc.Rollback(user, "board-service", domain.ArgoCDRollbackRequest{HistoryID: 9, Reason: "bad release"})
+	assert.ErrorIs(t, err, response.ErrHistoryNotFound)
+}
+
+// TestArgoCDDeployService_Rejected verifies ArgoCD rejections become client errors and are not audited
+func TestArgoCDDeployService_Rejected(t *testing.T) {
+	f := newArgoCDDeployTestFixture(t)
+	f.rejectAs = http.StatusBadRequest
+	user := portalUser(domain.RoleAdmin)
+
+	_, err := f.svc.Rollback(user, "board-service", domain.ArgoCDRollbackRequest{HistoryID: 1, Reason: "bad release"})
+	assertAppErrorCode(t, err, apperrors.ErrCodeConflict)
+
+	_, err = f.svc.Sync(user, "chat-service", domain.ArgoCDSyncRequest{Reason: "deploy"})
+	assertAppErrorCode(t, err, apperrors.ErrCodeNotFound)
+
+	assert.Empty(t, f.auditDetails(t))
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"ops-service/internal/domain"
+)
+
+// maxDiffLines bounds the line diff; larger values are shown as a full replacement
+const maxDiffLines = 2000
+
+// formatForDiff pretty-prints JSON objects and arrays so their diff is line by line
+func formatForDiff(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return value
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(trimmed), "", "  "); err != nil {
+		return value
+	}
+	return buf.String()
+}
+
+// diffLines returns a line diff from a to b based on their longest common subsequence
+func diffLines(a, b string) []domain.DiffLine {
+	from := strings.Split(a, "\n")
+	to := strings.Split(b, "\n")
+
+	if len(from) > maxDiffLines || len(to) > maxDiffLines {
+		lines := make([]domain.DiffLine, 0, len(from)+len(to))
+		for _, l := range from {
+			lines = append(lines, domain.DiffLine{Op: domain.DiffDelete, Text: l})
+		}
+		for _, l := range to {
+			lines = append(lines, domain.DiffLine{Op: domain.DiffInsert, Text: l})
+		}
+		return lines
+	}
+
+	// lcs[i][j] = from[i:]와 to[j:]의 최장 공통 부분열 길이
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]domain.DiffLine, 0, len(from)+len(to))
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			lines = append(lines, domain.DiffLine{Op: domain.DiffEqual, Text: from[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, domain.DiffLine{Op: domain.DiffDelete, Text: from[i]})
+			i++
+		default:
+			lines = append(lines, domain.DiffLine{Op: domain.DiffInsert, Text: to[j]})
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		lines = append(lines, domain.DiffLine{Op: domain.DiffDelete, Text: from[i]})
+	}
+	for ; j < len(to); j++ {
+		lines = append(lines, domain.DiffLine{Op: domain.DiffInsert, Text: to[j]})
+	}
+	return lines
+}
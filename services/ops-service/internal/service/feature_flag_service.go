@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+// publishTimeout bounds publishing the snapshots of a change to Redis
+const publishTimeout = 5 * time.Second
+
+// FeatureFlagService handles feature flag business logic.
+// Every change is published to Redis so services using the featureflag client pick it up immediately.
+type FeatureFlagService struct {
+	repo     *repository.FeatureFlagRepository
+	auditSvc *AuditLogService
+	redis    *redis.Client // nil이면 변경 알림 없이 클라이언트 폴링에만 의존
+	logger   *zap.Logger
+}
+
+// NewFeatureFlagService creates a new feature flag service
+func NewFeatureFlagService(
+	repo *repository.FeatureFlagRepository,
+	auditSvc *AuditLogService,
+	redisClient *redis.Client,
+	logger *zap.Logger,
+) *FeatureFlagService {
+	return &FeatureFlagService{
+		repo:     repo,
+		auditSvc: auditSvc,
+		redis:    redisClient,
+		logger:   logger,
+	}
+}
+
+// GetAll gets all feature flags
+func (s *FeatureFlagService) GetAll() ([]domain.FeatureFlag, error) {
+	return s.repo.GetAll()
+}
+
+// GetByID gets a feature flag by ID
+func (s *FeatureFlagService) GetByID(id uuid.UUID) (*domain.FeatureFlag, error) {
+	flag, err := s.repo.GetByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrFlagNotFound
+		}
+		return nil, err
+	}
+	return flag, nil
+}
+
+// Snapshot returns every flag as served to env
+func (s *FeatureFlagService) Snapshot(env string) (*featureflag.Snapshot, error) {
+	if err := featureflag.ValidateEnvironment(env); err != nil {
+		return nil, response.NewValidationError("Invalid environment", err.Error())
+	}
+
+	flags, err := s.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &featureflag.Snapshot{
+		Environment: env,
+		GeneratedAt: time.Now(),
+		Flags:       make(map[string]featureflag.Flag, len(flags)),
+	}
+	for i := range flags {
+		snapshot.Flags[flags[i].Key] = flags[i].ForEnvironment(env)
+	}
+	return snapshot, nil
+}
+
+// Create creates a new feature flag. It starts disabled in every environment.
+func (s *FeatureFlagService) Create(userID uuid.UUID, userEmail string, req domain.CreateFeatureFlagRequest) (*domain.FeatureFlag, error) {
+	if err := featureflag.ValidateKey(req.Key); err != nil {
+		return nil, response.NewValidationError("Invalid flag key", err.Error())
+	}
+	if !req.Type.IsValid() {
+		return nil, response.NewValidationError("Invalid flag type", string(req.Type))
+	}
+	if err := req.Type.ValidateValue(req.DefaultValue); err != nil {
+		return nil, response.NewValidationError("Invalid default value", err.Error())
+	}
+
+	exists, err := s.repo.ExistsByKey(req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, response.ErrFlagExists
+	}
+
+	flag := &domain.FeatureFlag{
+		Key:          req.Key,
+		Type:         req.Type,
+		Description:  req.Description,
+		DefaultValue: string(req.DefaultValue),
+		UpdatedBy:    userID,
+	}
+	if err := s.repo.Create(flag); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceFeatureFlag, flag.ID.String(),
+		fmt.Sprintf("Created %s flag: %s", req.Type, req.Key))
+	s.logger.Info("Feature flag created",
+		zap.String("key", req.Key),
+		zap.String("createdBy", userEmail),
+	)
+
+	s.publishAll()
+	return flag, nil
+}
+
+// Update updates the description and default value of a feature flag
+func (s *FeatureFlagService) Update(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateFeatureFlagRequest) (*domain.FeatureFlag, error) {
+	flag, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := flag.Type.ValidateValue(req.DefaultValue); err != nil {
+		return nil, response.NewValidationError("Invalid default value", err.Error())
+	}
+
+	oldDefault := flag.DefaultValue
+	flag.Description = req.Description
+	flag.DefaultValue = string(req.DefaultValue)
+	flag.UpdatedBy = userID
+	if err := s.repo.Update(flag); err != nil {
+		return nil, err
+	}
+
+	details := "Updated flag: " + flag.Key
+	if oldDefault != flag.DefaultValue {
+		details += fmt.Sprintf(" (default %s -> %s)", oldDefault, flag.DefaultValue)
+	}
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceFeatureFlag, flag.ID.String(), details)
+	s.logger.Info("Feature flag updated",
+		zap.String("key", flag.Key),
+		zap.String("updatedBy", userEmail),
+	)
+
+	s.publishAll()
+	return flag, nil
+}
+
+// UpdateEnvironment sets whether a flag is on in env, the value it serves and its targeting rules
+func (s *FeatureFlagService) UpdateEnvironment(userID uuid.UUID, userEmail string, id uuid.UUID, env string, req domain.UpdateFlagEnvironmentRequest) (*domain.FeatureFlag, error) {
+	if err := featureflag.ValidateEnvironment(env); err != nil {
+		return nil, response.NewValidationError("Invalid environment", err.Error())
+	}
+
+	flag, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEnvironmentRequest(flag.Type, req); err != nil {
+		return nil, err
+	}
+
+	state := &domain.FeatureFlagEnvironment{
+		FlagID:      flag.ID,
+		Environment: env,
+		Enabled:     req.Enabled,
+		Value:       string(req.Value),
+		Rules:       req.Rules,
+		UpdatedBy:   userID,
+		UpdatedAt:   time.Now(),
+	}
+	if err := s.repo.UpsertEnvironment(state); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceFeatureFlag, flag.ID.String(),
+		fmt.Sprintf("Updated flag %s in %s (enabled=%t, rules=%d)", flag.Key, env, req.Enabled, len(req.Rules)))
+	s.logger.Info("Feature flag environment updated",
+		zap.String("key", flag.Key),
+		zap.String("environment", env),
+		zap.Bool("enabled", req.Enabled),
+		zap.String("updatedBy", userEmail),
+	)
+
+	s.publish(env)
+	return s.GetByID(id)
+}
+
+// Delete deletes a feature flag. Services fall back to their own defaults for it.
+func (s *FeatureFlagService) Delete(userID uuid.UUID, userEmail string, id uuid.UUID) error {
+	flag, err := s.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	// 삭제 후에는 환경 목록을 알 수 없으므로 미리 조회
+	envs, err := s.repo.ListEnvironments()
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(id); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionDelete, domain.ResourceFeatureFlag, id.String(), "Deleted flag: "+flag.Key)
+	s.logger.Info("Feature flag deleted",
+		zap.String("key", flag.Key),
+		zap.String("deletedBy", userEmail),
+	)
+
+	s.publish(envs...)
+	return nil
+}
+
+// validateEnvironmentRequest checks the value and rules against the flag type
+func validateEnvironmentRequest(flagType featureflag.FlagType, req domain.UpdateFlagEnvironmentRequest) error {
+	if len(req.Value) > 0 {
+		if err := flagType.ValidateValue(req.Value); err != nil {
+			return response.NewValidationError("Invalid value", err.Error())
+		}
+	}
+	for i, rule := range req.Rules {
+		if err := rule.Validate(flagType); err != nil {
+			return response.NewValidationError("Invalid rule", fmt.Sprintf("rule %d: %v", i+1, err))
+		}
+	}
+	return nil
+}
+
+// publishAll publishes the snapshots of every environment any flag has settings for.
+// Other environments pick up the change on their next poll.
+func (s *FeatureFlagService) publishAll() {
+	envs, err := s.repo.ListEnvironments()
+	if err != nil {
+		s.logger.Warn("Failed to list feature flag environments", zap.Error(err))
+		return
+	}
+	s.publish(envs...)
+}
+
+// publish publishes the snapshots of envs to Redis. Failures are logged; clients still poll.
+func (s *FeatureFlagService) publish(envs ...string) {
+	if s.redis == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	for _, env := range envs {
+		snapshot, err := s.Snapshot(env)
+		if err == nil {
+			err = featureflag.PublishSnapshot(ctx, s.redis, snapshot)
+		}
+		if err != nil {
+			s.logger.Warn("Failed to publish feature flag snapshot",
+				zap.String("environment", env),
+				zap.Error(err))
+		}
+	}
+}
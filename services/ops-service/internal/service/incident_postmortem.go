@@ -0,0 +1,121 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"ops-service/internal/domain"
+)
+
+// postMortemEvent is one line of the merged post-mortem timeline
+type postMortemEvent struct {
+	at   time.Time
+	text string
+}
+
+// renderPostMortem renders an incident with its timeline and evidence as Markdown.
+// Root cause and action items are left as headings for the team to fill in.
+func renderPostMortem(incident *domain.Incident, now time.Time) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Post-mortem: %s\n\n", escapeMarkdown(incident.Title))
+
+	sb.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&sb, "| Severity | %s |\n", incident.Severity)
+	fmt.Fprintf(&sb, "| Status | %s |\n", incident.Status)
+	fmt.Fprintf(&sb, "| Commander | %s |\n", orNone(incident.CommanderEmail))
+	fmt.Fprintf(&sb, "| Affected services | %s |\n", formatServices(incident.AffectedServices))
+	fmt.Fprintf(&sb, "| Started | %s |\n", formatTime(&incident.StartedAt))
+	fmt.Fprintf(&sb, "| Identified | %s |\n", formatTime(incident.IdentifiedAt))
+	fmt.Fprintf(&sb, "| Mitigated | %s |\n", formatTime(incident.MonitoringAt))
+	fmt.Fprintf(&sb, "| Resolved | %s |\n", formatTime(incident.ResolvedAt))
+	end := now
+	if incident.ResolvedAt != nil {
+		end = *incident.ResolvedAt
+	}
+	duration := end.Sub(incident.StartedAt).Round(time.Minute).String()
+	if incident.ResolvedAt == nil {
+		duration += " (ongoing)"
+	}
+	fmt.Fprintf(&sb, "| Duration | %s |\n\n", duration)
+
+	sb.WriteString("## Summary\n\n")
+	if strings.TrimSpace(incident.Summary) != "" {
+		sb.WriteString(strings.TrimSpace(incident.Summary) + "\n\n")
+	} else {
+		sb.WriteString("_No summary._\n\n")
+	}
+
+	sb.WriteString("## Timeline\n\n")
+	events := make([]postMortemEvent, 0, len(incident.Timeline)+len(incident.Evidence))
+	for _, e := range incident.Timeline {
+		events = append(events, postMortemEvent{
+			at:   e.CreatedAt,
+			text: fmt.Sprintf("%s — %s", escapeMarkdown(e.Message), e.AuthorEmail),
+		})
+	}
+	for _, e := range incident.Evidence {
+		events = append(events, postMortemEvent{
+			at:   e.OccurredAt,
+			text: fmt.Sprintf("[%s] %s", e.Kind, escapeMarkdown(e.Title)),
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].at.Before(events[j].at) })
+	if len(events) == 0 {
+		sb.WriteString("_No timeline entries._\n")
+	}
+	for _, e := range events {
+		fmt.Fprintf(&sb, "- **%s** %s\n", e.at.UTC().Format("2006-01-02 15:04:05Z"), e.text)
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Evidence\n\n")
+	sections := []struct {
+		kind  domain.EvidenceKind
+		title string
+	}{
+		{domain.EvidenceAlert, "Alerts"},
+		{domain.EvidenceDeployment, "Deployments"},
+		{domain.EvidenceErrorSpike, "Error spikes"},
+	}
+	for _, section := range sections {
+		fmt.Fprintf(&sb, "### %s\n\n", section.title)
+		found := false
+		for _, e := range incident.Evidence {
+			if e.Kind != section.kind {
+				continue
+			}
+			found = true
+			fmt.Fprintf(&sb, "- **%s** %s", e.OccurredAt.UTC().Format("2006-01-02 15:04:05Z"), escapeMarkdown(e.Title))
+			if e.Detail != "" {
+				fmt.Fprintf(&sb, " — %s", escapeMarkdown(e.Detail))
+			}
+			sb.WriteString("\n")
+		}
+		if !found {
+			sb.WriteString("_None._\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## Root cause\n\n_To be filled in._\n\n")
+	sb.WriteString("## Action items\n\n- [ ] _To be filled in._\n")
+
+	return sb.String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "—"
+	}
+	return t.UTC().Format("2006-01-02 15:04:05Z")
+}
+
+// escapeMarkdown keeps user input on one line and out of table syntax
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return strings.ReplaceAll(s, "|", "\\|")
+}
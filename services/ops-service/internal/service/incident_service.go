@@ -0,0 +1,574 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+const (
+	// evidenceLookback widens the evidence window before the incident start,
+	// because the deployment or alert that caused an incident usually precedes its declaration
+	evidenceLookback = 30 * time.Minute
+	// errorSpikeRate is the namespace 5xx rate (%) at which a trend point counts as a spike
+	errorSpikeRate = 2.0
+	// maxEvidenceAlerts bounds how many alerts are attached per collection
+	maxEvidenceAlerts = 200
+)
+
+// IncidentService handles incidents, their timeline and automatically attached evidence
+type IncidentService struct {
+	repo         *repository.IncidentRepository
+	alertRepo    *repository.AlertRepository
+	userRepo     *repository.PortalUserRepository
+	auditSvc     *AuditLogService
+	argoCDClient *client.ArgoCDClient     // nil이면 배포 이력 수집 생략
+	prometheus   *client.PrometheusClient // nil이면 에러 spike 수집 생략
+	namespace    string
+	logger       *zap.Logger
+}
+
+// IncidentServiceConfig holds configuration for IncidentService
+type IncidentServiceConfig struct {
+	IncidentRepo     *repository.IncidentRepository
+	AlertRepo        *repository.AlertRepository
+	UserRepo         *repository.PortalUserRepository
+	AuditSvc         *AuditLogService
+	ArgoCDClient     *client.ArgoCDClient
+	PrometheusClient *client.PrometheusClient
+	PrometheusNS     string
+	Logger           *zap.Logger
+}
+
+// NewIncidentService creates a new incident service
+func NewIncidentService(cfg IncidentServiceConfig) *IncidentService {
+	return &IncidentService{
+		repo:         cfg.IncidentRepo,
+		alertRepo:    cfg.AlertRepo,
+		userRepo:     cfg.UserRepo,
+		auditSvc:     cfg.AuditSvc,
+		argoCDClient: cfg.ArgoCDClient,
+		prometheus:   cfg.PrometheusClient,
+		namespace:    cfg.PrometheusNS,
+		logger:       cfg.Logger,
+	}
+}
+
+// List lists incidents
+func (s *IncidentService) List(opts repository.IncidentListOptions) ([]domain.Incident, int64, error) {
+	return s.repo.List(opts)
+}
+
+// GetByID gets an incident with its timeline and evidence
+func (s *IncidentService) GetByID(id uuid.UUID) (*domain.Incident, error) {
+	incident, err := s.repo.GetByIDWithDetails(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrIncidentNotFound
+		}
+		return nil, err
+	}
+	return incident, nil
+}
+
+// Create declares an incident and attaches evidence from its window
+func (s *IncidentService) Create(ctx context.Context, userID uuid.UUID, userEmail string, req domain.CreateIncidentRequest) (*domain.Incident, error) {
+	now := time.Now()
+	startedAt := now
+	if req.StartedAt != nil {
+		if req.StartedAt.After(now) {
+			return nil, response.NewValidationError("Invalid start time", "startedAt must not be in the future")
+		}
+		startedAt = *req.StartedAt
+	}
+
+	incident := &domain.Incident{
+		Title:            strings.TrimSpace(req.Title),
+		Summary:          req.Summary,
+		Severity:         req.Severity,
+		Status:           domain.IncidentInvestigating,
+		AffectedServices: normalizeServices(req.AffectedServices),
+		StartedAt:        startedAt,
+		CreatedByID:      userID,
+		CreatedByEmail:   userEmail,
+	}
+	if err := validateIncident(incident); err != nil {
+		return nil, err
+	}
+
+	commanderID := userID
+	if req.CommanderID != nil {
+		commanderID = *req.CommanderID
+	}
+	if err := s.setCommander(incident, commanderID); err != nil {
+		return nil, err
+	}
+
+	entry := &domain.IncidentTimelineEntry{
+		Type:        domain.TimelineStatusChange,
+		Message:     fmt.Sprintf("Incident declared (%s, commander %s)", incident.Severity, incident.CommanderEmail),
+		AuthorID:    userID,
+		AuthorEmail: userEmail,
+	}
+	if err := s.repo.Create(incident, entry); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceIncident, incident.ID.String(),
+		fmt.Sprintf("Declared %s incident %q (commander %s)", incident.Severity, incident.Title, incident.CommanderEmail))
+
+	s.logger.Info("Incident declared",
+		zap.String("incidentId", incident.ID.String()),
+		zap.String("severity", string(incident.Severity)),
+		zap.String("createdBy", userEmail),
+	)
+
+	s.collectEvidence(ctx, incident)
+	return s.GetByID(incident.ID)
+}
+
+// Update updates the title, summary, severity, affected services and commander of an incident
+func (s *IncidentService) Update(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateIncidentRequest) (*domain.Incident, error) {
+	incident, err := s.getIncident(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []domain.IncidentTimelineEntry
+	var changes []string
+	addEntry := func(t domain.TimelineEntryType, msg string) {
+		entries = append(entries, domain.IncidentTimelineEntry{Type: t, Message: msg, AuthorID: userID, AuthorEmail: userEmail})
+		changes = append(changes, msg)
+	}
+
+	if req.Severity != incident.Severity {
+		addEntry(domain.TimelineSeverityChange, fmt.Sprintf("Severity changed from %s to %s", incident.Severity, req.Severity))
+		incident.Severity = req.Severity
+	}
+	if title := strings.TrimSpace(req.Title); title != incident.Title {
+		addEntry(domain.TimelineUpdate, fmt.Sprintf("Title changed to %q", title))
+		incident.Title = title
+	}
+	if req.Summary != incident.Summary {
+		addEntry(domain.TimelineUpdate, "Summary updated")
+		incident.Summary = req.Summary
+	}
+	if services := normalizeServices(req.AffectedServices); strings.Join(services, ",") != strings.Join(incident.AffectedServices, ",") {
+		addEntry(domain.TimelineUpdate, fmt.Sprintf("Affected services changed to %s", formatServices(services)))
+		incident.AffectedServices = services
+	}
+	if req.CommanderID != nil && (incident.CommanderID == nil || *req.CommanderID != *incident.CommanderID) {
+		previous := incident.CommanderEmail
+		if err := s.setCommander(incident, *req.CommanderID); err != nil {
+			return nil, err
+		}
+		addEntry(domain.TimelineCommanderChange, fmt.Sprintf("Commander changed from %s to %s", orNone(previous), incident.CommanderEmail))
+	}
+
+	if len(entries) == 0 {
+		return s.GetByID(id)
+	}
+	if err := validateIncident(incident); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(incident, entries); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceIncident, incident.ID.String(),
+		fmt.Sprintf("Updated incident %q: %s", incident.Title, strings.Join(changes, "; ")))
+
+	return s.GetByID(id)
+}
+
+// UpdateStatus moves an incident to another status. Resolving collects evidence once more
+// so the post-mortem covers the whole incident; moving a resolved incident back reopens it.
+func (s *IncidentService) UpdateStatus(ctx context.Context, userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateIncidentStatusRequest) (*domain.Incident, error) {
+	if !req.Status.IsValid() {
+		return nil, response.NewValidationError("Invalid status", "must be investigating, identified, monitoring or resolved")
+	}
+
+	incident, err := s.getIncident(id)
+	if err != nil {
+		return nil, err
+	}
+	if incident.Status == req.Status {
+		return nil, response.ErrSameStatus
+	}
+
+	now := time.Now()
+	previous := incident.Status
+	incident.Status = req.Status
+	switch req.Status {
+	case domain.IncidentIdentified:
+		if incident.IdentifiedAt == nil {
+			incident.IdentifiedAt = &now
+		}
+	case domain.IncidentMonitoring:
+		if incident.MonitoringAt == nil {
+			incident.MonitoringAt = &now
+		}
+	case domain.IncidentResolved:
+		incident.ResolvedAt = &now
+	}
+	if previous == domain.IncidentResolved {
+		incident.ResolvedAt = nil
+	}
+
+	message := fmt.Sprintf("Status changed from %s to %s", previous, req.Status)
+	if note := strings.TrimSpace(req.Message); note != "" {
+		message += ": " + note
+	}
+	entry := domain.IncidentTimelineEntry{
+		Type:        domain.TimelineStatusChange,
+		Message:     message,
+		AuthorID:    userID,
+		AuthorEmail: userEmail,
+	}
+	if err := s.repo.Update(incident, []domain.IncidentTimelineEntry{entry}); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceIncident, incident.ID.String(),
+		fmt.Sprintf("Incident %q status %s -> %s", incident.Title, previous, req.Status))
+
+	s.logger.Info("Incident status changed",
+		zap.String("incidentId", incident.ID.String()),
+		zap.String("from", string(previous)),
+		zap.String("to", string(req.Status)),
+		zap.String("changedBy", userEmail),
+	)
+
+	if req.Status == domain.IncidentResolved {
+		s.collectEvidence(ctx, incident)
+	}
+	return s.GetByID(id)
+}
+
+// AddNote appends a note to the incident timeline
+func (s *IncidentService) AddNote(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.AddIncidentNoteRequest) (*domain.IncidentTimelineEntry, error) {
+	incident, err := s.getIncident(id)
+	if err != nil {
+		return nil, err
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, response.NewValidationError("Note is empty", "")
+	}
+
+	entry := &domain.IncidentTimelineEntry{
+		IncidentID:  id,
+		Type:        domain.TimelineNote,
+		Message:     message,
+		AuthorID:    userID,
+		AuthorEmail: userEmail,
+	}
+	if err := s.repo.AddTimelineEntry(entry); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceIncident, incident.ID.String(),
+		fmt.Sprintf("Added note to incident %q", incident.Title))
+
+	return entry, nil
+}
+
+// CollectEvidence attaches alerts, ArgoCD syncs and error spikes from the incident window
+func (s *IncidentService) CollectEvidence(ctx context.Context, userID uuid.UUID, userEmail string, id uuid.UUID) (*domain.Incident, error) {
+	incident, err := s.getIncident(id)
+	if err != nil {
+		return nil, err
+	}
+	added := s.collectEvidence(ctx, incident)
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceIncident, incident.ID.String(),
+		fmt.Sprintf("Collected evidence for incident %q (%d new)", incident.Title, added))
+
+	return s.GetByID(id)
+}
+
+// PostMortem renders the incident as a Markdown post-mortem document
+func (s *IncidentService) PostMortem(id uuid.UUID) (string, error) {
+	incident, err := s.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+	return renderPostMortem(incident, time.Now()), nil
+}
+
+func (s *IncidentService) getIncident(id uuid.UUID) (*domain.Incident, error) {
+	incident, err := s.repo.GetByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrIncidentNotFound
+		}
+		return nil, err
+	}
+	return incident, nil
+}
+
+// setCommander makes an active portal user the commander of the incident
+func (s *IncidentService) setCommander(incident *domain.Incident, userID uuid.UUID) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return response.NewValidationError("Invalid commander", "commander must be a portal user")
+		}
+		return err
+	}
+	if !user.IsActive {
+		return response.NewValidationError("Invalid commander", "commander must be an active portal user")
+	}
+	incident.CommanderID = &user.ID
+	incident.CommanderEmail = user.Email
+	return nil
+}
+
+// collectEvidence attaches evidence from every available source and returns how many items were new.
+// Sources that fail are logged and skipped so incident updates never fail because of them.
+func (s *IncidentService) collectEvidence(ctx context.Context, incident *domain.Incident) int64 {
+	start := incident.StartedAt.Add(-evidenceLookback)
+	end := time.Now()
+	if incident.ResolvedAt != nil {
+		end = *incident.ResolvedAt
+	}
+
+	var evidence []domain.IncidentEvidence
+	collectors := []struct {
+		name string
+		fn   func(context.Context, *domain.Incident, time.Time, time.Time) ([]domain.IncidentEvidence, error)
+	}{
+		{"alerts", s.alertEvidence},
+		{"deployments", s.deploymentEvidence},
+		{"error_spikes", s.errorSpikeEvidence},
+	}
+	for _, c := range collectors {
+		items, err := c.fn(ctx, incident, start, end)
+		if err != nil {
+			s.logger.Warn("Failed to collect incident evidence",
+				zap.String("incidentId", incident.ID.String()),
+				zap.String("source", c.name),
+				zap.Error(err))
+			continue
+		}
+		evidence = append(evidence, items...)
+	}
+
+	for i := range evidence {
+		evidence[i].IncidentID = incident.ID
+	}
+	added, err := s.repo.AddEvidence(evidence)
+	if err != nil {
+		s.logger.Warn("Failed to attach incident evidence",
+			zap.String("incidentId", incident.ID.String()),
+			zap.Error(err))
+		return 0
+	}
+	if added > 0 {
+		s.logger.Info("Incident evidence attached",
+			zap.String("incidentId", incident.ID.String()),
+			zap.Int64("count", added))
+	}
+	return added
+}
+
+func (s *IncidentService) alertEvidence(_ context.Context, incident *domain.Incident, start, end time.Time) ([]domain.IncidentEvidence, error) {
+	alerts, _, err := s.alertRepo.ListAlerts(repository.AlertListOptions{
+		Since: &start,
+		Until: &end,
+		Limit: maxEvidenceAlerts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var evidence []domain.IncidentEvidence
+	for _, a := range alerts {
+		service := a.Labels["service"]
+		if service == "" {
+			service = a.Labels["app"]
+		}
+		if !affects(incident, service) {
+			continue
+		}
+		detail := a.Summary
+		if a.EndsAt != nil {
+			detail += fmt.Sprintf(" (resolved %s)", a.EndsAt.UTC().Format(time.RFC3339))
+		}
+		evidence = append(evidence, domain.IncidentEvidence{
+			Kind:       domain.EvidenceAlert,
+			SourceKey:  a.ID.String(),
+			Service:    service,
+			Title:      fmt.Sprintf("[%s] %s fired", a.Severity, a.RuleName),
+			Detail:     detail,
+			OccurredAt: a.StartsAt,
+		})
+	}
+	return evidence, nil
+}
+
+func (s *IncidentService) deploymentEvidence(_ context.Context, incident *domain.Incident, start, end time.Time) ([]domain.IncidentEvidence, error) {
+	if s.argoCDClient == nil {
+		return nil, nil
+	}
+
+	history, err := s.argoCDClient.GetAllDeploymentHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	var evidence []domain.IncidentEvidence
+	for _, h := range history {
+		if h.DeployedAt.Before(start) || h.DeployedAt.After(end) || !affects(incident, h.AppName) {
+			continue
+		}
+		evidence = append(evidence, domain.IncidentEvidence{
+			Kind:       domain.EvidenceDeployment,
+			SourceKey:  fmt.Sprintf("%s@%s@%d", h.AppName, h.Revision, h.DeployedAt.Unix()),
+			Service:    h.AppName,
+			Title:      fmt.Sprintf("ArgoCD synced %s to %s", h.AppName, shortRevision(h.Revision)),
+			Detail:     fmt.Sprintf("sync %s, health %s", h.SyncStatus, h.HealthStatus),
+			OccurredAt: h.DeployedAt,
+		})
+	}
+	return evidence, nil
+}
+
+func (s *IncidentService) errorSpikeEvidence(ctx context.Context, _ *domain.Incident, start, end time.Time) ([]domain.IncidentEvidence, error) {
+	if s.prometheus == nil {
+		return nil, nil
+	}
+
+	// Prometheus는 range query당 11,000 포인트로 제한되므로 긴 incident는 step을 늘림
+	step := time.Minute
+	if window := end.Sub(start); window/step > 10000 {
+		step = (window / 10000).Truncate(time.Minute) + time.Minute
+	}
+	trend, err := s.prometheus.GetErrorTrendRange(ctx, s.namespace, start.Truncate(step), end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	var evidence []domain.IncidentEvidence
+	for _, spike := range findErrorSpikes(trend, errorSpikeRate) {
+		from := time.Unix(spike.start, 0)
+		to := time.Unix(spike.end, 0)
+		evidence = append(evidence, domain.IncidentEvidence{
+			Kind:      domain.EvidenceErrorSpike,
+			SourceKey: fmt.Sprintf("%s@%d", s.namespace, spike.start),
+			Title:     fmt.Sprintf("5xx rate peaked at %.2f%% in %s", spike.peak, s.namespace),
+			Detail: fmt.Sprintf("5xx rate above %.1f%% from %s to %s",
+				errorSpikeRate, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339)),
+			OccurredAt: from,
+		})
+	}
+	return evidence, nil
+}
+
+// errorSpike is a run of consecutive trend points at or above the spike rate
+type errorSpike struct {
+	start, end int64
+	peak       float64
+}
+
+// findErrorSpikes groups consecutive trend points at or above rate into spikes
+func findErrorSpikes(trend []client.ErrorTrendPoint, rate float64) []errorSpike {
+	var spikes []errorSpike
+	var current *errorSpike
+	for _, p := range trend {
+		if p.ErrorRate < rate {
+			current = nil
+			continue
+		}
+		if current == nil {
+			spikes = append(spikes, errorSpike{start: p.Timestamp})
+			current = &spikes[len(spikes)-1]
+		}
+		current.end = p.Timestamp
+		if p.ErrorRate > current.peak {
+			current.peak = p.ErrorRate
+		}
+	}
+	return spikes
+}
+
+// affects reports whether name (a service or ArgoCD app) belongs to the incident's affected services.
+// Incidents without affected services collect evidence from every service.
+func affects(incident *domain.Incident, name string) bool {
+	if len(incident.AffectedServices) == 0 {
+		return true
+	}
+	if name == "" {
+		return false
+	}
+	for _, svc := range incident.AffectedServices {
+		// ArgoCD 앱 이름은 서비스 이름에 환경 접미사가 붙는 경우가 있음 (예: board-service-prod)
+		if name == svc || strings.HasPrefix(name, svc+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIncident checks title and severity of an incident
+func validateIncident(incident *domain.Incident) error {
+	if incident.Title == "" {
+		return response.NewValidationError("Title is required", "")
+	}
+	if len(incident.Title) > 200 {
+		return response.NewValidationError("Title is too long", "must be at most 200 characters")
+	}
+	if !incident.Severity.IsValid() {
+		return response.NewValidationError("Invalid severity", "must be sev1, sev2 or sev3")
+	}
+	return nil
+}
+
+// normalizeServices trims, deduplicates and sorts service names
+func normalizeServices(services []string) []string {
+	seen := make(map[string]bool, len(services))
+	normalized := make([]string, 0, len(services))
+	for _, svc := range services {
+		svc = strings.TrimSpace(svc)
+		if svc == "" || seen[svc] {
+			continue
+		}
+		seen[svc] = true
+		normalized = append(normalized, svc)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+func formatServices(services []string) string {
+	if len(services) == 0 {
+		return "none"
+	}
+	return strings.Join(services, ", ")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func shortRevision(revision string) string {
+	if len(revision) > 7 {
+		return revision[:7]
+	}
+	return revision
+}
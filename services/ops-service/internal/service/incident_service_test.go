@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+)
+
+func TestFindErrorSpikes(t *testing.T) {
+	trend := func(rates ...float64) []client.ErrorTrendPoint {
+		points := make([]client.ErrorTrendPoint, len(rates))
+		for i, r := range rates {
+			points[i] = client.ErrorTrendPoint{Timestamp: int64(i * 60), ErrorRate: r}
+		}
+		return points
+	}
+
+	tests := []struct {
+		name  string
+		trend []client.ErrorTrendPoint
+		want  []errorSpike
+	}{
+		{"no points", nil, nil},
+		{"below rate", trend(0, 1.9, 1), nil},
+		{"single point at rate", trend(0, 2, 0), []errorSpike{{start: 60, end: 60, peak: 2}}},
+		{"consecutive points merge", trend(1, 3, 8, 4, 1), []errorSpike{{start: 60, end: 180, peak: 8}}},
+		{"gap splits spikes", trend(5, 1, 6, 7), []errorSpike{{start: 0, end: 0, peak: 5}, {start: 120, end: 180, peak: 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findErrorSpikes(tt.trend, 2))
+		})
+	}
+}
+
+func TestAffects(t *testing.T) {
+	scoped := &domain.Incident{AffectedServices: []string{"board-service", "chat-service"}}
+
+	tests := []struct {
+		name     string
+		incident *domain.Incident
+		service  string
+		want     bool
+	}{
+		{"every service without scope", &domain.Incident{}, "noti-service", true},
+		{"unlabelled without scope", &domain.Incident{}, "", true},
+		{"affected service", scoped, "board-service", true},
+		{"argocd app with environment suffix", scoped, "board-service-prod", true},
+		{"other service", scoped, "noti-service", false},
+		{"service name prefix only", scoped, "board-services", false},
+		{"unlabelled with scope", scoped, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, affects(tt.incident, tt.service))
+		})
+	}
+}
+
+func TestRenderPostMortem(t *testing.T) {
+	started := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	resolved := started.Add(95 * time.Minute)
+	incident := &domain.Incident{
+		Title:            "Board | API down",
+		Summary:          "Boards failed to load.",
+		Severity:         domain.IncidentSev1,
+		Status:           domain.IncidentResolved,
+		AffectedServices: []string{"board-service"},
+		CommanderEmail:   "commander@wealist.co.kr",
+		StartedAt:        started,
+		ResolvedAt:       &resolved,
+		Timeline: []domain.IncidentTimelineEntry{
+			{Message: "Incident declared", AuthorEmail: "commander@wealist.co.kr", CreatedAt: started},
+			{Message: "Rolled back\nboard-service", AuthorEmail: "dev@wealist.co.kr", CreatedAt: started.Add(30 * time.Minute)},
+		},
+		Evidence: []domain.IncidentEvidence{
+			{Kind: domain.EvidenceDeployment, Title: "board-service deployed abc123", OccurredAt: started.Add(-10 * time.Minute)},
+			{Kind: domain.EvidenceAlert, Title: "[critical] slo-burn-rate fired", Detail: "burning at 20x", OccurredAt: started.Add(5 * time.Minute)},
+		},
+	}
+
+	doc := renderPostMortem(incident, resolved.Add(time.Hour))
+
+	assert.True(t, strings.HasPrefix(doc, "# Post-mortem: Board \\| API down\n"))
+	assert.Contains(t, doc, "| Severity | sev1 |")
+	assert.Contains(t, doc, "| Commander | commander@wealist.co.kr |")
+	assert.Contains(t, doc, "| Identified | — |")
+	assert.Contains(t, doc, "| Resolved | 2026-03-02 11:35:00Z |")
+	assert.Contains(t, doc, "| Duration | 1h35m0s |")
+	assert.Contains(t, doc, "Boards failed to load.")
+
+	// 타임라인 항목과 증거가 시간순으로 합쳐짐
+	timeline := []string{
+		"- **2026-03-02 09:50:00Z** [deployment] board-service deployed abc123",
+		"- **2026-03-02 10:00:00Z** Incident declared — commander@wealist.co.kr",
+		"- **2026-03-02 10:05:00Z** [alert] [critical] slo-burn-rate fired",
+		"- **2026-03-02 10:30:00Z** Rolled back board-service — dev@wealist.co.kr",
+	}
+	last := -1
+	for _, line := range timeline {
+		i := strings.Index(doc, line)
+		require.GreaterOrEqual(t, i, 0, "missing timeline line %q", line)
+		assert.Greater(t, i, last, "timeline line %q out of order", line)
+		last = i
+	}
+
+	assert.Contains(t, doc, "### Alerts\n\n- **2026-03-02 10:05:00Z** [critical] slo-burn-rate fired — burning at 20x\n")
+	assert.Contains(t, doc, "### Error spikes\n\n_None._\n")
+	assert.Contains(t, doc, "## Root cause\n\n_To be filled in._")
+}
+
+func TestRenderPostMortem_Ongoing(t *testing.T) {
+	started := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	doc := renderPostMortem(&domain.Incident{
+		Title:     "Slow uploads",
+		Severity:  domain.IncidentSev3,
+		Status:    domain.IncidentInvestigating,
+		StartedAt: started,
+	}, started.Add(20*time.Minute))
+
+	assert.Contains(t, doc, "| Duration | 20m0s (ongoing) |")
+	assert.Contains(t, doc, "| Commander | none |")
+	assert.Contains(t, doc, "_No summary._")
+	assert.Contains(t, doc, "_No timeline entries._")
+}
+
+// newIncidentTestService prepares an incident service without ArgoCD and Prometheus
+func newIncidentTestService(t *testing.T) (*IncidentService, *gorm.DB) {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE incidents (
+			id TEXT PRIMARY KEY, title TEXT NOT NULL, summary TEXT, severity TEXT NOT NULL, status TEXT NOT NULL,
+			affected_services TEXT, commander_id TEXT, commander_email TEXT, started_at DATETIME NOT NULL,
+			identified_at DATETIME, monitoring_at DATETIME, resolved_at DATETIME, created_by_id TEXT NOT NULL,
+			created_by_email TEXT NOT NULL, created_at DATETIME, updated_at DATETIME, deleted_at DATETIME
+		)`,
+		`CREATE TABLE incident_timeline_entries (
+			id TEXT PRIMARY KEY, incident_id TEXT NOT NULL, type TEXT NOT NULL, message TEXT NOT NULL,
+			author_id TEXT NOT NULL, author_email TEXT NOT NULL, created_at DATETIME
+		)`,
+		`CREATE TABLE incident_evidence (
+			id TEXT PRIMARY KEY, incident_id TEXT NOT NULL, kind TEXT NOT NULL, source_key TEXT NOT NULL,
+			service TEXT, title TEXT NOT NULL, detail TEXT, occurred_at DATETIME NOT NULL, created_at DATETIME,
+			UNIQUE (incident_id, kind, source_key)
+		)`,
+		`CREATE TABLE alerts (
+			id TEXT PRIMARY KEY, fingerprint TEXT NOT NULL, rule_id TEXT NOT NULL, rule_name TEXT NOT NULL,
+			severity TEXT NOT NULL, labels TEXT, summary TEXT, value REAL, status TEXT NOT NULL, silenced INTEGER,
+			starts_at DATETIME NOT NULL, ends_at DATETIME, last_evaluated_at DATETIME, last_notified_at DATETIME,
+			created_at DATETIME, updated_at DATETIME
+		)`,
+		`CREATE TABLE audit_logs (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, user_email TEXT NOT NULL, action TEXT NOT NULL,
+			resource_type TEXT NOT NULL, resource_id TEXT NOT NULL, details TEXT, ip_address TEXT, user_agent TEXT,
+			created_at DATETIME
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	logger := zap.NewNop()
+	return NewIncidentService(IncidentServiceConfig{
+		IncidentRepo: repository.NewIncidentRepository(db),
+		AlertRepo:    repository.NewAlertRepository(db),
+		AuditSvc:     NewAuditLogService(repository.NewAuditLogRepository(db), logger),
+		Logger:       logger,
+	}), db
+}
+
+// TestIncidentService_NotesAndEvidenceAreAudited verifies notes and manual evidence collection reach the audit log
+func TestIncidentService_NotesAndEvidenceAreAudited(t *testing.T) {
+	svc, db := newIncidentTestService(t)
+	userID := uuid.New()
+	now := time.Now()
+
+	incident := &domain.Incident{
+		Title:            "Board API down",
+		Severity:         domain.IncidentSev2,
+		Status:           domain.IncidentInvestigating,
+		AffectedServices: []string{"board-service"},
+		StartedAt:        now.Add(-10 * time.Minute),
+		CreatedByID:      userID,
+		CreatedByEmail:   "oncall@wealist.co.kr",
+	}
+	require.NoError(t, repository.NewIncidentRepository(db).Create(incident, &domain.IncidentTimelineEntry{
+		Type: domain.TimelineStatusChange, Message: "Incident declared", AuthorID: userID, AuthorEmail: "oncall@wealist.co.kr",
+	}))
+	require.NoError(t, repository.NewAlertRepository(db).CreateAlert(&domain.Alert{
+		Fingerprint: "fp", RuleID: uuid.New(), RuleName: "high-error-rate", Severity: domain.SeverityWarning,
+		Labels: map[string]string{"service": "board-service"}, Summary: "board-service 5xx rate is 12%",
+		Status: domain.AlertFiring, StartsAt: now.Add(-5 * time.Minute), LastEvaluatedAt: now,
+	}))
+
+	_, err := svc.AddNote(userID, "oncall@wealist.co.kr", incident.ID, domain.AddIncidentNoteRequest{Message: "  "})
+	assert.Error(t, err)
+	entry, err := svc.AddNote(userID, "oncall@wealist.co.kr", incident.ID, domain.AddIncidentNoteRequest{Message: "Rolling back"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TimelineNote, entry.Type)
+
+	updated, err := svc.CollectEvidence(context.Background(), userID, "oncall@wealist.co.kr", incident.ID)
+	require.NoError(t, err)
+	require.Len(t, updated.Evidence, 1)
+	assert.Equal(t, domain.EvidenceAlert, updated.Evidence[0].Kind)
+	_, err = svc.CollectEvidence(context.Background(), userID, "oncall@wealist.co.kr", incident.ID)
+	require.NoError(t, err)
+
+	var details []string
+	require.NoError(t, db.Model(&domain.AuditLog{}).
+		Where("resource_type = ? AND resource_id = ?", domain.ResourceIncident, incident.ID.String()).
+		Order("created_at").
+		Pluck("details", &details).Error)
+	assert.Equal(t, []string{
+		`Added note to incident "Board API down"`,
+		`Collected evidence for incident "Board API down" (1 new)`,
+		`Collected evidence for incident "Board API down" (0 new)`,
+	}, details)
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"ops-service/internal/client"
+	"ops-service/internal/domain"
+	"ops-service/internal/repository"
+	"ops-service/internal/response"
+)
+
+// SLOService handles SLO definitions and evaluates them against Prometheus
+type SLOService struct {
+	repo       *repository.SLODefinitionRepository
+	auditSvc   *AuditLogService
+	prometheus *client.PrometheusClient // nil이면 정의 관리만 가능
+	namespace  string
+	logger     *zap.Logger
+}
+
+// NewSLOService creates a new SLO service
+func NewSLOService(
+	repo *repository.SLODefinitionRepository,
+	auditSvc *AuditLogService,
+	prometheus *client.PrometheusClient,
+	namespace string,
+	logger *zap.Logger,
+) *SLOService {
+	return &SLOService{
+		repo:       repo,
+		auditSvc:   auditSvc,
+		prometheus: prometheus,
+		namespace:  namespace,
+		logger:     logger,
+	}
+}
+
+// GetAll gets all SLO definitions
+func (s *SLOService) GetAll() ([]domain.SLODefinition, error) {
+	return s.repo.GetAll()
+}
+
+// GetByID gets an SLO definition by ID
+func (s *SLOService) GetByID(id uuid.UUID) (*domain.SLODefinition, error) {
+	def, err := s.repo.GetByID(id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, response.ErrSLONotFound
+		}
+		return nil, err
+	}
+	return def, nil
+}
+
+// Create creates a new SLO definition
+func (s *SLOService) Create(userID uuid.UUID, userEmail string, req domain.CreateSLODefinitionRequest) (*domain.SLODefinition, error) {
+	if req.Window == "" {
+		req.Window = domain.SLOWindow28d
+	}
+	if req.BurnRateThresholds == nil {
+		req.BurnRateThresholds = defaultBurnRateThresholds()
+	}
+
+	def := &domain.SLODefinition{
+		ServiceName:        strings.TrimSpace(req.ServiceName),
+		Description:        req.Description,
+		Enabled:            true,
+		AvailabilityTarget: req.AvailabilityTarget,
+		LatencyP50Target:   req.LatencyP50Target,
+		LatencyP99Target:   req.LatencyP99Target,
+		Window:             req.Window,
+		ErrorRatioQuery:    req.ErrorRatioQuery,
+		LatencyQuery:       req.LatencyQuery,
+		BurnRateThresholds: req.BurnRateThresholds,
+		UpdatedBy:          userID,
+	}
+	if err := validateSLODefinition(def); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ExistsByServiceName(def.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, response.ErrSLOExists
+	}
+
+	if err := s.repo.Create(def); err != nil {
+		return nil, err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionCreate, domain.ResourceSLO, def.ID.String(),
+		fmt.Sprintf("Created SLO for %s: %.3f%% over %s", def.ServiceName, def.AvailabilityTarget, def.Window))
+
+	s.logger.Info("SLO definition created",
+		zap.String("service", def.ServiceName),
+		zap.String("createdBy", userEmail),
+	)
+
+	return def, nil
+}
+
+// Update updates an SLO definition
+func (s *SLOService) Update(userID uuid.UUID, userEmail string, id uuid.UUID, req domain.UpdateSLODefinitionRequest) (*domain.SLODefinition, error) {
+	def, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := sloSummary(def)
+	def.Description = req.Description
+	def.AvailabilityTarget = req.AvailabilityTarget
+	def.LatencyP50Target = req.LatencyP50Target
+	def.LatencyP99Target = req.LatencyP99Target
+	def.Window = req.Window
+	def.ErrorRatioQuery = req.ErrorRatioQuery
+	def.LatencyQuery = req.LatencyQuery
+	if req.BurnRateThresholds != nil {
+		def.BurnRateThresholds = req.BurnRateThresholds
+	}
+	if req.Enabled != nil {
+		def.Enabled = *req.Enabled
+	}
+	def.UpdatedBy = userID
+
+	if err := validateSLODefinition(def); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(def); err != nil {
+		return nil, err
+	}
+
+	details := "Updated SLO for " + def.ServiceName
+	if after := sloSummary(def); after != before {
+		details += fmt.Sprintf(" (%s -> %s)", before, after)
+	}
+	s.auditSvc.Log(userID, userEmail, domain.ActionUpdate, domain.ResourceSLO, def.ID.String(), details)
+
+	s.logger.Info("SLO definition updated",
+		zap.String("service", def.ServiceName),
+		zap.String("updatedBy", userEmail),
+	)
+
+	return def, nil
+}
+
+// Delete deletes an SLO definition
+func (s *SLOService) Delete(userID uuid.UUID, userEmail string, id uuid.UUID) error {
+	def, err := s.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(id); err != nil {
+		return err
+	}
+
+	s.auditSvc.Log(userID, userEmail, domain.ActionDelete, domain.ResourceSLO, id.String(), "Deleted SLO for "+def.ServiceName)
+
+	s.logger.Info("SLO definition deleted",
+		zap.String("service", def.ServiceName),
+		zap.String("deletedBy", userEmail),
+	)
+
+	return nil
+}
+
+// Overview returns the SLO status of every enabled definition
+func (s *SLOService) Overview(ctx context.Context) (*client.SLOOverview, error) {
+	targets, err := s.enabledTargets()
+	if err != nil {
+		return nil, err
+	}
+	return s.prometheus.GetSLOOverview(ctx, s.namespace, targets)
+}
+
+// BurnRates returns the error budget burn rates of every enabled definition
+func (s *SLOService) BurnRates(ctx context.Context) ([]client.BurnRate, error) {
+	targets, err := s.enabledTargets()
+	if err != nil {
+		return nil, err
+	}
+	return s.prometheus.GetBurnRates(ctx, s.namespace, targets)
+}
+
+// ErrorBudgetHistory returns how a definition's error budget was spent over its window
+func (s *SLOService) ErrorBudgetHistory(ctx context.Context, id uuid.UUID) (*client.ErrorBudgetHistory, error) {
+	if s.prometheus == nil {
+		return nil, response.ErrNoPrometheus
+	}
+	def, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.prometheus.GetErrorBudgetHistory(ctx, s.namespace, toSLOTarget(def))
+}
+
+// Targets returns the Prometheus targets of every enabled definition
+func (s *SLOService) Targets() ([]client.SLOTarget, error) {
+	defs, err := s.repo.GetEnabled()
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]client.SLOTarget, len(defs))
+	for i := range defs {
+		targets[i] = toSLOTarget(&defs[i])
+	}
+	return targets, nil
+}
+
+func (s *SLOService) enabledTargets() ([]client.SLOTarget, error) {
+	if s.prometheus == nil {
+		return nil, response.ErrNoPrometheus
+	}
+	return s.Targets()
+}
+
+// toSLOTarget converts a definition to the target evaluated by the Prometheus client
+func toSLOTarget(def *domain.SLODefinition) client.SLOTarget {
+	thresholds := make([]client.BurnRateThreshold, len(def.BurnRateThresholds))
+	for i, t := range def.BurnRateThresholds {
+		thresholds[i] = client.BurnRateThreshold{Window: t.Window, Threshold: t.Threshold}
+	}
+	return client.SLOTarget{
+		ServiceName:        def.ServiceName,
+		Availability:       def.AvailabilityTarget,
+		LatencyP50:         def.LatencyP50Target,
+		LatencyP99:         def.LatencyP99Target,
+		Window:             string(def.Window),
+		ErrorRatioQuery:    def.ErrorRatioQuery,
+		LatencyQuery:       def.LatencyQuery,
+		BurnRateThresholds: thresholds,
+	}
+}
+
+func defaultBurnRateThresholds() []domain.BurnRateThreshold {
+	defaults := client.DefaultBurnRateThresholds()
+	thresholds := make([]domain.BurnRateThreshold, len(defaults))
+	for i, t := range defaults {
+		thresholds[i] = domain.BurnRateThreshold{Window: t.Window, Threshold: t.Threshold}
+	}
+	return thresholds
+}
+
+// validateSLODefinition checks objectives, window, SLI templates and burn rate thresholds
+func validateSLODefinition(def *domain.SLODefinition) error {
+	if def.ServiceName == "" {
+		return response.NewValidationError("Service name is required", "")
+	}
+	if def.AvailabilityTarget <= 0 || def.AvailabilityTarget >= 100 {
+		return response.NewValidationError("Invalid availability target", "must be between 0 and 100 (exclusive)")
+	}
+	if def.LatencyP50Target < 0 || def.LatencyP99Target < 0 {
+		return response.NewValidationError("Invalid latency target", "must not be negative")
+	}
+	if def.LatencyP50Target > 0 && def.LatencyP99Target > 0 && def.LatencyP50Target > def.LatencyP99Target {
+		return response.NewValidationError("Invalid latency target", "P50 target must not exceed P99 target")
+	}
+	if !def.Window.IsValid() {
+		return response.NewValidationError("Invalid window", "must be 7d or 28d")
+	}
+
+	// 샘플 값으로 렌더링해 템플릿 오류를 저장 전에 확인
+	sample := client.SLIQueryData{Service: def.ServiceName, Namespace: "default", Window: string(def.Window), Quantile: 0.99}
+	if def.ErrorRatioQuery != "" {
+		if _, err := client.RenderSLIQuery(def.ErrorRatioQuery, sample); err != nil {
+			return response.NewValidationError("Invalid error ratio query", err.Error())
+		}
+	}
+	if def.LatencyQuery != "" {
+		if _, err := client.RenderSLIQuery(def.LatencyQuery, sample); err != nil {
+			return response.NewValidationError("Invalid latency query", err.Error())
+		}
+	}
+
+	for i, t := range def.BurnRateThresholds {
+		if _, err := client.ParseWindow(t.Window); err != nil {
+			return response.NewValidationError("Invalid burn rate threshold", fmt.Sprintf("threshold %d: %v", i+1, err))
+		}
+		if t.Threshold <= 0 {
+			return response.NewValidationError("Invalid burn rate threshold", fmt.Sprintf("threshold %d: must be positive", i+1))
+		}
+	}
+	return nil
+}
+
+// sloSummary describes the objectives of a definition for audit details
+func sloSummary(def *domain.SLODefinition) string {
+	return fmt.Sprintf("%.3f%%/%s, p50 %.0fms, p99 %.0fms, enabled=%t",
+		def.AvailabilityTarget, def.Window, def.LatencyP50Target, def.LatencyP99Target, def.Enabled)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ops-service/internal/domain"
+	"ops-service/internal/response"
+)
+
+func TestValidateSLODefinition(t *testing.T) {
+	valid := func() domain.SLODefinition {
+		return domain.DefaultSLODefinitions()[0]
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(def *domain.SLODefinition)
+		wantErr string
+	}{
+		{name: "default definition", modify: func(def *domain.SLODefinition) {}},
+		{name: "no latency objectives", modify: func(def *domain.SLODefinition) {
+			def.LatencyP50Target, def.LatencyP99Target = 0, 0
+		}},
+		{name: "custom SLI queries", modify: func(def *domain.SLODefinition) {
+			def.ErrorRatioQuery = `sum(rate(errors{service="{{.Service}}",namespace="{{.Namespace}}"}[{{.Window}}]))`
+			def.LatencyQuery = `histogram_quantile({{.Quantile}}, sum(rate(latency_bucket{service="{{.Service}}"}[5m])) by (le))`
+		}},
+		{name: "missing service", modify: func(def *domain.SLODefinition) { def.ServiceName = "" }, wantErr: "Service name is required"},
+		{name: "zero availability", modify: func(def *domain.SLODefinition) { def.AvailabilityTarget = 0 }, wantErr: "Invalid availability target"},
+		{name: "full availability", modify: func(def *domain.SLODefinition) { def.AvailabilityTarget = 100 }, wantErr: "Invalid availability target"},
+		{name: "negative latency", modify: func(def *domain.SLODefinition) { def.LatencyP99Target = -1 }, wantErr: "Invalid latency target"},
+		{name: "p50 above p99", modify: func(def *domain.SLODefinition) {
+			def.LatencyP50Target, def.LatencyP99Target = 600, 500
+		}, wantErr: "Invalid latency target"},
+		{name: "unsupported window", modify: func(def *domain.SLODefinition) { def.Window = "30d" }, wantErr: "Invalid window"},
+		{name: "unknown template field", modify: func(def *domain.SLODefinition) {
+			def.ErrorRatioQuery = `rate(errors{service="{{.Svc}}"}[5m])`
+		}, wantErr: "Invalid error ratio query"},
+		{name: "broken latency template", modify: func(def *domain.SLODefinition) {
+			def.LatencyQuery = `histogram_quantile({{.Quantile, ...)`
+		}, wantErr: "Invalid latency query"},
+		{name: "invalid burn rate window", modify: func(def *domain.SLODefinition) {
+			def.BurnRateThresholds = []domain.BurnRateThreshold{{Window: "1 hour", Threshold: 14.4}}
+		}, wantErr: "Invalid burn rate threshold"},
+		{name: "non-positive burn rate threshold", modify: func(def *domain.SLODefinition) {
+			def.BurnRateThresholds = []domain.BurnRateThreshold{{Window: "1h", Threshold: 0}}
+		}, wantErr: "Invalid burn rate threshold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := valid()
+			tt.modify(&def)
+			err := validateSLODefinition(&def)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			appErr, ok := err.(*response.AppError)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantErr, appErr.Message)
+		})
+	}
+}
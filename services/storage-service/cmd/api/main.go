@@ -18,6 +18,11 @@
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// @securityDefinitions.apikey InternalAPIKey
+// @in header
+// @name x-internal-api-key
+// @description Shared key for service-to-service calls.
+
 package main
 
 import (
@@ -30,15 +35,22 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 	"storage-service/internal/client"
 	"storage-service/internal/config"
 	"storage-service/internal/database"
+	"storage-service/internal/job"
 	"storage-service/internal/middleware"
+	"storage-service/internal/repository"
 	"storage-service/internal/router"
+	"storage-service/internal/service"
 )
 
 func main() {
@@ -175,7 +187,7 @@ func main() {
 	// Initialize User API client
 	var userClient client.UserClient
 	if cfg.UserAPI.BaseURL != "" {
-		userClient = client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger)
+		userClient = client.NewUserClient(cfg.UserAPI.BaseURL, cfg.UserAPI.Timeout, logger, commonclient.NewProfileCacheClient(database.GetRedis()))
 		logger.Info("User API client initialized",
 			zap.String("url", cfg.UserAPI.BaseURL),
 			zap.Duration("timeout", cfg.UserAPI.Timeout))
@@ -183,6 +195,104 @@ func main() {
 		logger.Warn("User API base URL not configured, workspace validation disabled")
 	}
 
+	// 커스텀 역할을 포함한 워크스페이스 권한 (user-service 조회, 짧게 캐시)
+	var permissions *permission.Resolver
+	if cfg.UserAPI.BaseURL != "" {
+		permissions = permission.NewResolver(permission.NewRemoteChecker(permission.RemoteCheckerConfig{
+			BaseURL: cfg.UserAPI.BaseURL,
+			Timeout: cfg.UserAPI.Timeout,
+			Logger:  logger,
+		}), logger)
+	}
+
+	// Initialize feature flag client (flags serve their defaults without ops-service)
+	var flagClient *featureflag.Client
+	if cfg.Flags.BaseURL != "" {
+		flagClient = featureflag.NewClient(featureflag.Config{
+			BaseURL:         cfg.Flags.BaseURL,
+			Environment:     cfg.Flags.Environment,
+			InternalAPIKey:  cfg.Internal.APIKey,
+			RefreshInterval: cfg.Flags.RefreshInterval,
+			Redis:           database.GetRedis(),
+			Logger:          logger,
+		})
+		flagClient.Start(ctx)
+		logger.Info("Feature flag client initialized",
+			zap.String("base_url", cfg.Flags.BaseURL),
+			zap.String("environment", cfg.Flags.Environment))
+	} else {
+		logger.Warn("OPS_SERVICE_URL not configured, feature flags serve their defaults")
+	}
+
+	// Initialize maintenance service (orphan cleanup, expired shares, trash purge, reconciliation)
+	fileRepo := repository.NewFileRepository(db)
+	folderRepo := repository.NewFolderRepository(db)
+	shareRepo := repository.NewShareRepository(db)
+	maintenanceService := service.NewMaintenanceService(
+		fileRepo,
+		folderRepo,
+		shareRepo,
+		repository.NewWorkspaceSettingsRepository(db),
+		service.NewFileService(fileRepo, folderRepo, s3Client, logger, nil, cfg.Upload.WorkspaceQuota),
+		service.NewShareService(shareRepo, fileRepo, folderRepo, s3Client, userClient, logger),
+		s3Client,
+		service.MaintenanceOptions{
+			DefaultTrashRetentionDays: cfg.Maintenance.TrashRetentionDays,
+			OrphanObjectGracePeriod:   cfg.Maintenance.OrphanGracePeriod,
+			DeleteOrphanObjects:       cfg.Maintenance.DeleteOrphanObjects,
+		},
+		logger,
+	)
+
+	// Setup maintenance scheduler
+	var scheduler *cron.Cron
+	if cfg.Maintenance.Enabled && s3Client != nil {
+		var locker job.Locker = job.LocalLocker{}
+		if redisClient := database.GetRedis(); redisClient != nil {
+			locker = job.NewRedisLocker(redisClient)
+		} else {
+			logger.Warn("Redis not available, maintenance jobs will run without distributed lock")
+		}
+		maintenanceJob := job.NewMaintenanceJob(maintenanceService, locker, cfg.Maintenance.LockTTL, logger)
+
+		scheduler = cron.New()
+		if _, err := scheduler.AddFunc(cfg.Maintenance.Schedule, maintenanceJob.Run); err != nil {
+			logger.Fatal("Failed to schedule maintenance job", zap.Error(err))
+		}
+		if _, err := scheduler.AddFunc(cfg.Maintenance.ReconcileSchedule, maintenanceJob.RunReconcile); err != nil {
+			logger.Fatal("Failed to schedule reconciliation job", zap.Error(err))
+		}
+		scheduler.Start()
+		logger.Info("Maintenance jobs scheduled",
+			zap.String("schedule", cfg.Maintenance.Schedule),
+			zap.String("reconcile_schedule", cfg.Maintenance.ReconcileSchedule),
+			zap.Int("default_trash_retention_days", cfg.Maintenance.TrashRetentionDays),
+		)
+	} else {
+		logger.Warn("Maintenance jobs disabled",
+			zap.Bool("enabled", cfg.Maintenance.Enabled),
+			zap.Bool("s3_configured", s3Client != nil),
+		)
+	}
+
+	// Start thumbnail worker
+	var thumbnailWorker *job.ThumbnailWorker
+	if cfg.Thumbnail.Enabled && s3Client != nil {
+		thumbnailService := service.NewThumbnailService(fileRepo, s3Client, cfg.Thumbnail.Concurrency, logger)
+		thumbnailWorker = job.NewThumbnailWorker(thumbnailService, cfg.Thumbnail.PollInterval, cfg.Thumbnail.BatchSize, logger)
+		thumbnailWorker.Start()
+		logger.Info("Thumbnail worker started",
+			zap.Duration("poll_interval", cfg.Thumbnail.PollInterval),
+			zap.Int("batch_size", cfg.Thumbnail.BatchSize),
+			zap.Int("concurrency", cfg.Thumbnail.Concurrency),
+		)
+	} else {
+		logger.Warn("Thumbnail worker disabled",
+			zap.Bool("enabled", cfg.Thumbnail.Enabled),
+			zap.Bool("s3_configured", s3Client != nil),
+		)
+	}
+
 	// Setup router
 	r := router.Setup(router.Config{
 		DB:              db,
@@ -194,7 +304,12 @@ func main() {
 		UserClient:      userClient,
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
+		WorkspaceQuota:  cfg.Upload.WorkspaceQuota,
 		ServiceName:     "storage-service",
+		Maintenance:     maintenanceService,
+		InternalAPIKey:  cfg.Internal.APIKey,
+		FeatureFlags:    flagClient,
+		Permissions:     permissions,
 	})
 
 	// Create HTTP server
@@ -221,6 +336,21 @@ func main() {
 	<-quit
 	logger.Info("Shutting down server...")
 
+	// Stop scheduler and wait for running jobs
+	if scheduler != nil {
+		<-scheduler.Stop().Done()
+	}
+
+	// Stop thumbnail worker after the current batch
+	if thumbnailWorker != nil {
+		thumbnailWorker.Stop()
+	}
+
+	// Stop following feature flag changes
+	if flagClient != nil {
+		flagClient.Close()
+	}
+
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
@@ -0,0 +1,111 @@
+// Command thumbnail-backfill queues thumbnail generation for image files that were
+// uploaded before the thumbnail pipeline existed.
+//
+// Usage:
+//
+//	thumbnail-backfill [-workspace <uuid>] [-retry-failed] [-process]
+//
+// By default files are only queued and the API service's thumbnail worker picks them up.
+// With -process the command also generates the thumbnails itself until the queue is empty.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/config"
+	"storage-service/internal/database"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/service"
+)
+
+func main() {
+	configPath := flag.String("config", "configs/config.yaml", "path to config file")
+	workspace := flag.String("workspace", "", "only backfill files in this workspace (UUID)")
+	retryFailed := flag.Bool("retry-failed", false, "also requeue files whose thumbnail generation failed")
+	process := flag.Bool("process", false, "generate thumbnails in this process until the queue is empty")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	req := domain.ThumbnailBackfillRequest{RetryFailed: *retryFailed}
+	if *workspace != "" {
+		id, err := uuid.Parse(*workspace)
+		if err != nil {
+			logger.Fatal("Invalid workspace ID", zap.String("workspace", *workspace), zap.Error(err))
+		}
+		req.WorkspaceID = &id
+	}
+
+	db, err := database.New(database.Config{
+		DSN:             cfg.Database.GetDSN(),
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+	})
+	if err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	var s3Client *client.S3Client
+	if *process {
+		s3Client, err = client.NewS3Client(&cfg.S3)
+		if err != nil {
+			logger.Fatal("Failed to initialize S3 client", zap.Error(err))
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	thumbnailService := service.NewThumbnailService(repository.NewFileRepository(db), s3Client, cfg.Thumbnail.Concurrency, logger)
+
+	result, err := thumbnailService.Backfill(ctx, req)
+	if err != nil {
+		logger.Fatal("Failed to queue thumbnail backfill", zap.Error(err))
+	}
+	logger.Info("Thumbnail backfill queued", zap.Int64("files", result.Queued))
+
+	if !*process {
+		return
+	}
+
+	// 큐가 빌 때까지 배치 단위로 처리
+	start := time.Now()
+	total := 0
+	for ctx.Err() == nil {
+		claimed, err := thumbnailService.ProcessPending(ctx, cfg.Thumbnail.BatchSize)
+		if err != nil {
+			logger.Fatal("Failed to process thumbnails", zap.Error(err))
+		}
+		total += claimed
+		if claimed == 0 {
+			break
+		}
+	}
+	logger.Info("Thumbnail backfill processed",
+		zap.Int("files", total),
+		zap.Duration("elapsed", time.Since(start)),
+	)
+}
@@ -1,15 +1,21 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 
 	internalConfig "storage-service/internal/config"
@@ -114,10 +120,15 @@ func NewS3Client(cfg *internalConfig.S3Config) (*S3Client, error) {
 	}, nil
 }
 
+// GenerateFileKey generates a unique file key with workspace prefix
+func GenerateFileKey(workspaceID, fileName string) string {
+	return fmt.Sprintf("%s%s/%s", WorkspacePrefix(workspaceID), uuid.New().String(), fileName)
+}
+
 // GeneratePresignedURL generates a presigned URL for uploading a file
 func (c *S3Client) GeneratePresignedURL(ctx context.Context, workspaceID, fileName, contentType string) (string, string, error) {
 	// Generate unique file key with workspace prefix
-	fileKey := fmt.Sprintf("storage/%s/%s/%s", workspaceID, uuid.New().String(), fileName)
+	fileKey := GenerateFileKey(workspaceID, fileName)
 
 	// Use presignClient which is configured with public endpoint
 	presignClient := s3.NewPresignClient(c.presignClient)
@@ -159,6 +170,32 @@ func (c *S3Client) GenerateDownloadURL(ctx context.Context, fileKey, fileName st
 	return presignedReq.URL, nil
 }
 
+// GenerateShareURL generates a short-lived presigned URL for a public share link.
+// inline=true lets browsers render the object (preview) instead of downloading it.
+func (c *S3Client) GenerateShareURL(ctx context.Context, fileKey, fileName string, inline bool, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.presignClient)
+
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+
+	getObjectInput := &s3.GetObjectInput{
+		Bucket:                     aws.String(c.bucket),
+		Key:                        aws.String(fileKey),
+		ResponseContentDisposition: aws.String(fmt.Sprintf("%s; filename=\"%s\"", disposition, fileName)),
+	}
+
+	presignedReq, err := presignClient.PresignGetObject(ctx, getObjectInput, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate share URL: %w", err)
+	}
+
+	return presignedReq.URL, nil
+}
+
 // GetFileURL returns the public URL for a file
 func (c *S3Client) GetFileURL(fileKey string) string {
 	// CDN mode: publicEndpoint is set but endpoint is empty (AWS S3 + CloudFront)
@@ -206,6 +243,34 @@ func (c *S3Client) CopyFile(ctx context.Context, sourceKey, destKey string) erro
 	return nil
 }
 
+// PutObject uploads a small in-memory object (e.g. a generated thumbnail)
+func (c *S3Client) PutObject(ctx context.Context, fileKey, contentType string, data []byte) error {
+	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(c.bucket),
+		Key:           aws.String(fileKey),
+		Body:          bytes.NewReader(data),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	return nil
+}
+
+// GetObject opens a streaming reader for an object in S3.
+// The caller must close the returned reader.
+func (c *S3Client) GetObject(ctx context.Context, fileKey string) (io.ReadCloser, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return out.Body, nil
+}
+
 // FileExists checks if a file exists in S3
 func (c *S3Client) FileExists(ctx context.Context, fileKey string) (bool, error) {
 	_, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
@@ -213,8 +278,216 @@ func (c *S3Client) FileExists(ctx context.Context, fileKey string) (bool, error)
 		Key:    aws.String(fileKey),
 	})
 	if err != nil {
-		// Check if error is "not found"
-		return false, nil
+		// 404만 "없음"으로 취급하고, 그 외 오류는 호출자가 판단하도록 반환
+		var notFound *types.NotFound
+		var respErr *awshttp.ResponseError
+		if errors.As(err, &notFound) || (errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to head object: %w", err)
 	}
 	return true, nil
 }
+
+// ObjectInfo describes an object listed from S3
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ListObjects lists all objects under the given prefix
+// 페이지네이션을 따라가며 prefix 하위의 모든 객체를 조회합니다.
+func (c *S3Client) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+
+	paginator := s3.NewListObjectsV2Paginator(c.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			info := ObjectInfo{
+				Key:  aws.ToString(obj.Key),
+				Size: aws.ToInt64(obj.Size),
+			}
+			if obj.LastModified != nil {
+				info.LastModified = *obj.LastModified
+			}
+			objects = append(objects, info)
+		}
+	}
+
+	return objects, nil
+}
+
+// ListPrefixes lists the immediate sub-prefixes ("directories") under the given prefix
+func (c *S3Client) ListPrefixes(ctx context.Context, prefix string) ([]string, error) {
+	var prefixes []string
+
+	paginator := s3.NewListObjectsV2Paginator(c.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(c.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list prefixes: %w", err)
+		}
+		for _, p := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.ToString(p.Prefix))
+		}
+	}
+
+	return prefixes, nil
+}
+
+// WorkspacePrefix returns the S3 key prefix under which a workspace's files are stored
+func WorkspacePrefix(workspaceID string) string {
+	return fmt.Sprintf("storage/%s/", workspaceID)
+}
+
+// UploadedPart describes a part of a multipart upload already stored in S3
+type UploadedPart struct {
+	PartNumber   int32     `json:"partNumber"`
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// MultipartUploadInfo describes an in-progress multipart upload listed from S3
+type MultipartUploadInfo struct {
+	Key       string
+	UploadID  string
+	Initiated time.Time
+}
+
+// CreateMultipartUpload starts a multipart upload and returns its upload ID
+func (c *S3Client) CreateMultipartUpload(ctx context.Context, fileKey, contentType string) (string, error) {
+	out, err := c.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(c.bucket),
+		Key:         aws.String(fileKey),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	return aws.ToString(out.UploadId), nil
+}
+
+// GeneratePresignedPartURL generates a presigned URL for uploading one part of a multipart upload
+func (c *S3Client) GeneratePresignedPartURL(ctx context.Context, fileKey, uploadID string, partNumber int32, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.presignClient)
+
+	presignedReq, err := presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(c.bucket),
+		Key:        aws.String(fileKey),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(partNumber),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned part URL: %w", err)
+	}
+
+	return presignedReq.URL, nil
+}
+
+// ListParts lists the parts already uploaded for a multipart upload
+func (c *S3Client) ListParts(ctx context.Context, fileKey, uploadID string) ([]UploadedPart, error) {
+	var parts []UploadedPart
+
+	paginator := s3.NewListPartsPaginator(c.client, &s3.ListPartsInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(fileKey),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list parts: %w", err)
+		}
+		for _, p := range page.Parts {
+			part := UploadedPart{
+				PartNumber: aws.ToInt32(p.PartNumber),
+				ETag:       aws.ToString(p.ETag),
+				Size:       aws.ToInt64(p.Size),
+			}
+			if p.LastModified != nil {
+				part.LastModified = *p.LastModified
+			}
+			parts = append(parts, part)
+		}
+	}
+
+	return parts, nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the final object
+func (c *S3Client) CompleteMultipartUpload(ctx context.Context, fileKey, uploadID string, parts []UploadedPart) error {
+	completed := make([]types.CompletedPart, 0, len(parts))
+	for _, p := range parts {
+		completed = append(completed, types.CompletedPart{
+			ETag:       aws.String(p.ETag),
+			PartNumber: aws.Int32(p.PartNumber),
+		})
+	}
+
+	_, err := c.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(c.bucket),
+		Key:             aws.String(fileKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	return nil
+}
+
+// AbortMultipartUpload aborts a multipart upload and frees its stored parts
+func (c *S3Client) AbortMultipartUpload(ctx context.Context, fileKey, uploadID string) error {
+	_, err := c.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(fileKey),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
+
+// ListMultipartUploads lists in-progress multipart uploads under the given prefix
+func (c *S3Client) ListMultipartUploads(ctx context.Context, prefix string) ([]MultipartUploadInfo, error) {
+	var uploads []MultipartUploadInfo
+
+	paginator := s3.NewListMultipartUploadsPaginator(c.client, &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list multipart uploads: %w", err)
+		}
+		for _, u := range page.Uploads {
+			info := MultipartUploadInfo{
+				Key:      aws.ToString(u.Key),
+				UploadID: aws.ToString(u.UploadId),
+			}
+			if u.Initiated != nil {
+				info.Initiated = *u.Initiated
+			}
+			uploads = append(uploads, info)
+		}
+	}
+
+	return uploads, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
 	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
@@ -14,22 +15,48 @@ import (
 // UserClient defines the interface for User API interactions
 type UserClient interface {
 	ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error)
+	GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error)
+	ResolveProfiles(ctx context.Context, keys []commonclient.ProfileKey) (map[commonclient.ProfileKey]*commonclient.WorkspaceProfile, error)
 }
 
 // userClient implements UserClient interface using common HTTP client
 type userClient struct {
 	*commonclient.BaseHTTPClient
+	profiles *commonclient.ProfileResolver
 }
 
 // NewUserClient creates a new User API client
-func NewUserClient(baseURL string, timeout time.Duration, logger *zap.Logger) UserClient {
+// profileCache is optional; when set, resolved profiles are cached in Redis.
+func NewUserClient(baseURL string, timeout time.Duration, logger *zap.Logger, profileCache *redis.Client) UserClient {
 	return &userClient{
 		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
+		profiles: commonclient.NewProfileResolver(commonclient.ProfileResolverConfig{
+			BaseURL: baseURL,
+			Timeout: timeout,
+			Logger:  logger,
+			Redis:   profileCache,
+		}),
 	}
 }
 
-// ValidateWorkspaceMember validates if a user is a member of a workspace
+// ResolveProfiles resolves many workspace profiles with batched requests.
+// Pairs without a profile are missing from the result.
+func (c *userClient) ResolveProfiles(ctx context.Context, keys []commonclient.ProfileKey) (map[commonclient.ProfileKey]*commonclient.WorkspaceProfile, error) {
+	return c.profiles.Resolve(ctx, keys)
+}
+
+// ValidateWorkspaceMember validates if a user is a member of a workspace.
+// Guests are not members; use GetWorkspaceAccess for project-scoped checks.
 func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, userID uuid.UUID, token string) (bool, error) {
+	access, err := c.GetWorkspaceAccess(ctx, workspaceID, userID, token)
+	if err != nil {
+		return false, err
+	}
+	return access.IsWorkspaceMember(), nil
+}
+
+// GetWorkspaceAccess validates workspace access and returns the guest scope along with membership
+func (c *userClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error) {
 	url := c.BuildURL(fmt.Sprintf("/workspaces/%s/validate-member/%s", workspaceID.String(), userID.String()))
 
 	c.Logger.Debug("Validating workspace member",
@@ -45,16 +72,15 @@ func (c *userClient) ValidateWorkspaceMember(ctx context.Context, workspaceID, u
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", userID.String()),
 		)
-		return false, err
+		return nil, err
 	}
 
-	isValid := response.IsWorkspaceMember()
-
 	c.Logger.Debug("Workspace member validation result",
-		zap.Bool("is_valid", isValid),
+		zap.Bool("is_valid", response.IsWorkspaceMember()),
+		zap.Bool("is_guest", response.IsGuest),
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("user_id", userID.String()),
 	)
 
-	return isValid, nil
+	return &response, nil
 }
@@ -13,15 +13,56 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server    ServerConfig    `yaml:"server"`
-	Database  DatabaseConfig  `yaml:"database"`
-	Logger    LoggerConfig    `yaml:"logger"`
-	JWT       JWTConfig       `yaml:"jwt"`
-	AuthAPI   AuthAPIConfig   `yaml:"auth_api"`
-	UserAPI   UserAPIConfig   `yaml:"user_api"`
-	CORS      CORSConfig      `yaml:"cors"`
-	S3        S3Config        `yaml:"s3"`
-	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Server      ServerConfig      `yaml:"server"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Logger      LoggerConfig      `yaml:"logger"`
+	JWT         JWTConfig         `yaml:"jwt"`
+	AuthAPI     AuthAPIConfig     `yaml:"auth_api"`
+	UserAPI     UserAPIConfig     `yaml:"user_api"`
+	CORS        CORSConfig        `yaml:"cors"`
+	S3          S3Config          `yaml:"s3"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	Maintenance MaintenanceConfig `yaml:"maintenance"`
+	Thumbnail   ThumbnailConfig   `yaml:"thumbnail"`
+	Upload      UploadConfig      `yaml:"upload"`
+	Internal    InternalConfig    `yaml:"internal"`
+	Flags       FeatureFlagConfig `yaml:"feature_flags"`
+}
+
+// FeatureFlagConfig holds the ops-service feature flag client configuration
+type FeatureFlagConfig struct {
+	BaseURL         string        `yaml:"base_url"` // empty serves every flag's default
+	Environment     string        `yaml:"environment"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// InternalConfig holds configuration of the service-to-service API
+type InternalConfig struct {
+	APIKey string `yaml:"api_key"` // x-internal-api-key header value; empty disables the internal API
+}
+
+// UploadConfig holds upload limits
+type UploadConfig struct {
+	WorkspaceQuota int64 `yaml:"workspace_quota"` // max bytes stored per workspace; 0 = unlimited
+}
+
+// ThumbnailConfig holds the asynchronous thumbnail worker configuration
+type ThumbnailConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	PollInterval time.Duration `yaml:"poll_interval"` // how often pending files are picked up
+	BatchSize    int           `yaml:"batch_size"`
+	Concurrency  int           `yaml:"concurrency"` // images decoded in parallel (memory bound)
+}
+
+// MaintenanceConfig holds scheduled storage maintenance configuration
+type MaintenanceConfig struct {
+	Enabled             bool          `yaml:"enabled"`
+	Schedule            string        `yaml:"schedule"`             // cron spec for cleanup + trash purge
+	ReconcileSchedule   string        `yaml:"reconcile_schedule"`   // cron spec for S3/DB reconciliation
+	TrashRetentionDays  int           `yaml:"trash_retention_days"` // default when a workspace has no setting
+	LockTTL             time.Duration `yaml:"lock_ttl"`
+	OrphanGracePeriod   time.Duration `yaml:"orphan_grace_period"`
+	DeleteOrphanObjects bool          `yaml:"delete_orphan_objects"` // false = report only
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -29,6 +70,10 @@ type RateLimitConfig struct {
 	Enabled           bool `yaml:"enabled"`
 	RequestsPerMinute int  `yaml:"requests_per_minute"`
 	BurstSize         int  `yaml:"burst_size"`
+
+	// Public share link resolution (per client IP, always enforced when Redis is available)
+	ShareLinkRequestsPerMinute int `yaml:"share_link_requests_per_minute"`
+	ShareLinkFailuresPerHour   int `yaml:"share_link_failures_per_hour"` // Wrong password / unknown token
 }
 
 // ServerConfig holds server configuration
@@ -157,6 +202,12 @@ func getDefaultConfig() Config {
 		CORS: CORSConfig{
 			AllowedOrigins: "*",
 		},
+		Maintenance: MaintenanceConfig{
+			Enabled: true,
+		},
+		Thumbnail: ThumbnailConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -256,6 +307,22 @@ func (c *Config) overrideFromEnv() {
 		c.UserAPI.Timeout = 5 * time.Second
 	}
 
+	// Feature flags
+	if baseURL := os.Getenv("OPS_SERVICE_URL"); baseURL != "" {
+		c.Flags.BaseURL = baseURL
+	}
+	if env := os.Getenv("FEATURE_FLAG_ENV"); env != "" {
+		c.Flags.Environment = env
+	}
+	if c.Flags.Environment == "" {
+		c.Flags.Environment = "prod"
+	}
+	if interval := os.Getenv("FEATURE_FLAG_REFRESH_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Flags.RefreshInterval = d
+		}
+	}
+
 	// CORS
 	if origins := os.Getenv("CORS_ORIGINS"); origins != "" {
 		c.CORS.AllowedOrigins = origins
@@ -299,6 +366,92 @@ func (c *Config) overrideFromEnv() {
 	if c.RateLimit.RequestsPerMinute == 0 {
 		c.RateLimit.RequestsPerMinute = 60
 	}
+	if rpm := os.Getenv("SHARE_LINK_RATE_LIMIT_PER_MINUTE"); rpm != "" {
+		if v, err := strconv.Atoi(rpm); err == nil {
+			c.RateLimit.ShareLinkRequestsPerMinute = v
+		}
+	}
+	if failures := os.Getenv("SHARE_LINK_MAX_FAILURES_PER_HOUR"); failures != "" {
+		if v, err := strconv.Atoi(failures); err == nil {
+			c.RateLimit.ShareLinkFailuresPerHour = v
+		}
+	}
+	if c.RateLimit.ShareLinkRequestsPerMinute == 0 {
+		c.RateLimit.ShareLinkRequestsPerMinute = 30
+	}
+	if c.RateLimit.ShareLinkFailuresPerHour == 0 {
+		c.RateLimit.ShareLinkFailuresPerHour = 20
+	}
+
+	// Upload
+	if quota := os.Getenv("WORKSPACE_STORAGE_QUOTA"); quota != "" {
+		if v, err := strconv.ParseInt(quota, 10, 64); err == nil {
+			c.Upload.WorkspaceQuota = v
+		}
+	}
+
+	// Maintenance
+	if enabled := os.Getenv("MAINTENANCE_ENABLED"); enabled != "" {
+		c.Maintenance.Enabled = enabled == "true"
+	}
+	if schedule := os.Getenv("MAINTENANCE_SCHEDULE"); schedule != "" {
+		c.Maintenance.Schedule = schedule
+	}
+	if schedule := os.Getenv("RECONCILE_SCHEDULE"); schedule != "" {
+		c.Maintenance.ReconcileSchedule = schedule
+	}
+	if days := os.Getenv("TRASH_RETENTION_DAYS"); days != "" {
+		if v, err := strconv.Atoi(days); err == nil {
+			c.Maintenance.TrashRetentionDays = v
+		}
+	}
+	if deleteOrphans := os.Getenv("RECONCILE_DELETE_ORPHANS"); deleteOrphans != "" {
+		c.Maintenance.DeleteOrphanObjects = deleteOrphans == "true"
+	}
+	if c.Maintenance.Schedule == "" {
+		c.Maintenance.Schedule = "@hourly"
+	}
+	if c.Maintenance.ReconcileSchedule == "" {
+		c.Maintenance.ReconcileSchedule = "@daily"
+	}
+	if c.Maintenance.TrashRetentionDays == 0 {
+		c.Maintenance.TrashRetentionDays = 30
+	}
+	if c.Maintenance.LockTTL == 0 {
+		c.Maintenance.LockTTL = 30 * time.Minute
+	}
+	if c.Maintenance.OrphanGracePeriod == 0 {
+		c.Maintenance.OrphanGracePeriod = 24 * time.Hour
+	}
+
+	// Thumbnail
+	if enabled := os.Getenv("THUMBNAIL_ENABLED"); enabled != "" {
+		c.Thumbnail.Enabled = enabled == "true"
+	}
+	if interval := os.Getenv("THUMBNAIL_POLL_INTERVAL"); interval != "" {
+		if v, err := time.ParseDuration(interval); err == nil {
+			c.Thumbnail.PollInterval = v
+		}
+	}
+	if concurrency := os.Getenv("THUMBNAIL_CONCURRENCY"); concurrency != "" {
+		if v, err := strconv.Atoi(concurrency); err == nil {
+			c.Thumbnail.Concurrency = v
+		}
+	}
+	if c.Thumbnail.PollInterval == 0 {
+		c.Thumbnail.PollInterval = 5 * time.Second
+	}
+	if c.Thumbnail.BatchSize == 0 {
+		c.Thumbnail.BatchSize = 10
+	}
+	if c.Thumbnail.Concurrency == 0 {
+		c.Thumbnail.Concurrency = 2
+	}
+
+	// Internal API
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		c.Internal.APIKey = apiKey
+	}
 }
 
 // validate validates the configuration
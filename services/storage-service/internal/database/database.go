@@ -50,6 +50,12 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.File{},
 		&domain.FileShare{},
 		&domain.FolderShare{},
+		&domain.ShareAccessLog{},
+		&domain.WorkspaceStorageSettings{},
+		&domain.Tag{},
+		&domain.ItemTag{},
+		&domain.Star{},
+		&domain.RecentItem{},
 	)
 }
 
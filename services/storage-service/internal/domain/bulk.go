@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// MaxBulkItems is the maximum number of files and folders in a single bulk request
+const MaxBulkItems = 500
+
+// BulkItemType distinguishes files from folders in bulk results
+type BulkItemType string
+
+const (
+	BulkItemTypeFile   BulkItemType = "file"
+	BulkItemTypeFolder BulkItemType = "folder"
+)
+
+// BulkItemsRequest represents a mixed set of files and folders to operate on
+type BulkItemsRequest struct {
+	WorkspaceID uuid.UUID   `json:"workspaceId" binding:"required"`
+	FileIDs     []uuid.UUID `json:"fileIds,omitempty"`
+	FolderIDs   []uuid.UUID `json:"folderIds,omitempty"`
+}
+
+// ItemCount returns the total number of items in the request
+func (r *BulkItemsRequest) ItemCount() int {
+	return len(r.FileIDs) + len(r.FolderIDs)
+}
+
+// BulkTransferRequest represents a bulk move or copy into a destination folder
+type BulkTransferRequest struct {
+	BulkItemsRequest
+	TargetFolderID *uuid.UUID     `json:"targetFolderId,omitempty"`                                                 // nil means workspace root
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty" binding:"omitempty,oneof=rename skip overwrite"` // Applies to folders
+}
+
+// BulkItemResult represents the outcome for a single item of a bulk request
+type BulkItemResult struct {
+	ID        uuid.UUID    `json:"id"`
+	Type      BulkItemType `json:"type"`
+	Success   bool         `json:"success"`
+	Skipped   bool         `json:"skipped,omitempty"` // Name conflict resolved with the skip policy
+	NewID     *uuid.UUID   `json:"newId,omitempty"`   // Set for copies
+	ErrorCode string       `json:"errorCode,omitempty"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// BulkOperationResponse summarizes a bulk request
+type BulkOperationResponse struct {
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkItemResult `json:"results"`
+}
+
+// ArchiveRequest represents request for downloading files and folders as a ZIP
+type ArchiveRequest struct {
+	WorkspaceID uuid.UUID   `json:"workspaceId" binding:"required"`
+	FileIDs     []uuid.UUID `json:"fileIds,omitempty"`
+	FolderIDs   []uuid.UUID `json:"folderIds,omitempty"`
+	Name        string      `json:"name,omitempty"` // Archive file name without extension
+}
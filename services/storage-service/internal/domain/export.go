@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserFilesExport is the storage data of a user returned for personal data export
+type UserFilesExport struct {
+	UserID     uuid.UUID      `json:"userId"`
+	ExportedAt time.Time      `json:"exportedAt"`
+	Files      []ExportedFile `json:"files"`
+}
+
+// ExportedFile is the metadata of a file uploaded by the user
+type ExportedFile struct {
+	ID           uuid.UUID  `json:"id"`
+	WorkspaceID  uuid.UUID  `json:"workspaceId"`
+	ProjectID    *uuid.UUID `json:"projectId,omitempty"`
+	FolderID     *uuid.UUID `json:"folderId,omitempty"`
+	Name         string     `json:"name"`
+	OriginalName string     `json:"originalName"`
+	FileSize     int64      `json:"fileSize"`
+	ContentType  string     `json:"contentType"`
+	Source       FileSource `json:"source,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
@@ -30,6 +30,13 @@ type File struct {
 	ContentType string     `gorm:"size:128;not null" json:"contentType"`
 	Status      FileStatus `gorm:"size:20;not null;default:'ACTIVE'" json:"status"`
 	Version     int        `gorm:"not null;default:1" json:"version"` // File versioning
+	UploadID    *string    `gorm:"size:1024" json:"-"`                 // S3 multipart upload ID (nil for single PUT uploads)
+	PartSize    int64      `gorm:"not null;default:0" json:"-"`        // Multipart part size in bytes
+	ThumbnailStatus    ThumbnailStatus `gorm:"size:20;index" json:"thumbnailStatus,omitempty"`
+	ThumbnailAttempts  int             `gorm:"not null;default:0" json:"-"`
+	ThumbnailError     *string         `gorm:"size:512" json:"thumbnailError,omitempty"` // Last generation failure
+	ThumbnailUpdatedAt *time.Time      `json:"-"`
+	Source      FileSource `gorm:"size:20;not null;default:'';index" json:"source,omitempty"` // Owning service of a linked object
 	UploadedBy  uuid.UUID  `gorm:"type:uuid;not null;index" json:"uploadedBy"`
 	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
 	UpdatedAt   time.Time  `gorm:"not null" json:"updatedAt"`
@@ -46,6 +53,11 @@ func (File) TableName() string {
 	return "storage_files"
 }
 
+// IsMultipart returns true if this file is being uploaded via S3 multipart upload
+func (f *File) IsMultipart() bool {
+	return f.UploadID != nil && *f.UploadID != ""
+}
+
 // IsDeleted returns true if this file is in trash
 func (f *File) IsDeleted() bool {
 	return f.DeletedAt != nil || f.Status == FileStatusDeleted
@@ -141,11 +153,20 @@ type FileResponse struct {
 	IsImage      bool       `json:"isImage"`
 	IsDocument   bool       `json:"isDocument"`
 	Extension    string     `json:"extension"`
+
+	ThumbnailStatus ThumbnailStatus `json:"thumbnailStatus,omitempty"`
+	ThumbnailURL    *string         `json:"thumbnailUrl,omitempty"` // Small thumbnail (grid view)
+	PreviewURL      *string         `json:"previewUrl,omitempty"`   // Large thumbnail (preview pane)
+
+	Source FileSource `json:"source,omitempty"` // Set for objects linked from another service
+
+	Tags      []TagResponse `json:"tags,omitempty"`
+	IsStarred bool          `json:"isStarred,omitempty"`
 }
 
 // ToResponse converts File to FileResponse
 func (f *File) ToResponse(fileURL string) FileResponse {
-	return FileResponse{
+	resp := FileResponse{
 		ID:           f.ID,
 		WorkspaceID:  f.WorkspaceID,
 		ProjectID:    f.ProjectID,
@@ -164,7 +185,21 @@ func (f *File) ToResponse(fileURL string) FileResponse {
 		IsImage:      f.IsImage(),
 		IsDocument:   f.IsDocument(),
 		Extension:    f.GetExtension(),
+
+		ThumbnailStatus: f.ThumbnailStatus,
+		Source:          f.Source,
+	}
+
+	// 썸네일 URL은 원본 URL과 같은 방식(base URL + key)으로 구성
+	if f.ThumbnailStatus == ThumbnailStatusReady && strings.HasSuffix(fileURL, f.FileKey) {
+		base := strings.TrimSuffix(fileURL, f.FileKey)
+		small := base + ThumbnailKey(f.FileKey, ThumbnailSmall)
+		large := base + ThumbnailKey(f.FileKey, ThumbnailLarge)
+		resp.ThumbnailURL = &small
+		resp.PreviewURL = &large
 	}
+
+	return resp
 }
 
 // FileListResponse represents list of files with pagination
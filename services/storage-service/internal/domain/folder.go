@@ -58,6 +58,43 @@ type UpdateFolderRequest struct {
 	ParentID *uuid.UUID `json:"parentId,omitempty"` // For moving folder
 }
 
+// ConflictPolicy decides what happens when the destination already has an item with the same name
+type ConflictPolicy string
+
+const (
+	ConflictPolicyRename    ConflictPolicy = "rename"    // Keep both; the incoming item gets a " (n)" suffix
+	ConflictPolicySkip      ConflictPolicy = "skip"      // Leave the destination untouched
+	ConflictPolicyOverwrite ConflictPolicy = "overwrite" // Move the existing item to trash
+)
+
+// OrDefault returns the policy, falling back to rename when unset
+func (p ConflictPolicy) OrDefault() ConflictPolicy {
+	if p == "" {
+		return ConflictPolicyRename
+	}
+	return p
+}
+
+// CopyFolderRequest represents request for deep-copying a folder
+type CopyFolderRequest struct {
+	TargetFolderID *uuid.UUID     `json:"targetFolderId,omitempty"` // nil means workspace root
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty" binding:"omitempty,oneof=rename skip overwrite"`
+}
+
+// MoveFolderRequest represents request for moving a folder with its subtree
+type MoveFolderRequest struct {
+	TargetFolderID *uuid.UUID     `json:"targetFolderId,omitempty"` // nil means workspace root
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty" binding:"omitempty,oneof=rename skip overwrite"`
+}
+
+// FolderTransferResponse represents the result of a folder copy or move
+type FolderTransferResponse struct {
+	Folder        *FolderResponse `json:"folder,omitempty"` // nil when skipped
+	Skipped       bool            `json:"skipped"`
+	FoldersCopied int             `json:"foldersCopied,omitempty"`
+	FilesCopied   int             `json:"filesCopied,omitempty"`
+}
+
 // FolderResponse represents folder data returned to client
 type FolderResponse struct {
 	ID          uuid.UUID        `json:"id"`
@@ -76,6 +113,8 @@ type FolderResponse struct {
 	FileCount   int64            `json:"fileCount"`
 	FolderCount int64            `json:"folderCount"`
 	TotalSize   int64            `json:"totalSize"` // Total size in bytes
+	Tags        []TagResponse    `json:"tags,omitempty"`
+	IsStarred   bool             `json:"isStarred,omitempty"`
 }
 
 // ToResponse converts Folder to FolderResponse
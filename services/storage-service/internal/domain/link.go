@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxLinkFolderDepth limits the folder path that is created for linked objects
+const MaxLinkFolderDepth = 5
+
+// FileSource identifies the service that owns the S3 object of a file
+type FileSource string
+
+const (
+	FileSourceStorage FileSource = ""      // Uploaded through storage-service
+	FileSourceBoard   FileSource = "board" // Board, project and comment attachments
+	FileSourceChat    FileSource = "chat"  // Chat message attachments
+)
+
+// KeyPrefix returns the S3 key prefix owned by an external source
+func (s FileSource) KeyPrefix() (string, bool) {
+	switch s {
+	case FileSourceBoard:
+		return "board/", true
+	case FileSourceChat:
+		return "chat/", true
+	default:
+		return "", false
+	}
+}
+
+// OwnsKey reports whether an S3 key lives under the prefix of an external source
+func (s FileSource) OwnsKey(fileKey string) bool {
+	prefix, ok := s.KeyPrefix()
+	if !ok || !strings.HasPrefix(fileKey, prefix) || len(fileKey) == len(prefix) {
+		return false
+	}
+	return !strings.Contains(fileKey, "..")
+}
+
+// IsLinked returns true if the S3 object is owned by another service.
+// Linked objects are never deleted by storage-service.
+func (f *File) IsLinked() bool {
+	return f.Source != FileSourceStorage
+}
+
+// LinkObjectRequest registers an object uploaded by another service as a file
+type LinkObjectRequest struct {
+	WorkspaceID uuid.UUID  `json:"workspaceId" binding:"required"`
+	ProjectID   *uuid.UUID `json:"projectId,omitempty"` // Storage project; ignored when the folder belongs to a project
+	// SourceProjectID files the object under the private storage project mirroring a project of the
+	// source service (e.g. a board project), created on first use. It takes precedence over ProjectID.
+	SourceProjectID   *uuid.UUID `json:"sourceProjectId,omitempty"`
+	SourceProjectName string     `json:"sourceProjectName,omitempty" binding:"max=255"`
+	FolderID          *uuid.UUID `json:"folderId,omitempty"`
+	FolderPath        []string   `json:"folderPath,omitempty" binding:"omitempty,max=5,dive,min=1,max=255"` // Folder names from the root, created when missing
+	Source            FileSource `json:"source" binding:"required"`
+	FileKey           string     `json:"fileKey" binding:"required,max=512"`
+	FileName          string     `json:"fileName" binding:"required,max=255"`
+	FileSize          int64      `json:"fileSize" binding:"required,min=1"`
+	ContentType       string     `json:"contentType" binding:"required"`
+	UploadedBy        uuid.UUID  `json:"uploadedBy" binding:"required"`
+}
+
+// UnlinkObjectRequest removes the file registered for an externally owned object
+type UnlinkObjectRequest struct {
+	Source  FileSource `json:"source" binding:"required"`
+	FileKey string     `json:"fileKey" binding:"required"`
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InitiateMultipartUploadRequest represents request for starting a resumable multipart upload
+type InitiateMultipartUploadRequest struct {
+	WorkspaceID uuid.UUID  `json:"workspaceId" binding:"required"`
+	ProjectID   *uuid.UUID `json:"projectId,omitempty"`
+	FolderID    *uuid.UUID `json:"folderId,omitempty"`
+	FileName    string     `json:"fileName" binding:"required"`
+	ContentType string     `json:"contentType" binding:"required"`
+	FileSize    int64      `json:"fileSize" binding:"required,min=1"`
+}
+
+// InitiateMultipartUploadResponse represents response for a started multipart upload
+type InitiateMultipartUploadResponse struct {
+	FileID    uuid.UUID `json:"fileId"`
+	FileKey   string    `json:"fileKey"`
+	UploadID  string    `json:"uploadId"`
+	PartSize  int64     `json:"partSize"`  // Every part except the last must be exactly this size
+	PartCount int       `json:"partCount"` // Number of parts the client must upload
+}
+
+// MultipartPartURLResponse represents a presigned URL for uploading one part
+type MultipartPartURLResponse struct {
+	PartNumber int       `json:"partNumber"`
+	UploadURL  string    `json:"uploadUrl"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// MultipartPart represents a part already uploaded to storage
+type MultipartPart struct {
+	PartNumber int    `json:"partNumber" binding:"required,min=1"`
+	ETag       string `json:"etag" binding:"required"`
+	Size       int64  `json:"size,omitempty"`
+}
+
+// MultipartUploadStatusResponse lists uploaded parts so a client can resume
+type MultipartUploadStatusResponse struct {
+	FileID        uuid.UUID       `json:"fileId"`
+	UploadID      string          `json:"uploadId"`
+	PartSize      int64           `json:"partSize"`
+	PartCount     int             `json:"partCount"`
+	UploadedParts []MultipartPart `json:"uploadedParts"`
+	MissingParts  []int           `json:"missingParts"`
+	UploadedBytes int64           `json:"uploadedBytes"`
+}
+
+// CompleteMultipartUploadRequest represents request for completing a multipart upload.
+// If Parts is empty, the uploaded parts are read back from storage.
+type CompleteMultipartUploadRequest struct {
+	Parts []MultipartPart `json:"parts,omitempty" binding:"omitempty,dive"`
+}
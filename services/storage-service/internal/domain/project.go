@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 )
 
 // ProjectPermission represents the permission level for a project member
@@ -21,8 +23,10 @@ type Project struct {
 	WorkspaceID       uuid.UUID         `gorm:"type:uuid;not null;index" json:"workspaceId"`
 	Name              string            `gorm:"size:255;not null" json:"name"`
 	Description       *string           `gorm:"size:1024" json:"description,omitempty"`
-	DefaultPermission ProjectPermission `gorm:"size:20;not null;default:'VIEWER'" json:"defaultPermission"` // Default permission for workspace members
-	IsPublic          bool              `gorm:"not null;default:false" json:"isPublic"`                     // If true, all workspace members can access with default permission
+	DefaultPermission ProjectPermission `gorm:"size:20;not null;default:'VIEWER'" json:"defaultPermission"`                                  // Default permission for workspace members
+	IsPublic          bool              `gorm:"not null;default:false" json:"isPublic"`                                                      // If true, all workspace members can access with default permission
+	Source            FileSource        `gorm:"size:20;not null;default:'';uniqueIndex:idx_storage_projects_source" json:"source,omitempty"` // Service that created the project; empty for storage projects
+	SourceProjectID   *uuid.UUID        `gorm:"type:uuid;uniqueIndex:idx_storage_projects_source" json:"sourceProjectId,omitempty"`          // Project of the source service this project mirrors
 	CreatedBy         uuid.UUID         `gorm:"type:uuid;not null" json:"createdBy"`
 	CreatedAt         time.Time         `gorm:"not null" json:"createdAt"`
 	UpdatedAt         time.Time         `gorm:"not null" json:"updatedAt"`
@@ -63,19 +67,37 @@ func (ProjectMember) TableName() string {
 	return "storage_project_members"
 }
 
+// Has reports whether the permission level grants perm, using the built-in
+// storage roles of the shared permission model
+func (p ProjectPermission) Has(perm permission.Permission) bool {
+	return permission.RoleHas(permission.ScopeStorage, string(p), perm)
+}
+
 // CanView returns true if the permission allows viewing
 func (p ProjectPermission) CanView() bool {
-	return p == ProjectPermissionOwner || p == ProjectPermissionEditor || p == ProjectPermissionViewer
+	return p.Has(permission.StorageRead)
 }
 
 // CanEdit returns true if the permission allows editing
 func (p ProjectPermission) CanEdit() bool {
-	return p == ProjectPermissionOwner || p == ProjectPermissionEditor
+	return p.Has(permission.StorageWrite)
 }
 
 // CanManage returns true if the permission allows managing (owner only)
 func (p ProjectPermission) CanManage() bool {
-	return p == ProjectPermissionOwner
+	return p.Has(permission.StorageManage)
+}
+
+// StoragePermission returns the storage permission a required permission level stands for
+func (p ProjectPermission) StoragePermission() permission.Permission {
+	switch p {
+	case ProjectPermissionOwner:
+		return permission.StorageManage
+	case ProjectPermissionEditor:
+		return permission.StorageWrite
+	default:
+		return permission.StorageRead
+	}
 }
 
 // IsValid returns true if the permission is a valid value
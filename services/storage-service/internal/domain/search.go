@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileTypeFamily groups content types for search filters
+type FileTypeFamily string
+
+const (
+	FileTypeImage    FileTypeFamily = "image"
+	FileTypeVideo    FileTypeFamily = "video"
+	FileTypeAudio    FileTypeFamily = "audio"
+	FileTypeDocument FileTypeFamily = "document"
+	FileTypeArchive  FileTypeFamily = "archive"
+)
+
+// ContentTypeMatch describes how a type family matches stored content types
+type ContentTypeMatch struct {
+	Prefixes []string // e.g. "image/"
+	Types    []string // Exact content types
+}
+
+// fileTypeFamilies maps each family to the content types it covers
+var fileTypeFamilies = map[FileTypeFamily]ContentTypeMatch{
+	FileTypeImage: {Prefixes: []string{"image/"}},
+	FileTypeVideo: {Prefixes: []string{"video/"}},
+	FileTypeAudio: {Prefixes: []string{"audio/"}},
+	FileTypeDocument: {
+		Prefixes: []string{"text/", "application/vnd.openxmlformats-officedocument.", "application/vnd.oasis.opendocument.", "application/vnd.ms-"},
+		Types:    []string{"application/pdf", "application/msword", "application/rtf"},
+	},
+	FileTypeArchive: {
+		Types: []string{
+			"application/zip", "application/x-zip-compressed", "application/gzip", "application/x-gzip",
+			"application/x-tar", "application/x-7z-compressed", "application/x-rar-compressed", "application/vnd.rar",
+		},
+	},
+}
+
+// ContentTypes returns the content type match of a family and whether the family is known
+func (f FileTypeFamily) ContentTypes() (ContentTypeMatch, bool) {
+	m, ok := fileTypeFamilies[f]
+	return m, ok
+}
+
+// FileSearchSort is the field search results are ordered by
+type FileSearchSort string
+
+const (
+	FileSearchSortName      FileSearchSort = "name"
+	FileSearchSortSize      FileSearchSort = "size"
+	FileSearchSortCreatedAt FileSearchSort = "createdAt"
+	FileSearchSortUpdatedAt FileSearchSort = "updatedAt"
+)
+
+// Valid returns true if the sort field is supported
+func (s FileSearchSort) Valid() bool {
+	switch s {
+	case FileSearchSortName, FileSearchSortSize, FileSearchSortCreatedAt, FileSearchSortUpdatedAt:
+		return true
+	}
+	return false
+}
+
+// FileSearchFilter holds the criteria of an advanced file search.
+// Empty fields are not applied.
+type FileSearchFilter struct {
+	WorkspaceID   uuid.UUID
+	Query         string         // Matches name or original name
+	TagIDs        []uuid.UUID    // File must have every tag
+	TypeFamily    FileTypeFamily // Content type family
+	UploadedBy    *uuid.UUID
+	MinSize       *int64
+	MaxSize       *int64
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	ProjectID     *uuid.UUID
+	FolderID      *uuid.UUID // Folder subtree, including nested folders
+	FolderPath    string     // Resolved from FolderID by the service
+	Sort          FileSearchSort
+	Descending    bool
+	Page          int
+	PageSize      int
+}
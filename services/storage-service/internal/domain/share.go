@@ -33,6 +33,10 @@ type FileShare struct {
 	ShareLink       *string         `gorm:"size:128;uniqueIndex" json:"shareLink,omitempty"` // Unique share link token
 	LinkExpiresAt   *time.Time      `json:"linkExpiresAt,omitempty"`                          // Expiration for share link
 	IsPublic        bool            `gorm:"not null;default:false" json:"isPublic"`          // Anyone with link can access
+	PasswordHash    *string         `gorm:"size:255" json:"-"`                               // bcrypt hash, nil means no password
+	MaxDownloads    *int            `json:"maxDownloads,omitempty"`                          // nil means unlimited
+	DownloadCount   int             `gorm:"not null;default:0" json:"downloadCount"`
+	AllowDownload   bool            `gorm:"not null;default:true" json:"allowDownload"`      // false = preview only
 	CreatedAt       time.Time       `gorm:"not null" json:"createdAt"`
 	UpdatedAt       time.Time       `gorm:"not null" json:"updatedAt"`
 
@@ -53,6 +57,16 @@ func (s *FileShare) IsExpired() bool {
 	return time.Now().After(*s.LinkExpiresAt)
 }
 
+// HasPassword returns true if the share link is password protected
+func (s *FileShare) HasPassword() bool {
+	return s.PasswordHash != nil && *s.PasswordHash != ""
+}
+
+// DownloadLimitReached returns true if the share link has no downloads left
+func (s *FileShare) DownloadLimitReached() bool {
+	return s.MaxDownloads != nil && s.DownloadCount >= *s.MaxDownloads
+}
+
 // FolderShare represents a sharing configuration for a folder
 type FolderShare struct {
 	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
@@ -64,6 +78,10 @@ type FolderShare struct {
 	LinkExpiresAt   *time.Time      `json:"linkExpiresAt,omitempty"`
 	IsPublic        bool            `gorm:"not null;default:false" json:"isPublic"`
 	IncludeChildren bool            `gorm:"not null;default:true" json:"includeChildren"` // Share includes subfolders
+	PasswordHash    *string         `gorm:"size:255" json:"-"`
+	MaxDownloads    *int            `json:"maxDownloads,omitempty"`
+	DownloadCount   int             `gorm:"not null;default:0" json:"downloadCount"`
+	AllowDownload   bool            `gorm:"not null;default:true" json:"allowDownload"`
 	CreatedAt       time.Time       `gorm:"not null" json:"createdAt"`
 	UpdatedAt       time.Time       `gorm:"not null" json:"updatedAt"`
 
@@ -84,6 +102,16 @@ func (s *FolderShare) IsExpired() bool {
 	return time.Now().After(*s.LinkExpiresAt)
 }
 
+// HasPassword returns true if the share link is password protected
+func (s *FolderShare) HasPassword() bool {
+	return s.PasswordHash != nil && *s.PasswordHash != ""
+}
+
+// DownloadLimitReached returns true if the share link has no downloads left
+func (s *FolderShare) DownloadLimitReached() bool {
+	return s.MaxDownloads != nil && s.DownloadCount >= *s.MaxDownloads
+}
+
 // CreateShareRequest represents request for creating a share
 type CreateShareRequest struct {
 	EntityType      ShareType       `json:"entityType" binding:"required"`      // FILE or FOLDER
@@ -93,12 +121,18 @@ type CreateShareRequest struct {
 	IsPublic        bool            `json:"isPublic"`                           // Create public link
 	ExpiresInDays   *int            `json:"expiresInDays,omitempty"`            // Expiration in days (nil = never)
 	IncludeChildren *bool           `json:"includeChildren,omitempty"`          // For folder shares
+	Password        *string         `json:"password,omitempty" binding:"omitempty,min=4,max=72"` // Optional link password
+	MaxDownloads    *int            `json:"maxDownloads,omitempty" binding:"omitempty,min=1"`    // nil = unlimited
+	AllowDownload   *bool           `json:"allowDownload,omitempty"`                             // false = preview only (default true)
 }
 
 // UpdateShareRequest represents request for updating a share
 type UpdateShareRequest struct {
 	Permission    *PermissionLevel `json:"permission,omitempty"`
 	ExpiresInDays *int             `json:"expiresInDays,omitempty"` // nil to remove expiration
+	Password      *string          `json:"password,omitempty" binding:"omitempty,max=72"` // "" removes the password
+	MaxDownloads  *int             `json:"maxDownloads,omitempty" binding:"omitempty,min=0"` // 0 removes the limit
+	AllowDownload *bool            `json:"allowDownload,omitempty"`
 }
 
 // ShareResponse represents share data returned to client
@@ -118,6 +152,10 @@ type ShareResponse struct {
 	IsPublic        bool            `json:"isPublic"`
 	IsExpired       bool            `json:"isExpired"`
 	IncludeChildren bool            `json:"includeChildren,omitempty"`
+	HasPassword     bool            `json:"hasPassword"`
+	MaxDownloads    *int            `json:"maxDownloads,omitempty"`
+	DownloadCount   int             `json:"downloadCount"`
+	AllowDownload   bool            `json:"allowDownload"`
 	CreatedAt       time.Time       `json:"createdAt"`
 	UpdatedAt       time.Time       `json:"updatedAt"`
 }
@@ -141,3 +179,92 @@ type SharedWithMeResponse struct {
 	PageSize   int          `json:"pageSize"`
 	TotalPages int          `json:"totalPages"`
 }
+
+// ShareAccessAction represents what happened when a share link was accessed
+type ShareAccessAction string
+
+const (
+	ShareAccessResolve        ShareAccessAction = "RESOLVE"         // Link metadata viewed
+	ShareAccessBrowse         ShareAccessAction = "BROWSE"          // Shared folder contents listed
+	ShareAccessPreview        ShareAccessAction = "PREVIEW"         // Inline preview URL issued
+	ShareAccessDownload       ShareAccessAction = "DOWNLOAD"        // Download URL issued
+	ShareAccessPasswordFailed ShareAccessAction = "PASSWORD_FAILED" // Missing or wrong password
+	ShareAccessDenied         ShareAccessAction = "DENIED"          // Expired, limit reached or download disabled
+)
+
+// ShareAccessLog records a single access to a public share link
+type ShareAccessLog struct {
+	ID         uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ShareID    uuid.UUID         `gorm:"type:uuid;not null;index:idx_share_access_logs_share,priority:1" json:"shareId"`
+	ShareType  ShareType         `gorm:"size:20;not null" json:"shareType"`
+	Action     ShareAccessAction `gorm:"size:20;not null" json:"action"`
+	IPAddress  string            `gorm:"size:64" json:"ipAddress"`
+	UserAgent  string            `gorm:"size:512" json:"userAgent"`
+	AccessedAt time.Time         `gorm:"not null;index:idx_share_access_logs_share,priority:2" json:"accessedAt"`
+}
+
+// TableName returns the table name for ShareAccessLog
+func (ShareAccessLog) TableName() string {
+	return "storage_share_access_logs"
+}
+
+// ShareAccessInfo identifies the client accessing a public share link
+type ShareAccessInfo struct {
+	Password  string
+	IPAddress string
+	UserAgent string
+}
+
+// ShareAccessLogListResponse represents a paginated list of share access logs
+type ShareAccessLogListResponse struct {
+	Logs       []ShareAccessLog `json:"logs"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"pageSize"`
+	TotalPages int              `json:"totalPages"`
+}
+
+// ShareLinkAccessRequest carries the optional link password for public share endpoints
+type ShareLinkAccessRequest struct {
+	Password string `json:"password"`
+}
+
+// ShareLinkURLResponse represents a presigned URL issued through a public share link
+type ShareLinkURLResponse struct {
+	URL                string    `json:"url"`
+	FileName           string    `json:"fileName"`
+	ContentType        string    `json:"contentType"`
+	FileSize           int64     `json:"fileSize"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+	RemainingDownloads *int      `json:"remainingDownloads,omitempty"` // nil = unlimited
+}
+
+// SharedFolderItem is a folder visible through a public folder share link
+type SharedFolderItem struct {
+	ID        uuid.UUID  `json:"id"`
+	ParentID  *uuid.UUID `json:"parentId,omitempty"`
+	Name      string     `json:"name"`
+	Path      string     `json:"path"` // Relative to the shared folder ("/" is the shared folder itself)
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// SharedFileItem is a file visible through a public folder share link
+type SharedFileItem struct {
+	ID          uuid.UUID `json:"id"`
+	FolderID    uuid.UUID `json:"folderId"`
+	Name        string    `json:"name"`
+	Path        string    `json:"path"` // Relative to the shared folder
+	ContentType string    `json:"contentType"`
+	FileSize    int64     `json:"fileSize"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// SharedFolderContentsResponse represents the contents of a folder reached through a public share link
+type SharedFolderContentsResponse struct {
+	ShareID       uuid.UUID          `json:"shareId"`
+	Folder        SharedFolderItem   `json:"folder"`
+	Folders       []SharedFolderItem `json:"folders"`
+	Files         []SharedFileItem   `json:"files"`
+	Recursive     bool               `json:"recursive"`
+	AllowDownload bool               `json:"allowDownload"`
+}
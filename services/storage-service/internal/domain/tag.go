@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tag and recent item limits
+const (
+	MaxTagsPerWorkspace = 500
+	MaxTagsPerItem      = 20
+	MaxRecentItems      = 100 // Per user and workspace
+)
+
+// ItemType distinguishes files from folders for tags, stars and recent items
+type ItemType string
+
+const (
+	ItemTypeFile   ItemType = "FILE"
+	ItemTypeFolder ItemType = "FOLDER"
+)
+
+// Tag represents a user-defined label shared by all members of a workspace
+type Tag struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_storage_tags_workspace_name,priority:1" json:"workspaceId"`
+	Name        string    `gorm:"size:50;not null;uniqueIndex:idx_storage_tags_workspace_name,priority:2" json:"name"` // Stored lowercase
+	Color       *string   `gorm:"size:7" json:"color,omitempty"`                                                       // Hex color code like #FF5733
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for Tag
+func (Tag) TableName() string {
+	return "storage_tags"
+}
+
+// ItemTag attaches a tag to a file or folder
+type ItemTag struct {
+	TagID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"tagId"`
+	ItemType    ItemType  `gorm:"size:20;primaryKey;index:idx_storage_item_tags_item,priority:1" json:"itemType"`
+	ItemID      uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_storage_item_tags_item,priority:2" json:"itemId"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// TableName returns the table name for ItemTag
+func (ItemTag) TableName() string {
+	return "storage_item_tags"
+}
+
+// Star marks a file or folder as a favorite of a single user
+type Star struct {
+	UserID      uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_storage_stars_user_workspace,priority:1" json:"userId"`
+	ItemType    ItemType  `gorm:"size:20;primaryKey" json:"itemType"`
+	ItemID      uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"itemId"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index:idx_storage_stars_user_workspace,priority:2" json:"workspaceId"`
+	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
+}
+
+// TableName returns the table name for Star
+func (Star) TableName() string {
+	return "storage_stars"
+}
+
+// RecentItem records the last time a user opened a file or folder
+type RecentItem struct {
+	UserID      uuid.UUID `gorm:"type:uuid;primaryKey;index:idx_storage_recent_items_user,priority:1" json:"userId"`
+	ItemType    ItemType  `gorm:"size:20;primaryKey" json:"itemType"`
+	ItemID      uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"itemId"`
+	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index:idx_storage_recent_items_user,priority:2" json:"workspaceId"`
+	AccessedAt  time.Time `gorm:"not null;index:idx_storage_recent_items_user,priority:3" json:"accessedAt"`
+}
+
+// TableName returns the table name for RecentItem
+func (RecentItem) TableName() string {
+	return "storage_recent_items"
+}
+
+// CreateTagRequest represents request for creating a tag
+type CreateTagRequest struct {
+	WorkspaceID uuid.UUID `json:"workspaceId" binding:"required"`
+	Name        string    `json:"name" binding:"required,min=1,max=50"`
+	Color       *string   `json:"color,omitempty" binding:"omitempty,hexcolor"`
+}
+
+// UpdateTagRequest represents request for renaming or recoloring a tag
+type UpdateTagRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=1,max=50"`
+	Color *string `json:"color,omitempty" binding:"omitempty,hexcolor"`
+}
+
+// SetItemTagsRequest replaces the tags of a file or folder
+type SetItemTagsRequest struct {
+	TagIDs []uuid.UUID `json:"tagIds"`
+}
+
+// TagResponse represents tag data returned to client
+type TagResponse struct {
+	ID          uuid.UUID `json:"id"`
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	Name        string    `json:"name"`
+	Color       *string   `json:"color,omitempty"`
+	ItemCount   int64     `json:"itemCount"`
+	CreatedBy   uuid.UUID `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// ToResponse converts Tag to TagResponse
+func (t *Tag) ToResponse() TagResponse {
+	return TagResponse{
+		ID:          t.ID,
+		WorkspaceID: t.WorkspaceID,
+		Name:        t.Name,
+		Color:       t.Color,
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// StarredItemsResponse lists the starred files and folders of a user in a workspace
+type StarredItemsResponse struct {
+	Folders []FolderResponse `json:"folders"`
+	Files   []FileResponse   `json:"files"`
+}
+
+// RecentItemResponse is a single recently accessed file or folder
+type RecentItemResponse struct {
+	ItemType   ItemType        `json:"itemType"`
+	AccessedAt time.Time       `json:"accessedAt"`
+	File       *FileResponse   `json:"file,omitempty"`
+	Folder     *FolderResponse `json:"folder,omitempty"`
+}
+
+// RecentItemsResponse lists recently accessed items, most recent first
+type RecentItemsResponse struct {
+	Items []RecentItemResponse `json:"items"`
+}
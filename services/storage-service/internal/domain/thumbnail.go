@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ThumbnailStatus represents the state of a file's thumbnail generation
+type ThumbnailStatus string
+
+const (
+	ThumbnailStatusNone        ThumbnailStatus = ""            // Not an image or never queued
+	ThumbnailStatusPending     ThumbnailStatus = "PENDING"     // Waiting for the worker
+	ThumbnailStatusProcessing  ThumbnailStatus = "PROCESSING"  // Claimed by a worker
+	ThumbnailStatusReady       ThumbnailStatus = "READY"       // Thumbnails stored in S3
+	ThumbnailStatusFailed      ThumbnailStatus = "FAILED"      // Gave up after MaxThumbnailAttempts
+	ThumbnailStatusUnsupported ThumbnailStatus = "UNSUPPORTED" // Format cannot be decoded or image too large
+)
+
+// MaxThumbnailAttempts is the number of tries before a thumbnail is marked FAILED
+const MaxThumbnailAttempts = 3
+
+// ThumbnailSize is a named thumbnail variant bounded by MaxDimension pixels
+type ThumbnailSize struct {
+	Name         string
+	MaxDimension int
+}
+
+// Thumbnail sizes generated for every supported image
+var (
+	ThumbnailSmall = ThumbnailSize{Name: "small", MaxDimension: 256}  // Grid view
+	ThumbnailLarge = ThumbnailSize{Name: "large", MaxDimension: 1024} // Preview pane
+
+	ThumbnailSizes = []ThumbnailSize{ThumbnailSmall, ThumbnailLarge}
+)
+
+// ThumbnailExtensions are the image formats that can be decoded in pure Go
+var ThumbnailExtensions = []string{".jpg", ".jpeg", ".png", ".gif"}
+
+// ThumbnailKey returns the S3 key of a thumbnail derived from the original file key.
+// Thumbnails live outside the "storage/" prefix so reconciliation does not treat them as orphans.
+func ThumbnailKey(fileKey string, size ThumbnailSize) string {
+	return fmt.Sprintf("thumbnails/%s/%s.jpg", size.Name, fileKey)
+}
+
+// SupportsThumbnail returns true if thumbnails can be generated for this file
+func (f *File) SupportsThumbnail() bool {
+	ext := f.GetExtension()
+	for _, e := range ThumbnailExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// InitialThumbnailStatus returns PENDING for images that support thumbnails, otherwise none
+func (f *File) InitialThumbnailStatus() ThumbnailStatus {
+	if f.SupportsThumbnail() {
+		return ThumbnailStatusPending
+	}
+	return ThumbnailStatusNone
+}
+
+// ThumbnailBackfillRequest represents a request for queueing thumbnails of existing files
+type ThumbnailBackfillRequest struct {
+	WorkspaceID *uuid.UUID `json:"workspaceId,omitempty"` // nil = all workspaces
+	RetryFailed bool       `json:"retryFailed"`           // Also re-queue FAILED files
+}
+
+// ThumbnailBackfillResult reports how many files were queued for thumbnail generation
+type ThumbnailBackfillResult struct {
+	Queued int64 `json:"queued"`
+}
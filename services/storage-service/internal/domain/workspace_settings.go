@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTrashRetentionDays is the retention applied when a workspace has no explicit setting
+const DefaultTrashRetentionDays = 30
+
+// WorkspaceStorageSettings holds per-workspace storage policies
+type WorkspaceStorageSettings struct {
+	WorkspaceID        uuid.UUID `gorm:"type:uuid;primaryKey" json:"workspaceId"`
+	TrashRetentionDays int       `gorm:"not null;default:30" json:"trashRetentionDays"` // 0 disables auto-purge
+	UpdatedBy          uuid.UUID `gorm:"type:uuid;not null" json:"updatedBy"`
+	CreatedAt          time.Time `gorm:"not null" json:"createdAt"`
+	UpdatedAt          time.Time `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName returns the table name for WorkspaceStorageSettings
+func (WorkspaceStorageSettings) TableName() string {
+	return "storage_workspace_settings"
+}
+
+// UpdateWorkspaceStorageSettingsRequest represents request for updating workspace storage settings
+type UpdateWorkspaceStorageSettingsRequest struct {
+	TrashRetentionDays *int `json:"trashRetentionDays,omitempty" binding:"omitempty,min=0,max=3650"`
+}
+
+// WorkspaceStorageSettingsResponse represents workspace storage settings returned to client
+type WorkspaceStorageSettingsResponse struct {
+	WorkspaceID        uuid.UUID `json:"workspaceId"`
+	TrashRetentionDays int       `json:"trashRetentionDays"`
+	IsDefault          bool      `json:"isDefault"` // true if no explicit setting is stored
+}
+
+// PurgeResult summarizes a trash auto-purge run
+type PurgeResult struct {
+	WorkspacesScanned int   `json:"workspacesScanned"`
+	FilesPurged       int   `json:"filesPurged"`
+	FoldersPurged     int   `json:"foldersPurged"`
+	BytesFreed        int64 `json:"bytesFreed"`
+	Failed            int   `json:"failed"`
+}
+
+// ReconcileResult summarizes a storage reconciliation run for one workspace
+type ReconcileResult struct {
+	WorkspaceID    uuid.UUID `json:"workspaceId"`
+	ObjectsScanned int       `json:"objectsScanned"`
+	RowsScanned    int       `json:"rowsScanned"`
+	OrphanObjects  []string  `json:"orphanObjects"`  // S3 objects with no DB row
+	MissingObjects []string  `json:"missingObjects"` // DB rows whose S3 object is gone
+	OrphansDeleted int       `json:"orphansDeleted"`
+	LinkedRemoved  int       `json:"linkedRemoved"` // Linked files whose object was deleted by the owning service
+}
+
+// WorkspacePurgeResult summarizes the hard purge of a deleted workspace
+type WorkspacePurgeResult struct {
+	WorkspaceID    uuid.UUID `json:"workspaceId"`
+	FilesDeleted   int64     `json:"filesDeleted"`
+	ObjectsDeleted int       `json:"objectsDeleted"`
+}
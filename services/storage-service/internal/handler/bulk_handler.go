@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// BulkHandler handles bulk file/folder operations and ZIP archive downloads
+type BulkHandler struct {
+	bulkService    *service.BulkService
+	archiveService *service.ArchiveService
+	logger         *zap.Logger
+}
+
+// NewBulkHandler creates a new BulkHandler
+func NewBulkHandler(bulkService *service.BulkService, archiveService *service.ArchiveService, logger *zap.Logger) *BulkHandler {
+	return &BulkHandler{
+		bulkService:    bulkService,
+		archiveService: archiveService,
+		logger:         logger,
+	}
+}
+
+// BulkMove godoc
+// @Summary Move files and folders
+// @Description Moves a mixed set of files and folders into a destination folder (root if omitted)
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param request body domain.BulkTransferRequest true "Bulk move request"
+// @Success 200 {object} domain.BulkOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/bulk/move [post]
+func (h *BulkHandler) BulkMove(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.BulkTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	result, err := h.bulkService.Move(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// BulkCopy godoc
+// @Summary Copy files and folders
+// @Description Copies a mixed set of files and folders (including S3 objects) into a destination folder
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param request body domain.BulkTransferRequest true "Bulk copy request"
+// @Success 200 {object} domain.BulkOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/bulk/copy [post]
+func (h *BulkHandler) BulkCopy(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.BulkTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	result, err := h.bulkService.Copy(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// BulkTrash godoc
+// @Summary Move files and folders to trash
+// @Description Moves a mixed set of files and folders to trash
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param request body domain.BulkItemsRequest true "Bulk trash request"
+// @Success 200 {object} domain.BulkOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/bulk/trash [post]
+func (h *BulkHandler) BulkTrash(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.BulkItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	result, err := h.bulkService.Trash(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// BulkRestore godoc
+// @Summary Restore files and folders from trash
+// @Description Restores a mixed set of files and folders from trash
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param request body domain.BulkItemsRequest true "Bulk restore request"
+// @Success 200 {object} domain.BulkOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/bulk/restore [post]
+func (h *BulkHandler) BulkRestore(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.BulkItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	result, err := h.bulkService.Restore(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// BulkPermanentDelete godoc
+// @Summary Permanently delete files and folders
+// @Description Permanently deletes a mixed set of files and folders (cannot be undone)
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param request body domain.BulkItemsRequest true "Bulk delete request"
+// @Success 200 {object} domain.BulkOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/bulk/delete [post]
+func (h *BulkHandler) BulkPermanentDelete(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.BulkItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	result, err := h.bulkService.PermanentDelete(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// DownloadArchive godoc
+// @Summary Download files and folders as ZIP
+// @Description Streams a ZIP archive of selected files and folders, preserving folder hierarchy
+// @Tags bulk
+// @Accept json
+// @Produce application/zip
+// @Param request body domain.ArchiveRequest true "Archive request"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/archive [post]
+func (h *BulkHandler) DownloadArchive(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.ArchiveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// 스트리밍 시작 전에 모든 검증을 완료
+	plan, err := h.archiveService.PrepareArchive(c.Request.Context(), req, userID, c.GetString("jwtToken"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	streamArchive(c, h.archiveService, plan, h.logger)
+}
+
+// streamArchive writes an archive plan to the response as a ZIP download
+func streamArchive(c *gin.Context, archiveService *service.ArchiveService, plan *service.ArchivePlan, logger *zap.Logger) {
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(plan.Name)))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	// 헤더 전송 이후에는 에러 응답을 보낼 수 없으므로 로그만 남기고 연결 종료
+	if err := archiveService.WriteArchive(c.Request.Context(), c.Writer, plan); err != nil {
+		if logger != nil {
+			logger.Error("Failed to stream archive",
+				zap.String("archive", plan.Name),
+				zap.Error(err),
+			)
+		}
+		c.Abort()
+	}
+}
@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"storage-service/internal/domain"
 	"storage-service/internal/service"
@@ -12,15 +16,24 @@ import (
 
 // FileHandler handles file HTTP requests
 type FileHandler struct {
-	fileService   *service.FileService
-	accessService service.AccessService
+	fileService     *service.FileService
+	accessService   service.AccessService
+	tagService      *service.TagService
+	userItemService *service.UserItemService
 }
 
 // NewFileHandler creates a new FileHandler
-func NewFileHandler(fileService *service.FileService, accessService service.AccessService) *FileHandler {
+func NewFileHandler(
+	fileService *service.FileService,
+	accessService service.AccessService,
+	tagService *service.TagService,
+	userItemService *service.UserItemService,
+) *FileHandler {
 	return &FileHandler{
-		fileService:   fileService,
-		accessService: accessService,
+		fileService:     fileService,
+		accessService:   accessService,
+		tagService:      tagService,
+		userItemService: userItemService,
 	}
 }
 
@@ -143,6 +156,10 @@ func (h *FileHandler) GetFile(c *gin.Context) {
 		}
 	}
 
+	if h.userItemService != nil {
+		h.userItemService.RecordAccess(c.Request.Context(), userID, domain.ItemTypeFile, file.ID, file.WorkspaceID)
+	}
+
 	respondWithData(c, http.StatusOK, file.ToResponse(h.fileService.GetFileURL(file.FileKey)))
 }
 
@@ -389,11 +406,22 @@ func (h *FileHandler) GetTrashFiles(c *gin.Context) {
 
 // SearchFiles godoc
 // @Summary Search files
-// @Description Searches files by name in workspace
+// @Description Searches active files in a workspace by name, tags, type, uploader, size, date, project and folder subtree
 // @Tags files
 // @Produce json
 // @Param workspaceId path string true "Workspace ID"
-// @Param q query string true "Search query"
+// @Param q query string false "Name search query"
+// @Param tagIds query string false "Comma-separated tag IDs (file must have all tags)"
+// @Param type query string false "Content type family (image, video, audio, document, archive)"
+// @Param uploadedBy query string false "Uploader user ID"
+// @Param minSize query int false "Minimum size in bytes"
+// @Param maxSize query int false "Maximum size in bytes"
+// @Param createdAfter query string false "Created at or after (RFC3339 or YYYY-MM-DD)"
+// @Param createdBefore query string false "Created before (RFC3339, or YYYY-MM-DD inclusive)"
+// @Param projectId query string false "Project ID"
+// @Param folderId query string false "Folder ID (includes nested folders)"
+// @Param sort query string false "Sort field (name, size, createdAt, updatedAt; default createdAt)"
+// @Param order query string false "Sort order (asc, desc; default asc for name, desc otherwise)"
 // @Param page query int false "Page number (default 1)"
 // @Param pageSize query int false "Page size (default 20, max 100)"
 // @Success 200 {object} domain.FileListResponse
@@ -424,22 +452,136 @@ func (h *FileHandler) SearchFiles(c *gin.Context) {
 		}
 	}
 
-	query := c.Query("q")
-	if query == "" {
-		handleBadRequest(c, "Search query is required")
+	filter, err := parseFileSearchFilter(c)
+	if err != nil {
+		handleBadRequest(c, err.Error())
 		return
 	}
+	filter.WorkspaceID = workspaceID
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+	// 프로젝트 필터는 프로젝트 조회 권한 필요
+	if filter.ProjectID != nil && h.accessService != nil {
+		if err := h.accessService.ValidateProjectAccess(c.Request.Context(), *filter.ProjectID, userID, token, domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
 
-	response, err := h.fileService.SearchFiles(c.Request.Context(), workspaceID, query, page, pageSize)
+	result, err := h.fileService.SearchFiles(c.Request.Context(), filter)
 	if err != nil {
-		handleInternalError(c, err.Error())
+		handleServiceError(c, err)
 		return
 	}
 
-	respondWithData(c, http.StatusOK, response)
+	if h.tagService != nil {
+		if err := h.tagService.AttachFileTags(c.Request.Context(), result.Files); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+	if h.userItemService != nil {
+		if err := h.userItemService.MarkStarredFiles(c.Request.Context(), userID, result.Files); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// parseFileSearchFilter reads search filters from query parameters
+func parseFileSearchFilter(c *gin.Context) (domain.FileSearchFilter, error) {
+	filter := domain.FileSearchFilter{
+		Query:      strings.TrimSpace(c.Query("q")),
+		TypeFamily: domain.FileTypeFamily(c.Query("type")),
+		Sort:       domain.FileSearchSort(c.Query("sort")),
+	}
+	filter.Page, _ = strconv.Atoi(c.DefaultQuery("page", "1"))
+	filter.PageSize, _ = strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	if v := c.Query("tagIds"); v != "" {
+		for _, part := range strings.Split(v, ",") {
+			id, err := parseUUID(strings.TrimSpace(part))
+			if err != nil {
+				return filter, fmt.Errorf("invalid tag ID: %s", part)
+			}
+			filter.TagIDs = append(filter.TagIDs, id)
+		}
+	}
+
+	var err error
+	if filter.UploadedBy, err = optionalUUIDQuery(c, "uploadedBy"); err != nil {
+		return filter, err
+	}
+	if filter.ProjectID, err = optionalUUIDQuery(c, "projectId"); err != nil {
+		return filter, err
+	}
+	if filter.FolderID, err = optionalUUIDQuery(c, "folderId"); err != nil {
+		return filter, err
+	}
+
+	for key, dst := range map[string]**int64{"minSize": &filter.MinSize, "maxSize": &filter.MaxSize} {
+		if v := c.Query(key); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s", key)
+			}
+			*dst = &n
+		}
+	}
+
+	if filter.CreatedAfter, err = optionalTimeQuery(c, "createdAfter", false); err != nil {
+		return filter, err
+	}
+	if filter.CreatedBefore, err = optionalTimeQuery(c, "createdBefore", true); err != nil {
+		return filter, err
+	}
+
+	switch c.Query("order") {
+	case "":
+		filter.Descending = filter.Sort != domain.FileSearchSortName
+	case "asc":
+		filter.Descending = false
+	case "desc":
+		filter.Descending = true
+	default:
+		return filter, fmt.Errorf("invalid order: must be asc or desc")
+	}
+
+	return filter, nil
+}
+
+// optionalUUIDQuery parses an optional UUID query parameter
+func optionalUUIDQuery(c *gin.Context, key string) (*uuid.UUID, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	id, err := parseUUID(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", key)
+	}
+	return &id, nil
+}
+
+// optionalTimeQuery parses an optional RFC3339 or YYYY-MM-DD query parameter.
+// With endOfDay, a date-only value covers the whole day.
+func optionalTimeQuery(c *gin.Context, key string, endOfDay bool) (*time.Time, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: use RFC3339 or YYYY-MM-DD", key)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
 }
 
 // GetStorageUsage godoc
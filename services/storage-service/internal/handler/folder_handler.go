@@ -12,17 +12,27 @@ import (
 
 // FolderHandler handles folder HTTP requests
 type FolderHandler struct {
-	folderService *service.FolderService
-	fileService   *service.FileService
-	accessService service.AccessService
+	folderService   *service.FolderService
+	fileService     *service.FileService
+	accessService   service.AccessService
+	tagService      *service.TagService
+	userItemService *service.UserItemService
 }
 
 // NewFolderHandler creates a new FolderHandler
-func NewFolderHandler(folderService *service.FolderService, fileService *service.FileService, accessService service.AccessService) *FolderHandler {
+func NewFolderHandler(
+	folderService *service.FolderService,
+	fileService *service.FileService,
+	accessService service.AccessService,
+	tagService *service.TagService,
+	userItemService *service.UserItemService,
+) *FolderHandler {
 	return &FolderHandler{
-		folderService: folderService,
-		fileService:   fileService,
-		accessService: accessService,
+		folderService:   folderService,
+		fileService:     fileService,
+		accessService:   accessService,
+		tagService:      tagService,
+		userItemService: userItemService,
 	}
 }
 
@@ -110,6 +120,10 @@ func (h *FolderHandler) GetFolder(c *gin.Context) {
 		}
 	}
 
+	if h.userItemService != nil {
+		h.userItemService.RecordAccess(c.Request.Context(), userID, domain.ItemTypeFolder, folder.ID, folder.WorkspaceID)
+	}
+
 	respondWithData(c, http.StatusOK, folder.ToResponse())
 }
 
@@ -180,7 +194,42 @@ func (h *FolderHandler) GetFolderContents(c *gin.Context) {
 
 	// Fill in file URLs
 	for i := range response.Files {
-		response.Files[i].FileURL = h.fileService.GetFileURL(response.Files[i].FileURL)
+		file := &response.Files[i]
+		file.FileURL = h.fileService.GetFileURL(file.FileURL)
+		// 썸네일 URL도 키 기준으로 채워져 있으므로 함께 변환
+		if file.ThumbnailURL != nil {
+			thumbnailURL := h.fileService.GetFileURL(*file.ThumbnailURL)
+			file.ThumbnailURL = &thumbnailURL
+		}
+		if file.PreviewURL != nil {
+			previewURL := h.fileService.GetFileURL(*file.PreviewURL)
+			file.PreviewURL = &previewURL
+		}
+	}
+
+	// 태그와 즐겨찾기 표시
+	if h.tagService != nil {
+		if err := h.tagService.AttachFolderTags(c.Request.Context(), response.Children); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		if err := h.tagService.AttachFileTags(c.Request.Context(), response.Files); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+	if h.userItemService != nil {
+		if err := h.userItemService.MarkStarredFolders(c.Request.Context(), userID, response.Children); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		if err := h.userItemService.MarkStarredFiles(c.Request.Context(), userID, response.Files); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		if folderID != nil {
+			h.userItemService.RecordAccess(c.Request.Context(), userID, domain.ItemTypeFolder, *folderID, workspaceID)
+		}
 	}
 
 	respondWithData(c, http.StatusOK, response)
@@ -277,6 +326,12 @@ func (h *FolderHandler) UpdateFolder(c *gin.Context) {
 		return
 	}
 
+	// 이동 시 대상 폴더 권한도 확인 (프로젝트 간 이동)
+	if err := h.validateDestinationAccess(c, req.ParentID, userID, token); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
 	folder, err := h.folderService.UpdateFolder(c.Request.Context(), folderID, req, userID)
 	if err != nil {
 		handleBadRequest(c, err.Error())
@@ -286,6 +341,130 @@ func (h *FolderHandler) UpdateFolder(c *gin.Context) {
 	respondWithData(c, http.StatusOK, folder.ToResponse())
 }
 
+// MoveFolder godoc
+// @Summary Move a folder
+// @Description Moves a folder with its subtree into another folder (root if omitted), re-checking permission on the destination
+// @Tags folders
+// @Accept json
+// @Produce json
+// @Param folderId path string true "Folder ID"
+// @Param request body domain.MoveFolderRequest true "Move folder request"
+// @Success 200 {object} domain.FolderTransferResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/folders/{folderId}/move [post]
+func (h *FolderHandler) MoveFolder(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	folderID, err := parseUUID(c.Param("folderId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid folder ID")
+		return
+	}
+
+	var req domain.MoveFolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Validate access (editor on both source and destination)
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFolderAccess(c.Request.Context(), folderID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+	if err := h.validateDestinationAccess(c, req.TargetFolderID, userID, token); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	result, err := h.folderService.MoveFolder(c.Request.Context(), folderID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// CopyFolder godoc
+// @Summary Copy a folder
+// @Description Deep-copies a folder subtree including stored files into another folder (root if omitted)
+// @Tags folders
+// @Accept json
+// @Produce json
+// @Param folderId path string true "Folder ID"
+// @Param request body domain.CopyFolderRequest true "Copy folder request"
+// @Success 201 {object} domain.FolderTransferResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/folders/{folderId}/copy [post]
+func (h *FolderHandler) CopyFolder(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	folderID, err := parseUUID(c.Param("folderId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid folder ID")
+		return
+	}
+
+	var req domain.CopyFolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Validate access (viewer on source, editor on destination)
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFolderAccess(c.Request.Context(), folderID, userID, token, domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+	if err := h.validateDestinationAccess(c, req.TargetFolderID, userID, token); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	result, err := h.folderService.CopyFolder(c.Request.Context(), folderID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Skipped {
+		status = http.StatusOK
+	}
+	respondWithData(c, status, result)
+}
+
+// validateDestinationAccess checks editor permission on a destination folder (nil or root needs no extra check)
+func (h *FolderHandler) validateDestinationAccess(c *gin.Context, targetID *uuid.UUID, userID uuid.UUID, token string) error {
+	if h.accessService == nil || targetID == nil || *targetID == uuid.Nil {
+		return nil
+	}
+	return h.accessService.ValidateFolderAccess(c.Request.Context(), *targetID, userID, token, domain.ProjectPermissionEditor)
+}
+
 // DeleteFolder godoc
 // @Summary Delete a folder
 // @Description Moves folder to trash (soft delete)
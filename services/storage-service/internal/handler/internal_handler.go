@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// InternalHandler handles service-to-service storage requests
+type InternalHandler struct {
+	linkService  *service.LinkService
+	fileService  *service.FileService
+	purgeService *service.PurgeService
+}
+
+// NewInternalHandler creates a new InternalHandler
+func NewInternalHandler(linkService *service.LinkService, fileService *service.FileService, purgeService *service.PurgeService) *InternalHandler {
+	return &InternalHandler{
+		linkService:  linkService,
+		fileService:  fileService,
+		purgeService: purgeService,
+	}
+}
+
+// LinkObject godoc
+// @Summary Link an existing object
+// @Description Registers an object uploaded by another service (board, chat) as a storage file without copying it.
+// @Description Linking the same object again returns the existing file.
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param request body domain.LinkObjectRequest true "Link request"
+// @Success 201 {object} domain.FileResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security InternalAPIKey
+// @Router /internal/storage/files/link [post]
+func (h *InternalHandler) LinkObject(c *gin.Context) {
+	var req domain.LinkObjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	file, err := h.linkService.LinkObject(c.Request.Context(), req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusCreated, file.ToResponse(h.fileService.GetFileURL(file.FileKey)))
+}
+
+// UnlinkObject godoc
+// @Summary Unlink an object
+// @Description Removes the storage file registered for an object owned by another service. The object itself is kept.
+// @Tags internal
+// @Accept json
+// @Produce json
+// @Param request body domain.UnlinkObjectRequest true "Unlink request"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security InternalAPIKey
+// @Router /internal/storage/files/unlink [post]
+func (h *InternalHandler) UnlinkObject(c *gin.Context) {
+	var req domain.UnlinkObjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.linkService.UnlinkObject(c.Request.Context(), req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Object unlinked", nil)
+}
+
+// PurgeWorkspace godoc
+// @Summary Purge a deleted workspace
+// @Description Permanently deletes all files, folders, shares and S3 objects of a workspace. Idempotent.
+// @Tags internal
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.WorkspacePurgeResult
+// @Failure 400 {object} ErrorResponse
+// @Security InternalAPIKey
+// @Router /internal/purge/workspaces/{workspaceId} [delete]
+func (h *InternalHandler) PurgeWorkspace(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	result, err := h.purgeService.PurgeWorkspace(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// PurgeUser godoc
+// @Summary Purge a deleted account
+// @Description Permanently deletes stars, recent items, project memberships and shares granted to a user. Idempotent.
+// @Tags internal
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Security InternalAPIKey
+// @Router /internal/purge/users/{userId} [delete]
+func (h *InternalHandler) PurgeUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.purgeService.PurgeUser(c.Request.Context(), userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "User storage data purged", nil)
+}
+
+// ExportUserData godoc
+// @Summary Export the files of a user
+// @Description Returns the metadata of all files the user uploaded. Called by the user-service personal data export job.
+// @Tags internal
+// @Produce json
+// @Param userId path string true "User ID"
+// @Success 200 {object} domain.UserFilesExport
+// @Failure 400 {object} ErrorResponse
+// @Security InternalAPIKey
+// @Router /internal/export/users/{userId} [get]
+func (h *InternalHandler) ExportUserData(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid user ID")
+		return
+	}
+
+	result, err := h.fileService.ExportUserFiles(c.Request.Context(), userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
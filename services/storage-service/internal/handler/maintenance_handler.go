@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// MaintenanceHandler handles workspace storage settings HTTP requests
+type MaintenanceHandler struct {
+	maintenanceService *service.MaintenanceService
+	accessService      service.AccessService
+}
+
+// NewMaintenanceHandler creates a new MaintenanceHandler
+func NewMaintenanceHandler(maintenanceService *service.MaintenanceService, accessService service.AccessService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		accessService:      accessService,
+	}
+}
+
+// GetWorkspaceSettings godoc
+// @Summary Get workspace storage settings
+// @Description Gets storage settings (e.g. trash retention) for a workspace
+// @Tags workspaces
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.WorkspaceStorageSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/settings [get]
+func (h *MaintenanceHandler) GetWorkspaceSettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	settings, err := h.maintenanceService.GetWorkspaceSettings(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings)
+}
+
+// UpdateWorkspaceSettings godoc
+// @Summary Update workspace storage settings
+// @Description Updates storage settings for a workspace (owner or admin only). trashRetentionDays=0 disables trash auto-purge.
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.UpdateWorkspaceStorageSettingsRequest true "Update settings request"
+// @Success 200 {object} domain.WorkspaceStorageSettingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/settings [put]
+func (h *MaintenanceHandler) UpdateWorkspaceSettings(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	// 보존 기간 변경은 모든 멤버의 휴지통 영구 삭제로 이어지므로 소유자/관리자만 허용
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAdmin(c.Request.Context(), workspaceID, userID, token); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	var req domain.UpdateWorkspaceStorageSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	settings, err := h.maintenanceService.UpdateWorkspaceSettings(c.Request.Context(), workspaceID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, settings)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/service"
+)
+
+// settingsTestUserClient는 고정된 워크스페이스 접근 결과를 반환합니다.
+type settingsTestUserClient struct {
+	client.UserClient
+	access *commonclient.WorkspaceValidationResponse
+}
+
+func (c *settingsTestUserClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error) {
+	return c.access, nil
+}
+
+func TestMaintenanceHandler_UpdateWorkspaceSettings_RequiresAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		access *commonclient.WorkspaceValidationResponse
+	}{
+		{"member", &commonclient.WorkspaceValidationResponse{IsMember: true, RoleName: "MEMBER"}},
+		{"public workspace visitor", &commonclient.WorkspaceValidationResponse{IsMember: true}},
+		{"guest", &commonclient.WorkspaceValidationResponse{IsGuest: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessService := service.NewAccessService(nil, nil, nil, &settingsTestUserClient{access: tt.access}, nil, zap.NewNop())
+			// 관리자 확인에서 거부되어야 하므로 maintenance service는 호출되지 않음
+			h := NewMaintenanceHandler(nil, accessService)
+
+			router := gin.New()
+			router.PUT("/storage/workspaces/:workspaceId/settings", func(c *gin.Context) {
+				c.Set("user_id", uuid.New())
+				h.UpdateWorkspaceSettings(c)
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/storage/workspaces/"+uuid.New().String()+"/settings",
+				strings.NewReader(`{"trashRetentionDays":1}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/domain"
+)
+
+// InitiateMultipartUpload godoc
+// @Summary Start multipart upload
+// @Description Starts a resumable multipart upload for large files and returns the part layout
+// @Tags files
+// @Accept json
+// @Produce json
+// @Param request body domain.InitiateMultipartUploadRequest true "Multipart upload request"
+// @Success 201 {object} domain.InitiateMultipartUploadResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/multipart [post]
+func (h *FileHandler) InitiateMultipartUpload(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	token := c.GetString("jwtToken")
+
+	var req domain.InitiateMultipartUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Validate access (need editor permission to upload)
+	if h.accessService != nil {
+		if err := h.accessService.ValidateResourceAccess(c.Request.Context(), req.WorkspaceID, req.ProjectID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	resp, err := h.fileService.InitiateMultipartUpload(c.Request.Context(), req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusCreated, resp)
+}
+
+// GetMultipartUploadStatus godoc
+// @Summary Get multipart upload status
+// @Description Lists uploaded and missing parts so an interrupted upload can be resumed
+// @Tags files
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} domain.MultipartUploadStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/multipart [get]
+func (h *FileHandler) GetMultipartUploadStatus(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	status, err := h.fileService.GetMultipartUploadStatus(c.Request.Context(), fileID, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, status)
+}
+
+// GenerateMultipartPartURL godoc
+// @Summary Generate part upload URL
+// @Description Generates a presigned URL for uploading a single part of a multipart upload
+// @Tags files
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param partNumber path int true "Part number (1-based)"
+// @Success 200 {object} domain.MultipartPartURLResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/multipart/parts/{partNumber} [post]
+func (h *FileHandler) GenerateMultipartPartURL(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	partNumber, err := strconv.Atoi(c.Param("partNumber"))
+	if err != nil {
+		handleBadRequest(c, "Invalid part number")
+		return
+	}
+
+	resp, err := h.fileService.GenerateMultipartPartURL(c.Request.Context(), fileID, partNumber, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, resp)
+}
+
+// CompleteMultipartUpload godoc
+// @Summary Complete multipart upload
+// @Description Assembles uploaded parts and activates the file
+// @Tags files
+// @Accept json
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param request body domain.CompleteMultipartUploadRequest false "Uploaded parts (optional)"
+// @Success 200 {object} domain.FileResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/multipart/complete [post]
+func (h *FileHandler) CompleteMultipartUpload(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	// 본문은 선택 사항 (비어 있으면 저장소의 파트 목록 사용)
+	var req domain.CompleteMultipartUploadRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			handleBadRequest(c, "Invalid request body: "+err.Error())
+			return
+		}
+	}
+
+	file, err := h.fileService.CompleteMultipartUpload(c.Request.Context(), fileID, req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, file.ToResponse(h.fileService.GetFileURL(file.FileKey)))
+}
+
+// AbortMultipartUpload godoc
+// @Summary Abort multipart upload
+// @Description Aborts a multipart upload and discards uploaded parts
+// @Tags files
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/multipart [delete]
+func (h *FileHandler) AbortMultipartUpload(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	if err := h.fileService.AbortMultipartUpload(c.Request.Context(), fileID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Multipart upload aborted", nil)
+}
@@ -2,8 +2,11 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"storage-service/internal/domain"
 	"storage-service/internal/service"
@@ -11,13 +14,17 @@ import (
 
 // ShareHandler handles share HTTP requests
 type ShareHandler struct {
-	shareService *service.ShareService
+	shareService   *service.ShareService
+	archiveService *service.ArchiveService
+	logger         *zap.Logger
 }
 
 // NewShareHandler creates a new ShareHandler
-func NewShareHandler(shareService *service.ShareService) *ShareHandler {
+func NewShareHandler(shareService *service.ShareService, archiveService *service.ArchiveService, logger *zap.Logger) *ShareHandler {
 	return &ShareHandler{
-		shareService: shareService,
+		shareService:   shareService,
+		archiveService: archiveService,
+		logger:         logger,
 	}
 }
 
@@ -138,13 +145,17 @@ func (h *ShareHandler) GetSharedWithMe(c *gin.Context) {
 
 // GetShareByLink godoc
 // @Summary Get share by link
-// @Description Gets share details by public share link
+// @Description Gets share details by public share link. Password-protected links require the X-Share-Password header.
 // @Tags shares
 // @Produce json
 // @Param link path string true "Share link"
+// @Param X-Share-Password header string false "Share link password"
 // @Success 200 {object} domain.ShareResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
-// @Router /storage/shares/link/{link} [get]
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link} [get]
 func (h *ShareHandler) GetShareByLink(c *gin.Context) {
 	link := c.Param("link")
 	if link == "" {
@@ -152,15 +163,278 @@ func (h *ShareHandler) GetShareByLink(c *gin.Context) {
 		return
 	}
 
-	share, err := h.shareService.GetShareByLink(c.Request.Context(), link)
+	share, err := h.shareService.GetShareByLink(c.Request.Context(), link, shareAccessInfo(c))
 	if err != nil {
-		handleNotFound(c, err.Error())
+		handleServiceError(c, err)
 		return
 	}
 
 	respondWithData(c, http.StatusOK, share)
 }
 
+// DownloadByLink godoc
+// @Summary Download a file via share link
+// @Description Issues a short-lived download URL for a publicly shared file and consumes one download of the link limit
+// @Tags shares
+// @Accept json
+// @Produce json
+// @Param link path string true "Share link"
+// @Param X-Share-Password header string false "Share link password"
+// @Param request body domain.ShareLinkAccessRequest false "Share link password (alternative to header)"
+// @Success 200 {object} domain.ShareLinkURLResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/download [post]
+func (h *ShareHandler) DownloadByLink(c *gin.Context) {
+	link := c.Param("link")
+	if link == "" {
+		handleBadRequest(c, "Share link is required")
+		return
+	}
+
+	resp, err := h.shareService.DownloadByLink(c.Request.Context(), link, shareAccessInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, resp)
+}
+
+// PreviewByLink godoc
+// @Summary Preview a file via share link
+// @Description Issues a short-lived inline preview URL for a publicly shared file (does not count as a download)
+// @Tags shares
+// @Produce json
+// @Param link path string true "Share link"
+// @Param X-Share-Password header string false "Share link password"
+// @Success 200 {object} domain.ShareLinkURLResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/preview [get]
+func (h *ShareHandler) PreviewByLink(c *gin.Context) {
+	link := c.Param("link")
+	if link == "" {
+		handleBadRequest(c, "Share link is required")
+		return
+	}
+
+	resp, err := h.shareService.PreviewByLink(c.Request.Context(), link, shareAccessInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, resp)
+}
+
+// ListSharedFolder godoc
+// @Summary Browse a shared folder
+// @Description Lists the contents of a publicly shared folder or one of its subfolders (subfolders only when the share includes children)
+// @Tags shares
+// @Produce json
+// @Param link path string true "Share link"
+// @Param folderId query string false "Subfolder ID inside the shared folder (default: shared folder)"
+// @Param recursive query bool false "List the whole subtree"
+// @Param X-Share-Password header string false "Share link password"
+// @Success 200 {object} domain.SharedFolderContentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/contents [get]
+func (h *ShareHandler) ListSharedFolder(c *gin.Context) {
+	folderID, ok := optionalFolderID(c)
+	if !ok {
+		return
+	}
+
+	recursive := c.Query("recursive") == "true"
+
+	contents, err := h.shareService.ListSharedFolder(c.Request.Context(), c.Param("link"), folderID, recursive, shareAccessInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, contents)
+}
+
+// PreviewSharedFolderFile godoc
+// @Summary Preview a file in a shared folder
+// @Description Issues a short-lived inline preview URL for a file inside a publicly shared folder
+// @Tags shares
+// @Produce json
+// @Param link path string true "Share link"
+// @Param fileId path string true "File ID"
+// @Param X-Share-Password header string false "Share link password"
+// @Success 200 {object} domain.ShareLinkURLResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/files/{fileId}/preview [get]
+func (h *ShareHandler) PreviewSharedFolderFile(c *gin.Context) {
+	h.sharedFolderFileURL(c, true)
+}
+
+// DownloadSharedFolderFile godoc
+// @Summary Download a file in a shared folder
+// @Description Issues a short-lived download URL for a file inside a publicly shared folder and consumes one download of the link limit
+// @Tags shares
+// @Accept json
+// @Produce json
+// @Param link path string true "Share link"
+// @Param fileId path string true "File ID"
+// @Param X-Share-Password header string false "Share link password"
+// @Param request body domain.ShareLinkAccessRequest false "Share link password (alternative to header)"
+// @Success 200 {object} domain.ShareLinkURLResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/files/{fileId}/download [post]
+func (h *ShareHandler) DownloadSharedFolderFile(c *gin.Context) {
+	h.sharedFolderFileURL(c, false)
+}
+
+// DownloadSharedFolderArchive godoc
+// @Summary Download a shared folder as ZIP
+// @Description Streams a ZIP archive of a publicly shared folder (or one of its subfolders) and consumes one download of the link limit
+// @Tags shares
+// @Accept json
+// @Produce application/zip
+// @Param link path string true "Share link"
+// @Param folderId query string false "Subfolder ID inside the shared folder (default: shared folder)"
+// @Param X-Share-Password header string false "Share link password"
+// @Param request body domain.ShareLinkAccessRequest false "Share link password (alternative to header)"
+// @Success 200 {file} binary
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /public/storage/shares/link/{link}/archive [post]
+func (h *ShareHandler) DownloadSharedFolderArchive(c *gin.Context) {
+	folderID, ok := optionalFolderID(c)
+	if !ok {
+		return
+	}
+
+	// 스트리밍 시작 전에 권한/범위/아카이브 제한 검증을 완료
+	plan, err := h.shareService.PrepareSharedFolderArchive(c.Request.Context(), c.Param("link"), folderID, shareAccessInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	streamArchive(c, h.archiveService, plan, h.logger)
+}
+
+// sharedFolderFileURL issues a preview (inline) or download URL for a file inside a shared folder
+func (h *ShareHandler) sharedFolderFileURL(c *gin.Context, inline bool) {
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	resp, err := h.shareService.GetSharedFolderFileURL(c.Request.Context(), c.Param("link"), fileID, inline, shareAccessInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, resp)
+}
+
+// optionalFolderID parses the optional folderId query parameter, responding with 400 if it is invalid
+func optionalFolderID(c *gin.Context) (*uuid.UUID, bool) {
+	folderIDStr := c.Query("folderId")
+	if folderIDStr == "" {
+		return nil, true
+	}
+
+	folderID, err := parseUUID(folderIDStr)
+	if err != nil {
+		handleBadRequest(c, "Invalid folder ID")
+		return nil, false
+	}
+	return &folderID, true
+}
+
+// GetAccessLogs godoc
+// @Summary Get share link access logs
+// @Description Gets access logs (time, IP, user agent) of a share link. Only the sharer can view them.
+// @Tags shares
+// @Produce json
+// @Param shareId path string true "Share ID"
+// @Param type query string true "Entity type (FILE or FOLDER)"
+// @Param page query int false "Page number (default 1)"
+// @Param pageSize query int false "Page size (default 20, max 100)"
+// @Success 200 {object} domain.ShareAccessLogListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/shares/{shareId}/access-logs [get]
+func (h *ShareHandler) GetAccessLogs(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	shareID, err := parseUUID(c.Param("shareId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid share ID")
+		return
+	}
+
+	var entityType domain.ShareType
+	switch c.Query("type") {
+	case "FILE":
+		entityType = domain.ShareTypeFile
+	case "FOLDER":
+		entityType = domain.ShareTypeFolder
+	default:
+		handleBadRequest(c, "Invalid entity type, must be FILE or FOLDER")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "20"))
+
+	logs, err := h.shareService.GetAccessLogs(c.Request.Context(), shareID, entityType, userID, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, logs)
+}
+
+// shareAccessInfo extracts the link password and client information from the request.
+// The password is read from the X-Share-Password header or, for requests with a body, the JSON body.
+func shareAccessInfo(c *gin.Context) domain.ShareAccessInfo {
+	password := c.GetHeader("X-Share-Password")
+	if password == "" && c.Request.ContentLength > 0 {
+		var req domain.ShareLinkAccessRequest
+		if err := c.ShouldBindJSON(&req); err == nil {
+			password = req.Password
+		}
+	}
+
+	return domain.ShareAccessInfo{
+		Password:  password,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
 // UpdateShare godoc
 // @Summary Update a share
 // @Description Updates share permission or expiration
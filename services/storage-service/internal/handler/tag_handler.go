@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// TagHandler handles workspace tag HTTP requests
+type TagHandler struct {
+	tagService    *service.TagService
+	accessService service.AccessService
+}
+
+// NewTagHandler creates a new TagHandler
+func NewTagHandler(tagService *service.TagService, accessService service.AccessService) *TagHandler {
+	return &TagHandler{
+		tagService:    tagService,
+		accessService: accessService,
+	}
+}
+
+// CreateTag godoc
+// @Summary Create a tag
+// @Description Creates a tag shared by all members of a workspace. Names are case-insensitive.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body domain.CreateTagRequest true "Tag creation request"
+// @Success 201 {object} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/tags [post]
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), req.WorkspaceID, userID, c.GetString("jwtToken")); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	tag, err := h.tagService.CreateTag(c.Request.Context(), req, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusCreated, tag.ToResponse())
+}
+
+// GetWorkspaceTags godoc
+// @Summary Get workspace tags
+// @Description Gets all tags of a workspace with the number of tagged items
+// @Tags tags
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/tags [get]
+func (h *TagHandler) GetWorkspaceTags(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, c.GetString("jwtToken")); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	tags, err := h.tagService.GetWorkspaceTags(c.Request.Context(), workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// UpdateTag godoc
+// @Summary Update a tag
+// @Description Renames or recolors a tag (empty color removes it)
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tagId path string true "Tag ID"
+// @Param request body domain.UpdateTagRequest true "Tag update request"
+// @Success 200 {object} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/tags/{tagId} [put]
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	tagID, err := parseUUID(c.Param("tagId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	var req domain.UpdateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if !h.validateTagAccess(c, tagID, userID) {
+		return
+	}
+
+	tag, err := h.tagService.UpdateTag(c.Request.Context(), tagID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tag.ToResponse())
+}
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Deletes a tag and removes it from all files and folders
+// @Tags tags
+// @Produce json
+// @Param tagId path string true "Tag ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/tags/{tagId} [delete]
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	tagID, err := parseUUID(c.Param("tagId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid tag ID")
+		return
+	}
+
+	if !h.validateTagAccess(c, tagID, userID) {
+		return
+	}
+
+	if err := h.tagService.DeleteTag(c.Request.Context(), tagID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Tag deleted successfully", nil)
+}
+
+// SetFileTags godoc
+// @Summary Set file tags
+// @Description Replaces the tags of a file (an empty list removes all tags)
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Param request body domain.SetItemTagsRequest true "Tag IDs"
+// @Success 200 {array} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/tags [put]
+func (h *TagHandler) SetFileTags(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	var req domain.SetItemTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Tagging changes the item, so editor permission is required
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, c.GetString("jwtToken"), domain.ProjectPermissionEditor); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	tags, err := h.tagService.SetFileTags(c.Request.Context(), fileID, req.TagIDs, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// SetFolderTags godoc
+// @Summary Set folder tags
+// @Description Replaces the tags of a folder (an empty list removes all tags)
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param folderId path string true "Folder ID"
+// @Param request body domain.SetItemTagsRequest true "Tag IDs"
+// @Success 200 {array} domain.TagResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/folders/{folderId}/tags [put]
+func (h *TagHandler) SetFolderTags(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	folderID, err := parseUUID(c.Param("folderId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid folder ID")
+		return
+	}
+
+	var req domain.SetItemTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBadRequest(c, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFolderAccess(c.Request.Context(), folderID, userID, c.GetString("jwtToken"), domain.ProjectPermissionEditor); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	tags, err := h.tagService.SetFolderTags(c.Request.Context(), folderID, req.TagIDs, userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, tags)
+}
+
+// validateTagAccess checks that the user is a member of the tag's workspace
+func (h *TagHandler) validateTagAccess(c *gin.Context, tagID, userID uuid.UUID) bool {
+	tag, err := h.tagService.GetTag(c.Request.Context(), tagID)
+	if err != nil {
+		handleServiceError(c, err)
+		return false
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), tag.WorkspaceID, userID, c.GetString("jwtToken")); err != nil {
+			handleServiceError(c, err)
+			return false
+		}
+	}
+	return true
+}
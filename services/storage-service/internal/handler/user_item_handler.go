@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/service"
+)
+
+// UserItemHandler handles starred items and recently accessed lists
+type UserItemHandler struct {
+	userItemService *service.UserItemService
+	tagService      *service.TagService
+	accessService   service.AccessService
+}
+
+// NewUserItemHandler creates a new UserItemHandler
+func NewUserItemHandler(userItemService *service.UserItemService, tagService *service.TagService, accessService service.AccessService) *UserItemHandler {
+	return &UserItemHandler{
+		userItemService: userItemService,
+		tagService:      tagService,
+		accessService:   accessService,
+	}
+}
+
+// StarFile godoc
+// @Summary Star a file
+// @Description Adds a file to the current user's starred items
+// @Tags starred
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/star [put]
+func (h *UserItemHandler) StarFile(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	fileID, err := parseUUID(c.Param("fileId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid file ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFileAccess(c.Request.Context(), fileID, userID, c.GetString("jwtToken"), domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	if err := h.userItemService.StarFile(c.Request.Context(), fileID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "File starred", nil)
+}
+
+// UnstarFile godoc
+// @Summary Unstar a file
+// @Description Removes a file from the current user's starred items
+// @Tags starred
+// @Produce json
+// @Param fileId path string true "File ID"
+// @Success 200 {object} SuccessResponse
+// @Security BearerAuth
+// @Router /storage/files/{fileId}/star [delete]
+func (h *UserItemHandler) UnstarFile(c *gin.Context) {
+	h.unstar(c, domain.ItemTypeFile, "fileId")
+}
+
+// StarFolder godoc
+// @Summary Star a folder
+// @Description Adds a folder to the current user's starred items
+// @Tags starred
+// @Produce json
+// @Param folderId path string true "Folder ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/folders/{folderId}/star [put]
+func (h *UserItemHandler) StarFolder(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	folderID, err := parseUUID(c.Param("folderId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid folder ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateFolderAccess(c.Request.Context(), folderID, userID, c.GetString("jwtToken"), domain.ProjectPermissionViewer); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	if err := h.userItemService.StarFolder(c.Request.Context(), folderID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Folder starred", nil)
+}
+
+// UnstarFolder godoc
+// @Summary Unstar a folder
+// @Description Removes a folder from the current user's starred items
+// @Tags starred
+// @Produce json
+// @Param folderId path string true "Folder ID"
+// @Success 200 {object} SuccessResponse
+// @Security BearerAuth
+// @Router /storage/folders/{folderId}/star [delete]
+func (h *UserItemHandler) UnstarFolder(c *gin.Context) {
+	h.unstar(c, domain.ItemTypeFolder, "folderId")
+}
+
+// unstar removes the user's own star; no access check is needed since only the user's row is touched
+func (h *UserItemHandler) unstar(c *gin.Context, itemType domain.ItemType, param string) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	itemID, err := parseUUID(c.Param(param))
+	if err != nil {
+		handleBadRequest(c, "Invalid "+param)
+		return
+	}
+
+	if err := h.userItemService.Unstar(c.Request.Context(), userID, itemType, itemID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, http.StatusOK, "Star removed", nil)
+}
+
+// GetStarred godoc
+// @Summary Get starred items
+// @Description Gets the files and folders the current user starred in a workspace
+// @Tags starred
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.StarredItemsResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/starred [get]
+func (h *UserItemHandler) GetStarred(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, c.GetString("jwtToken")); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	result, err := h.userItemService.GetStarred(c.Request.Context(), userID, workspaceID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	if h.tagService != nil {
+		if err := h.tagService.AttachFolderTags(c.Request.Context(), result.Folders); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		if err := h.tagService.AttachFileTags(c.Request.Context(), result.Files); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
+
+// GetRecent godoc
+// @Summary Get recently accessed items
+// @Description Gets the files and folders the current user opened most recently in a workspace
+// @Tags starred
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param limit query int false "Number of items (default 20, max 100)"
+// @Success 200 {object} domain.RecentItemsResponse
+// @Failure 400 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /storage/workspaces/{workspaceId}/recent [get]
+func (h *UserItemHandler) GetRecent(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		handleUnauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := parseUUID(c.Param("workspaceId"))
+	if err != nil {
+		handleBadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if h.accessService != nil {
+		if err := h.accessService.ValidateWorkspaceAccess(c.Request.Context(), workspaceID, userID, c.GetString("jwtToken")); err != nil {
+			handleServiceError(c, err)
+			return
+		}
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	result, err := h.userItemService.GetRecent(c.Request.Context(), userID, workspaceID, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	respondWithData(c, http.StatusOK, result)
+}
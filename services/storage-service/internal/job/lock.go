@@ -0,0 +1,74 @@
+// Package job provides background job implementations.
+package job
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// releaseScript deletes the lock only if it is still held by the same owner
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Locker acquires distributed locks so that only one replica runs a job at a time
+type Locker interface {
+	// TryLock returns a release function if the lock was acquired, or nil if another holder has it
+	TryLock(ctx context.Context, key string, ttl time.Duration) (release func(), err error)
+}
+
+// RedisLocker implements Locker with Redis SET NX
+type RedisLocker struct {
+	client *redis.Client
+}
+
+// NewRedisLocker creates a new RedisLocker
+func NewRedisLocker(client *redis.Client) *RedisLocker {
+	return &RedisLocker{client: client}
+}
+
+// TryLock tries to acquire the lock once without waiting
+func (l *RedisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := l.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return func() {
+		// 작업 컨텍스트가 취소되었더라도 락은 해제
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = releaseScript.Run(releaseCtx, l.client, []string{key}, token).Err()
+	}, nil
+}
+
+// LocalLocker is a no-op Locker used when Redis is unavailable (single replica)
+type LocalLocker struct{}
+
+// TryLock always succeeds
+func (LocalLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), error) {
+	return func() {}, nil
+}
+
+func newLockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
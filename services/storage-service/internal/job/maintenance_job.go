@@ -0,0 +1,120 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/domain"
+)
+
+const (
+	maintenanceLockKey = "lock:storage:maintenance"
+	reconcileLockKey   = "lock:storage:reconcile"
+)
+
+// Maintainer is the set of maintenance operations the job drives
+type Maintainer interface {
+	CleanupOrphanedUploads(ctx context.Context) error
+	CleanupExpiredShares(ctx context.Context) error
+	PurgeExpiredTrash(ctx context.Context) (*domain.PurgeResult, error)
+	ReconcileWorkspaces(ctx context.Context) ([]domain.ReconcileResult, error)
+}
+
+// MaintenanceJob runs scheduled storage maintenance under a distributed lock
+type MaintenanceJob struct {
+	maintainer Maintainer
+	locker     Locker
+	lockTTL    time.Duration
+	logger     *zap.Logger
+}
+
+// NewMaintenanceJob creates a new MaintenanceJob instance
+func NewMaintenanceJob(maintainer Maintainer, locker Locker, lockTTL time.Duration, logger *zap.Logger) *MaintenanceJob {
+	if lockTTL <= 0 {
+		lockTTL = 30 * time.Minute
+	}
+	return &MaintenanceJob{
+		maintainer: maintainer,
+		locker:     locker,
+		lockTTL:    lockTTL,
+		logger:     logger,
+	}
+}
+
+// Run executes orphan upload cleanup, expired share cleanup and trash auto-purge
+func (j *MaintenanceJob) Run() {
+	j.withLock(maintenanceLockKey, func(ctx context.Context) {
+		j.logger.Info("Starting storage maintenance job")
+
+		if err := j.maintainer.CleanupOrphanedUploads(ctx); err != nil {
+			j.logger.Error("Failed to clean up orphaned uploads", zap.Error(err))
+		}
+
+		if err := j.maintainer.CleanupExpiredShares(ctx); err != nil {
+			j.logger.Error("Failed to clean up expired shares", zap.Error(err))
+		}
+
+		result, err := j.maintainer.PurgeExpiredTrash(ctx)
+		if err != nil {
+			j.logger.Error("Failed to purge expired trash", zap.Error(err))
+		}
+		if result != nil {
+			j.logger.Info("Trash auto-purge completed",
+				zap.Int("workspaces", result.WorkspacesScanned),
+				zap.Int("files_purged", result.FilesPurged),
+				zap.Int("folders_purged", result.FoldersPurged),
+				zap.Int64("bytes_freed", result.BytesFreed),
+				zap.Int("failed", result.Failed),
+			)
+		}
+
+		j.logger.Info("Storage maintenance job completed")
+	})
+}
+
+// RunReconcile executes the S3/database reconciliation pass
+func (j *MaintenanceJob) RunReconcile() {
+	j.withLock(reconcileLockKey, func(ctx context.Context) {
+		j.logger.Info("Starting storage reconciliation job")
+
+		results, err := j.maintainer.ReconcileWorkspaces(ctx)
+		if err != nil {
+			j.logger.Error("Failed to reconcile storage", zap.Error(err))
+		}
+
+		orphans, missing, deleted := 0, 0, 0
+		for _, r := range results {
+			orphans += len(r.OrphanObjects)
+			missing += len(r.MissingObjects)
+			deleted += r.OrphansDeleted
+		}
+
+		j.logger.Info("Storage reconciliation job completed",
+			zap.Int("workspaces", len(results)),
+			zap.Int("orphan_objects", orphans),
+			zap.Int("missing_objects", missing),
+			zap.Int("orphans_deleted", deleted),
+		)
+	})
+}
+
+// withLock runs fn only if the lock could be acquired; the context expires with the lock
+func (j *MaintenanceJob) withLock(key string, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithTimeout(context.Background(), j.lockTTL)
+	defer cancel()
+
+	release, err := j.locker.TryLock(ctx, key, j.lockTTL)
+	if err != nil {
+		j.logger.Error("Failed to acquire job lock", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if release == nil {
+		j.logger.Debug("Job lock held by another instance, skipping", zap.String("key", key))
+		return
+	}
+	defer release()
+
+	fn(ctx)
+}
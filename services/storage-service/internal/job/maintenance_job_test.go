@@ -0,0 +1,106 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"storage-service/internal/domain"
+)
+
+// MockMaintainer is a mock implementation of Maintainer
+type MockMaintainer struct {
+	mock.Mock
+}
+
+func (m *MockMaintainer) CleanupOrphanedUploads(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
+
+func (m *MockMaintainer) CleanupExpiredShares(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
+
+func (m *MockMaintainer) PurgeExpiredTrash(ctx context.Context) (*domain.PurgeResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PurgeResult), args.Error(1)
+}
+
+func (m *MockMaintainer) ReconcileWorkspaces(ctx context.Context) ([]domain.ReconcileResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ReconcileResult), args.Error(1)
+}
+
+// busyLocker simulates a lock held by another instance
+type busyLocker struct{}
+
+func (busyLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), error) {
+	return nil, nil
+}
+
+// failingLocker simulates Redis being unreachable
+type failingLocker struct{}
+
+func (failingLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), error) {
+	return nil, errors.New("redis down")
+}
+
+func TestMaintenanceJob_Run_AllStepsExecuted(t *testing.T) {
+	m := new(MockMaintainer)
+	m.On("CleanupOrphanedUploads", mock.Anything).Return(nil)
+	m.On("CleanupExpiredShares", mock.Anything).Return(nil)
+	m.On("PurgeExpiredTrash", mock.Anything).Return(&domain.PurgeResult{FilesPurged: 2}, nil)
+
+	NewMaintenanceJob(m, LocalLocker{}, time.Minute, zap.NewNop()).Run()
+
+	m.AssertExpectations(t)
+}
+
+func TestMaintenanceJob_Run_ContinuesAfterStepFailure(t *testing.T) {
+	m := new(MockMaintainer)
+	m.On("CleanupOrphanedUploads", mock.Anything).Return(errors.New("db error"))
+	m.On("CleanupExpiredShares", mock.Anything).Return(errors.New("db error"))
+	m.On("PurgeExpiredTrash", mock.Anything).Return(nil, errors.New("db error"))
+
+	NewMaintenanceJob(m, LocalLocker{}, time.Minute, zap.NewNop()).Run()
+
+	m.AssertExpectations(t)
+}
+
+func TestMaintenanceJob_Run_SkipsWhenLockHeld(t *testing.T) {
+	m := new(MockMaintainer)
+
+	NewMaintenanceJob(m, busyLocker{}, time.Minute, zap.NewNop()).Run()
+
+	m.AssertNotCalled(t, "CleanupOrphanedUploads", mock.Anything)
+	m.AssertNotCalled(t, "PurgeExpiredTrash", mock.Anything)
+}
+
+func TestMaintenanceJob_Run_SkipsWhenLockErrors(t *testing.T) {
+	m := new(MockMaintainer)
+
+	NewMaintenanceJob(m, failingLocker{}, time.Minute, zap.NewNop()).Run()
+
+	m.AssertNotCalled(t, "CleanupOrphanedUploads", mock.Anything)
+}
+
+func TestMaintenanceJob_RunReconcile(t *testing.T) {
+	m := new(MockMaintainer)
+	m.On("ReconcileWorkspaces", mock.Anything).Return([]domain.ReconcileResult{
+		{OrphanObjects: []string{"storage/ws/a/file.txt"}, MissingObjects: []string{}},
+	}, nil)
+
+	NewMaintenanceJob(m, LocalLocker{}, time.Minute, zap.NewNop()).RunReconcile()
+
+	m.AssertExpectations(t)
+}
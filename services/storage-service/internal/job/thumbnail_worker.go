@@ -0,0 +1,92 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ThumbnailProcessor generates thumbnails for pending files
+type ThumbnailProcessor interface {
+	ProcessPending(ctx context.Context, limit int) (int, error)
+}
+
+// ThumbnailWorker polls for files waiting for thumbnails and processes them in batches.
+// Claiming is done in the database, so every service instance can run a worker.
+type ThumbnailWorker struct {
+	processor ThumbnailProcessor
+	interval  time.Duration
+	batchSize int
+	logger    *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewThumbnailWorker creates a new ThumbnailWorker instance
+func NewThumbnailWorker(processor ThumbnailProcessor, interval time.Duration, batchSize int, logger *zap.Logger) *ThumbnailWorker {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if batchSize <= 0 {
+		batchSize = 10
+	}
+	return &ThumbnailWorker{
+		processor: processor,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Start runs the worker loop in the background until Stop is called
+func (w *ThumbnailWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.drain(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the worker to stop and waits for the current batch to finish
+func (w *ThumbnailWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+}
+
+// drain processes batches until no pending files remain
+func (w *ThumbnailWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := w.processor.ProcessPending(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to process thumbnails", zap.Error(err))
+			return
+		}
+		if claimed > 0 {
+			w.logger.Debug("Thumbnail batch processed", zap.Int("files", claimed))
+		}
+		// 배치가 가득 차지 않았으면 대기 중인 작업이 더 없음
+		if claimed < w.batchSize {
+			return
+		}
+	}
+}
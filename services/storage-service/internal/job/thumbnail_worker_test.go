@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockThumbnailProcessor is a mock implementation of ThumbnailProcessor
+type MockThumbnailProcessor struct {
+	mock.Mock
+}
+
+func (m *MockThumbnailProcessor) ProcessPending(ctx context.Context, limit int) (int, error) {
+	args := m.Called(ctx, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func TestThumbnailWorker_DrainUntilBatchNotFull(t *testing.T) {
+	processor := new(MockThumbnailProcessor)
+	processor.On("ProcessPending", mock.Anything, 5).Return(5, nil).Twice()
+	processor.On("ProcessPending", mock.Anything, 5).Return(2, nil).Once()
+
+	worker := NewThumbnailWorker(processor, time.Minute, 5, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessPending", 3)
+}
+
+func TestThumbnailWorker_DrainStopsOnError(t *testing.T) {
+	processor := new(MockThumbnailProcessor)
+	processor.On("ProcessPending", mock.Anything, 10).Return(0, errors.New("db down")).Once()
+
+	worker := NewThumbnailWorker(processor, time.Minute, 0, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessPending", 1)
+}
+
+func TestThumbnailWorker_StartStop(t *testing.T) {
+	processor := new(MockThumbnailProcessor)
+	processor.On("ProcessPending", mock.Anything, 10).Return(0, nil)
+
+	worker := NewThumbnailWorker(processor, 10*time.Millisecond, 10, zap.NewNop())
+	worker.Start()
+	time.Sleep(30 * time.Millisecond)
+	worker.Stop()
+
+	assert.GreaterOrEqual(t, len(processor.Calls), 1)
+}
@@ -0,0 +1,88 @@
+// 이 파일은 공개 공유 링크 무차별 대입(brute-force) 방지 미들웨어를 포함합니다.
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
+)
+
+// ShareLinkGuard는 공유 링크 조회를 클라이언트 IP 기준으로 제한합니다.
+// 분당 요청 수 제한과 함께, 존재하지 않는 토큰(404)이나 잘못된 비밀번호(401) 응답을
+// 시간당 실패 횟수로 누적하여 한도를 넘으면 요청을 차단합니다.
+type ShareLinkGuard struct {
+	requests *ratelimit.RedisRateLimiter
+	failures *ratelimit.RedisRateLimiter
+	logger   *zap.Logger
+}
+
+// NewShareLinkGuard는 새 ShareLinkGuard를 생성합니다.
+// requestsPerMinute: IP당 분당 링크 조회 수, failuresPerHour: IP당 시간당 허용 실패 수
+func NewShareLinkGuard(client *redis.Client, requestsPerMinute, failuresPerHour int, logger *zap.Logger) *ShareLinkGuard {
+	requestConfig := ratelimit.DefaultConfig().
+		WithRequestsPerMinute(requestsPerMinute).
+		WithBurstSize(0).
+		WithKeyPrefix("rl:storage:share:")
+
+	failureConfig := ratelimit.DefaultConfig().
+		WithRequestsPerMinute(failuresPerHour).
+		WithBurstSize(0).
+		WithWindowSize(time.Hour).
+		WithKeyPrefix("rl:storage:share-fail:")
+
+	return &ShareLinkGuard{
+		requests: ratelimit.NewRedisRateLimiter(client, requestConfig, logger),
+		failures: ratelimit.NewRedisRateLimiter(client, failureConfig, logger),
+		logger:   logger,
+	}
+}
+
+// Middleware는 공유 링크 라우트에 적용할 Gin 미들웨어를 반환합니다.
+// Redis 오류 시에는 가용성을 위해 요청을 허용합니다 (fail-open).
+func (g *ShareLinkGuard) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		key := ratelimit.IPKey(c)
+
+		// 실패 한도를 이미 초과한 IP는 조회 자체를 차단
+		if remaining, err := g.failures.GetRemaining(ctx, key); err == nil && remaining <= 0 {
+			g.reject(c, key, "Too many failed share link attempts. Please try again later.", time.Hour)
+			return
+		}
+
+		if allowed, err := g.requests.Allow(ctx, key); err == nil && !allowed {
+			g.reject(c, key, "Rate limit exceeded. Please try again later.", time.Minute)
+			return
+		}
+
+		c.Next()
+
+		// 존재하지 않는 토큰 또는 잘못된 비밀번호는 실패로 누적
+		switch c.Writer.Status() {
+		case http.StatusNotFound, http.StatusUnauthorized:
+			if _, err := g.failures.Allow(ctx, key); err != nil {
+				g.logger.Warn("Failed to record share link failure", zap.String("key", key), zap.Error(err))
+			}
+		}
+	}
+}
+
+// reject는 429 응답으로 요청을 중단합니다.
+func (g *ShareLinkGuard) reject(c *gin.Context, key, message string, retryAfter time.Duration) {
+	g.logger.Warn("Share link request blocked",
+		zap.String("key", key),
+		zap.String("path", c.Request.URL.Path),
+	)
+	c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+		"error":      "Too Many Requests",
+		"message":    message,
+		"retryAfter": int(retryAfter.Seconds()),
+	})
+}
@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"storage-service/internal/domain"
 )
@@ -62,6 +64,18 @@ func (r *FileRepository) FindByFileKey(ctx context.Context, fileKey string) (*do
 	return &file, nil
 }
 
+// FindByFileKeyWithDeleted finds a file by S3 key including deleted ones
+func (r *FileRepository) FindByFileKeyWithDeleted(ctx context.Context, fileKey string) (*domain.File, error) {
+	var file domain.File
+	err := r.db.WithContext(ctx).
+		Where("file_key = ?", fileKey).
+		First(&file).Error
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
 // FindByWorkspaceID finds all files in a workspace with pagination
 func (r *FileRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID, page, pageSize int) ([]domain.File, int64, error) {
 	var files []domain.File
@@ -103,6 +117,19 @@ func (r *FileRepository) FindByFolderID(ctx context.Context, workspaceID uuid.UU
 	return files, err
 }
 
+// FindActiveByFolderIDs finds all active files directly inside any of the given folders
+func (r *FileRepository) FindActiveByFolderIDs(ctx context.Context, folderIDs []uuid.UUID) ([]domain.File, error) {
+	var files []domain.File
+	if len(folderIDs) == 0 {
+		return files, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("folder_id IN ? AND status = ? AND deleted_at IS NULL", folderIDs, domain.FileStatusActive).
+		Order("name ASC").
+		Find(&files).Error
+	return files, err
+}
+
 // FindRootFiles finds all files in the root folder
 func (r *FileRepository) FindRootFiles(ctx context.Context, workspaceID uuid.UUID) ([]domain.File, error) {
 	return r.FindByFolderID(ctx, workspaceID, nil)
@@ -118,6 +145,16 @@ func (r *FileRepository) FindByUploadedBy(ctx context.Context, workspaceID, user
 	return files, err
 }
 
+// FindAllByUploadedBy finds the files a user uploaded across all workspaces, oldest first
+func (r *FileRepository) FindAllByUploadedBy(ctx context.Context, userID uuid.UUID) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).
+		Where("uploaded_by = ? AND status = ? AND deleted_at IS NULL", userID, domain.FileStatusActive).
+		Order("created_at ASC").
+		Find(&files).Error
+	return files, err
+}
+
 // FindByStatus finds files by status
 func (r *FileRepository) FindByStatus(ctx context.Context, status domain.FileStatus) ([]domain.File, error) {
 	var files []domain.File
@@ -127,16 +164,44 @@ func (r *FileRepository) FindByStatus(ctx context.Context, status domain.FileSta
 	return files, err
 }
 
-// FindUploadingFiles finds all files that are still in uploading status
+// FindUploadingFiles finds all single-PUT files that are still in uploading status
 func (r *FileRepository) FindUploadingFiles(ctx context.Context, olderThan time.Duration) ([]domain.File, error) {
 	var files []domain.File
 	cutoff := time.Now().Add(-olderThan)
 	err := r.db.WithContext(ctx).
-		Where("status = ? AND created_at < ?", domain.FileStatusUploading, cutoff).
+		Where("status = ? AND created_at < ? AND upload_id IS NULL", domain.FileStatusUploading, cutoff).
 		Find(&files).Error
 	return files, err
 }
 
+// FindStaleMultipartUploads finds multipart uploads with no activity for the given duration
+func (r *FileRepository) FindStaleMultipartUploads(ctx context.Context, idleFor time.Duration) ([]domain.File, error) {
+	var files []domain.File
+	cutoff := time.Now().Add(-idleFor)
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND upload_id IS NOT NULL AND updated_at < ?", domain.FileStatusUploading, cutoff).
+		Find(&files).Error
+	return files, err
+}
+
+// ExistsByUploadID checks if a file record references the given multipart upload ID
+func (r *FileRepository) ExistsByUploadID(ctx context.Context, uploadID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("upload_id = ?", uploadID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Touch bumps updated_at to record upload activity
+func (r *FileRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		Update("updated_at", time.Now()).Error
+}
+
 // Update updates a file record
 func (r *FileRepository) Update(ctx context.Context, file *domain.File) error {
 	return r.db.WithContext(ctx).Save(file).Error
@@ -188,9 +253,14 @@ func (r *FileRepository) Restore(ctx context.Context, id uuid.UUID) error {
 		}).Error
 }
 
-// PermanentDelete permanently deletes a file record
+// PermanentDelete permanently deletes a file record with its tags, stars and recent entries
 func (r *FileRepository) PermanentDelete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Unscoped().Delete(&domain.File{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteItemReferences(tx, domain.ItemTypeFile, []uuid.UUID{id}); err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.File{}, id).Error
+	})
 }
 
 // FindDeleted finds all deleted files in a workspace (trash)
@@ -203,6 +273,70 @@ func (r *FileRepository) FindDeleted(ctx context.Context, workspaceID uuid.UUID)
 	return files, err
 }
 
+// FindTrashWorkspaceIDs finds the distinct workspaces that have files in trash
+func (r *FileRepository) FindTrashWorkspaceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("deleted_at IS NOT NULL").
+		Distinct().
+		Pluck("workspace_id", &ids).Error
+	return ids, err
+}
+
+// FindDeletedBefore finds files in trash of a workspace that were deleted before the cutoff
+func (r *FileRepository) FindDeletedBefore(ctx context.Context, workspaceID uuid.UUID, cutoff time.Time, limit int) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ?", workspaceID, cutoff).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// FindWorkspaceIDs finds the distinct workspaces that have any file record
+func (r *FileRepository) FindWorkspaceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Distinct().
+		Pluck("workspace_id", &ids).Error
+	return ids, err
+}
+
+// FindFileKeysByWorkspaceID finds the S3 keys and statuses of all files in a workspace, including trash.
+// Objects linked from other services live outside the workspace prefix and are excluded.
+func (r *FileRepository) FindFileKeysByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (map[string]domain.FileStatus, error) {
+	var rows []struct {
+		FileKey string
+		Status  domain.FileStatus
+	}
+	err := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Select("file_key, status").
+		Where("workspace_id = ? AND source = ?", workspaceID, domain.FileSourceStorage).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]domain.FileStatus, len(rows))
+	for _, row := range rows {
+		keys[row.FileKey] = row.Status
+	}
+	return keys, nil
+}
+
+// FindLinkedByWorkspaceID finds files of a workspace whose S3 object is owned by another service, including trash
+func (r *FileRepository) FindLinkedByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND source <> ?", workspaceID, domain.FileSourceStorage).
+		Find(&files).Error
+	return files, err
+}
+
 // CountByWorkspaceID counts files in a workspace
 func (r *FileRepository) CountByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
 	var count int64
@@ -223,6 +357,16 @@ func (r *FileRepository) CountByFolderID(ctx context.Context, folderID uuid.UUID
 	return count, err
 }
 
+// CountByFolderIDWithDeleted counts files in a folder including trashed files
+func (r *FileRepository) CountByFolderIDWithDeleted(ctx context.Context, folderID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("folder_id = ?", folderID).
+		Count(&count).Error
+	return count, err
+}
+
 // SumSizeByWorkspaceID calculates total size of files in a workspace
 func (r *FileRepository) SumSizeByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
 	var result struct {
@@ -290,32 +434,187 @@ func (r *FileRepository) GenerateUniqueName(ctx context.Context, workspaceID uui
 	return "", fmt.Errorf("could not generate unique name for file: %s", name)
 }
 
-// Search searches files by name
-func (r *FileRepository) Search(ctx context.Context, workspaceID uuid.UUID, query string, page, pageSize int) ([]domain.File, int64, error) {
+// fileSearchColumns maps search sort fields to columns
+var fileSearchColumns = map[domain.FileSearchSort]string{
+	domain.FileSearchSortName:      "name",
+	domain.FileSearchSortSize:      "file_size",
+	domain.FileSearchSortCreatedAt: "created_at",
+	domain.FileSearchSortUpdatedAt: "updated_at",
+}
+
+// Search finds active files matching the filter with pagination
+func (r *FileRepository) Search(ctx context.Context, filter domain.FileSearchFilter) ([]domain.File, int64, error) {
 	var files []domain.File
 	var total int64
 
-	searchQuery := "%" + query + "%"
-
-	// Count total
-	err := r.db.WithContext(ctx).
+	query := r.db.WithContext(ctx).
 		Model(&domain.File{}).
-		Where("workspace_id = ? AND (name ILIKE ? OR original_name ILIKE ?) AND deleted_at IS NULL",
-			workspaceID, searchQuery, searchQuery).
-		Count(&total).Error
-	if err != nil {
+		Where("workspace_id = ? AND status = ? AND deleted_at IS NULL", filter.WorkspaceID, domain.FileStatusActive)
+
+	if filter.Query != "" {
+		searchQuery := "%" + escapeLike(filter.Query) + "%"
+		query = query.Where("(name ILIKE ? OR original_name ILIKE ?)", searchQuery, searchQuery)
+	}
+	if m, ok := filter.TypeFamily.ContentTypes(); ok {
+		conds := make([]string, 0, len(m.Prefixes)+1)
+		args := make([]interface{}, 0, len(m.Prefixes)+1)
+		for _, prefix := range m.Prefixes {
+			conds = append(conds, "content_type LIKE ?")
+			args = append(args, escapeLike(prefix)+"%")
+		}
+		if len(m.Types) > 0 {
+			conds = append(conds, "content_type IN ?")
+			args = append(args, m.Types)
+		}
+		query = query.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+	if filter.UploadedBy != nil {
+		query = query.Where("uploaded_by = ?", *filter.UploadedBy)
+	}
+	if filter.MinSize != nil {
+		query = query.Where("file_size >= ?", *filter.MinSize)
+	}
+	if filter.MaxSize != nil {
+		query = query.Where("file_size <= ?", *filter.MaxSize)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.FolderID != nil {
+		// 하위 폴더 전체 포함 (Path 접두사)
+		query = query.Where(
+			"(folder_id = ? OR folder_id IN (?))",
+			*filter.FolderID,
+			r.db.Model(&domain.Folder{}).
+				Select("id").
+				Where("workspace_id = ? AND path LIKE ? AND deleted_at IS NULL", filter.WorkspaceID, escapeLike(filter.FolderPath)+"/%"),
+		)
+	}
+	if len(filter.TagIDs) > 0 {
+		// 지정한 태그를 모두 가진 파일
+		query = query.Where("id IN (?)",
+			r.db.Model(&domain.ItemTag{}).
+				Select("item_id").
+				Where("item_type = ? AND tag_id IN ?", domain.ItemTypeFile, filter.TagIDs).
+				Group("item_id").
+				Having("COUNT(DISTINCT tag_id) = ?", len(filter.TagIDs)),
+		)
+	}
+
+	// Count와 Find에서 같은 조건을 재사용
+	query = query.Session(&gorm.Session{})
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// Get files with pagination
-	offset := (page - 1) * pageSize
-	err = r.db.WithContext(ctx).
-		Where("workspace_id = ? AND (name ILIKE ? OR original_name ILIKE ?) AND deleted_at IS NULL",
-			workspaceID, searchQuery, searchQuery).
-		Order("created_at DESC").
+	column, ok := fileSearchColumns[filter.Sort]
+	if !ok {
+		column = "created_at"
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	err := query.
+		Order(clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: filter.Descending}).
+		Order("id").
 		Offset(offset).
-		Limit(pageSize).
+		Limit(filter.PageSize).
 		Find(&files).Error
 
 	return files, total, err
 }
+
+// ============================================================
+// Thumbnail Operations
+// ============================================================
+
+// ClaimThumbnailJobs marks up to limit files as PROCESSING and returns them.
+// PENDING files are claimed once retryAfter has passed since their last attempt, and
+// PROCESSING files older than staleBefore (crashed worker) are reclaimed.
+// SKIP LOCKED lets several service instances run workers concurrently.
+func (r *FileRepository) ClaimThumbnailJobs(ctx context.Context, limit int, retryAfter, staleBefore time.Time) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND deleted_at IS NULL", domain.FileStatusActive).
+			Where("((thumbnail_status = ? AND (thumbnail_updated_at IS NULL OR thumbnail_updated_at < ?)) OR (thumbnail_status = ? AND thumbnail_updated_at < ?))",
+				domain.ThumbnailStatusPending, retryAfter, domain.ThumbnailStatusProcessing, staleBefore).
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&files).Error
+		if err != nil || len(files) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(files))
+		for i := range files {
+			ids[i] = files[i].ID
+		}
+		now := time.Now()
+		return tx.Model(&domain.File{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"thumbnail_status":     domain.ThumbnailStatusProcessing,
+				"thumbnail_attempts":   gorm.Expr("thumbnail_attempts + 1"),
+				"thumbnail_updated_at": now,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range files {
+		files[i].ThumbnailAttempts++
+	}
+	return files, nil
+}
+
+// UpdateThumbnailStatus records the result of a thumbnail generation attempt
+func (r *FileRepository) UpdateThumbnailStatus(ctx context.Context, id uuid.UUID, status domain.ThumbnailStatus, errMsg *string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"thumbnail_status":     status,
+			"thumbnail_error":      errMsg,
+			"thumbnail_updated_at": time.Now(),
+		}).Error
+}
+
+// QueueThumbnailBackfill marks active image files without thumbnails as PENDING.
+// If retryFailed is true, FAILED files are re-queued with their attempt counter reset.
+func (r *FileRepository) QueueThumbnailBackfill(ctx context.Context, workspaceID *uuid.UUID, extensions []string, retryFailed bool) (int64, error) {
+	statuses := []domain.ThumbnailStatus{domain.ThumbnailStatusNone}
+	if retryFailed {
+		statuses = append(statuses, domain.ThumbnailStatusFailed)
+	}
+
+	patterns := make([]string, 0, len(extensions))
+	args := make([]interface{}, 0, len(extensions))
+	for _, ext := range extensions {
+		patterns = append(patterns, "LOWER(name) LIKE ?")
+		args = append(args, "%"+ext)
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&domain.File{}).
+		Where("status = ? AND deleted_at IS NULL", domain.FileStatusActive).
+		Where("(thumbnail_status IS NULL OR thumbnail_status IN ?)", statuses).
+		Where("("+strings.Join(patterns, " OR ")+")", args...)
+	if workspaceID != nil {
+		query = query.Where("workspace_id = ?", *workspaceID)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"thumbnail_status":     domain.ThumbnailStatusPending,
+		"thumbnail_attempts":   0,
+		"thumbnail_error":      nil,
+		"thumbnail_updated_at": nil,
+	})
+	return result.RowsAffected, result.Error
+}
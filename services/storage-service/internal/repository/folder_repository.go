@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -96,7 +98,7 @@ func (r *FolderRepository) FindByPath(ctx context.Context, workspaceID uuid.UUID
 func (r *FolderRepository) FindChildrenRecursive(ctx context.Context, workspaceID uuid.UUID, parentPath string) ([]domain.Folder, error) {
 	var folders []domain.Folder
 	err := r.db.WithContext(ctx).
-		Where("workspace_id = ? AND path LIKE ? AND deleted_at IS NULL", workspaceID, parentPath+"/%").
+		Where("workspace_id = ? AND path LIKE ? AND deleted_at IS NULL", workspaceID, escapeLike(parentPath)+"/%").
 		Order("path ASC").
 		Find(&folders).Error
 	return folders, err
@@ -121,8 +123,96 @@ func (r *FolderRepository) UpdatePath(ctx context.Context, workspaceID uuid.UUID
 	// Update all descendants by replacing prefix
 	return r.db.WithContext(ctx).
 		Model(&domain.Folder{}).
-		Where("workspace_id = ? AND path LIKE ?", workspaceID, oldPath+"/%").
-		Update("path", gorm.Expr("REPLACE(path, ?, ?)", oldPath, newPath)).Error
+		Where("workspace_id = ? AND path LIKE ?", workspaceID, escapeLike(oldPath)+"/%").
+		Update("path", gorm.Expr("? || SUBSTRING(path FROM ?)", newPath, len(oldPath)+1)).Error
+}
+
+// MoveSubtree saves a moved folder and rewrites the paths of its subtree in one transaction.
+// If projectChanged is true, descendant folders and their files are moved to the folder's project.
+// If replaced is not nil, that folder and its subtree are moved to trash first (overwrite on conflict).
+func (r *FolderRepository) MoveSubtree(ctx context.Context, folder *domain.Folder, oldPath string, projectChanged bool, replaced *domain.Folder) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if replaced != nil {
+			if err := trashSubtree(tx, replaced); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Save(folder).Error; err != nil {
+			return err
+		}
+
+		// 하위 폴더 경로는 접두사만 교체 (REPLACE는 경로 중간의 같은 문자열까지 바꿀 수 있음)
+		updates := map[string]interface{}{
+			"path":       gorm.Expr("? || SUBSTRING(path FROM ?)", folder.Path, len(oldPath)+1),
+			"updated_at": time.Now(),
+		}
+		if projectChanged {
+			updates["project_id"] = folder.ProjectID
+		}
+		err := tx.Model(&domain.Folder{}).
+			Where("workspace_id = ? AND path LIKE ?", folder.WorkspaceID, escapeLike(oldPath)+"/%").
+			Updates(updates).Error
+		if err != nil || !projectChanged {
+			return err
+		}
+
+		// 하위 트리의 파일도 새 프로젝트로 이동
+		return tx.Model(&domain.File{}).
+			Where("folder_id IN (?)", tx.Model(&domain.Folder{}).Select("id").
+				Where("workspace_id = ? AND (path = ? OR path LIKE ?)", folder.WorkspaceID, folder.Path, escapeLike(folder.Path)+"/%")).
+			Update("project_id", folder.ProjectID).Error
+	})
+}
+
+// CreateTree inserts a copied folder tree and its files in one transaction.
+// Folders must be ordered so that parents come before children.
+// If replaced is not nil, that folder and its subtree are moved to trash first (overwrite on conflict).
+func (r *FolderRepository) CreateTree(ctx context.Context, folders []domain.Folder, files []domain.File, replaced *domain.Folder) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if replaced != nil {
+			if err := trashSubtree(tx, replaced); err != nil {
+				return err
+			}
+		}
+		for i := range folders {
+			if err := tx.Create(&folders[i]).Error; err != nil {
+				return err
+			}
+		}
+		if len(files) > 0 {
+			if err := tx.CreateInBatches(files, 100).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// trashSubtree soft deletes a folder, its descendants and all of their files within a transaction
+func trashSubtree(tx *gorm.DB, folder *domain.Folder) error {
+	now := time.Now()
+	subtree := tx.Model(&domain.Folder{}).Select("id").
+		Where("workspace_id = ? AND (path = ? OR path LIKE ?) AND deleted_at IS NULL", folder.WorkspaceID, folder.Path, escapeLike(folder.Path)+"/%")
+
+	err := tx.Model(&domain.File{}).
+		Where("folder_id IN (?) AND deleted_at IS NULL", subtree).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"status":     domain.FileStatusDeleted,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Model(&domain.Folder{}).
+		Where("workspace_id = ? AND (path = ? OR path LIKE ?) AND deleted_at IS NULL", folder.WorkspaceID, folder.Path, escapeLike(folder.Path)+"/%").
+		Update("deleted_at", now).Error
+}
+
+// escapeLike escapes LIKE wildcards in user-provided names
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
 }
 
 // SoftDelete soft deletes a folder (move to trash)
@@ -161,9 +251,14 @@ func (r *FolderRepository) Restore(ctx context.Context, id uuid.UUID) error {
 		Update("deleted_at", nil).Error
 }
 
-// PermanentDelete permanently deletes a folder
+// PermanentDelete permanently deletes a folder with its tags, stars and recent entries
 func (r *FolderRepository) PermanentDelete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Unscoped().Delete(&domain.Folder{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteItemReferences(tx, domain.ItemTypeFolder, []uuid.UUID{id}); err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.Folder{}, id).Error
+	})
 }
 
 // FindDeleted finds all deleted folders in a workspace (trash)
@@ -176,6 +271,99 @@ func (r *FolderRepository) FindDeleted(ctx context.Context, workspaceID uuid.UUI
 	return folders, err
 }
 
+// FindTrashWorkspaceIDs finds the distinct workspaces that have folders in trash
+func (r *FolderRepository) FindTrashWorkspaceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&domain.Folder{}).
+		Where("deleted_at IS NOT NULL").
+		Distinct().
+		Pluck("workspace_id", &ids).Error
+	return ids, err
+}
+
+// FindDeletedBefore finds folders in trash of a workspace that were deleted before the cutoff.
+// Deepest folders come first so that children are purged before their parents.
+func (r *FolderRepository) FindDeletedBefore(ctx context.Context, workspaceID uuid.UUID, cutoff time.Time) ([]domain.Folder, error) {
+	var folders []domain.Folder
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND deleted_at IS NOT NULL AND deleted_at < ?", workspaceID, cutoff).
+		Order("LENGTH(path) DESC").
+		Find(&folders).Error
+	return folders, err
+}
+
+// FindByNameInParent finds an active folder by name within a parent (nil means root)
+func (r *FolderRepository) FindByNameInParent(ctx context.Context, workspaceID uuid.UUID, parentID *uuid.UUID, name string) (*domain.Folder, error) {
+	var folder domain.Folder
+	query := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND name = ? AND deleted_at IS NULL", workspaceID, name)
+
+	if parentID == nil {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", parentID)
+	}
+
+	if err := query.First(&folder).Error; err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// FindOrCreateInParent returns the active folder named folder.Name in folder's parent and project
+// scope, creating folder when there is none. Calls for the same name are serialized with a
+// transaction-scoped advisory lock so concurrent callers never create duplicates.
+func (r *FolderRepository) FindOrCreateInParent(ctx context.Context, folder *domain.Folder) (*domain.Folder, error) {
+	lockKey := fmt.Sprintf("storage_folders:%s:%s:%s:%s",
+		folder.WorkspaceID, uuidOrEmpty(folder.ProjectID), uuidOrEmpty(folder.ParentID), folder.Name)
+
+	var result domain.Folder
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", lockKey).Error; err != nil {
+			return err
+		}
+
+		query := tx.Where("workspace_id = ? AND name = ? AND deleted_at IS NULL", folder.WorkspaceID, folder.Name)
+		if folder.ParentID == nil {
+			query = query.Where("parent_id IS NULL")
+		} else {
+			query = query.Where("parent_id = ?", folder.ParentID)
+		}
+		if folder.ProjectID == nil {
+			query = query.Where("project_id IS NULL")
+		} else {
+			query = query.Where("project_id = ?", folder.ProjectID)
+		}
+
+		err := query.First(&result).Error
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := tx.Create(folder).Error; err != nil {
+			return err
+		}
+		result = *folder
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// uuidOrEmpty formats an optional ID for lock keys
+func uuidOrEmpty(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
 // CountByWorkspaceID counts folders in a workspace
 func (r *FolderRepository) CountByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
 	var count int64
@@ -196,6 +384,16 @@ func (r *FolderRepository) CountByParentID(ctx context.Context, parentID uuid.UU
 	return count, err
 }
 
+// CountByParentIDWithDeleted counts child folders of a folder including trashed folders
+func (r *FolderRepository) CountByParentIDWithDeleted(ctx context.Context, parentID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.Folder{}).
+		Where("parent_id = ?", parentID).
+		Count(&count).Error
+	return count, err
+}
+
 // ExistsByNameInParent checks if a folder with the given name exists in the parent
 func (r *FolderRepository) ExistsByNameInParent(ctx context.Context, workspaceID uuid.UUID, parentID *uuid.UUID, name string) (bool, error) {
 	var count int64
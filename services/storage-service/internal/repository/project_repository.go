@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"storage-service/internal/domain"
 )
@@ -26,6 +27,7 @@ type ProjectRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	PermanentDelete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) error
+	FindOrCreateBySource(ctx context.Context, project *domain.Project) (*domain.Project, error)
 
 	// Project Members
 	AddMember(ctx context.Context, member *domain.ProjectMember) error
@@ -136,6 +138,30 @@ func (r *projectRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	return result.Error
 }
 
+// FindOrCreateBySource returns the project mirroring project.SourceProjectID of project.Source,
+// creating it from project when missing. Soft deleted projects are returned as they are.
+// Concurrent calls for the same source project return the same row.
+func (r *projectRepository) FindOrCreateBySource(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "source"}, {Name: "source_project_id"}},
+			DoNothing: true,
+		}).
+		Create(project).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var existing domain.Project
+	err = r.db.WithContext(ctx).
+		Where("source = ? AND source_project_id = ?", project.Source, project.SourceProjectID).
+		First(&existing).Error
+	if err != nil {
+		return nil, err
+	}
+	return &existing, nil
+}
+
 // AddMember adds a new member to a project
 func (r *projectRepository) AddMember(ctx context.Context, member *domain.ProjectMember) error {
 	// Check if member already exists
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+)
+
+// PurgeRepository hard-deletes storage records of deleted workspaces and accounts
+// 다른 저장소와 달리 소프트 삭제된 행까지 포함해 영구 삭제합니다.
+type PurgeRepository struct {
+	db *gorm.DB
+}
+
+// NewPurgeRepository creates a new PurgeRepository
+func NewPurgeRepository(db *gorm.DB) *PurgeRepository {
+	return &PurgeRepository{db: db}
+}
+
+// purgeStep is a single hard delete executed inside a purge transaction
+type purgeStep struct {
+	model interface{}
+	query string
+	args  []interface{}
+}
+
+// runPurgeSteps executes the steps in order and stops at the first error
+func runPurgeSteps(tx *gorm.DB, steps []purgeStep) error {
+	for _, step := range steps {
+		if err := tx.Unscoped().Where(step.query, step.args...).Delete(step.model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeWorkspace deletes every storage row belonging to a workspace in one transaction
+// Returns the number of file records removed. Safe to call repeatedly.
+func (r *PurgeRepository) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	var filesDeleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fileIDs := tx.Model(&domain.File{}).Unscoped().Select("id").Where("workspace_id = ?", workspaceID)
+		folderIDs := tx.Model(&domain.Folder{}).Unscoped().Select("id").Where("workspace_id = ?", workspaceID)
+		fileShareIDs := tx.Model(&domain.FileShare{}).Select("id").Where("file_id IN (?)", fileIDs)
+		folderShareIDs := tx.Model(&domain.FolderShare{}).Select("id").Where("folder_id IN (?)", folderIDs)
+		projectIDs := tx.Model(&domain.Project{}).Unscoped().Select("id").Where("workspace_id = ?", workspaceID)
+
+		// 공유/태그 등 파일·폴더를 참조하는 행을 먼저 삭제
+		if err := runPurgeSteps(tx, []purgeStep{
+			{&domain.ShareAccessLog{}, "share_id IN (?) OR share_id IN (?)", []interface{}{fileShareIDs, folderShareIDs}},
+			{&domain.FileShare{}, "file_id IN (?)", []interface{}{fileIDs}},
+			{&domain.FolderShare{}, "folder_id IN (?)", []interface{}{folderIDs}},
+			{&domain.ItemTag{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.Tag{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.Star{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.RecentItem{}, "workspace_id = ?", []interface{}{workspaceID}},
+		}); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("workspace_id = ?", workspaceID).Delete(&domain.File{})
+		if result.Error != nil {
+			return result.Error
+		}
+		filesDeleted = result.RowsAffected
+
+		return runPurgeSteps(tx, []purgeStep{
+			{&domain.Folder{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.ProjectMember{}, "project_id IN (?)", []interface{}{projectIDs}},
+			{&domain.Project{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.WorkspaceStorageSettings{}, "workspace_id = ?", []interface{}{workspaceID}},
+		})
+	})
+	return filesDeleted, err
+}
+
+// PurgeUser deletes the personal storage data of a user across all workspaces
+// Files the user uploaded belong to the workspace and are kept.
+func (r *PurgeRepository) PurgeUser(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return runPurgeSteps(tx, []purgeStep{
+			{&domain.Star{}, "user_id = ?", []interface{}{userID}},
+			{&domain.RecentItem{}, "user_id = ?", []interface{}{userID}},
+			{&domain.ProjectMember{}, "user_id = ?", []interface{}{userID}},
+			{&domain.FileShare{}, "shared_with_id = ?", []interface{}{userID}},
+			{&domain.FolderShare{}, "shared_with_id = ?", []interface{}{userID}},
+		})
+	})
+}
@@ -94,9 +94,20 @@ func (r *ShareRepository) UpdateFileShare(ctx context.Context, share *domain.Fil
 	return r.db.WithContext(ctx).Save(share).Error
 }
 
-// DeleteFileShare deletes a file share
+// DeleteFileShare deletes a file share and its access logs
 func (r *ShareRepository) DeleteFileShare(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.FileShare{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("share_id = ?", id).Delete(&domain.ShareAccessLog{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.FileShare{}, id).Error
+	})
+}
+
+// IncrementFileShareDownload atomically consumes one download of a file share link.
+// Returns false if the download limit has already been reached.
+func (r *ShareRepository) IncrementFileShareDownload(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.incrementDownload(ctx, &domain.FileShare{}, id)
 }
 
 // DeleteFileSharesByFileID deletes all shares for a file
@@ -178,9 +189,20 @@ func (r *ShareRepository) UpdateFolderShare(ctx context.Context, share *domain.F
 	return r.db.WithContext(ctx).Save(share).Error
 }
 
-// DeleteFolderShare deletes a folder share
+// DeleteFolderShare deletes a folder share and its access logs
 func (r *ShareRepository) DeleteFolderShare(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&domain.FolderShare{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("share_id = ?", id).Delete(&domain.ShareAccessLog{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.FolderShare{}, id).Error
+	})
+}
+
+// IncrementFolderShareDownload atomically consumes one download of a folder share link.
+// Returns false if the download limit has already been reached.
+func (r *ShareRepository) IncrementFolderShareDownload(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.incrementDownload(ctx, &domain.FolderShare{}, id)
 }
 
 // DeleteFolderSharesByFolderID deletes all shares for a folder
@@ -263,20 +285,82 @@ func (r *ShareRepository) GetSharedWithMeCount(ctx context.Context, userID uuid.
 	return fileCount + folderCount, nil
 }
 
-// CleanupExpiredShares removes expired share links
+// CleanupExpiredShares removes expired share links and their access logs
 func (r *ShareRepository) CleanupExpiredShares(ctx context.Context) error {
 	now := time.Now()
 
-	// Clean up expired file shares
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Clean up access logs of expired shares
+		expiredFileShares := tx.Model(&domain.FileShare{}).
+			Select("id").
+			Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now)
+		expiredFolderShares := tx.Model(&domain.FolderShare{}).
+			Select("id").
+			Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now)
+		err := tx.Where("share_id IN (?) OR share_id IN (?)", expiredFileShares, expiredFolderShares).
+			Delete(&domain.ShareAccessLog{}).Error
+		if err != nil {
+			return err
+		}
+
+		// Clean up expired file shares
+		err = tx.Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now).
+			Delete(&domain.FileShare{}).Error
+		if err != nil {
+			return err
+		}
+
+		// Clean up expired folder shares
+		return tx.Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now).
+			Delete(&domain.FolderShare{}).Error
+	})
+}
+
+// incrementDownload increments download_count only while it is below max_downloads,
+// so concurrent requests cannot exceed the limit
+func (r *ShareRepository) incrementDownload(ctx context.Context, model interface{}, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(model).
+		Where("id = ? AND (max_downloads IS NULL OR download_count < max_downloads)", id).
+		Updates(map[string]interface{}{
+			"download_count": gorm.Expr("download_count + 1"),
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ============================================================
+// Share Access Log Operations
+// ============================================================
+
+// CreateAccessLog records an access to a share link
+func (r *ShareRepository) CreateAccessLog(ctx context.Context, log *domain.ShareAccessLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+// FindAccessLogs finds access logs of a share with pagination (newest first)
+func (r *ShareRepository) FindAccessLogs(ctx context.Context, shareID uuid.UUID, page, pageSize int) ([]domain.ShareAccessLog, int64, error) {
+	var logs []domain.ShareAccessLog
+	var total int64
+
 	err := r.db.WithContext(ctx).
-		Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now).
-		Delete(&domain.FileShare{}).Error
+		Model(&domain.ShareAccessLog{}).
+		Where("share_id = ?", shareID).
+		Count(&total).Error
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
 
-	// Clean up expired folder shares
-	return r.db.WithContext(ctx).
-		Where("link_expires_at IS NOT NULL AND link_expires_at < ?", now).
-		Delete(&domain.FolderShare{}).Error
+	offset := (page - 1) * pageSize
+	err = r.db.WithContext(ctx).
+		Where("share_id = ?", shareID).
+		Order("accessed_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&logs).Error
+
+	return logs, total, err
 }
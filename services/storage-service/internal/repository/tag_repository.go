@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// TagRepository handles tag database operations
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new TagRepository
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+// TagCount is the number of items attached to a tag
+type TagCount struct {
+	TagID uuid.UUID
+	Count int64
+}
+
+// ItemTagRow is a tag attached to an item
+type ItemTagRow struct {
+	ItemID uuid.UUID
+	domain.Tag
+}
+
+// Create creates a new tag
+func (r *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	return r.db.WithContext(ctx).Create(tag).Error
+}
+
+// FindByID finds a tag by ID
+func (r *TagRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindByIDs finds tags of a workspace by IDs
+func (r *TagRepository) FindByIDs(ctx context.Context, workspaceID uuid.UUID, ids []uuid.UUID) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	if len(ids) == 0 {
+		return tags, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND id IN ?", workspaceID, ids).
+		Find(&tags).Error
+	return tags, err
+}
+
+// FindByWorkspaceID finds all tags in a workspace ordered by name
+func (r *TagRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		Order("name ASC").
+		Find(&tags).Error
+	return tags, err
+}
+
+// ExistsByName checks if a tag with the name exists in the workspace
+func (r *TagRepository) ExistsByName(ctx context.Context, workspaceID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).
+		Model(&domain.Tag{}).
+		Where("workspace_id = ? AND name = ?", workspaceID, name)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+// CountByWorkspaceID counts tags in a workspace
+func (r *TagRepository) CountByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.Tag{}).
+		Where("workspace_id = ?", workspaceID).
+		Count(&count).Error
+	return count, err
+}
+
+// CountItems counts the active files and folders attached to each tag of a workspace
+func (r *TagRepository) CountItems(ctx context.Context, workspaceID uuid.UUID) ([]TagCount, error) {
+	var counts []TagCount
+	err := r.db.WithContext(ctx).
+		Model(&domain.ItemTag{}).
+		Select("storage_item_tags.tag_id, COUNT(*) AS count").
+		Joins("LEFT JOIN storage_files ON storage_item_tags.item_type = ? AND storage_files.id = storage_item_tags.item_id", domain.ItemTypeFile).
+		Joins("LEFT JOIN storage_folders ON storage_item_tags.item_type = ? AND storage_folders.id = storage_item_tags.item_id", domain.ItemTypeFolder).
+		Where("storage_item_tags.workspace_id = ?", workspaceID).
+		Where("(storage_files.id IS NOT NULL AND storage_files.deleted_at IS NULL) OR (storage_folders.id IS NOT NULL AND storage_folders.deleted_at IS NULL)").
+		Group("storage_item_tags.tag_id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// Update updates a tag
+func (r *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	return r.db.WithContext(ctx).Save(tag).Error
+}
+
+// Delete deletes a tag and detaches it from all items
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&domain.ItemTag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Tag{}, id).Error
+	})
+}
+
+// ============================================================
+// Item Tag Operations
+// ============================================================
+
+// SetItemTags replaces the tags attached to an item
+func (r *TagRepository) SetItemTags(ctx context.Context, workspaceID uuid.UUID, itemType domain.ItemType, itemID uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("item_type = ? AND item_id = ?", itemType, itemID).Delete(&domain.ItemTag{}).Error; err != nil {
+			return err
+		}
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		rows := make([]domain.ItemTag, 0, len(tagIDs))
+		for _, tagID := range tagIDs {
+			rows = append(rows, domain.ItemTag{
+				TagID:       tagID,
+				ItemType:    itemType,
+				ItemID:      itemID,
+				WorkspaceID: workspaceID,
+				CreatedBy:   userID,
+				CreatedAt:   now,
+			})
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+	})
+}
+
+// FindItemTags returns the tags attached to the given items
+func (r *TagRepository) FindItemTags(ctx context.Context, itemType domain.ItemType, itemIDs []uuid.UUID) ([]ItemTagRow, error) {
+	var rows []ItemTagRow
+	if len(itemIDs) == 0 {
+		return rows, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&domain.ItemTag{}).
+		Select("storage_item_tags.item_id, storage_tags.*").
+		Joins("JOIN storage_tags ON storage_tags.id = storage_item_tags.tag_id").
+		Where("storage_item_tags.item_type = ? AND storage_item_tags.item_id IN ?", itemType, itemIDs).
+		Order("storage_tags.name ASC").
+		Scan(&rows).Error
+	return rows, err
+}
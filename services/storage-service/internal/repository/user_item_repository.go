@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// UserItemRepository handles per-user starred and recently accessed items
+type UserItemRepository struct {
+	db *gorm.DB
+}
+
+// NewUserItemRepository creates a new UserItemRepository
+func NewUserItemRepository(db *gorm.DB) *UserItemRepository {
+	return &UserItemRepository{db: db}
+}
+
+// ============================================================
+// Star Operations
+// ============================================================
+
+// Star stars an item; starring an already starred item is a no-op
+func (r *UserItemRepository) Star(ctx context.Context, star *domain.Star) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(star).Error
+}
+
+// Unstar removes a star
+func (r *UserItemRepository) Unstar(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND item_type = ? AND item_id = ?", userID, itemType, itemID).
+		Delete(&domain.Star{}).Error
+}
+
+// FindStarredFiles finds the active files starred by a user in a workspace
+func (r *UserItemRepository) FindStarredFiles(ctx context.Context, userID, workspaceID uuid.UUID) ([]domain.File, error) {
+	var files []domain.File
+	err := r.db.WithContext(ctx).
+		Joins("JOIN storage_stars ON storage_stars.item_type = ? AND storage_stars.item_id = storage_files.id", domain.ItemTypeFile).
+		Where("storage_stars.user_id = ? AND storage_files.workspace_id = ?", userID, workspaceID).
+		Where("storage_files.deleted_at IS NULL AND storage_files.status = ?", domain.FileStatusActive).
+		Order("storage_stars.created_at DESC").
+		Find(&files).Error
+	return files, err
+}
+
+// FindStarredFolders finds the active folders starred by a user in a workspace
+func (r *UserItemRepository) FindStarredFolders(ctx context.Context, userID, workspaceID uuid.UUID) ([]domain.Folder, error) {
+	var folders []domain.Folder
+	err := r.db.WithContext(ctx).
+		Joins("JOIN storage_stars ON storage_stars.item_type = ? AND storage_stars.item_id = storage_folders.id", domain.ItemTypeFolder).
+		Where("storage_stars.user_id = ? AND storage_folders.workspace_id = ?", userID, workspaceID).
+		Where("storage_folders.deleted_at IS NULL").
+		Order("storage_stars.created_at DESC").
+		Find(&folders).Error
+	return folders, err
+}
+
+// FindStarredIDs returns which of the given items are starred by the user
+func (r *UserItemRepository) FindStarredIDs(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if len(itemIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&domain.Star{}).
+		Where("user_id = ? AND item_type = ? AND item_id IN ?", userID, itemType, itemIDs).
+		Pluck("item_id", &ids).Error
+	return ids, err
+}
+
+// ============================================================
+// Recent Item Operations
+// ============================================================
+
+// RecordAccess upserts the last access time of an item and trims the user's
+// recent list to the newest limit entries
+func (r *UserItemRepository) RecordAccess(ctx context.Context, item *domain.RecentItem, limit int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "item_type"}, {Name: "item_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"accessed_at", "workspace_id"}),
+		}).Create(item).Error
+		if err != nil {
+			return err
+		}
+
+		// limit번째 이후의 오래된 항목 삭제
+		return tx.Exec(`
+			DELETE FROM storage_recent_items
+			WHERE user_id = ? AND workspace_id = ? AND accessed_at < (
+				SELECT accessed_at FROM storage_recent_items
+				WHERE user_id = ? AND workspace_id = ?
+				ORDER BY accessed_at DESC
+				OFFSET ? LIMIT 1
+			)`,
+			item.UserID, item.WorkspaceID, item.UserID, item.WorkspaceID, limit-1,
+		).Error
+	})
+}
+
+// FindRecent finds the most recently accessed items of a user in a workspace
+func (r *UserItemRepository) FindRecent(ctx context.Context, userID, workspaceID uuid.UUID, limit int) ([]domain.RecentItem, error) {
+	var items []domain.RecentItem
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND workspace_id = ?", userID, workspaceID).
+		Order("accessed_at DESC").
+		Limit(limit).
+		Find(&items).Error
+	return items, err
+}
+
+// ============================================================
+// Cleanup
+// ============================================================
+
+// deleteItemReferences removes tags, stars and recent entries of permanently deleted items
+func deleteItemReferences(tx *gorm.DB, itemType domain.ItemType, itemIDs []uuid.UUID) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	for _, model := range []interface{}{&domain.ItemTag{}, &domain.Star{}, &domain.RecentItem{}} {
+		if err := tx.Where("item_type = ? AND item_id IN ?", itemType, itemIDs).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"storage-service/internal/domain"
+)
+
+// WorkspaceSettingsRepository handles workspace storage settings database operations
+type WorkspaceSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceSettingsRepository creates a new WorkspaceSettingsRepository
+func NewWorkspaceSettingsRepository(db *gorm.DB) *WorkspaceSettingsRepository {
+	return &WorkspaceSettingsRepository{db: db}
+}
+
+// FindByWorkspaceID finds the settings of a workspace
+func (r *WorkspaceSettingsRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceStorageSettings, error) {
+	var settings domain.WorkspaceStorageSettings
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		First(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// FindByWorkspaceIDs finds the settings of several workspaces, keyed by workspace ID
+func (r *WorkspaceSettingsRepository) FindByWorkspaceIDs(ctx context.Context, workspaceIDs []uuid.UUID) (map[uuid.UUID]domain.WorkspaceStorageSettings, error) {
+	result := make(map[uuid.UUID]domain.WorkspaceStorageSettings, len(workspaceIDs))
+	if len(workspaceIDs) == 0 {
+		return result, nil
+	}
+
+	var settings []domain.WorkspaceStorageSettings
+	err := r.db.WithContext(ctx).
+		Where("workspace_id IN ?", workspaceIDs).
+		Find(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range settings {
+		result[s.WorkspaceID] = s
+	}
+	return result, nil
+}
+
+// Upsert creates or updates the settings of a workspace
+func (r *WorkspaceSettingsRepository) Upsert(ctx context.Context, settings *domain.WorkspaceStorageSettings) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "workspace_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"trash_retention_days", "updated_by", "updated_at"}),
+		}).
+		Create(settings).Error
+}
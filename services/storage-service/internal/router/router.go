@@ -9,8 +9,10 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
 	"storage-service/internal/client"
 	"storage-service/internal/config"
@@ -33,7 +35,12 @@ type Config struct {
 	Metrics         *metrics.Metrics
 	RedisClient     *redis.Client
 	RateLimitConfig config.RateLimitConfig
-	ServiceName     string // Service name for OTEL tracing
+	WorkspaceQuota  int64                       // Max bytes stored per workspace; 0 = unlimited
+	ServiceName     string                      // Service name for OTEL tracing
+	Maintenance     *service.MaintenanceService // Optional; created from repositories if nil
+	InternalAPIKey  string                      // Service-to-service API key; empty disables /internal routes
+	FeatureFlags    *featureflag.Client         // ops-service feature flags (nil serves every flag's default)
+	Permissions     *permission.Resolver        // workspace permissions incl. custom roles (nil uses membership only)
 }
 
 // Setup sets up the router with all routes
@@ -89,21 +96,53 @@ func Setup(cfg Config) *gin.Engine {
 
 	// Initialize services
 	// 각 서비스에 필요한 의존성 주입
-	folderService := service.NewFolderService(folderRepo, fileRepo, cfg.Logger)
-	fileService := service.NewFileService(fileRepo, folderRepo, cfg.S3Client, cfg.Logger, m) // 메트릭 포함
-	shareService := service.NewShareService(shareRepo, fileRepo, folderRepo, cfg.Logger)
-	projectService := service.NewProjectService(projectRepo, cfg.UserClient, cfg.Logger)
-	accessService := service.NewAccessService(projectRepo, fileRepo, folderRepo, cfg.UserClient, cfg.Logger)
+	folderService := service.NewFolderService(folderRepo, fileRepo, cfg.S3Client, cfg.Logger)
+	fileService := service.NewFileService(fileRepo, folderRepo, cfg.S3Client, cfg.Logger, m, cfg.WorkspaceQuota) // 메트릭 포함
+	shareService := service.NewShareService(shareRepo, fileRepo, folderRepo, cfg.S3Client, cfg.UserClient, cfg.Logger)
+	projectService := service.NewProjectService(projectRepo, cfg.UserClient, cfg.Permissions, cfg.Logger)
+	accessService := service.NewAccessService(projectRepo, fileRepo, folderRepo, cfg.UserClient, cfg.Permissions, cfg.Logger)
+	bulkService := service.NewBulkService(fileService, folderService, fileRepo, folderRepo, accessService, cfg.Logger)
+	archiveService := service.NewArchiveService(fileRepo, folderRepo, cfg.S3Client, accessService, cfg.FeatureFlags, cfg.Logger)
+	tagService := service.NewTagService(repository.NewTagRepository(cfg.DB), fileRepo, folderRepo, cfg.Logger)
+	userItemService := service.NewUserItemService(repository.NewUserItemRepository(cfg.DB), fileRepo, folderRepo, fileService, cfg.Logger)
+	linkService := service.NewLinkService(fileRepo, folderRepo, projectRepo, cfg.S3Client, cfg.Logger)
+
+	maintenanceService := cfg.Maintenance
+	if maintenanceService == nil {
+		maintenanceService = service.NewMaintenanceService(
+			fileRepo, folderRepo, shareRepo, repository.NewWorkspaceSettingsRepository(cfg.DB),
+			fileService, shareService, cfg.S3Client, service.MaintenanceOptions{}, cfg.Logger,
+		)
+	}
 
 	// Initialize handlers
-	folderHandler := handler.NewFolderHandler(folderService, fileService, accessService)
-	fileHandler := handler.NewFileHandler(fileService, accessService)
-	shareHandler := handler.NewShareHandler(shareService)
+	folderHandler := handler.NewFolderHandler(folderService, fileService, accessService, tagService, userItemService)
+	fileHandler := handler.NewFileHandler(fileService, accessService, tagService, userItemService)
+	shareHandler := handler.NewShareHandler(shareService, archiveService, cfg.Logger)
 	projectHandler := handler.NewProjectHandler(projectService)
+	maintenanceHandler := handler.NewMaintenanceHandler(maintenanceService, accessService)
+	bulkHandler := handler.NewBulkHandler(bulkService, archiveService, cfg.Logger)
+	tagHandler := handler.NewTagHandler(tagService, accessService)
+	userItemHandler := handler.NewUserItemHandler(userItemService, tagService, accessService)
+	purgeService := service.NewPurgeService(repository.NewPurgeRepository(cfg.DB), cfg.S3Client, cfg.Logger)
+	internalHandler := handler.NewInternalHandler(linkService, fileService, purgeService)
 
 	// API routes group
 	api := r.Group(cfg.BasePath)
 
+	// Share link guard - 공유 링크 토큰 무차별 대입 방지 (IP 기준)
+	shareLinkGuard := func(c *gin.Context) { c.Next() }
+	if cfg.RedisClient != nil {
+		shareLinkGuard = middleware.NewShareLinkGuard(
+			cfg.RedisClient,
+			cfg.RateLimitConfig.ShareLinkRequestsPerMinute,
+			cfg.RateLimitConfig.ShareLinkFailuresPerHour,
+			cfg.Logger,
+		).Middleware()
+	} else {
+		cfg.Logger.Warn("Redis is not available, share link rate limiting is disabled")
+	}
+
 	// Auth middleware - check ISTIO_JWT_MODE first
 	var authMiddleware gin.HandlerFunc
 	istioJWTMode := os.Getenv("ISTIO_JWT_MODE") == "true"
@@ -138,12 +177,19 @@ func Setup(cfg Config) *gin.Engine {
 			folders.GET("/contents", folderHandler.GetFolderContents)
 			folders.GET("/:folderId", folderHandler.GetFolder)
 			folders.PUT("/:folderId", folderHandler.UpdateFolder)
+			folders.POST("/:folderId/move", folderHandler.MoveFolder)
+			folders.POST("/:folderId/copy", folderHandler.CopyFolder)
 			folders.DELETE("/:folderId", folderHandler.DeleteFolder)
 			folders.POST("/:folderId/restore", folderHandler.RestoreFolder)
 			folders.DELETE("/:folderId/permanent", folderHandler.PermanentDeleteFolder)
 
 			// Folder shares
 			folders.GET("/:folderId/shares", shareHandler.GetFolderShares)
+
+			// Folder tags and stars
+			folders.PUT("/:folderId/tags", tagHandler.SetFolderTags)
+			folders.PUT("/:folderId/star", userItemHandler.StarFolder)
+			folders.DELETE("/:folderId/star", userItemHandler.UnstarFolder)
 		}
 
 		// ============================================================
@@ -153,6 +199,11 @@ func Setup(cfg Config) *gin.Engine {
 		{
 			files.POST("/upload-url", fileHandler.GenerateUploadURL)
 			files.POST("/confirm", fileHandler.ConfirmUpload)
+			files.POST("/multipart", fileHandler.InitiateMultipartUpload)
+			files.GET("/:fileId/multipart", fileHandler.GetMultipartUploadStatus)
+			files.POST("/:fileId/multipart/parts/:partNumber", fileHandler.GenerateMultipartPartURL)
+			files.POST("/:fileId/multipart/complete", fileHandler.CompleteMultipartUpload)
+			files.DELETE("/:fileId/multipart", fileHandler.AbortMultipartUpload)
 			files.GET("/:fileId", fileHandler.GetFile)
 			files.GET("/:fileId/download", fileHandler.GetDownloadURL)
 			files.PUT("/:fileId", fileHandler.UpdateFile)
@@ -162,22 +213,53 @@ func Setup(cfg Config) *gin.Engine {
 
 			// File shares
 			files.GET("/:fileId/shares", shareHandler.GetFileShares)
+
+			// File tags and stars
+			files.PUT("/:fileId/tags", tagHandler.SetFileTags)
+			files.PUT("/:fileId/star", userItemHandler.StarFile)
+			files.DELETE("/:fileId/star", userItemHandler.UnstarFile)
 		}
 
+		// ============================================================
+		// Bulk routes (mixed files and folders)
+		// ============================================================
+		bulk := storage.Group("/bulk")
+		{
+			bulk.POST("/move", bulkHandler.BulkMove)
+			bulk.POST("/copy", bulkHandler.BulkCopy)
+			bulk.POST("/trash", bulkHandler.BulkTrash)
+			bulk.POST("/restore", bulkHandler.BulkRestore)
+			bulk.POST("/delete", bulkHandler.BulkPermanentDelete)
+		}
+
+		// ZIP download of selected files and folders
+		storage.POST("/archive", bulkHandler.DownloadArchive)
+
 		// ============================================================
 		// Share routes
 		// ============================================================
 		shares := storage.Group("/shares")
 		{
 			shares.POST("", shareHandler.CreateShare)
-			shares.GET("/link/:link", shareHandler.GetShareByLink)
+			shares.GET("/link/:link", shareLinkGuard, shareHandler.GetShareByLink)
 			shares.PUT("/:shareId", shareHandler.UpdateShare)
 			shares.DELETE("/:shareId", shareHandler.DeleteShare)
+			shares.GET("/:shareId/access-logs", shareHandler.GetAccessLogs)
 		}
 
 		// Shared with me
 		storage.GET("/shared-with-me", shareHandler.GetSharedWithMe)
 
+		// ============================================================
+		// Tag routes
+		// ============================================================
+		tags := storage.Group("/tags")
+		{
+			tags.POST("", tagHandler.CreateTag)
+			tags.PUT("/:tagId", tagHandler.UpdateTag)
+			tags.DELETE("/:tagId", tagHandler.DeleteTag)
+		}
+
 		// ============================================================
 		// Project routes
 		// ============================================================
@@ -208,6 +290,15 @@ func Setup(cfg Config) *gin.Engine {
 			workspaces.GET("/:workspaceId/files/search", fileHandler.SearchFiles)
 			workspaces.GET("/:workspaceId/usage", fileHandler.GetStorageUsage)
 
+			// Tags, starred and recent items
+			workspaces.GET("/:workspaceId/tags", tagHandler.GetWorkspaceTags)
+			workspaces.GET("/:workspaceId/starred", userItemHandler.GetStarred)
+			workspaces.GET("/:workspaceId/recent", userItemHandler.GetRecent)
+
+			// Storage settings (trash retention)
+			workspaces.GET("/:workspaceId/settings", maintenanceHandler.GetWorkspaceSettings)
+			workspaces.PUT("/:workspaceId/settings", maintenanceHandler.UpdateWorkspaceSettings)
+
 			// Trash
 			workspaces.GET("/:workspaceId/trash/folders", folderHandler.GetTrashFolders)
 			workspaces.GET("/:workspaceId/trash/files", fileHandler.GetTrashFiles)
@@ -218,8 +309,47 @@ func Setup(cfg Config) *gin.Engine {
 	// Public routes (no auth required for shared links)
 	// ============================================================
 	public := api.Group("/public/storage")
+	public.Use(shareLinkGuard)
 	{
 		public.GET("/shares/link/:link", shareHandler.GetShareByLink)
+		public.GET("/shares/link/:link/preview", shareHandler.PreviewByLink)
+		public.POST("/shares/link/:link/download", shareHandler.DownloadByLink)
+
+		// Shared folder browsing (scoped to the shared folder subtree)
+		public.GET("/shares/link/:link/contents", shareHandler.ListSharedFolder)
+		public.GET("/shares/link/:link/files/:fileId/preview", shareHandler.PreviewSharedFolderFile)
+		public.POST("/shares/link/:link/files/:fileId/download", shareHandler.DownloadSharedFolderFile)
+		public.POST("/shares/link/:link/archive", shareHandler.DownloadSharedFolderArchive)
+	}
+
+	// ============================================================
+	// Internal routes (service-to-service, API key auth)
+	// ============================================================
+	if cfg.InternalAPIKey != "" {
+		internal := api.Group("/internal/storage")
+		internal.Use(middleware.InternalAuth(cfg.InternalAPIKey))
+		{
+			// 다른 서비스가 업로드한 객체를 파일로 연결
+			internal.POST("/files/link", internalHandler.LinkObject)
+			internal.POST("/files/unlink", internalHandler.UnlinkObject)
+		}
+
+		// 삭제된 워크스페이스/계정 영구 삭제 (user-service 삭제 작업에서 호출)
+		purge := api.Group("/internal/purge")
+		purge.Use(middleware.InternalAuth(cfg.InternalAPIKey))
+		{
+			purge.DELETE("/workspaces/:workspaceId", internalHandler.PurgeWorkspace)
+			purge.DELETE("/users/:userId", internalHandler.PurgeUser)
+		}
+
+		// 개인 데이터 내보내기 (user-service 내보내기 작업에서 호출)
+		export := api.Group("/internal/export")
+		export.Use(middleware.InternalAuth(cfg.InternalAPIKey))
+		{
+			export.GET("/users/:userId", internalHandler.ExportUserData)
+		}
+	} else {
+		cfg.Logger.Warn("Internal API key is not configured, internal routes are disabled")
 	}
 
 	return r
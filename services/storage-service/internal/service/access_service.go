@@ -6,6 +6,8 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"storage-service/internal/client"
 	"storage-service/internal/domain"
 	"storage-service/internal/repository"
@@ -16,6 +18,7 @@ import (
 type AccessService interface {
 	// Workspace level
 	ValidateWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) error
+	ValidateWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) error
 
 	// Project level
 	ValidateProjectAccess(ctx context.Context, projectID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error
@@ -36,6 +39,7 @@ type accessService struct {
 	fileRepo    *repository.FileRepository
 	folderRepo  *repository.FolderRepository
 	userClient  client.UserClient
+	permissions *permission.Resolver // workspace permissions incl. custom roles (nil uses membership only)
 	logger      *zap.Logger
 }
 
@@ -45,6 +49,7 @@ func NewAccessService(
 	fileRepo *repository.FileRepository,
 	folderRepo *repository.FolderRepository,
 	userClient client.UserClient,
+	permissions *permission.Resolver,
 	logger *zap.Logger,
 ) AccessService {
 	return &accessService{
@@ -52,6 +57,7 @@ func NewAccessService(
 		fileRepo:    fileRepo,
 		folderRepo:  folderRepo,
 		userClient:  userClient,
+		permissions: permissions,
 		logger:      logger,
 	}
 }
@@ -80,6 +86,33 @@ func (s *accessService) ValidateWorkspaceAccess(ctx context.Context, workspaceID
 	return nil
 }
 
+// ValidateWorkspaceAdmin validates that a user is the owner or an admin of a workspace
+func (s *accessService) ValidateWorkspaceAdmin(ctx context.Context, workspaceID, userID uuid.UUID, token string) error {
+	if s.userClient == nil {
+		s.logger.Warn("User client not configured, skipping workspace admin validation")
+		return nil
+	}
+
+	access, err := s.userClient.GetWorkspaceAccess(ctx, workspaceID, userID, token)
+	if err != nil {
+		s.logger.Error("Failed to validate workspace admin",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return response.ErrNotWorkspaceMember
+	}
+
+	if !access.IsWorkspaceMember() {
+		return response.ErrNotWorkspaceMember
+	}
+	if !access.IsWorkspaceAdmin() {
+		return response.ErrInsufficientPermission
+	}
+
+	return nil
+}
+
 // ValidateProjectAccess validates that a user has the required permission for a project
 func (s *accessService) ValidateProjectAccess(ctx context.Context, projectID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error {
 	project, err := s.projectRepo.GetByID(ctx, projectID)
@@ -87,31 +120,13 @@ func (s *accessService) ValidateProjectAccess(ctx context.Context, projectID, us
 		return err
 	}
 
-	// First validate workspace membership
-	if err := s.ValidateWorkspaceAccess(ctx, project.WorkspaceID, userID, token); err != nil {
-		return err
-	}
-
-	// Get user's permission for this project
-	perm, err := s.projectRepo.GetUserPermission(ctx, projectID, userID)
+	// Validate workspace membership (or the guest scope) and check the user's permission for this project
+	_, allowed, err := authorizeProjectPermission(ctx, s.projectRepo, s.userClient, s.permissions, s.logger, project, userID, token, requiredPermission)
 	if err != nil {
-		return response.ErrAccessDenied
+		return err
 	}
-
-	// Check if permission is sufficient
-	switch requiredPermission {
-	case domain.ProjectPermissionViewer:
-		if !perm.CanView() {
-			return response.ErrInsufficientPermission
-		}
-	case domain.ProjectPermissionEditor:
-		if !perm.CanEdit() {
-			return response.ErrInsufficientPermission
-		}
-	case domain.ProjectPermissionOwner:
-		if !perm.CanManage() {
-			return response.ErrInsufficientPermission
-		}
+	if !allowed {
+		return response.ErrInsufficientPermission
 	}
 
 	return nil
@@ -129,19 +144,13 @@ func (s *accessService) ValidateFileAccess(ctx context.Context, fileID, userID u
 		return err
 	}
 
-	// Validate workspace membership
-	if err := s.ValidateWorkspaceAccess(ctx, file.WorkspaceID, userID, token); err != nil {
-		return err
-	}
-
-	// If file belongs to a project, validate project permission
+	// If file belongs to a project, validate project permission (guests may access shared projects)
 	if file.ProjectID != nil {
 		return s.ValidateProjectAccess(ctx, *file.ProjectID, userID, token, requiredPermission)
 	}
 
-	// File is at workspace level - workspace membership is sufficient for viewing
-	// For editing, we could add additional checks here if needed
-	return nil
+	// File is at workspace level - the workspace role decides
+	return s.validateWorkspacePermission(ctx, file.WorkspaceID, userID, token, requiredPermission)
 }
 
 // ValidateFolderAccess validates access to a folder
@@ -151,28 +160,18 @@ func (s *accessService) ValidateFolderAccess(ctx context.Context, folderID, user
 		return err
 	}
 
-	// Validate workspace membership
-	if err := s.ValidateWorkspaceAccess(ctx, folder.WorkspaceID, userID, token); err != nil {
-		return err
-	}
-
-	// If folder belongs to a project, validate project permission
+	// If folder belongs to a project, validate project permission (guests may access shared projects)
 	if folder.ProjectID != nil {
 		return s.ValidateProjectAccess(ctx, *folder.ProjectID, userID, token, requiredPermission)
 	}
 
-	// Folder is at workspace level - workspace membership is sufficient for viewing
-	return nil
+	// Folder is at workspace level - the workspace role decides
+	return s.validateWorkspacePermission(ctx, folder.WorkspaceID, userID, token, requiredPermission)
 }
 
 // ValidateResourceAccess validates access to a workspace and optionally a project
 func (s *accessService) ValidateResourceAccess(ctx context.Context, workspaceID uuid.UUID, projectID *uuid.UUID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error {
-	// Always validate workspace membership
-	if err := s.ValidateWorkspaceAccess(ctx, workspaceID, userID, token); err != nil {
-		return err
-	}
-
-	// If project is specified, validate project permission
+	// If project is specified, validate project permission (guests may access shared projects)
 	if projectID != nil {
 		// Verify project belongs to this workspace
 		project, err := s.projectRepo.GetByID(ctx, *projectID)
@@ -187,5 +186,19 @@ func (s *accessService) ValidateResourceAccess(ctx context.Context, workspaceID
 		return s.ValidateProjectAccess(ctx, *projectID, userID, token, requiredPermission)
 	}
 
+	// Otherwise the workspace role decides
+	return s.validateWorkspacePermission(ctx, workspaceID, userID, token, requiredPermission)
+}
+
+// validateWorkspacePermission validates workspace membership and that the user's workspace role,
+// which may be a custom role, grants the storage permission of requiredPermission.
+// If user-service cannot resolve the role, membership is sufficient.
+func (s *accessService) validateWorkspacePermission(ctx context.Context, workspaceID, userID uuid.UUID, token string, requiredPermission domain.ProjectPermission) error {
+	if err := s.ValidateWorkspaceAccess(ctx, workspaceID, userID, token); err != nil {
+		return err
+	}
+	if !s.permissions.Allows(ctx, workspaceID, userID, requiredPermission.StoragePermission(), true) {
+		return response.ErrInsufficientPermission
+	}
 	return nil
 }
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// FlagZIPDownload switches ZIP downloads of selected files and folders per workspace or user (default on)
+const FlagZIPDownload = "storage.zip-download"
+
+// Archive limits
+const (
+	MaxArchiveEntries = 10000
+	MaxArchiveSize    = 10 * 1024 * 1024 * 1024 // 10GB
+)
+
+// storedExtensions are already compressed, so they are stored without deflate
+var storedExtensions = map[string]bool{
+	".zip": true, ".gz": true, ".tar": true, ".rar": true, ".7z": true,
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true,
+	".mp3": true, ".mp4": true, ".mov": true, ".avi": true, ".webm": true,
+	".docx": true, ".xlsx": true, ".pptx": true, ".pdf": true,
+}
+
+// ArchiveEntry is a single file or directory inside a ZIP archive
+type ArchiveEntry struct {
+	Path     string // Path inside the archive; directories end with "/"
+	FileKey  string // Empty for directories
+	Size     int64
+	Modified time.Time
+}
+
+// ArchivePlan lists the entries of a ZIP archive before it is streamed
+type ArchivePlan struct {
+	Name      string
+	Entries   []ArchiveEntry
+	TotalSize int64
+
+	used map[string]bool
+}
+
+// ArchiveService builds ZIP archives of files and folders
+// 선택한 파일/폴더를 폴더 계층을 유지한 ZIP으로 스트리밍합니다.
+// 전체 아카이브를 메모리에 올리지 않고 S3 객체를 하나씩 복사합니다.
+type ArchiveService struct {
+	fileRepo      *repository.FileRepository
+	folderRepo    *repository.FolderRepository
+	s3Client      *client.S3Client
+	accessService AccessService
+	flags         *featureflag.Client // nil이면 항상 허용
+	logger        *zap.Logger
+}
+
+// NewArchiveService creates a new ArchiveService
+func NewArchiveService(
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	s3Client *client.S3Client,
+	accessService AccessService,
+	flags *featureflag.Client,
+	logger *zap.Logger,
+) *ArchiveService {
+	return &ArchiveService{
+		fileRepo:      fileRepo,
+		folderRepo:    folderRepo,
+		s3Client:      s3Client,
+		accessService: accessService,
+		flags:         flags,
+		logger:        logger,
+	}
+}
+
+// PrepareArchive resolves and authorizes the requested items into an archive plan.
+// All validation happens here so that errors can be returned before streaming starts.
+func (s *ArchiveService) PrepareArchive(ctx context.Context, req domain.ArchiveRequest, userID uuid.UUID, token string) (*ArchivePlan, error) {
+	if len(req.FileIDs)+len(req.FolderIDs) == 0 {
+		return nil, response.NewValidationError("at least one file or folder is required", "")
+	}
+	ectx := featureflag.EvalContext{WorkspaceID: req.WorkspaceID.String(), UserID: userID.String()}
+	if !s.flags.Bool(FlagZIPDownload, ectx, true) {
+		return nil, response.NewForbiddenError("ZIP download is disabled", req.WorkspaceID.String())
+	}
+
+	plan := newArchivePlan(req.Name)
+
+	for _, id := range uniqueIDs(req.FolderIDs) {
+		folder, err := s.folderRepo.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("folder not found", id.String())
+			}
+			return nil, fmt.Errorf("failed to get folder: %w", err)
+		}
+		if folder.WorkspaceID != req.WorkspaceID {
+			return nil, response.NewForbiddenError("folder belongs to different workspace", id.String())
+		}
+		if s.accessService != nil {
+			if err := s.accessService.ValidateResourceAccess(ctx, folder.WorkspaceID, folder.ProjectID, userID, token, domain.ProjectPermissionViewer); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.addFolder(ctx, plan, folder, "", true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range uniqueIDs(req.FileIDs) {
+		file, err := s.fileRepo.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("file not found", id.String())
+			}
+			return nil, fmt.Errorf("failed to get file: %w", err)
+		}
+		if file.WorkspaceID != req.WorkspaceID {
+			return nil, response.NewForbiddenError("file belongs to different workspace", id.String())
+		}
+		if file.Status != domain.FileStatusActive {
+			return nil, response.NewConflictError("file is not available for download", id.String())
+		}
+		if s.accessService != nil {
+			if err := s.accessService.ValidateResourceAccess(ctx, file.WorkspaceID, file.ProjectID, userID, token, domain.ProjectPermissionViewer); err != nil {
+				return nil, err
+			}
+		}
+		if err := plan.addFile("", file); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// PrepareFolderArchive builds an archive plan for a single folder without access checks.
+// Callers are responsible for authorizing access to the folder.
+func (s *ArchiveService) PrepareFolderArchive(ctx context.Context, folder *domain.Folder, includeChildren bool) (*ArchivePlan, error) {
+	plan := newArchivePlan(folder.Name)
+	if err := s.addFolder(ctx, plan, folder, "", includeChildren); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// addFolder adds a folder, and optionally its subtree, preserving the folder Path hierarchy
+func (s *ArchiveService) addFolder(ctx context.Context, plan *ArchivePlan, folder *domain.Folder, prefix string, includeChildren bool) error {
+	root := plan.uniquePath(path.Join(prefix, sanitizeArchiveName(folder.Name)))
+	dirs := map[uuid.UUID]string{folder.ID: root}
+	folderIDs := []uuid.UUID{folder.ID}
+	if err := plan.addDir(root, folder.UpdatedAt); err != nil {
+		return err
+	}
+
+	if includeChildren {
+		descendants, err := s.folderRepo.FindChildrenRecursive(ctx, folder.WorkspaceID, folder.Path)
+		if err != nil {
+			return fmt.Errorf("failed to load folder tree: %w", err)
+		}
+		for _, child := range descendants {
+			// 폴더 Path에서 선택한 폴더 이후의 상대 경로를 유지
+			rel := strings.TrimPrefix(child.Path, folder.Path+"/")
+			parts := strings.Split(rel, "/")
+			for i := range parts {
+				parts[i] = sanitizeArchiveName(parts[i])
+			}
+			dir := path.Join(append([]string{root}, parts...)...)
+			dirs[child.ID] = dir
+			folderIDs = append(folderIDs, child.ID)
+			if err := plan.addDir(dir, child.UpdatedAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	files, err := s.fileRepo.FindActiveByFolderIDs(ctx, folderIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load folder files: %w", err)
+	}
+	for i := range files {
+		if err := plan.addFile(dirs[*files[i].FolderID], &files[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteArchive streams the planned entries as a ZIP archive to w
+func (s *ArchiveService) WriteArchive(ctx context.Context, w io.Writer, plan *ArchivePlan) error {
+	zw := zip.NewWriter(w)
+
+	for _, entry := range plan.Entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     entry.Path,
+			Modified: entry.Modified,
+			Method:   zip.Deflate,
+		}
+		if entry.FileKey == "" {
+			header.Method = zip.Store
+			if _, err := zw.CreateHeader(header); err != nil {
+				return fmt.Errorf("failed to write directory entry: %w", err)
+			}
+			continue
+		}
+		if storedExtensions[strings.ToLower(filepath.Ext(entry.Path))] {
+			header.Method = zip.Store
+		}
+		header.UncompressedSize64 = uint64(entry.Size)
+
+		dst, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("failed to write file entry: %w", err)
+		}
+		if err := s.copyObject(ctx, dst, entry.FileKey); err != nil {
+			return fmt.Errorf("failed to write %s: %w", entry.Path, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+// copyObject streams a single S3 object into the archive
+func (s *ArchiveService) copyObject(ctx context.Context, dst io.Writer, fileKey string) error {
+	body, err := s.s3Client.GetObject(ctx, fileKey)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = io.Copy(dst, body)
+	return err
+}
+
+// newArchivePlan creates an empty plan with a sanitized archive name
+func newArchivePlan(name string) *ArchivePlan {
+	name = strings.TrimSuffix(sanitizeArchiveName(name), ".zip")
+	if name == "" || name == "_" {
+		name = "archive"
+	}
+	return &ArchivePlan{Name: name + ".zip", used: make(map[string]bool)}
+}
+
+// addDir adds a directory entry, enforcing the entry limit
+func (p *ArchivePlan) addDir(dir string, modified time.Time) error {
+	if err := p.checkEntryLimit(); err != nil {
+		return err
+	}
+	p.used[dir] = true
+	p.Entries = append(p.Entries, ArchiveEntry{Path: dir + "/", Modified: modified})
+	return nil
+}
+
+// addFile adds a file entry under dir, enforcing archive limits
+func (p *ArchivePlan) addFile(dir string, file *domain.File) error {
+	if err := p.checkEntryLimit(); err != nil {
+		return err
+	}
+	if p.TotalSize+file.FileSize > MaxArchiveSize {
+		return response.NewValidationError(fmt.Sprintf("archive exceeds maximum size (%d GB)", MaxArchiveSize/(1024*1024*1024)), "")
+	}
+
+	entryPath := p.uniquePath(path.Join(dir, sanitizeArchiveName(file.Name)))
+	p.used[entryPath] = true
+	p.Entries = append(p.Entries, ArchiveEntry{
+		Path:     entryPath,
+		FileKey:  file.FileKey,
+		Size:     file.FileSize,
+		Modified: file.UpdatedAt,
+	})
+	p.TotalSize += file.FileSize
+	return nil
+}
+
+// checkEntryLimit rejects adding an entry to a plan that already holds MaxArchiveEntries entries
+func (p *ArchivePlan) checkEntryLimit() error {
+	if len(p.Entries) >= MaxArchiveEntries {
+		return response.NewValidationError(fmt.Sprintf("archive exceeds maximum of %d entries", MaxArchiveEntries), "")
+	}
+	return nil
+}
+
+// uniquePath appends " (n)" when an entry path is already used
+func (p *ArchivePlan) uniquePath(name string) string {
+	if !p.used[name] {
+		return name
+	}
+
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s (%d)%s", base, i, ext)
+		if !p.used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// sanitizeArchiveName prevents path traversal through user-controlled names
+func sanitizeArchiveName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
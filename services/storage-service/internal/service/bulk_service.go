@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// BulkService runs file and folder operations on many items at once
+// 여러 파일/폴더에 대한 이동, 복사, 휴지통, 복원, 영구 삭제를 일괄 처리합니다.
+// 항목별로 권한을 검사하고 결과를 개별적으로 반환합니다.
+type BulkService struct {
+	fileService   *FileService
+	folderService *FolderService
+	fileRepo      *repository.FileRepository
+	folderRepo    *repository.FolderRepository
+	accessService AccessService
+	logger        *zap.Logger
+}
+
+// NewBulkService creates a new BulkService
+func NewBulkService(
+	fileService *FileService,
+	folderService *FolderService,
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	accessService AccessService,
+	logger *zap.Logger,
+) *BulkService {
+	return &BulkService{
+		fileService:   fileService,
+		folderService: folderService,
+		fileRepo:      fileRepo,
+		folderRepo:    folderRepo,
+		accessService: accessService,
+		logger:        logger,
+	}
+}
+
+// folderOrder controls the order in which selected folders are processed
+type folderOrder int
+
+const (
+	folderOrderAsGiven folderOrder = iota
+	folderOrderShallowFirst
+	folderOrderDeepFirst
+)
+
+// bulkPlan describes how a bulk operation processes its items
+type bulkPlan struct {
+	action       string
+	foldersFirst bool
+	folderOrder  folderOrder
+	fileOp       func(ctx context.Context, file *domain.File) (*uuid.UUID, error)
+	folderOp     func(ctx context.Context, folder *domain.Folder) (newID *uuid.UUID, skipped bool, err error)
+}
+
+// Move moves files and folders into a destination folder
+func (s *BulkService) Move(ctx context.Context, req domain.BulkTransferRequest, userID uuid.UUID, token string) (*domain.BulkOperationResponse, error) {
+	target, err := s.resolveTarget(ctx, req, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// UpdateFile/MoveFolder는 uuid.Nil을 루트로 해석
+	destination := uuid.Nil
+	if target != nil {
+		destination = target.ID
+	}
+
+	return s.run(ctx, req.BulkItemsRequest, userID, token, bulkPlan{
+		action: "move",
+		fileOp: func(ctx context.Context, file *domain.File) (*uuid.UUID, error) {
+			_, err := s.fileService.UpdateFile(ctx, file.ID, domain.UpdateFileRequest{FolderID: &destination}, userID)
+			return nil, err
+		},
+		folderOp: func(ctx context.Context, folder *domain.Folder) (*uuid.UUID, bool, error) {
+			result, err := s.folderService.MoveFolder(ctx, folder.ID, domain.MoveFolderRequest{
+				TargetFolderID: &destination,
+				ConflictPolicy: req.ConflictPolicy,
+			}, userID)
+			if err != nil {
+				return nil, false, err
+			}
+			return nil, result.Skipped, nil
+		},
+	})
+}
+
+// Copy copies files and folder subtrees (including S3 objects) into a destination folder
+func (s *BulkService) Copy(ctx context.Context, req domain.BulkTransferRequest, userID uuid.UUID, token string) (*domain.BulkOperationResponse, error) {
+	target, err := s.resolveTarget(ctx, req, userID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetID *uuid.UUID
+	if target != nil {
+		targetID = &target.ID
+	}
+
+	return s.run(ctx, req.BulkItemsRequest, userID, token, bulkPlan{
+		action: "copy",
+		fileOp: func(ctx context.Context, file *domain.File) (*uuid.UUID, error) {
+			copied, err := s.fileService.CopyFile(ctx, file.ID, targetID, userID)
+			if err != nil {
+				return nil, err
+			}
+			return &copied.ID, nil
+		},
+		folderOp: func(ctx context.Context, folder *domain.Folder) (*uuid.UUID, bool, error) {
+			if folder.IsDeleted() {
+				return nil, false, response.NewConflictError("cannot copy a folder in trash", folder.ID.String())
+			}
+			result, err := s.folderService.CopyFolder(ctx, folder.ID, domain.CopyFolderRequest{
+				TargetFolderID: targetID,
+				ConflictPolicy: req.ConflictPolicy,
+			}, userID)
+			if err != nil {
+				return nil, false, err
+			}
+			if result.Skipped {
+				return nil, true, nil
+			}
+			return &result.Folder.ID, false, nil
+		},
+	})
+}
+
+// Trash moves files and folders to trash
+func (s *BulkService) Trash(ctx context.Context, req domain.BulkItemsRequest, userID uuid.UUID, token string) (*domain.BulkOperationResponse, error) {
+	return s.run(ctx, req, userID, token, bulkPlan{
+		action:      "trash",
+		folderOrder: folderOrderDeepFirst,
+		fileOp: func(ctx context.Context, file *domain.File) (*uuid.UUID, error) {
+			if file.IsDeleted() {
+				return nil, nil // 이미 휴지통에 있음
+			}
+			return nil, s.fileService.DeleteFile(ctx, file.ID, userID)
+		},
+		folderOp: func(ctx context.Context, folder *domain.Folder) (*uuid.UUID, bool, error) {
+			if folder.IsDeleted() {
+				return nil, false, nil
+			}
+			return nil, false, s.folderService.DeleteFolder(ctx, folder.ID, userID)
+		},
+	})
+}
+
+// Restore restores files and folders from trash
+func (s *BulkService) Restore(ctx context.Context, req domain.BulkItemsRequest, userID uuid.UUID, token string) (*domain.BulkOperationResponse, error) {
+	// 폴더를 먼저(상위부터) 복원해야 파일이 살아있는 폴더로 돌아감
+	return s.run(ctx, req, userID, token, bulkPlan{
+		action:       "restore",
+		foldersFirst: true,
+		folderOrder:  folderOrderShallowFirst,
+		fileOp: func(ctx context.Context, file *domain.File) (*uuid.UUID, error) {
+			return nil, s.fileService.RestoreFile(ctx, file.ID, userID)
+		},
+		folderOp: func(ctx context.Context, folder *domain.Folder) (*uuid.UUID, bool, error) {
+			return nil, false, s.folderService.RestoreFolder(ctx, folder.ID, userID)
+		},
+	})
+}
+
+// PermanentDelete permanently deletes files and folders
+func (s *BulkService) PermanentDelete(ctx context.Context, req domain.BulkItemsRequest, userID uuid.UUID, token string) (*domain.BulkOperationResponse, error) {
+	return s.run(ctx, req, userID, token, bulkPlan{
+		action:      "delete",
+		folderOrder: folderOrderDeepFirst,
+		fileOp: func(ctx context.Context, file *domain.File) (*uuid.UUID, error) {
+			return nil, s.fileService.PermanentDeleteFile(ctx, file.ID, userID)
+		},
+		folderOp: func(ctx context.Context, folder *domain.Folder) (*uuid.UUID, bool, error) {
+			return nil, false, s.folderService.PermanentDeleteFolder(ctx, folder.ID, userID)
+		},
+	})
+}
+
+// resolveTarget validates the destination folder of a move or copy (nil means root)
+func (s *BulkService) resolveTarget(ctx context.Context, req domain.BulkTransferRequest, userID uuid.UUID, token string) (*domain.Folder, error) {
+	if req.TargetFolderID == nil || *req.TargetFolderID == uuid.Nil {
+		if s.accessService != nil {
+			if err := s.accessService.ValidateResourceAccess(ctx, req.WorkspaceID, nil, userID, token, domain.ProjectPermissionEditor); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	target, err := s.folderRepo.FindByID(ctx, *req.TargetFolderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("destination folder not found", req.TargetFolderID.String())
+		}
+		return nil, fmt.Errorf("failed to find destination folder: %w", err)
+	}
+	if target.WorkspaceID != req.WorkspaceID {
+		return nil, response.NewForbiddenError("destination folder belongs to different workspace", "")
+	}
+
+	if s.accessService != nil {
+		if err := s.accessService.ValidateResourceAccess(ctx, target.WorkspaceID, target.ProjectID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+// run loads, authorizes and processes every item of a bulk request
+func (s *BulkService) run(ctx context.Context, req domain.BulkItemsRequest, userID uuid.UUID, token string, plan bulkPlan) (*domain.BulkOperationResponse, error) {
+	if req.ItemCount() == 0 {
+		return nil, response.NewValidationError("at least one file or folder is required", "")
+	}
+	if req.ItemCount() > domain.MaxBulkItems {
+		return nil, response.NewValidationError(fmt.Sprintf("too many items (max %d)", domain.MaxBulkItems), "")
+	}
+
+	resp := &domain.BulkOperationResponse{Results: make([]domain.BulkItemResult, 0, req.ItemCount())}
+	record := func(result domain.BulkItemResult) {
+		if result.Success {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	// 폴더를 먼저 로드해 처리 순서를 정함
+	var folders []*domain.Folder
+	for _, id := range uniqueIDs(req.FolderIDs) {
+		folder, err := s.loadFolder(ctx, req.WorkspaceID, id, userID, token)
+		if err != nil {
+			record(failedResult(id, domain.BulkItemTypeFolder, err))
+			continue
+		}
+		folders = append(folders, folder)
+	}
+	switch plan.folderOrder {
+	case folderOrderShallowFirst:
+		sort.SliceStable(folders, func(i, j int) bool { return pathDepth(folders[i].Path) < pathDepth(folders[j].Path) })
+	case folderOrderDeepFirst:
+		sort.SliceStable(folders, func(i, j int) bool { return pathDepth(folders[i].Path) > pathDepth(folders[j].Path) })
+	}
+
+	processFolders := func() {
+		for _, folder := range folders {
+			newID, skipped, err := plan.folderOp(ctx, folder)
+			if err != nil {
+				record(failedResult(folder.ID, domain.BulkItemTypeFolder, err))
+				continue
+			}
+			record(domain.BulkItemResult{ID: folder.ID, Type: domain.BulkItemTypeFolder, Success: true, Skipped: skipped, NewID: newID})
+		}
+	}
+	processFiles := func() {
+		for _, id := range uniqueIDs(req.FileIDs) {
+			file, err := s.loadFile(ctx, req.WorkspaceID, id, userID, token)
+			if err != nil {
+				record(failedResult(id, domain.BulkItemTypeFile, err))
+				continue
+			}
+			newID, err := plan.fileOp(ctx, file)
+			if err != nil {
+				record(failedResult(id, domain.BulkItemTypeFile, err))
+				continue
+			}
+			record(domain.BulkItemResult{ID: id, Type: domain.BulkItemTypeFile, Success: true, NewID: newID})
+		}
+	}
+
+	if plan.foldersFirst {
+		processFolders()
+		processFiles()
+	} else {
+		// 휴지통/삭제는 파일을 먼저 처리해야 폴더와 함께 삭제된 파일로 인한 실패가 없음
+		processFiles()
+		processFolders()
+	}
+
+	s.logger.Info("Bulk operation completed",
+		zap.String("action", plan.action),
+		zap.String("workspaceId", req.WorkspaceID.String()),
+		zap.String("userId", userID.String()),
+		zap.Int("succeeded", resp.Succeeded),
+		zap.Int("failed", resp.Failed),
+	)
+
+	return resp, nil
+}
+
+// loadFile loads a file (including trashed ones) and checks editor access
+func (s *BulkService) loadFile(ctx context.Context, workspaceID, fileID, userID uuid.UUID, token string) (*domain.File, error) {
+	file, err := s.fileRepo.FindByIDWithDeleted(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file.WorkspaceID != workspaceID {
+		return nil, response.NewForbiddenError("file belongs to different workspace", "")
+	}
+	if s.accessService != nil {
+		if err := s.accessService.ValidateResourceAccess(ctx, file.WorkspaceID, file.ProjectID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+// loadFolder loads a folder (including trashed ones) and checks editor access
+func (s *BulkService) loadFolder(ctx context.Context, workspaceID, folderID, userID uuid.UUID, token string) (*domain.Folder, error) {
+	folder, err := s.folderRepo.FindByIDWithDeleted(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("folder not found", folderID.String())
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	if folder.WorkspaceID != workspaceID {
+		return nil, response.NewForbiddenError("folder belongs to different workspace", "")
+	}
+	if s.accessService != nil {
+		if err := s.accessService.ValidateResourceAccess(ctx, folder.WorkspaceID, folder.ProjectID, userID, token, domain.ProjectPermissionEditor); err != nil {
+			return nil, err
+		}
+	}
+	return folder, nil
+}
+
+// failedResult builds a failed item result from an error
+func failedResult(id uuid.UUID, itemType domain.BulkItemType, err error) domain.BulkItemResult {
+	result := domain.BulkItemResult{ID: id, Type: itemType}
+	switch {
+	case apperrors.AsAppError(err) != nil:
+		appErr := apperrors.AsAppError(err)
+		result.ErrorCode = appErr.Code
+		result.Error = appErr.Message
+	case errors.Is(err, response.ErrAccessDenied),
+		errors.Is(err, response.ErrNotWorkspaceMember),
+		errors.Is(err, response.ErrInsufficientPermission):
+		result.ErrorCode = apperrors.ErrCodeForbidden
+		result.Error = err.Error()
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		result.ErrorCode = apperrors.ErrCodeNotFound
+		result.Error = "not found"
+	default:
+		result.ErrorCode = apperrors.ErrCodeInternal
+		result.Error = "operation failed"
+	}
+	return result
+}
+
+// uniqueIDs removes duplicate IDs while keeping the original order
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// pathDepth returns the nesting depth of a folder path like /a/b
+func pathDepth(path string) int {
+	return strings.Count(path, "/")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +32,16 @@ var (
 // MaxFileSize is the maximum allowed file size (100MB)
 const MaxFileSize = 100 * 1024 * 1024
 
+// Multipart upload limits
+const (
+	MaxMultipartFileSize = 5 * 1024 * 1024 * 1024 // 5GB
+	MinPartSize          = 8 * 1024 * 1024        // S3 requires >= 5MB for all but the last part
+	MaxPartCount         = 10000                  // S3 hard limit
+	partURLExpiry        = 1 * time.Hour
+	orphanUploadTTL      = 1 * time.Hour  // single PUT uploads not confirmed within this window are removed
+	staleMultipartTTL    = 24 * time.Hour // multipart uploads idle longer than this are aborted
+)
+
 // FileService handles file business logic
 // 파일 업로드, 다운로드, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
@@ -40,16 +51,19 @@ type FileService struct {
 	s3Client   *client.S3Client
 	logger     *zap.Logger
 	metrics    *metrics.Metrics // 메트릭 수집을 위한 필드
+	quota      int64            // 워크스페이스당 최대 저장 용량 (bytes), 0이면 무제한
 }
 
 // NewFileService creates a new FileService
 // metrics 파라미터가 nil인 경우에도 안전하게 동작합니다.
+// workspaceQuota limits the bytes stored per workspace; 0 disables the quota.
 func NewFileService(
 	fileRepo *repository.FileRepository,
 	folderRepo *repository.FolderRepository,
 	s3Client *client.S3Client,
 	logger *zap.Logger,
 	m *metrics.Metrics,
+	workspaceQuota int64,
 ) *FileService {
 	return &FileService{
 		fileRepo:   fileRepo,
@@ -57,6 +71,7 @@ func NewFileService(
 		s3Client:   s3Client,
 		logger:     logger,
 		metrics:    m,
+		quota:      workspaceQuota,
 	}
 }
 
@@ -171,18 +186,41 @@ func (s *FileService) ConfirmUpload(ctx context.Context, fileID, userID uuid.UUI
 		return nil, response.NewConflictError("file is not in uploading state", string(file.Status))
 	}
 
+	// 멀티파트 업로드는 complete API로만 확정
+	if file.IsMultipart() {
+		return nil, response.NewConflictError("multipart upload must be completed via the multipart complete API", "")
+	}
+
+	if err := s.activateUpload(ctx, file); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("File upload confirmed",
+		zap.String("fileId", file.ID.String()),
+		zap.String("userId", userID.String()),
+		zap.Int64("fileSize", file.FileSize),
+	)
+
+	return file, nil
+}
+
+// activateUpload moves an uploaded file from UPLOADING to ACTIVE
+func (s *FileService) activateUpload(ctx context.Context, file *domain.File) error {
 	// Generate unique name if necessary
 	uniqueName, err := s.fileRepo.GenerateUniqueName(ctx, file.WorkspaceID, file.FolderID, file.Name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate unique name: %w", err)
+		return fmt.Errorf("failed to generate unique name: %w", err)
 	}
 	file.Name = uniqueName
 
 	file.Status = domain.FileStatusActive
+	file.UploadID = nil
 	file.UpdatedAt = time.Now()
+	// 이미지는 썸네일 워커가 비동기로 처리하도록 대기열에 등록
+	file.ThumbnailStatus = file.InitialThumbnailStatus()
 
 	if err := s.fileRepo.Update(ctx, file); err != nil {
-		return nil, fmt.Errorf("failed to update file status: %w", err)
+		return fmt.Errorf("failed to update file status: %w", err)
 	}
 
 	// 메트릭 기록: 파일 업로드 성공
@@ -190,15 +228,386 @@ func (s *FileService) ConfirmUpload(ctx context.Context, fileID, userID uuid.UUI
 		s.metrics.RecordFileUpload()
 	}
 
-	s.logger.Info("File upload confirmed",
+	return nil
+}
+
+// calculatePartSize picks a part size that keeps the part count within S3 limits
+func calculatePartSize(fileSize int64) (int64, int) {
+	partSize := int64(MinPartSize)
+	if minForCount := (fileSize + MaxPartCount - 1) / MaxPartCount; minForCount > partSize {
+		// 1MB 단위로 올림
+		const mb = 1024 * 1024
+		partSize = (minForCount + mb - 1) / mb * mb
+	}
+	partCount := int((fileSize + partSize - 1) / partSize)
+	return partSize, partCount
+}
+
+// multipartLayout returns the part size and count recorded for a multipart upload
+func multipartLayout(file *domain.File) (int64, int) {
+	if file.PartSize <= 0 {
+		return calculatePartSize(file.FileSize)
+	}
+	return file.PartSize, int((file.FileSize + file.PartSize - 1) / file.PartSize)
+}
+
+// validateUploadTarget validates extension and destination folder of a new upload
+func (s *FileService) validateUploadTarget(ctx context.Context, workspaceID uuid.UUID, folderID *uuid.UUID, fileName string) error {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if !isAllowedExtension(ext) {
+		return response.NewValidationError(fmt.Sprintf("file type not allowed: %s", ext), "")
+	}
+
+	if folderID != nil {
+		folder, err := s.folderRepo.FindByID(ctx, *folderID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return response.NewNotFoundError("folder not found", folderID.String())
+			}
+			return fmt.Errorf("failed to find folder: %w", err)
+		}
+		if folder.WorkspaceID != workspaceID {
+			return response.NewForbiddenError("folder belongs to different workspace", "")
+		}
+	}
+
+	return nil
+}
+
+// InitiateMultipartUpload starts a resumable multipart upload
+// 대용량 파일용 멀티파트 업로드 시작: UPLOADING 상태의 파일 레코드를 생성합니다.
+func (s *FileService) InitiateMultipartUpload(ctx context.Context, req domain.InitiateMultipartUploadRequest, userID uuid.UUID) (*domain.InitiateMultipartUploadResponse, error) {
+	if req.FileSize > MaxMultipartFileSize {
+		return nil, response.NewValidationError(fmt.Sprintf("file size exceeds maximum allowed (%d GB)", MaxMultipartFileSize/(1024*1024*1024)), "")
+	}
+
+	if err := s.validateUploadTarget(ctx, req.WorkspaceID, req.FolderID, req.FileName); err != nil {
+		return nil, err
+	}
+
+	if s.quota > 0 {
+		used, err := s.fileRepo.SumSizeByWorkspaceID(ctx, req.WorkspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate workspace usage: %w", err)
+		}
+		if err := checkWorkspaceQuota(used, req.FileSize, s.quota); err != nil {
+			return nil, err
+		}
+	}
+
+	fileKey := client.GenerateFileKey(req.WorkspaceID.String(), req.FileName)
+	uploadID, err := s.s3Client.CreateMultipartUpload(ctx, fileKey, req.ContentType)
+	if err != nil {
+		s.logger.Error("Failed to create multipart upload", zap.Error(err))
+		return nil, fmt.Errorf("failed to initiate multipart upload: %w", err)
+	}
+
+	partSize, partCount := calculatePartSize(req.FileSize)
+	now := time.Now()
+	file := &domain.File{
+		ID:           uuid.New(),
+		WorkspaceID:  req.WorkspaceID,
+		ProjectID:    req.ProjectID,
+		FolderID:     req.FolderID,
+		Name:         req.FileName,
+		OriginalName: req.FileName,
+		FileKey:      fileKey,
+		FileSize:     req.FileSize,
+		ContentType:  req.ContentType,
+		Status:       domain.FileStatusUploading,
+		Version:      1,
+		UploadID:     &uploadID,
+		PartSize:     partSize,
+		UploadedBy:   userID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.fileRepo.Create(ctx, file); err != nil {
+		// 레코드 생성 실패 시 S3 업로드도 정리
+		if abortErr := s.s3Client.AbortMultipartUpload(ctx, fileKey, uploadID); abortErr != nil {
+			s.logger.Warn("Failed to abort multipart upload after record failure", zap.Error(abortErr))
+		}
+		s.logger.Error("Failed to create file record", zap.Error(err))
+		return nil, fmt.Errorf("failed to create file record: %w", err)
+	}
+
+	s.logger.Info("Multipart upload initiated",
+		zap.String("fileId", file.ID.String()),
+		zap.String("fileName", req.FileName),
+		zap.Int64("fileSize", req.FileSize),
+		zap.Int("partCount", partCount),
+		zap.String("userId", userID.String()),
+	)
+
+	return &domain.InitiateMultipartUploadResponse{
+		FileID:    file.ID,
+		FileKey:   fileKey,
+		UploadID:  uploadID,
+		PartSize:  partSize,
+		PartCount: partCount,
+	}, nil
+}
+
+// getMultipartFile loads an in-progress multipart upload owned by the user
+func (s *FileService) getMultipartFile(ctx context.Context, fileID, userID uuid.UUID) (*domain.File, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to find file: %w", err)
+	}
+
+	// 업로드한 사용자만 접근 가능
+	if file.UploadedBy != userID {
+		return nil, response.NewForbiddenError("not authorized to access this upload", "")
+	}
+	if file.Status != domain.FileStatusUploading || !file.IsMultipart() {
+		return nil, response.NewConflictError("file is not an in-progress multipart upload", string(file.Status))
+	}
+
+	return file, nil
+}
+
+// GenerateMultipartPartURL generates a presigned URL for uploading part N
+func (s *FileService) GenerateMultipartPartURL(ctx context.Context, fileID uuid.UUID, partNumber int, userID uuid.UUID) (*domain.MultipartPartURLResponse, error) {
+	file, err := s.getMultipartFile(ctx, fileID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, partCount := multipartLayout(file)
+	if partNumber < 1 || partNumber > partCount {
+		return nil, response.NewValidationError(fmt.Sprintf("partNumber must be between 1 and %d", partCount), "")
+	}
+
+	url, err := s.s3Client.GeneratePresignedPartURL(ctx, file.FileKey, *file.UploadID, int32(partNumber), partURLExpiry)
+	if err != nil {
+		s.logger.Error("Failed to generate part URL",
+			zap.String("fileId", fileID.String()),
+			zap.Int("partNumber", partNumber),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to generate part upload URL: %w", err)
+	}
+
+	// 활동 기록: 오래 방치된 업로드만 정리되도록
+	if err := s.fileRepo.Touch(ctx, file.ID); err != nil {
+		s.logger.Warn("Failed to record upload activity", zap.Error(err))
+	}
+
+	return &domain.MultipartPartURLResponse{
+		PartNumber: partNumber,
+		UploadURL:  url,
+		ExpiresAt:  time.Now().Add(partURLExpiry),
+	}, nil
+}
+
+// GetMultipartUploadStatus lists uploaded and missing parts so the client can resume
+func (s *FileService) GetMultipartUploadStatus(ctx context.Context, fileID, userID uuid.UUID) (*domain.MultipartUploadStatusResponse, error) {
+	file, err := s.getMultipartFile(ctx, fileID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.s3Client.ListParts(ctx, file.FileKey, *file.UploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uploaded parts: %w", err)
+	}
+
+	partSize, partCount := multipartLayout(file)
+
+	status := &domain.MultipartUploadStatusResponse{
+		FileID:        file.ID,
+		UploadID:      *file.UploadID,
+		PartSize:      partSize,
+		PartCount:     partCount,
+		UploadedParts: []domain.MultipartPart{},
+		MissingParts:  []int{},
+	}
+
+	uploaded := make(map[int]bool, len(parts))
+	for _, p := range parts {
+		uploaded[int(p.PartNumber)] = true
+		status.UploadedParts = append(status.UploadedParts, domain.MultipartPart{
+			PartNumber: int(p.PartNumber),
+			ETag:       p.ETag,
+			Size:       p.Size,
+		})
+		status.UploadedBytes += p.Size
+	}
+	for n := 1; n <= partCount; n++ {
+		if !uploaded[n] {
+			status.MissingParts = append(status.MissingParts, n)
+		}
+	}
+
+	return status, nil
+}
+
+// CompleteMultipartUpload assembles the parts and activates the file
+// 멀티파트 업로드 완료: 파트를 병합하고 파일을 ACTIVE 상태로 전환합니다.
+func (s *FileService) CompleteMultipartUpload(ctx context.Context, fileID uuid.UUID, req domain.CompleteMultipartUploadRequest, userID uuid.UUID) (*domain.File, error) {
+	file, err := s.getMultipartFile(ctx, fileID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// S3에 실제 업로드된 파트 기준으로 검증
+	stored, err := s.s3Client.ListParts(ctx, file.FileKey, *file.UploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list uploaded parts: %w", err)
+	}
+	storedByNumber := make(map[int32]client.UploadedPart, len(stored))
+	for _, p := range stored {
+		storedByNumber[p.PartNumber] = p
+	}
+
+	_, partCount := multipartLayout(file)
+
+	parts := make([]client.UploadedPart, 0, partCount)
+	var totalSize int64
+	if len(req.Parts) > 0 {
+		for _, p := range req.Parts {
+			storedPart, ok := storedByNumber[int32(p.PartNumber)]
+			if !ok {
+				return nil, response.NewConflictError(fmt.Sprintf("part %d has not been uploaded", p.PartNumber), "")
+			}
+			if strings.Trim(storedPart.ETag, `"`) != strings.Trim(p.ETag, `"`) {
+				return nil, response.NewConflictError(fmt.Sprintf("etag mismatch for part %d", p.PartNumber), "")
+			}
+			parts = append(parts, storedPart)
+			totalSize += storedPart.Size
+		}
+	} else {
+		parts = append(parts, stored...)
+		for _, p := range stored {
+			totalSize += p.Size
+		}
+	}
+
+	if len(parts) != partCount {
+		return nil, response.NewConflictError(fmt.Sprintf("expected %d parts, got %d", partCount, len(parts)), "")
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	for i, p := range parts {
+		if int(p.PartNumber) != i+1 {
+			return nil, response.NewConflictError(fmt.Sprintf("part %d is missing", i+1), "")
+		}
+	}
+
+	// 선언한 크기보다 큰 파트를 올려 용량 제한을 우회하지 못하도록 실제 크기로 다시 검증
+	if err := s.checkUploadedSize(ctx, file, totalSize); err != nil {
+		return nil, s.rejectMultipartUpload(ctx, file, err)
+	}
+
+	if err := s.s3Client.CompleteMultipartUpload(ctx, file.FileKey, *file.UploadID, parts); err != nil {
+		s.logger.Error("Failed to complete multipart upload",
+			zap.String("fileId", fileID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	file.FileSize = totalSize
+	if err := s.activateUpload(ctx, file); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Multipart upload completed",
 		zap.String("fileId", file.ID.String()),
 		zap.String("userId", userID.String()),
 		zap.Int64("fileSize", file.FileSize),
+		zap.Int("partCount", len(parts)),
 	)
 
 	return file, nil
 }
 
+// checkUploadedSize validates the size of the uploaded parts against the declared size,
+// the multipart limit and the workspace quota
+func (s *FileService) checkUploadedSize(ctx context.Context, file *domain.File, totalSize int64) error {
+	if totalSize != file.FileSize {
+		return response.NewValidationError(
+			fmt.Sprintf("uploaded size %d does not match declared size %d", totalSize, file.FileSize), "")
+	}
+	if totalSize > MaxMultipartFileSize {
+		return response.NewValidationError(fmt.Sprintf("file size exceeds maximum allowed (%d GB)", MaxMultipartFileSize/(1024*1024*1024)), "")
+	}
+	if s.quota <= 0 {
+		return nil
+	}
+
+	// 사용량에는 업로드 중인 이 파일의 선언 크기가 이미 포함되어 있음
+	used, err := s.fileRepo.SumSizeByWorkspaceID(ctx, file.WorkspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to calculate workspace usage: %w", err)
+	}
+	return checkWorkspaceQuota(used-file.FileSize, totalSize, s.quota)
+}
+
+// checkWorkspaceQuota returns an error when adding size bytes to a workspace using used bytes exceeds the quota
+func checkWorkspaceQuota(used, size, quota int64) error {
+	if quota > 0 && used+size > quota {
+		return response.NewValidationError(
+			fmt.Sprintf("workspace storage quota exceeded (%d of %d bytes used)", used, quota), "")
+	}
+	return nil
+}
+
+// rejectMultipartUpload aborts an upload that failed validation and removes its file record.
+// It returns cause so callers can report why the upload was rejected.
+func (s *FileService) rejectMultipartUpload(ctx context.Context, file *domain.File, cause error) error {
+	if err := s.s3Client.AbortMultipartUpload(ctx, file.FileKey, *file.UploadID); err != nil {
+		// 정리에 실패해도 오래된 업로드 정리 작업이 다시 시도함
+		s.logger.Warn("Failed to abort rejected multipart upload",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+		return cause
+	}
+	if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+		s.logger.Warn("Failed to delete rejected multipart upload record",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	s.logger.Info("Multipart upload rejected",
+		zap.String("fileId", file.ID.String()),
+		zap.Error(cause),
+	)
+	return cause
+}
+
+// AbortMultipartUpload aborts a multipart upload and removes its file record
+func (s *FileService) AbortMultipartUpload(ctx context.Context, fileID, userID uuid.UUID) error {
+	file, err := s.getMultipartFile(ctx, fileID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.s3Client.AbortMultipartUpload(ctx, file.FileKey, *file.UploadID); err != nil {
+		s.logger.Error("Failed to abort multipart upload",
+			zap.String("fileId", fileID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+		return fmt.Errorf("failed to delete file record: %w", err)
+	}
+
+	s.logger.Info("Multipart upload aborted",
+		zap.String("fileId", file.ID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	return nil
+}
+
 // GetFile gets a file by ID
 // 파일 ID로 파일 조회
 func (s *FileService) GetFile(ctx context.Context, fileID uuid.UUID) (*domain.File, error) {
@@ -217,6 +626,37 @@ func (s *FileService) GetFileURL(fileKey string) string {
 	return s.s3Client.GetFileURL(fileKey)
 }
 
+// ExportUserFiles returns the metadata of all files a user uploaded (personal data export)
+func (s *FileService) ExportUserFiles(ctx context.Context, userID uuid.UUID) (*domain.UserFilesExport, error) {
+	files, err := s.fileRepo.FindAllByUploadedBy(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to find files for export", zap.String("user_id", userID.String()), zap.Error(err))
+		return nil, response.NewInternalError("Failed to export files", err.Error())
+	}
+
+	result := &domain.UserFilesExport{
+		UserID:     userID,
+		ExportedAt: time.Now(),
+		Files:      make([]domain.ExportedFile, 0, len(files)),
+	}
+	for _, file := range files {
+		result.Files = append(result.Files, domain.ExportedFile{
+			ID:           file.ID,
+			WorkspaceID:  file.WorkspaceID,
+			ProjectID:    file.ProjectID,
+			FolderID:     file.FolderID,
+			Name:         file.Name,
+			OriginalName: file.OriginalName,
+			FileSize:     file.FileSize,
+			ContentType:  file.ContentType,
+			Source:       file.Source,
+			CreatedAt:    file.CreatedAt,
+			UpdatedAt:    file.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
 // GetWorkspaceFiles gets all files in a workspace with pagination
 func (s *FileService) GetWorkspaceFiles(ctx context.Context, workspaceID uuid.UUID, page, pageSize int) (*domain.FileListResponse, error) {
 	if page < 1 {
@@ -323,6 +763,89 @@ func (s *FileService) UpdateFile(ctx context.Context, fileID uuid.UUID, req doma
 	return file, nil
 }
 
+// CopyFile copies a file and its S3 object into a destination folder (nil means root)
+// 파일 복사: S3 객체를 새 키로 복사하고 새 파일 레코드를 생성합니다.
+func (s *FileService) CopyFile(ctx context.Context, fileID uuid.UUID, targetFolderID *uuid.UUID, userID uuid.UUID) (*domain.File, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file.Status != domain.FileStatusActive {
+		return nil, response.NewConflictError("only active files can be copied", string(file.Status))
+	}
+
+	projectID := file.ProjectID
+	if targetFolderID != nil && *targetFolderID == uuid.Nil {
+		targetFolderID = nil
+	}
+	if targetFolderID != nil {
+		folder, err := s.folderRepo.FindByID(ctx, *targetFolderID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("destination folder not found", targetFolderID.String())
+			}
+			return nil, fmt.Errorf("failed to find folder: %w", err)
+		}
+		// 다른 워크스페이스로 복사 불가
+		if folder.WorkspaceID != file.WorkspaceID {
+			return nil, response.NewForbiddenError("cannot copy file to different workspace", "")
+		}
+		projectID = folder.ProjectID
+	}
+
+	name, err := s.fileRepo.GenerateUniqueName(ctx, file.WorkspaceID, targetFolderID, file.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unique name: %w", err)
+	}
+
+	newKey := client.GenerateFileKey(file.WorkspaceID.String(), file.OriginalName)
+	if err := s.s3Client.CopyFile(ctx, file.FileKey, newKey); err != nil {
+		s.logger.Error("Failed to copy file in S3",
+			zap.String("fileId", fileID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to copy file: %w", err)
+	}
+
+	now := time.Now()
+	copied := &domain.File{
+		ID:           uuid.New(),
+		WorkspaceID:  file.WorkspaceID,
+		ProjectID:    projectID,
+		FolderID:     targetFolderID,
+		Name:         name,
+		OriginalName: file.OriginalName,
+		FileKey:      newKey,
+		FileSize:     file.FileSize,
+		ContentType:  file.ContentType,
+		Status:       domain.FileStatusActive,
+		Version:      1,
+		UploadedBy:   userID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	copied.ThumbnailStatus = copied.InitialThumbnailStatus()
+
+	if err := s.fileRepo.Create(ctx, copied); err != nil {
+		// 레코드 생성 실패 시 복사한 객체 정리
+		if delErr := s.s3Client.DeleteFile(ctx, newKey); delErr != nil {
+			s.logger.Warn("Failed to clean up copied object", zap.Error(delErr))
+		}
+		return nil, fmt.Errorf("failed to create file record: %w", err)
+	}
+
+	s.logger.Info("File copied",
+		zap.String("sourceFileId", file.ID.String()),
+		zap.String("fileId", copied.ID.String()),
+		zap.String("userId", userID.String()),
+	)
+
+	return copied, nil
+}
+
 // DeleteFile soft deletes a file (move to trash)
 // 파일 삭제 (휴지통으로 이동): 소프트 삭제 성공 시 메트릭을 기록합니다.
 func (s *FileService) DeleteFile(ctx context.Context, fileID uuid.UUID, userID uuid.UUID) error {
@@ -399,11 +922,14 @@ func (s *FileService) PermanentDeleteFile(ctx context.Context, fileID uuid.UUID,
 		return fmt.Errorf("failed to get file: %w", err)
 	}
 
-	// Delete from S3
-	if err := s.s3Client.DeleteFile(ctx, file.FileKey); err != nil {
-		s.logger.Error("Failed to delete file from S3", zap.Error(err))
-		// Continue anyway to delete database record
+	// Delete from S3 (linked objects belong to another service and are kept)
+	if !file.IsLinked() {
+		if err := s.s3Client.DeleteFile(ctx, file.FileKey); err != nil {
+			s.logger.Error("Failed to delete file from S3", zap.Error(err))
+			// Continue anyway to delete database record
+		}
 	}
+	deleteThumbnails(ctx, s.s3Client, s.logger, file)
 
 	if err := s.fileRepo.PermanentDelete(ctx, fileID); err != nil {
 		return fmt.Errorf("failed to permanently delete file: %w", err)
@@ -422,39 +948,86 @@ func (s *FileService) GetTrashFiles(ctx context.Context, workspaceID uuid.UUID)
 	return s.fileRepo.FindDeleted(ctx, workspaceID)
 }
 
-// SearchFiles searches files by name
-func (s *FileService) SearchFiles(ctx context.Context, workspaceID uuid.UUID, query string, page, pageSize int) (*domain.FileListResponse, error) {
-	if page < 1 {
-		page = 1
+// SearchFiles searches active files with optional tag, type, uploader, size, date,
+// project and folder subtree filters
+func (s *FileService) SearchFiles(ctx context.Context, filter domain.FileSearchFilter) (*domain.FileListResponse, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
 	}
-	if pageSize < 1 || pageSize > 100 {
-		pageSize = 20
+	if filter.PageSize < 1 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+	if filter.Sort == "" {
+		filter.Sort = domain.FileSearchSortCreatedAt
+	}
+	if err := validateSearchFilter(filter); err != nil {
+		return nil, err
 	}
 
-	files, total, err := s.fileRepo.Search(ctx, workspaceID, query, page, pageSize)
+	// 폴더 필터는 하위 폴더까지 포함하므로 Path를 조회
+	if filter.FolderID != nil {
+		folder, err := s.folderRepo.FindByID(ctx, *filter.FolderID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("folder not found", filter.FolderID.String())
+			}
+			return nil, fmt.Errorf("failed to get folder: %w", err)
+		}
+		if folder.WorkspaceID != filter.WorkspaceID {
+			return nil, response.NewForbiddenError("folder belongs to different workspace", filter.FolderID.String())
+		}
+		filter.FolderPath = folder.Path
+	}
+
+	files, total, err := s.fileRepo.Search(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search files: %w", err)
 	}
 
-	var fileResponses []domain.FileResponse
+	fileResponses := make([]domain.FileResponse, 0, len(files))
 	for _, file := range files {
 		fileResponses = append(fileResponses, file.ToResponse(s.GetFileURL(file.FileKey)))
 	}
 
-	totalPages := int(total) / pageSize
-	if int(total)%pageSize > 0 {
+	totalPages := int(total) / filter.PageSize
+	if int(total)%filter.PageSize > 0 {
 		totalPages++
 	}
 
 	return &domain.FileListResponse{
 		Files:      fileResponses,
 		Total:      total,
-		Page:       page,
-		PageSize:   pageSize,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
 		TotalPages: totalPages,
 	}, nil
 }
 
+// validateSearchFilter checks that search ranges and enum values are consistent
+func validateSearchFilter(filter domain.FileSearchFilter) error {
+	if filter.TypeFamily != "" {
+		if _, ok := filter.TypeFamily.ContentTypes(); !ok {
+			return response.NewValidationError("invalid type filter", string(filter.TypeFamily))
+		}
+	}
+	if !filter.Sort.Valid() {
+		return response.NewValidationError("invalid sort field", string(filter.Sort))
+	}
+	if (filter.MinSize != nil && *filter.MinSize < 0) || (filter.MaxSize != nil && *filter.MaxSize < 0) {
+		return response.NewValidationError("size filters must not be negative", "")
+	}
+	if filter.MinSize != nil && filter.MaxSize != nil && *filter.MinSize > *filter.MaxSize {
+		return response.NewValidationError("minSize must not be greater than maxSize", "")
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && filter.CreatedAfter.After(*filter.CreatedBefore) {
+		return response.NewValidationError("createdAfter must be before createdBefore", "")
+	}
+	if len(filter.TagIDs) > domain.MaxTagsPerItem {
+		return response.NewValidationError(fmt.Sprintf("cannot filter by more than %d tags", domain.MaxTagsPerItem), "")
+	}
+	return nil
+}
+
 // GetStorageUsage gets storage usage for a workspace
 func (s *FileService) GetStorageUsage(ctx context.Context, workspaceID uuid.UUID) (int64, int64, error) {
 	totalSize, err := s.fileRepo.SumSizeByWorkspaceID(ctx, workspaceID)
@@ -471,8 +1044,9 @@ func (s *FileService) GetStorageUsage(ctx context.Context, workspaceID uuid.UUID
 }
 
 // CleanupOrphanedUploads cleans up files stuck in uploading state
+// 확정되지 않은 단일 업로드는 삭제하고, 방치된 멀티파트 업로드는 abort 합니다.
 func (s *FileService) CleanupOrphanedUploads(ctx context.Context) error {
-	files, err := s.fileRepo.FindUploadingFiles(ctx, 1*time.Hour)
+	files, err := s.fileRepo.FindUploadingFiles(ctx, orphanUploadTTL)
 	if err != nil {
 		return fmt.Errorf("failed to find orphaned uploads: %w", err)
 	}
@@ -499,6 +1073,66 @@ func (s *FileService) CleanupOrphanedUploads(ctx context.Context) error {
 		s.logger.Info("Cleaned up orphaned uploads", zap.Int("count", len(files)))
 	}
 
+	staleUploads, err := s.fileRepo.FindStaleMultipartUploads(ctx, staleMultipartTTL)
+	if err != nil {
+		return fmt.Errorf("failed to find stale multipart uploads: %w", err)
+	}
+
+	for _, file := range staleUploads {
+		// abort 실패 시 레코드를 남겨 다음 실행에서 재시도
+		if err := s.s3Client.AbortMultipartUpload(ctx, file.FileKey, *file.UploadID); err != nil {
+			s.logger.Error("Failed to abort stale multipart upload",
+				zap.Error(err),
+				zap.String("fileId", file.ID.String()),
+			)
+			continue
+		}
+		if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+			s.logger.Error("Failed to delete stale multipart upload record",
+				zap.Error(err),
+				zap.String("fileId", file.ID.String()),
+			)
+		}
+	}
+
+	if len(staleUploads) > 0 {
+		s.logger.Info("Aborted stale multipart uploads", zap.Int("count", len(staleUploads)))
+	}
+
+	return s.abortUntrackedMultipartUploads(ctx)
+}
+
+// abortUntrackedMultipartUploads aborts S3 multipart uploads that no file record references
+func (s *FileService) abortUntrackedMultipartUploads(ctx context.Context) error {
+	uploads, err := s.s3Client.ListMultipartUploads(ctx, "storage/")
+	if err != nil {
+		return fmt.Errorf("failed to list multipart uploads: %w", err)
+	}
+
+	cutoff := time.Now().Add(-staleMultipartTTL)
+	aborted := 0
+	for _, upload := range uploads {
+		if upload.Initiated.After(cutoff) {
+			continue
+		}
+		exists, err := s.fileRepo.ExistsByUploadID(ctx, upload.UploadID)
+		if err != nil || exists {
+			continue
+		}
+		if err := s.s3Client.AbortMultipartUpload(ctx, upload.Key, upload.UploadID); err != nil {
+			s.logger.Error("Failed to abort untracked multipart upload",
+				zap.Error(err),
+				zap.String("fileKey", upload.Key),
+			)
+			continue
+		}
+		aborted++
+	}
+
+	if aborted > 0 {
+		s.logger.Info("Aborted untracked multipart uploads", zap.Int("count", aborted))
+	}
+
 	return nil
 }
 
@@ -11,15 +11,23 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"storage-service/internal/client"
 	"storage-service/internal/domain"
 	"storage-service/internal/repository"
 	"storage-service/internal/response"
 )
 
+// Folder copy/move limits
+const (
+	MaxFolderCopyItems = 5000
+	maxFolderDepth     = 256
+)
+
 // FolderService handles folder business logic
 type FolderService struct {
 	folderRepo *repository.FolderRepository
 	fileRepo   *repository.FileRepository
+	s3Client   *client.S3Client
 	logger     *zap.Logger
 }
 
@@ -27,11 +35,13 @@ type FolderService struct {
 func NewFolderService(
 	folderRepo *repository.FolderRepository,
 	fileRepo *repository.FileRepository,
+	s3Client *client.S3Client,
 	logger *zap.Logger,
 ) *FolderService {
 	return &FolderService{
 		folderRepo: folderRepo,
 		fileRepo:   fileRepo,
+		s3Client:   s3Client,
 		logger:     logger,
 	}
 }
@@ -200,6 +210,7 @@ func (s *FolderService) UpdateFolder(ctx context.Context, folderID uuid.UUID, re
 	}
 
 	oldPath := folder.Path
+	oldProjectID := folder.ProjectID
 
 	// 이름 변경
 	if req.Name != nil && *req.Name != folder.Name {
@@ -234,39 +245,35 @@ func (s *FolderService) UpdateFolder(ctx context.Context, folderID uuid.UUID, re
 
 	// 폴더 이동
 	if req.ParentID != nil && (folder.ParentID == nil || *req.ParentID != *folder.ParentID) {
-		// 새 부모 폴더 검증
-		if *req.ParentID != uuid.Nil {
-			newParent, err := s.folderRepo.FindByID(ctx, *req.ParentID)
-			if err != nil {
-				return nil, response.NewNotFoundError("new parent folder not found", req.ParentID.String())
-			}
-			// 다른 워크스페이스로 이동 불가
-			if newParent.WorkspaceID != folder.WorkspaceID {
-				return nil, response.NewForbiddenError("cannot move folder to different workspace", "")
-			}
-			// 자신 또는 하위 폴더로 이동 불가
-			if strings.HasPrefix(newParent.Path, folder.Path+"/") || newParent.ID == folder.ID {
-				return nil, response.NewBadRequestError("cannot move folder into itself or its descendants", "")
-			}
-			folder.ParentID = &newParent.ID
-			folder.Path = newParent.Path + "/" + folder.Name
-		} else {
-			folder.ParentID = nil
-			folder.Path = "/" + folder.Name
+		newParent, err := s.resolveMoveTarget(ctx, folder, req.ParentID)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	folder.UpdatedAt = time.Now()
+		var newParentID *uuid.UUID
+		if newParent != nil {
+			newParentID = &newParent.ID
+		}
+		exists, err := s.folderRepo.ExistsByNameInParent(ctx, folder.WorkspaceID, newParentID, folder.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check duplicate name: %w", err)
+		}
+		if exists {
+			return nil, response.NewAlreadyExistsError("folder with this name already exists in destination", folder.Name)
+		}
 
-	if err := s.folderRepo.Update(ctx, folder); err != nil {
-		return nil, fmt.Errorf("failed to update folder: %w", err)
+		s.applyParent(folder, newParent)
 	}
 
-	// Update paths of descendants if path changed
+	folder.UpdatedAt = time.Now()
+
+	// 경로가 바뀌면 하위 트리까지 한 트랜잭션으로 갱신
 	if oldPath != folder.Path {
-		if err := s.folderRepo.UpdatePath(ctx, folder.WorkspaceID, oldPath, folder.Path); err != nil {
-			s.logger.Error("Failed to update descendant paths", zap.Error(err))
+		if err := s.folderRepo.MoveSubtree(ctx, folder, oldPath, !sameProject(oldProjectID, folder.ProjectID), nil); err != nil {
+			return nil, fmt.Errorf("failed to update folder: %w", err)
 		}
+	} else if err := s.folderRepo.Update(ctx, folder); err != nil {
+		return nil, fmt.Errorf("failed to update folder: %w", err)
 	}
 
 	s.logger.Info("Folder updated",
@@ -277,6 +284,328 @@ func (s *FolderService) UpdateFolder(ctx context.Context, folderID uuid.UUID, re
 	return folder, nil
 }
 
+// MoveFolder moves a folder with its subtree under a new parent (nil or uuid.Nil means root)
+// 폴더 이동: 순환 이동을 차단하고, 이름 충돌 정책을 적용하며, 하위 경로를 한 트랜잭션으로 갱신합니다.
+func (s *FolderService) MoveFolder(ctx context.Context, folderID uuid.UUID, req domain.MoveFolderRequest, userID uuid.UUID) (*domain.FolderTransferResponse, error) {
+	folder, err := s.folderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("folder not found", folderID.String())
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+
+	target, err := s.resolveMoveTarget(ctx, folder, req.TargetFolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 같은 위치로의 이동은 변경 없음
+	if sameParent(folder.ParentID, target) {
+		resp := folder.ToResponse()
+		return &domain.FolderTransferResponse{Folder: &resp}, nil
+	}
+
+	name, replaced, skip, err := s.resolveNameConflict(ctx, folder, target, req.ConflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if skip {
+		return &domain.FolderTransferResponse{Skipped: true}, nil
+	}
+
+	oldPath := folder.Path
+	oldProjectID := folder.ProjectID
+	folder.Name = name
+	s.applyParent(folder, target)
+	folder.UpdatedAt = time.Now()
+
+	if err := s.folderRepo.MoveSubtree(ctx, folder, oldPath, !sameProject(oldProjectID, folder.ProjectID), replaced); err != nil {
+		s.logger.Error("Failed to move folder",
+			zap.String("folderId", folder.ID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to move folder: %w", err)
+	}
+
+	s.logger.Info("Folder moved",
+		zap.String("folderId", folder.ID.String()),
+		zap.String("from", oldPath),
+		zap.String("to", folder.Path),
+		zap.String("userId", userID.String()),
+	)
+
+	resp := folder.ToResponse()
+	return &domain.FolderTransferResponse{Folder: &resp}, nil
+}
+
+// CopyFolder deep-copies a folder subtree, including S3 objects, under a new parent (nil means root)
+// 폴더 복사: S3 객체를 먼저 복사한 뒤 폴더/파일 레코드를 한 트랜잭션으로 생성합니다.
+// DB 저장에 실패하면 복사한 S3 객체를 정리합니다.
+func (s *FolderService) CopyFolder(ctx context.Context, folderID uuid.UUID, req domain.CopyFolderRequest, userID uuid.UUID) (*domain.FolderTransferResponse, error) {
+	source, err := s.folderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("folder not found", folderID.String())
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+
+	target, err := s.resolveMoveTarget(ctx, source, req.TargetFolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, replaced, skip, err := s.resolveNameConflict(ctx, source, target, req.ConflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if skip {
+		return &domain.FolderTransferResponse{Skipped: true}, nil
+	}
+
+	// 복사 시작 전 하위 트리 스냅샷
+	descendants, err := s.folderRepo.FindChildrenRecursive(ctx, source.WorkspaceID, source.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load folder tree: %w", err)
+	}
+	sourceIDs := make([]uuid.UUID, 0, len(descendants)+1)
+	sourceIDs = append(sourceIDs, source.ID)
+	for _, child := range descendants {
+		sourceIDs = append(sourceIDs, child.ID)
+	}
+	files, err := s.fileRepo.FindActiveByFolderIDs(ctx, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load folder files: %w", err)
+	}
+	if len(descendants)+len(files) > MaxFolderCopyItems {
+		return nil, response.NewValidationError(fmt.Sprintf("folder is too large to copy (max %d items)", MaxFolderCopyItems), "")
+	}
+
+	now := time.Now()
+	root := domain.Folder{
+		ID:          uuid.New(),
+		WorkspaceID: source.WorkspaceID,
+		ProjectID:   source.ProjectID,
+		Name:        name,
+		Color:       source.Color,
+		CreatedBy:   userID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.applyParent(&root, target)
+
+	// 원본 폴더 ID -> 복사본 폴더 (경로 오름차순이라 부모가 먼저 생성됨)
+	folders := []domain.Folder{root}
+	mapping := map[uuid.UUID]uuid.UUID{source.ID: root.ID}
+	for _, child := range descendants {
+		if child.ParentID == nil {
+			continue
+		}
+		parentID, ok := mapping[*child.ParentID]
+		if !ok {
+			continue
+		}
+		copied := domain.Folder{
+			ID:          uuid.New(),
+			WorkspaceID: child.WorkspaceID,
+			ProjectID:   root.ProjectID,
+			ParentID:    &parentID,
+			Name:        child.Name,
+			Path:        root.Path + strings.TrimPrefix(child.Path, source.Path),
+			Color:       child.Color,
+			CreatedBy:   userID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		mapping[child.ID] = copied.ID
+		folders = append(folders, copied)
+	}
+
+	copiedFiles := make([]domain.File, 0, len(files))
+	copiedKeys := make([]string, 0, len(files))
+	for _, file := range files {
+		destination, ok := mapping[*file.FolderID]
+		if !ok {
+			continue
+		}
+		newKey := client.GenerateFileKey(file.WorkspaceID.String(), file.OriginalName)
+		if err := s.s3Client.CopyFile(ctx, file.FileKey, newKey); err != nil {
+			s.cleanupCopiedObjects(ctx, copiedKeys)
+			s.logger.Error("Failed to copy file in S3",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to copy file %s: %w", file.Name, err)
+		}
+		copiedKeys = append(copiedKeys, newKey)
+
+		copiedFiles = append(copiedFiles, domain.File{
+			ID:           uuid.New(),
+			WorkspaceID:  file.WorkspaceID,
+			ProjectID:    root.ProjectID,
+			FolderID:     &destination,
+			Name:         file.Name,
+			OriginalName: file.OriginalName,
+			FileKey:      newKey,
+			FileSize:     file.FileSize,
+			ContentType:  file.ContentType,
+			Status:       domain.FileStatusActive,
+			Version:      1,
+			UploadedBy:   userID,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+
+			ThumbnailStatus: file.InitialThumbnailStatus(),
+		})
+	}
+
+	if err := s.folderRepo.CreateTree(ctx, folders, copiedFiles, replaced); err != nil {
+		s.cleanupCopiedObjects(ctx, copiedKeys)
+		s.logger.Error("Failed to save copied folder tree",
+			zap.String("folderId", source.ID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to copy folder: %w", err)
+	}
+
+	s.logger.Info("Folder copied",
+		zap.String("sourceFolderId", source.ID.String()),
+		zap.String("folderId", root.ID.String()),
+		zap.Int("folders", len(folders)),
+		zap.Int("files", len(copiedFiles)),
+		zap.String("userId", userID.String()),
+	)
+
+	resp := root.ToResponse()
+	return &domain.FolderTransferResponse{
+		Folder:        &resp,
+		FoldersCopied: len(folders),
+		FilesCopied:   len(copiedFiles),
+	}, nil
+}
+
+// resolveMoveTarget loads the destination folder (nil for root) and rejects cycles
+func (s *FolderService) resolveMoveTarget(ctx context.Context, folder *domain.Folder, targetID *uuid.UUID) (*domain.Folder, error) {
+	if targetID == nil || *targetID == uuid.Nil {
+		return nil, nil
+	}
+
+	target, err := s.folderRepo.FindByID(ctx, *targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("destination folder not found", targetID.String())
+		}
+		return nil, fmt.Errorf("failed to find destination folder: %w", err)
+	}
+	// 다른 워크스페이스로 이동 불가
+	if target.WorkspaceID != folder.WorkspaceID {
+		return nil, response.NewForbiddenError("cannot move folder to different workspace", "")
+	}
+
+	// 자신 또는 하위 폴더로 이동 불가: 경로와 부모 체인 모두 확인
+	if target.ID == folder.ID || strings.HasPrefix(target.Path, folder.Path+"/") {
+		return nil, response.NewBadRequestError("cannot move folder into itself or its descendants", "")
+	}
+	current := target
+	for depth := 0; current.ParentID != nil; depth++ {
+		if *current.ParentID == folder.ID {
+			return nil, response.NewBadRequestError("cannot move folder into itself or its descendants", "")
+		}
+		if depth >= maxFolderDepth {
+			return nil, response.NewConflictError("folder hierarchy is too deep or contains a cycle", "")
+		}
+		parent, err := s.folderRepo.FindByIDWithDeleted(ctx, *current.ParentID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				break
+			}
+			return nil, fmt.Errorf("failed to load folder ancestors: %w", err)
+		}
+		current = parent
+	}
+
+	return target, nil
+}
+
+// resolveNameConflict applies a conflict policy for the source folder's name in the destination.
+// It returns the name to use, the existing folder to replace (overwrite), or skip=true.
+// The source folder and its ancestors are never replaced, since trashing them would trash the source itself.
+func (s *FolderService) resolveNameConflict(ctx context.Context, source *domain.Folder, target *domain.Folder, policy domain.ConflictPolicy) (string, *domain.Folder, bool, error) {
+	workspaceID, name := source.WorkspaceID, source.Name
+	var parentID *uuid.UUID
+	if target != nil {
+		parentID = &target.ID
+	}
+
+	existing, err := s.folderRepo.FindByNameInParent(ctx, workspaceID, parentID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return name, nil, false, nil
+		}
+		return "", nil, false, fmt.Errorf("failed to check duplicate name: %w", err)
+	}
+
+	switch policy.OrDefault() {
+	case domain.ConflictPolicySkip:
+		return "", nil, true, nil
+	case domain.ConflictPolicyOverwrite:
+		if existing.ID == source.ID || strings.HasPrefix(source.Path, existing.Path+"/") {
+			return "", nil, false, response.NewConflictError("cannot overwrite the folder itself or one of its ancestors", existing.ID.String())
+		}
+		return name, existing, false, nil
+	case domain.ConflictPolicyRename:
+		unique, err := s.folderRepo.GenerateUniqueName(ctx, workspaceID, parentID, name)
+		if err != nil {
+			return "", nil, false, fmt.Errorf("failed to generate unique name: %w", err)
+		}
+		return unique, nil, false, nil
+	default:
+		return "", nil, false, response.NewValidationError("invalid conflict policy", string(policy))
+	}
+}
+
+// applyParent sets parent, path and project of a folder placed under target (nil means root)
+func (s *FolderService) applyParent(folder *domain.Folder, target *domain.Folder) {
+	if target == nil {
+		folder.ParentID = nil
+		folder.Path = "/" + folder.Name
+		return
+	}
+	folder.ParentID = &target.ID
+	folder.Path = target.Path + "/" + folder.Name
+	// 프로젝트 폴더 아래로 이동하면 해당 프로젝트 소속이 됨
+	folder.ProjectID = target.ProjectID
+}
+
+// cleanupCopiedObjects removes S3 objects created by a failed copy
+func (s *FolderService) cleanupCopiedObjects(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.s3Client.DeleteFile(ctx, key); err != nil {
+			s.logger.Warn("Failed to clean up copied object",
+				zap.String("fileKey", key),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// sameParent reports whether a folder already sits directly under target
+func sameParent(parentID *uuid.UUID, target *domain.Folder) bool {
+	if target == nil {
+		return parentID == nil
+	}
+	return parentID != nil && *parentID == target.ID
+}
+
+// sameProject reports whether two optional project IDs are equal
+func sameProject(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 // DeleteFolder soft deletes a folder (move to trash)
 // 폴더 삭제 (휴지통으로 이동): 하위 폴더 및 파일도 함께 삭제
 func (s *FolderService) DeleteFolder(ctx context.Context, folderID uuid.UUID, userID uuid.UUID) error {
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// resolveProjectPermission returns the caller's permission on a project, honoring the guest scope.
+// Workspace members get their usual project permission. Guests may only access projects shared
+// with them: they keep a direct membership permission if they have one and are viewers otherwise,
+// so public project defaults never widen a guest's access.
+func resolveProjectPermission(
+	ctx context.Context,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	logger *zap.Logger,
+	project *domain.Project,
+	userID uuid.UUID,
+	token string,
+) (*domain.ProjectPermission, error) {
+	perm, _, err := resolveProjectAccess(ctx, projectRepo, userClient, logger, project, userID, token)
+	return perm, err
+}
+
+// authorizeProjectPermission resolves the caller's permission on a project and reports whether it
+// grants required. Workspace members are also bound by their workspace role, so a custom role
+// without storage.write cannot edit files even where the member is a project editor. Managing a
+// project and guest access depend on the project permission alone. If user-service cannot resolve
+// the workspace role, the project permission decides.
+func authorizeProjectPermission(
+	ctx context.Context,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	permissions *permission.Resolver,
+	logger *zap.Logger,
+	project *domain.Project,
+	userID uuid.UUID,
+	token string,
+	required domain.ProjectPermission,
+) (*domain.ProjectPermission, bool, error) {
+	perm, isMember, err := resolveProjectAccess(ctx, projectRepo, userClient, logger, project, userID, token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	p := required.StoragePermission()
+	if !perm.Has(p) {
+		return perm, false, nil
+	}
+	if isMember && p != permission.StorageManage {
+		return perm, permissions.Allows(ctx, project.WorkspaceID, userID, p, true), nil
+	}
+	return perm, true, nil
+}
+
+// resolveProjectAccess returns the caller's project permission and whether it comes from workspace membership
+func resolveProjectAccess(
+	ctx context.Context,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	logger *zap.Logger,
+	project *domain.Project,
+	userID uuid.UUID,
+	token string,
+) (*domain.ProjectPermission, bool, error) {
+	access, err := workspaceAccess(ctx, userClient, logger, project.WorkspaceID, userID, token)
+	if err != nil {
+		return nil, false, err
+	}
+	if !access.CanAccessStorageProject(project.ID) {
+		return nil, false, response.ErrNotWorkspaceMember
+	}
+
+	if access.IsWorkspaceMember() {
+		perm, err := projectRepo.GetUserPermission(ctx, project.ID, userID)
+		if err != nil {
+			return nil, false, response.ErrAccessDenied
+		}
+		return perm, true, nil
+	}
+
+	if member, err := projectRepo.GetMember(ctx, project.ID, userID); err == nil {
+		return &member.Permission, false, nil
+	}
+	perm := domain.ProjectPermissionViewer
+	return &perm, false, nil
+}
+
+// workspaceAccess resolves the caller's workspace access including the guest scope.
+// Without a user client every caller is treated as a member, like ValidateWorkspaceMember.
+func workspaceAccess(
+	ctx context.Context,
+	userClient client.UserClient,
+	logger *zap.Logger,
+	workspaceID, userID uuid.UUID,
+	token string,
+) (*commonclient.WorkspaceValidationResponse, error) {
+	if userClient == nil {
+		logger.Warn("User client not configured, skipping workspace validation")
+		return &commonclient.WorkspaceValidationResponse{IsMember: true}, nil
+	}
+
+	access, err := userClient.GetWorkspaceAccess(ctx, workspaceID, userID, token)
+	if err != nil {
+		logger.Error("Failed to validate workspace member",
+			zap.Error(err),
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		// On error, deny access for security
+		return nil, response.ErrNotWorkspaceMember
+	}
+	return access, nil
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// LinkService registers S3 objects uploaded by other services as storage files
+// 보드/채팅 첨부파일처럼 다른 서비스가 소유한 객체를 복사 없이 파일 레코드로 연결합니다.
+// 연결된 객체는 원래 서비스가 관리하므로 storage-service는 객체를 삭제하지 않습니다.
+type LinkService struct {
+	fileRepo    *repository.FileRepository
+	folderRepo  *repository.FolderRepository
+	projectRepo repository.ProjectRepository
+	s3Client    *client.S3Client
+	logger      *zap.Logger
+}
+
+// NewLinkService creates a new LinkService
+func NewLinkService(
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	projectRepo repository.ProjectRepository,
+	s3Client *client.S3Client,
+	logger *zap.Logger,
+) *LinkService {
+	return &LinkService{
+		fileRepo:    fileRepo,
+		folderRepo:  folderRepo,
+		projectRepo: projectRepo,
+		s3Client:    s3Client,
+		logger:      logger,
+	}
+}
+
+// LinkObject creates an ACTIVE file for an existing object owned by another service.
+// Linking the same object again returns the existing file.
+func (s *LinkService) LinkObject(ctx context.Context, req domain.LinkObjectRequest) (*domain.File, error) {
+	if err := validateLinkRequest(req); err != nil {
+		return nil, err
+	}
+
+	// 같은 객체는 한 번만 연결 (재시도 시 기존 레코드 반환)
+	existing, err := s.fileRepo.FindByFileKeyWithDeleted(ctx, req.FileKey)
+	if err == nil {
+		if existing.Source != req.Source || existing.WorkspaceID != req.WorkspaceID {
+			return nil, response.NewConflictError("object is already registered", req.FileKey)
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to find file: %w", err)
+	}
+
+	if s.s3Client != nil {
+		exists, err := s.s3Client.FileExists(ctx, req.FileKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check object: %w", err)
+		}
+		if !exists {
+			return nil, response.NewNotFoundError("object not found", req.FileKey)
+		}
+	}
+
+	var projectID *uuid.UUID
+	if req.SourceProjectID != nil {
+		projectID, err = s.ensureSourceProject(ctx, req)
+	} else {
+		projectID, err = s.resolveProject(ctx, req.WorkspaceID, req.ProjectID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var folder *domain.Folder
+	if req.FolderID != nil {
+		folder, err = s.folderRepo.FindByID(ctx, *req.FolderID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("folder not found", req.FolderID.String())
+			}
+			return nil, fmt.Errorf("failed to find folder: %w", err)
+		}
+		if folder.WorkspaceID != req.WorkspaceID {
+			return nil, response.NewForbiddenError("folder belongs to different workspace", "")
+		}
+	} else if len(req.FolderPath) > 0 {
+		folder, err = s.ensureFolderPath(ctx, req.WorkspaceID, projectID, req.FolderPath, req.UploadedBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var folderID *uuid.UUID
+	if folder != nil {
+		folderID = &folder.ID
+		// 프로젝트 폴더에 들어가면 폴더의 프로젝트를 따름
+		if folder.ProjectID != nil {
+			projectID = folder.ProjectID
+		}
+	}
+
+	name, err := s.fileRepo.GenerateUniqueName(ctx, req.WorkspaceID, folderID, req.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unique name: %w", err)
+	}
+
+	now := time.Now()
+	file := &domain.File{
+		ID:           uuid.New(),
+		WorkspaceID:  req.WorkspaceID,
+		ProjectID:    projectID,
+		FolderID:     folderID,
+		Name:         name,
+		OriginalName: req.FileName,
+		FileKey:      req.FileKey,
+		FileSize:     req.FileSize,
+		ContentType:  req.ContentType,
+		Status:       domain.FileStatusActive,
+		Version:      1,
+		Source:       req.Source,
+		UploadedBy:   req.UploadedBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	file.ThumbnailStatus = file.InitialThumbnailStatus()
+
+	if err := s.fileRepo.Create(ctx, file); err != nil {
+		return nil, fmt.Errorf("failed to create file record: %w", err)
+	}
+
+	s.logger.Info("External object linked",
+		zap.String("fileId", file.ID.String()),
+		zap.String("source", string(file.Source)),
+		zap.String("fileKey", file.FileKey),
+		zap.String("workspaceId", file.WorkspaceID.String()),
+	)
+
+	return file, nil
+}
+
+// UnlinkObject removes the file registered for an external object.
+// The object itself is left to the owning service; unknown keys are a no-op.
+func (s *LinkService) UnlinkObject(ctx context.Context, req domain.UnlinkObjectRequest) error {
+	file, err := s.fileRepo.FindByFileKeyWithDeleted(ctx, req.FileKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find file: %w", err)
+	}
+	if file.Source != req.Source {
+		return response.NewForbiddenError("object is not owned by this source", req.FileKey)
+	}
+
+	deleteThumbnails(ctx, s.s3Client, s.logger, file)
+	if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+		return fmt.Errorf("failed to delete file record: %w", err)
+	}
+
+	s.logger.Info("External object unlinked",
+		zap.String("fileId", file.ID.String()),
+		zap.String("source", string(file.Source)),
+		zap.String("fileKey", file.FileKey),
+	)
+	return nil
+}
+
+// resolveProject checks that an optional storage project belongs to the workspace
+func (s *LinkService) resolveProject(ctx context.Context, workspaceID uuid.UUID, projectID *uuid.UUID) (*uuid.UUID, error) {
+	if projectID == nil || *projectID == uuid.Nil {
+		return nil, nil
+	}
+	project, err := s.projectRepo.GetByID(ctx, *projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProjectNotFound) {
+			return nil, response.NewNotFoundError("project not found", projectID.String())
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.WorkspaceID != workspaceID {
+		return nil, response.NewForbiddenError("project belongs to different workspace", "")
+	}
+	return &project.ID, nil
+}
+
+// ensureSourceProject returns the private storage project mirroring the source service's project,
+// creating it on first use. The uploader becomes a viewer so only people who attached files to the
+// project, not every workspace member, can browse them.
+func (s *LinkService) ensureSourceProject(ctx context.Context, req domain.LinkObjectRequest) (*uuid.UUID, error) {
+	name := strings.TrimSpace(req.SourceProjectName)
+	if name == "" {
+		name = "Untitled project"
+	}
+
+	now := time.Now()
+	project, err := s.projectRepo.FindOrCreateBySource(ctx, &domain.Project{
+		ID:                uuid.New(),
+		WorkspaceID:       req.WorkspaceID,
+		Name:              name,
+		DefaultPermission: domain.ProjectPermissionViewer,
+		IsPublic:          false,
+		Source:            req.Source,
+		SourceProjectID:   req.SourceProjectID,
+		CreatedBy:         req.UploadedBy,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create source project: %w", err)
+	}
+	if project.WorkspaceID != req.WorkspaceID {
+		return nil, response.NewForbiddenError("project belongs to different workspace", "")
+	}
+	if project.IsDeleted() {
+		return nil, response.NewConflictError("storage project of the source project was deleted", project.ID.String())
+	}
+
+	if project.CreatedBy != req.UploadedBy {
+		_, err := s.projectRepo.GetMember(ctx, project.ID, req.UploadedBy)
+		if errors.Is(err, repository.ErrProjectMemberNotFound) {
+			err = s.projectRepo.AddMember(ctx, &domain.ProjectMember{
+				ID:         uuid.New(),
+				ProjectID:  project.ID,
+				UserID:     req.UploadedBy,
+				Permission: domain.ProjectPermissionViewer,
+				AddedBy:    project.CreatedBy,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			})
+			if errors.Is(err, repository.ErrProjectMemberExists) {
+				err = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to add project member: %w", err)
+		}
+	}
+
+	return &project.ID, nil
+}
+
+// ensureFolderPath finds or creates the folders named in path, starting at the root of the
+// project (or the workspace when projectID is nil)
+func (s *LinkService) ensureFolderPath(ctx context.Context, workspaceID uuid.UUID, projectID *uuid.UUID, path []string, userID uuid.UUID) (*domain.Folder, error) {
+	var parent *domain.Folder
+	for _, name := range path {
+		now := time.Now()
+		folder := &domain.Folder{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			ProjectID:   projectID,
+			Name:        name,
+			Path:        "/" + name,
+			CreatedBy:   userID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if parent != nil {
+			folder.ParentID = &parent.ID
+			folder.Path = parent.Path + "/" + name
+			folder.ProjectID = parent.ProjectID
+		}
+
+		// 동시에 연결되는 첨부파일이 같은 폴더를 중복 생성하지 않도록 저장소에서 직렬화
+		found, err := s.folderRepo.FindOrCreateInParent(ctx, folder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find or create folder: %w", err)
+		}
+		parent = found
+	}
+	return parent, nil
+}
+
+// validateLinkRequest checks that the object lives under the source's own prefix
+func validateLinkRequest(req domain.LinkObjectRequest) error {
+	if _, ok := req.Source.KeyPrefix(); !ok {
+		return response.NewValidationError("unsupported source", string(req.Source))
+	}
+	if !req.Source.OwnsKey(req.FileKey) {
+		return response.NewValidationError("file key is outside the source prefix", req.FileKey)
+	}
+	if req.FileSize > MaxMultipartFileSize {
+		return response.NewValidationError(fmt.Sprintf("file size exceeds maximum allowed (%d GB)", MaxMultipartFileSize/(1024*1024*1024)), "")
+	}
+	if req.SourceProjectID != nil && req.FolderID != nil {
+		return response.NewValidationError("folderId cannot be combined with sourceProjectId", "")
+	}
+	if len(req.FolderPath) > domain.MaxLinkFolderDepth {
+		return response.NewValidationError(fmt.Sprintf("folder path cannot be deeper than %d levels", domain.MaxLinkFolderDepth), "")
+	}
+	for _, name := range req.FolderPath {
+		if strings.TrimSpace(name) == "" || strings.Contains(name, "/") {
+			return response.NewValidationError("invalid folder name in path", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// purgeBatchSize limits how many trashed files are purged per workspace in one run
+const purgeBatchSize = 500
+
+// maxReportedKeys limits how many keys are kept in a reconcile report
+const maxReportedKeys = 100
+
+// MaintenanceOptions configures storage maintenance behaviour
+type MaintenanceOptions struct {
+	DefaultTrashRetentionDays int           // Used when a workspace has no explicit setting
+	OrphanObjectGracePeriod   time.Duration // S3 objects younger than this are never treated as orphans
+	DeleteOrphanObjects       bool          // If false, reconciliation only reports orphans
+}
+
+// MaintenanceService handles scheduled storage maintenance
+// 고아 업로드 정리, 만료된 공유 정리, 휴지통 자동 비우기, S3/DB 정합성 검사를 담당합니다.
+type MaintenanceService struct {
+	fileRepo     *repository.FileRepository
+	folderRepo   *repository.FolderRepository
+	shareRepo    *repository.ShareRepository
+	settingsRepo *repository.WorkspaceSettingsRepository
+	fileService  *FileService
+	shareService *ShareService
+	s3Client     *client.S3Client
+	opts         MaintenanceOptions
+	logger       *zap.Logger
+}
+
+// NewMaintenanceService creates a new MaintenanceService
+func NewMaintenanceService(
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	shareRepo *repository.ShareRepository,
+	settingsRepo *repository.WorkspaceSettingsRepository,
+	fileService *FileService,
+	shareService *ShareService,
+	s3Client *client.S3Client,
+	opts MaintenanceOptions,
+	logger *zap.Logger,
+) *MaintenanceService {
+	if opts.DefaultTrashRetentionDays <= 0 {
+		opts.DefaultTrashRetentionDays = domain.DefaultTrashRetentionDays
+	}
+	if opts.OrphanObjectGracePeriod <= 0 {
+		opts.OrphanObjectGracePeriod = 24 * time.Hour
+	}
+	return &MaintenanceService{
+		fileRepo:     fileRepo,
+		folderRepo:   folderRepo,
+		shareRepo:    shareRepo,
+		settingsRepo: settingsRepo,
+		fileService:  fileService,
+		shareService: shareService,
+		s3Client:     s3Client,
+		opts:         opts,
+		logger:       logger,
+	}
+}
+
+// GetWorkspaceSettings gets the storage settings of a workspace, falling back to defaults
+func (s *MaintenanceService) GetWorkspaceSettings(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspaceStorageSettingsResponse, error) {
+	settings, err := s.settingsRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &domain.WorkspaceStorageSettingsResponse{
+				WorkspaceID:        workspaceID,
+				TrashRetentionDays: s.opts.DefaultTrashRetentionDays,
+				IsDefault:          true,
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get workspace settings: %w", err)
+	}
+
+	return &domain.WorkspaceStorageSettingsResponse{
+		WorkspaceID:        settings.WorkspaceID,
+		TrashRetentionDays: settings.TrashRetentionDays,
+	}, nil
+}
+
+// UpdateWorkspaceSettings updates the storage settings of a workspace
+func (s *MaintenanceService) UpdateWorkspaceSettings(ctx context.Context, workspaceID uuid.UUID, req domain.UpdateWorkspaceStorageSettingsRequest, userID uuid.UUID) (*domain.WorkspaceStorageSettingsResponse, error) {
+	current, err := s.GetWorkspaceSettings(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	retention := current.TrashRetentionDays
+	if req.TrashRetentionDays != nil {
+		if *req.TrashRetentionDays < 0 {
+			return nil, response.NewValidationError("trashRetentionDays cannot be negative", "")
+		}
+		retention = *req.TrashRetentionDays
+	}
+
+	now := time.Now()
+	settings := &domain.WorkspaceStorageSettings{
+		WorkspaceID:        workspaceID,
+		TrashRetentionDays: retention,
+		UpdatedBy:          userID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if err := s.settingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to update workspace settings: %w", err)
+	}
+
+	s.logger.Info("Workspace storage settings updated",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Int("trashRetentionDays", retention),
+		zap.String("userId", userID.String()),
+	)
+
+	return &domain.WorkspaceStorageSettingsResponse{
+		WorkspaceID:        workspaceID,
+		TrashRetentionDays: retention,
+	}, nil
+}
+
+// CleanupOrphanedUploads removes uploads that never got confirmed
+func (s *MaintenanceService) CleanupOrphanedUploads(ctx context.Context) error {
+	return s.fileService.CleanupOrphanedUploads(ctx)
+}
+
+// CleanupExpiredShares removes share links past their expiration
+func (s *MaintenanceService) CleanupExpiredShares(ctx context.Context) error {
+	return s.shareService.CleanupExpiredShares(ctx)
+}
+
+// PurgeExpiredTrash permanently deletes trashed files and folders older than each workspace's retention
+// 워크스페이스별 보관 기간이 지난 휴지통 항목을 S3와 DB에서 영구 삭제합니다.
+func (s *MaintenanceService) PurgeExpiredTrash(ctx context.Context) (*domain.PurgeResult, error) {
+	result := &domain.PurgeResult{}
+
+	workspaceIDs, err := s.trashWorkspaceIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(workspaceIDs) == 0 {
+		return result, nil
+	}
+
+	settings, err := s.settingsRepo.FindByWorkspaceIDs(ctx, workspaceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workspace settings: %w", err)
+	}
+
+	now := time.Now()
+	for _, workspaceID := range workspaceIDs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		retentionDays := s.opts.DefaultTrashRetentionDays
+		if ws, ok := settings[workspaceID]; ok {
+			retentionDays = ws.TrashRetentionDays
+		}
+		// 0이면 자동 비우기 비활성화
+		if retentionDays <= 0 {
+			continue
+		}
+
+		result.WorkspacesScanned++
+		cutoff := now.AddDate(0, 0, -retentionDays)
+		s.purgeWorkspaceTrash(ctx, workspaceID, cutoff, result)
+	}
+
+	return result, nil
+}
+
+// trashWorkspaceIDs returns the union of workspaces that have trashed files or folders
+func (s *MaintenanceService) trashWorkspaceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	fileWorkspaces, err := s.fileRepo.FindTrashWorkspaceIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workspaces with trashed files: %w", err)
+	}
+	folderWorkspaces, err := s.folderRepo.FindTrashWorkspaceIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workspaces with trashed folders: %w", err)
+	}
+	return unionUUIDs(fileWorkspaces, folderWorkspaces), nil
+}
+
+// purgeWorkspaceTrash purges the expired trash of a single workspace
+func (s *MaintenanceService) purgeWorkspaceTrash(ctx context.Context, workspaceID uuid.UUID, cutoff time.Time, result *domain.PurgeResult) {
+	files, err := s.fileRepo.FindDeletedBefore(ctx, workspaceID, cutoff, purgeBatchSize)
+	if err != nil {
+		s.logger.Error("Failed to find expired trashed files",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Error(err),
+		)
+		result.Failed++
+		return
+	}
+
+	for _, file := range files {
+		// S3 삭제 실패 시 DB 레코드를 남겨 다음 실행에서 재시도
+		if s.s3Client != nil {
+			if !file.IsLinked() {
+				if err := s.s3Client.DeleteFile(ctx, file.FileKey); err != nil {
+					s.logger.Error("Failed to delete trashed file from S3",
+						zap.String("fileId", file.ID.String()),
+						zap.String("fileKey", file.FileKey),
+						zap.Error(err),
+					)
+					result.Failed++
+					continue
+				}
+			}
+			deleteThumbnails(ctx, s.s3Client, s.logger, &file)
+		}
+		if err := s.shareRepo.DeleteFileSharesByFileID(ctx, file.ID); err != nil {
+			s.logger.Warn("Failed to delete shares of purged file",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+		}
+		if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+			s.logger.Error("Failed to delete trashed file record",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		result.FilesPurged++
+		result.BytesFreed += file.FileSize
+	}
+
+	folders, err := s.folderRepo.FindDeletedBefore(ctx, workspaceID, cutoff)
+	if err != nil {
+		s.logger.Error("Failed to find expired trashed folders",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Error(err),
+		)
+		result.Failed++
+		return
+	}
+
+	for _, folder := range folders {
+		// 휴지통에 있는 것을 포함해 파일/하위 폴더가 남아 있으면 건너뜀 (데이터 손실 방지)
+		// 이번 실행에서 다 비우지 못한 파일은 다음 실행에서 먼저 비운 뒤 폴더를 삭제
+		fileCount, err := s.fileRepo.CountByFolderIDWithDeleted(ctx, folder.ID)
+		if err != nil || fileCount > 0 {
+			continue
+		}
+		childCount, err := s.folderRepo.CountByParentIDWithDeleted(ctx, folder.ID)
+		if err != nil || childCount > 0 {
+			continue
+		}
+
+		if err := s.shareRepo.DeleteFolderSharesByFolderID(ctx, folder.ID); err != nil {
+			s.logger.Warn("Failed to delete shares of purged folder",
+				zap.String("folderId", folder.ID.String()),
+				zap.Error(err),
+			)
+		}
+		if err := s.folderRepo.PermanentDelete(ctx, folder.ID); err != nil {
+			s.logger.Error("Failed to delete trashed folder record",
+				zap.String("folderId", folder.ID.String()),
+				zap.Error(err),
+			)
+			result.Failed++
+			continue
+		}
+		result.FoldersPurged++
+	}
+}
+
+// ReconcileWorkspaces compares S3 objects with file records for every known workspace
+// S3에는 있으나 DB에 없는 객체, DB에는 있으나 S3에 없는 객체를 찾습니다.
+func (s *MaintenanceService) ReconcileWorkspaces(ctx context.Context) ([]domain.ReconcileResult, error) {
+	if s.s3Client == nil {
+		return nil, errors.New("s3 client not configured")
+	}
+
+	dbWorkspaces, err := s.fileRepo.FindWorkspaceIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find workspaces: %w", err)
+	}
+
+	prefixes, err := s.s3Client.ListPrefixes(ctx, "storage/")
+	if err != nil {
+		return nil, err
+	}
+	var s3Workspaces []uuid.UUID
+	for _, prefix := range prefixes {
+		id, err := uuid.Parse(strings.TrimSuffix(strings.TrimPrefix(prefix, "storage/"), "/"))
+		if err != nil {
+			continue
+		}
+		s3Workspaces = append(s3Workspaces, id)
+	}
+
+	var results []domain.ReconcileResult
+	for _, workspaceID := range unionUUIDs(dbWorkspaces, s3Workspaces) {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		result, err := s.ReconcileWorkspace(ctx, workspaceID)
+		if err != nil {
+			s.logger.Error("Failed to reconcile workspace",
+				zap.String("workspaceId", workspaceID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// ReconcileWorkspace compares S3 objects with file records for a single workspace
+func (s *MaintenanceService) ReconcileWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.ReconcileResult, error) {
+	if s.s3Client == nil {
+		return nil, errors.New("s3 client not configured")
+	}
+
+	objects, err := s.s3Client.ListObjects(ctx, client.WorkspacePrefix(workspaceID.String()))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.fileRepo.FindFileKeysByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load file keys: %w", err)
+	}
+
+	result := &domain.ReconcileResult{
+		WorkspaceID:    workspaceID,
+		ObjectsScanned: len(objects),
+		RowsScanned:    len(rows),
+		OrphanObjects:  []string{},
+		MissingObjects: []string{},
+	}
+
+	graceCutoff := time.Now().Add(-s.opts.OrphanObjectGracePeriod)
+	seen := make(map[string]struct{}, len(objects))
+	for _, obj := range objects {
+		seen[obj.Key] = struct{}{}
+		if _, ok := rows[obj.Key]; ok {
+			continue
+		}
+		// 업로드 직후의 객체는 아직 레코드가 생성 중일 수 있으므로 유예
+		if obj.LastModified.After(graceCutoff) {
+			continue
+		}
+		if len(result.OrphanObjects) < maxReportedKeys {
+			result.OrphanObjects = append(result.OrphanObjects, obj.Key)
+		}
+		if s.opts.DeleteOrphanObjects {
+			if err := s.s3Client.DeleteFile(ctx, obj.Key); err != nil {
+				s.logger.Error("Failed to delete orphan object",
+					zap.String("fileKey", obj.Key),
+					zap.Error(err),
+				)
+				continue
+			}
+			result.OrphansDeleted++
+		}
+	}
+
+	for key, status := range rows {
+		// 업로드 중인 파일은 아직 객체가 없을 수 있음
+		if status == domain.FileStatusUploading {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		if len(result.MissingObjects) < maxReportedKeys {
+			result.MissingObjects = append(result.MissingObjects, key)
+		}
+	}
+
+	s.removeStaleLinks(ctx, workspaceID, graceCutoff, result)
+
+	if len(result.OrphanObjects) > 0 || len(result.MissingObjects) > 0 {
+		s.logger.Warn("Storage reconciliation found inconsistencies",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Int("orphanObjects", len(result.OrphanObjects)),
+			zap.Int("missingObjects", len(result.MissingObjects)),
+			zap.Int("orphansDeleted", result.OrphansDeleted),
+		)
+	}
+
+	return result, nil
+}
+
+// removeStaleLinks removes linked files whose object was deleted by the owning service.
+// 보드 등 원 서비스가 첨부파일을 삭제해도 storage에는 알리지 않을 수 있으므로 여기서 정리합니다.
+func (s *MaintenanceService) removeStaleLinks(ctx context.Context, workspaceID uuid.UUID, graceCutoff time.Time, result *domain.ReconcileResult) {
+	linked, err := s.fileRepo.FindLinkedByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		s.logger.Error("Failed to load linked files",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	for i := range linked {
+		file := &linked[i]
+		// 방금 연결된 파일은 건너뜀
+		if file.CreatedAt.After(graceCutoff) {
+			continue
+		}
+		exists, err := s.s3Client.FileExists(ctx, file.FileKey)
+		if err != nil || exists {
+			continue
+		}
+		deleteThumbnails(ctx, s.s3Client, s.logger, file)
+		if err := s.fileRepo.PermanentDelete(ctx, file.ID); err != nil {
+			s.logger.Error("Failed to remove stale linked file",
+				zap.String("fileId", file.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		result.LinkedRemoved++
+	}
+
+	if result.LinkedRemoved > 0 {
+		s.logger.Info("Removed linked files whose object is gone",
+			zap.String("workspaceId", workspaceID.String()),
+			zap.Int("count", result.LinkedRemoved),
+		)
+	}
+}
+
+// unionUUIDs merges UUID slices, preserving first-seen order and dropping duplicates
+func unionUUIDs(lists ...[]uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{})
+	var result []uuid.UUID
+	for _, list := range lists {
+		for _, id := range list {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"storage-service/internal/client"
 	"storage-service/internal/domain"
 	"storage-service/internal/repository"
@@ -39,14 +42,16 @@ type ProjectService interface {
 type projectService struct {
 	projectRepo repository.ProjectRepository
 	userClient  client.UserClient
+	permissions *permission.Resolver // workspace permissions incl. custom roles (nil uses project permissions only)
 	logger      *zap.Logger
 }
 
 // NewProjectService creates a new project service
-func NewProjectService(projectRepo repository.ProjectRepository, userClient client.UserClient, logger *zap.Logger) ProjectService {
+func NewProjectService(projectRepo repository.ProjectRepository, userClient client.UserClient, permissions *permission.Resolver, logger *zap.Logger) ProjectService {
 	return &projectService{
 		projectRepo: projectRepo,
 		userClient:  userClient,
+		permissions: permissions,
 		logger:      logger,
 	}
 }
@@ -140,15 +145,10 @@ func (s *projectService) GetProject(ctx context.Context, projectID, userID uuid.
 		return nil, err
 	}
 
-	// Validate workspace membership first
-	if err := s.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, token); err != nil {
-		return nil, err
-	}
-
-	// Check project access
-	perm, err := s.projectRepo.GetUserPermission(ctx, projectID, userID)
+	// Validate workspace membership (or the guest scope) and check project access
+	perm, err := resolveProjectPermission(ctx, s.projectRepo, s.userClient, s.logger, project, userID, token)
 	if err != nil {
-		return nil, response.ErrAccessDenied
+		return nil, err
 	}
 
 	response := project.ToResponse()
@@ -171,17 +171,27 @@ func (s *projectService) GetProject(ctx context.Context, projectID, userID uuid.
 	return &response, nil
 }
 
-// GetWorkspaceProjects retrieves projects in a workspace that user has access to
+// GetWorkspaceProjects retrieves projects in a workspace that user has access to.
+// Guests only see the projects shared with them.
 func (s *projectService) GetWorkspaceProjects(ctx context.Context, workspaceID, userID uuid.UUID, token string, page, pageSize int) (*domain.ProjectListResponse, error) {
 	// Validate workspace membership
-	if err := s.ValidateWorkspaceMember(ctx, workspaceID, userID, token); err != nil {
+	access, err := workspaceAccess(ctx, s.userClient, s.logger, workspaceID, userID, token)
+	if err != nil {
 		return nil, err
 	}
+	if !access.IsWorkspaceMember() && !access.IsGuest {
+		return nil, response.ErrNotWorkspaceMember
+	}
 
 	// Get projects user has access to
-	projects, err := s.projectRepo.GetUserProjects(ctx, workspaceID, userID)
-	if err != nil {
-		return nil, err
+	var projects []domain.Project
+	if access.IsWorkspaceMember() {
+		projects, err = s.projectRepo.GetUserProjects(ctx, workspaceID, userID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		projects = s.guestProjects(ctx, workspaceID, access.GuestStorageProjectIDs)
 	}
 
 	// Calculate pagination
@@ -205,8 +215,12 @@ func (s *projectService) GetWorkspaceProjects(ctx context.Context, workspaceID,
 		responses[i] = p.ToResponse()
 
 		// Get user's permission
-		perm, _ := s.projectRepo.GetUserPermission(ctx, p.ID, userID)
-		responses[i].MyPermission = perm
+		if access.IsWorkspaceMember() {
+			perm, _ := s.projectRepo.GetUserPermission(ctx, p.ID, userID)
+			responses[i].MyPermission = perm
+		} else {
+			responses[i].MyPermission, _ = resolveProjectPermission(ctx, s.projectRepo, s.userClient, s.logger, &pagedProjects[i], userID, token)
+		}
 
 		// Get stats
 		fileCount, folderCount, totalSize, err := s.projectRepo.GetProjectStats(ctx, p.ID)
@@ -226,6 +240,19 @@ func (s *projectService) GetWorkspaceProjects(ctx context.Context, workspaceID,
 	}, nil
 }
 
+// guestProjects loads the shared projects of a guest that still exist in the workspace
+func (s *projectService) guestProjects(ctx context.Context, workspaceID uuid.UUID, projectIDs []uuid.UUID) []domain.Project {
+	projects := make([]domain.Project, 0, len(projectIDs))
+	for _, id := range projectIDs {
+		project, err := s.projectRepo.GetByID(ctx, id)
+		if err != nil || project.WorkspaceID != workspaceID {
+			continue
+		}
+		projects = append(projects, *project)
+	}
+	return projects
+}
+
 // UpdateProject updates a project
 func (s *projectService) UpdateProject(ctx context.Context, projectID uuid.UUID, req domain.UpdateProjectRequest, userID uuid.UUID, token string) (*domain.ProjectResponse, error) {
 	project, err := s.projectRepo.GetByID(ctx, projectID)
@@ -343,19 +370,13 @@ func (s *projectService) AddMember(ctx context.Context, projectID uuid.UUID, req
 
 // GetMembers retrieves all members of a project
 func (s *projectService) GetMembers(ctx context.Context, projectID, userID uuid.UUID, token string) ([]domain.ProjectMemberResponse, error) {
-	project, err := s.projectRepo.GetByID(ctx, projectID)
+	// Check access (must have at least view permission)
+	// CheckAccess also validates workspace membership or, for guests, that the project is shared with them
+	result, err := s.CheckAccess(ctx, projectID, userID, token, domain.ProjectPermissionViewer)
 	if err != nil {
 		return nil, err
 	}
-
-	// Validate workspace membership
-	if err := s.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, token); err != nil {
-		return nil, err
-	}
-
-	// Check access (must have at least view permission)
-	result, err := s.CheckAccess(ctx, projectID, userID, token, domain.ProjectPermissionViewer)
-	if err != nil || !result.HasAccess {
+	if !result.HasAccess {
 		return nil, response.ErrAccessDenied
 	}
 
@@ -470,15 +491,13 @@ func (s *projectService) CheckAccess(ctx context.Context, projectID, userID uuid
 		return result, err
 	}
 
-	// Validate workspace membership first
-	if err := s.ValidateWorkspaceMember(ctx, project.WorkspaceID, userID, token); err != nil {
-		result.Reason = "not a workspace member"
-		return result, err
-	}
-
-	// Get user's permission
-	perm, err := s.projectRepo.GetUserPermission(ctx, projectID, userID)
+	// Validate workspace membership (or the guest scope) and check the user's permission
+	perm, allowed, err := authorizeProjectPermission(ctx, s.projectRepo, s.userClient, s.permissions, s.logger, project, userID, token, requiredPermission)
 	if err != nil {
+		if errors.Is(err, response.ErrNotWorkspaceMember) {
+			result.Reason = "not a workspace member"
+			return result, err
+		}
 		result.Reason = "no access to project"
 		return result, nil
 	}
@@ -486,16 +505,7 @@ func (s *projectService) CheckAccess(ctx context.Context, projectID, userID uuid
 	result.Permission = perm
 	result.IsOwner = *perm == domain.ProjectPermissionOwner
 
-	// Check if permission is sufficient
-	switch requiredPermission {
-	case domain.ProjectPermissionViewer:
-		result.HasAccess = perm.CanView()
-	case domain.ProjectPermissionEditor:
-		result.HasAccess = perm.CanEdit()
-	case domain.ProjectPermissionOwner:
-		result.HasAccess = perm.CanManage()
-	}
-
+	result.HasAccess = allowed
 	if !result.HasAccess {
 		result.Reason = "insufficient permission"
 	}
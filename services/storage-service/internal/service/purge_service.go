@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+)
+
+// PurgeService permanently removes storage data of deleted workspaces and accounts
+// user-service의 삭제 작업(deletion job)이 유예 기간 후 내부 API로 호출합니다.
+// 모든 작업은 멱등적이며, 실패 시 호출자가 재시도합니다.
+type PurgeService struct {
+	purgeRepo *repository.PurgeRepository
+	s3Client  *client.S3Client
+	logger    *zap.Logger
+}
+
+// NewPurgeService creates a new PurgeService
+func NewPurgeService(purgeRepo *repository.PurgeRepository, s3Client *client.S3Client, logger *zap.Logger) *PurgeService {
+	return &PurgeService{
+		purgeRepo: purgeRepo,
+		s3Client:  s3Client,
+		logger:    logger,
+	}
+}
+
+// PurgeWorkspace deletes all objects and records of a workspace
+// S3 객체를 먼저 삭제하고 DB 행을 삭제합니다. S3 삭제가 실패하면 DB 행을 남겨 재시도 시 다시 찾을 수 있게 합니다.
+// 다른 서비스가 소유한 연결(linked) 객체는 워크스페이스 prefix 밖에 있으므로 삭제되지 않습니다.
+func (s *PurgeService) PurgeWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.WorkspacePurgeResult, error) {
+	result := &domain.WorkspacePurgeResult{WorkspaceID: workspaceID}
+
+	if s.s3Client != nil {
+		prefix := client.WorkspacePrefix(workspaceID.String())
+
+		// 진행 중인 멀티파트 업로드 중단
+		uploads, err := s.s3Client.ListMultipartUploads(ctx, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list multipart uploads: %w", err)
+		}
+		for _, upload := range uploads {
+			if err := s.s3Client.AbortMultipartUpload(ctx, upload.Key, upload.UploadID); err != nil {
+				return nil, fmt.Errorf("failed to abort multipart upload %s: %w", upload.Key, err)
+			}
+		}
+
+		prefixes := []string{prefix}
+		for _, size := range domain.ThumbnailSizes {
+			prefixes = append(prefixes, fmt.Sprintf("thumbnails/%s/%s", size.Name, prefix))
+		}
+		for _, p := range prefixes {
+			objects, err := s.s3Client.ListObjects(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				if err := s.s3Client.DeleteFile(ctx, obj.Key); err != nil {
+					return nil, fmt.Errorf("failed to delete object %s: %w", obj.Key, err)
+				}
+				result.ObjectsDeleted++
+			}
+		}
+	}
+
+	filesDeleted, err := s.purgeRepo.PurgeWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge workspace records: %w", err)
+	}
+	result.FilesDeleted = filesDeleted
+
+	s.logger.Info("Workspace storage purged",
+		zap.String("workspaceId", workspaceID.String()),
+		zap.Int64("filesDeleted", result.FilesDeleted),
+		zap.Int("objectsDeleted", result.ObjectsDeleted),
+	)
+	return result, nil
+}
+
+// PurgeUser deletes the personal storage data of a deleted account
+func (s *PurgeService) PurgeUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.purgeRepo.PurgeUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to purge user records: %w", err)
+	}
+
+	s.logger.Info("User storage data purged", zap.String("userId", userID.String()))
+	return nil
+}
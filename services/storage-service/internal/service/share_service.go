@@ -5,22 +5,35 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	"storage-service/internal/client"
 	"storage-service/internal/domain"
 	"storage-service/internal/repository"
 	"storage-service/internal/response"
 )
 
+// shareLinkURLExpiry is kept short so that issued URLs cannot be reused to bypass download limits
+const shareLinkURLExpiry = 5 * time.Minute
+
+// MaxSharedFolderListItems limits recursive listings of a shared folder
+const MaxSharedFolderListItems = 5000
+
 // ShareService handles share business logic
 type ShareService struct {
 	shareRepo  *repository.ShareRepository
 	fileRepo   *repository.FileRepository
 	folderRepo *repository.FolderRepository
+	s3Client   *client.S3Client
+	userClient client.UserClient // 공유자 이름 조회용 (nil이면 이름 없이 응답)
+	archives   *ArchiveService
 	logger     *zap.Logger
 }
 
@@ -29,13 +42,19 @@ func NewShareService(
 	shareRepo *repository.ShareRepository,
 	fileRepo *repository.FileRepository,
 	folderRepo *repository.FolderRepository,
+	s3Client *client.S3Client,
+	userClient client.UserClient,
 	logger *zap.Logger,
 ) *ShareService {
 	return &ShareService{
 		shareRepo:  shareRepo,
 		fileRepo:   fileRepo,
 		folderRepo: folderRepo,
-		logger:     logger,
+		s3Client:   s3Client,
+		userClient: userClient,
+		// 공유 링크 권한은 ShareService가 직접 검증하므로 accessService 없이 생성
+		archives: NewArchiveService(fileRepo, folderRepo, s3Client, nil, nil, logger),
+		logger:   logger,
 	}
 }
 
@@ -48,6 +67,24 @@ func generateShareLink() (string, error) {
 	return base64.URLEncoding.EncodeToString(bytes)[:32], nil
 }
 
+// hashSharePassword hashes a share link password with bcrypt
+func hashSharePassword(password string) (*string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	hashed := string(hash)
+	return &hashed, nil
+}
+
+// checkSharePassword reports whether password matches the stored hash
+func checkSharePassword(hash *string, password string) bool {
+	if hash == nil || *hash == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(*hash), []byte(password)) == nil
+}
+
 // CreateShare creates a new share for a file or folder
 // 파일 또는 폴더에 대한 공유 생성
 func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRequest, userID uuid.UUID) (*domain.ShareResponse, error) {
@@ -79,6 +116,16 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 	// 공개 링크 생성
 	var shareLink *string
 	var linkExpiresAt *time.Time
+	var passwordHash *string
+
+	allowDownload := true
+	if req.AllowDownload != nil {
+		allowDownload = *req.AllowDownload
+	}
+
+	if !req.IsPublic && (req.Password != nil || req.MaxDownloads != nil || req.AllowDownload != nil) {
+		return nil, response.NewValidationError("password, download limit and download settings require a public link", "")
+	}
 
 	if req.IsPublic {
 		link, err := generateShareLink()
@@ -91,6 +138,15 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 			expires := time.Now().AddDate(0, 0, *req.ExpiresInDays)
 			linkExpiresAt = &expires
 		}
+
+		// 링크 비밀번호는 bcrypt 해시로만 저장
+		if req.Password != nil && *req.Password != "" {
+			hash, err := hashSharePassword(*req.Password)
+			if err != nil {
+				return nil, response.NewInternalError("failed to hash share password", err.Error())
+			}
+			passwordHash = hash
+		}
 	}
 
 	now := time.Now()
@@ -113,6 +169,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 			ShareLink:     shareLink,
 			LinkExpiresAt: linkExpiresAt,
 			IsPublic:      req.IsPublic,
+			PasswordHash:  passwordHash,
+			MaxDownloads:  req.MaxDownloads,
+			AllowDownload: allowDownload,
 			CreatedAt:     now,
 			UpdatedAt:     now,
 		}
@@ -139,6 +198,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 			LinkExpiresAt: linkExpiresAt,
 			IsPublic:      req.IsPublic,
 			IsExpired:     share.IsExpired(),
+			HasPassword:   share.HasPassword(),
+			MaxDownloads:  share.MaxDownloads,
+			AllowDownload: share.AllowDownload,
 			CreatedAt:     now,
 			UpdatedAt:     now,
 		}, nil
@@ -168,6 +230,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 		LinkExpiresAt:   linkExpiresAt,
 		IsPublic:        req.IsPublic,
 		IncludeChildren: includeChildren,
+		PasswordHash:    passwordHash,
+		MaxDownloads:    req.MaxDownloads,
+		AllowDownload:   allowDownload,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
@@ -195,6 +260,9 @@ func (s *ShareService) CreateShare(ctx context.Context, req domain.CreateShareRe
 		IsPublic:        req.IsPublic,
 		IsExpired:       share.IsExpired(),
 		IncludeChildren: includeChildren,
+		HasPassword:     share.HasPassword(),
+		MaxDownloads:    share.MaxDownloads,
+		AllowDownload:   share.AllowDownload,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}, nil
@@ -230,11 +298,16 @@ func (s *ShareService) GetFileShares(ctx context.Context, fileID uuid.UUID) ([]d
 			LinkExpiresAt: share.LinkExpiresAt,
 			IsPublic:      share.IsPublic,
 			IsExpired:     share.IsExpired(),
+			HasPassword:   share.HasPassword(),
+			MaxDownloads:  share.MaxDownloads,
+			DownloadCount: share.DownloadCount,
+			AllowDownload: share.AllowDownload,
 			CreatedAt:     share.CreatedAt,
 			UpdatedAt:     share.UpdatedAt,
 		})
 	}
 
+	s.fillShareNames(ctx, file.WorkspaceID, responses)
 	return responses, nil
 }
 
@@ -269,17 +342,23 @@ func (s *ShareService) GetFolderShares(ctx context.Context, folderID uuid.UUID)
 			IsPublic:        share.IsPublic,
 			IsExpired:       share.IsExpired(),
 			IncludeChildren: share.IncludeChildren,
+			HasPassword:     share.HasPassword(),
+			MaxDownloads:    share.MaxDownloads,
+			DownloadCount:   share.DownloadCount,
+			AllowDownload:   share.AllowDownload,
 			CreatedAt:       share.CreatedAt,
 			UpdatedAt:       share.UpdatedAt,
 		})
 	}
 
+	s.fillShareNames(ctx, folder.WorkspaceID, responses)
 	return responses, nil
 }
 
 // GetSharedWithMe gets all items shared with the current user
 func (s *ShareService) GetSharedWithMe(ctx context.Context, userID uuid.UUID) ([]domain.SharedItem, error) {
 	var items []domain.SharedItem
+	var sharers []commonclient.ProfileKey // items와 같은 순서의 공유자 프로필 키
 
 	// Get file shares
 	fileShares, err := s.shareRepo.FindFileSharesBySharedWith(ctx, userID)
@@ -296,6 +375,7 @@ func (s *ShareService) GetSharedWithMe(ctx context.Context, userID uuid.UUID) ([
 				SharedByID: share.SharedByID,
 				SharedAt:   share.CreatedAt,
 			})
+			sharers = append(sharers, commonclient.ProfileKey{WorkspaceID: share.File.WorkspaceID, UserID: share.SharedByID})
 		}
 	}
 
@@ -314,22 +394,71 @@ func (s *ShareService) GetSharedWithMe(ctx context.Context, userID uuid.UUID) ([
 				SharedByID: share.SharedByID,
 				SharedAt:   share.CreatedAt,
 			})
+			sharers = append(sharers, commonclient.ProfileKey{WorkspaceID: share.Folder.WorkspaceID, UserID: share.SharedByID})
 		}
 	}
 
+	names := s.profileNames(ctx, sharers)
+	for i := range items {
+		items[i].SharedByName = names[sharers[i]]
+	}
+
 	return items, nil
 }
 
+// fillShareNames resolves SharedByName and SharedWithName with one batch profile lookup
+func (s *ShareService) fillShareNames(ctx context.Context, workspaceID uuid.UUID, responses []domain.ShareResponse) {
+	keys := make([]commonclient.ProfileKey, 0, len(responses)*2)
+	for _, r := range responses {
+		keys = append(keys, commonclient.ProfileKey{WorkspaceID: workspaceID, UserID: r.SharedByID})
+		if r.SharedWithID != nil {
+			keys = append(keys, commonclient.ProfileKey{WorkspaceID: workspaceID, UserID: *r.SharedWithID})
+		}
+	}
+
+	names := s.profileNames(ctx, keys)
+	for i := range responses {
+		responses[i].SharedByName = names[commonclient.ProfileKey{WorkspaceID: workspaceID, UserID: responses[i].SharedByID}]
+		if responses[i].SharedWithID != nil {
+			if name, ok := names[commonclient.ProfileKey{WorkspaceID: workspaceID, UserID: *responses[i].SharedWithID}]; ok {
+				responses[i].SharedWithName = &name
+			}
+		}
+	}
+}
+
+// profileNames returns the nicknames of the resolved profiles.
+// Lookup failures are logged and leave names empty.
+func (s *ShareService) profileNames(ctx context.Context, keys []commonclient.ProfileKey) map[commonclient.ProfileKey]string {
+	names := make(map[commonclient.ProfileKey]string, len(keys))
+	if s.userClient == nil || len(keys) == 0 {
+		return names
+	}
+
+	profiles, err := s.userClient.ResolveProfiles(ctx, keys)
+	if err != nil {
+		s.logger.Warn("Failed to resolve share user names", zap.Error(err), zap.Int("count", len(keys)))
+	}
+	for key, profile := range profiles {
+		names[key] = profile.NickName
+	}
+	return names
+}
+
 // GetShareByLink gets a share by its public link
-// 공개 링크로 공유 정보 조회
-func (s *ShareService) GetShareByLink(ctx context.Context, shareLink string) (*domain.ShareResponse, error) {
-	// 파일 공유 먼저 확인
-	fileShare, err := s.shareRepo.FindFileShareByLink(ctx, shareLink)
-	if err == nil {
-		// 만료 확인
-		if fileShare.IsExpired() {
-			return nil, response.NewForbiddenError("share link has expired", "")
+// 공개 링크로 공유 정보 조회 (비밀번호가 설정된 링크는 비밀번호 확인 필요)
+func (s *ShareService) GetShareByLink(ctx context.Context, shareLink string, access domain.ShareAccessInfo) (*domain.ShareResponse, error) {
+	fileShare, folderShare, err := s.findShareByLink(ctx, shareLink)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileShare != nil {
+		if err := s.authorizeLink(ctx, fileShare.ID, domain.ShareTypeFile, fileShare.IsExpired(), fileShare.PasswordHash, access); err != nil {
+			return nil, err
 		}
+		s.recordAccess(ctx, fileShare.ID, domain.ShareTypeFile, domain.ShareAccessResolve, access)
+
 		return &domain.ShareResponse{
 			ID:            fileShare.ID,
 			EntityType:    domain.ShareTypeFile,
@@ -339,32 +468,504 @@ func (s *ShareService) GetShareByLink(ctx context.Context, shareLink string) (*d
 			ShareLink:     fileShare.ShareLink,
 			LinkExpiresAt: fileShare.LinkExpiresAt,
 			IsPublic:      fileShare.IsPublic,
+			HasPassword:   fileShare.HasPassword(),
+			MaxDownloads:  fileShare.MaxDownloads,
+			DownloadCount: fileShare.DownloadCount,
+			AllowDownload: fileShare.AllowDownload,
 			CreatedAt:     fileShare.CreatedAt,
 		}, nil
 	}
 
+	if err := s.authorizeLink(ctx, folderShare.ID, domain.ShareTypeFolder, folderShare.IsExpired(), folderShare.PasswordHash, access); err != nil {
+		return nil, err
+	}
+	s.recordAccess(ctx, folderShare.ID, domain.ShareTypeFolder, domain.ShareAccessResolve, access)
+
+	return &domain.ShareResponse{
+		ID:              folderShare.ID,
+		EntityType:      domain.ShareTypeFolder,
+		EntityID:        folderShare.FolderID,
+		EntityName:      folderShare.Folder.Name,
+		Permission:      folderShare.Permission,
+		ShareLink:       folderShare.ShareLink,
+		LinkExpiresAt:   folderShare.LinkExpiresAt,
+		IsPublic:        folderShare.IsPublic,
+		IncludeChildren: folderShare.IncludeChildren,
+		HasPassword:     folderShare.HasPassword(),
+		MaxDownloads:    folderShare.MaxDownloads,
+		DownloadCount:   folderShare.DownloadCount,
+		AllowDownload:   folderShare.AllowDownload,
+		CreatedAt:       folderShare.CreatedAt,
+	}, nil
+}
+
+// DownloadByLink issues a download URL for a file shared via public link.
+// Each call consumes one download of the link's download limit.
+// 다운로드 허용 여부와 횟수 제한을 확인한 뒤 짧은 만료 시간의 다운로드 URL 발급
+func (s *ShareService) DownloadByLink(ctx context.Context, shareLink string, access domain.ShareAccessInfo) (*domain.ShareLinkURLResponse, error) {
+	share, err := s.resolveFileLink(ctx, shareLink, access)
+	if err != nil {
+		return nil, err
+	}
+
+	if !share.AllowDownload {
+		s.recordAccess(ctx, share.ID, domain.ShareTypeFile, domain.ShareAccessDenied, access)
+		return nil, response.NewForbiddenError("downloads are disabled for this share link", "")
+	}
+
+	// 동시 요청에도 제한을 넘지 않도록 조건부 UPDATE로 횟수 차감
+	ok, err := s.shareRepo.IncrementFileShareDownload(ctx, share.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.recordAccess(ctx, share.ID, domain.ShareTypeFile, domain.ShareAccessDenied, access)
+		return nil, response.NewForbiddenError("share link download limit reached", "")
+	}
+	share.DownloadCount++
+
+	resp, err := s.shareFileURL(ctx, share.File, share.MaxDownloads, share.DownloadCount, false)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAccess(ctx, share.ID, domain.ShareTypeFile, domain.ShareAccessDownload, access)
+
+	return resp, nil
+}
+
+// PreviewByLink issues an inline preview URL for a file shared via public link.
+// Previews are allowed for preview-only links and do not consume downloads.
+func (s *ShareService) PreviewByLink(ctx context.Context, shareLink string, access domain.ShareAccessInfo) (*domain.ShareLinkURLResponse, error) {
+	share, err := s.resolveFileLink(ctx, shareLink, access)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.shareFileURL(ctx, share.File, share.MaxDownloads, share.DownloadCount, true)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAccess(ctx, share.ID, domain.ShareTypeFile, domain.ShareAccessPreview, access)
+
+	return resp, nil
+}
+
+// ListSharedFolder lists a folder reached through a public folder share link.
+// folderID selects a subfolder (nil = the shared folder); recursive returns the whole subtree.
+// Subfolders are only visible when the share includes children.
+// 공유된 폴더 하위 경로만 조회할 수 있도록 모든 요청에서 범위를 검증
+func (s *ShareService) ListSharedFolder(ctx context.Context, shareLink string, folderID *uuid.UUID, recursive bool, access domain.ShareAccessInfo) (*domain.SharedFolderContentsResponse, error) {
+	share, err := s.resolveFolderLink(ctx, shareLink, access)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.scopedFolder(ctx, share, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := share.Folder
+	recursive = recursive && share.IncludeChildren
+
+	var subfolders []domain.Folder
+	if share.IncludeChildren {
+		if recursive {
+			subfolders, err = s.folderRepo.FindChildrenRecursive(ctx, folder.WorkspaceID, folder.Path)
+		} else {
+			subfolders, err = s.folderRepo.FindByParentID(ctx, folder.WorkspaceID, &folder.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	folderIDs := []uuid.UUID{folder.ID}
+	folderPaths := map[uuid.UUID]string{folder.ID: sharedPath(root, folder.Path)}
+	folders := make([]domain.SharedFolderItem, 0, len(subfolders))
+	for i := range subfolders {
+		child := &subfolders[i]
+		if !inShareScope(root, child) {
+			continue
+		}
+		item := toSharedFolderItem(root, child)
+		folders = append(folders, item)
+		folderPaths[child.ID] = item.Path
+		if recursive {
+			folderIDs = append(folderIDs, child.ID)
+		}
+	}
+
+	files, err := s.fileRepo.FindActiveByFolderIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(folders)+len(files) > MaxSharedFolderListItems {
+		return nil, response.NewValidationError("shared folder has too many items to list at once, browse subfolders individually", "")
+	}
+
+	fileItems := make([]domain.SharedFileItem, 0, len(files))
+	for _, file := range files {
+		fileItems = append(fileItems, domain.SharedFileItem{
+			ID:          file.ID,
+			FolderID:    *file.FolderID,
+			Name:        file.Name,
+			Path:        strings.TrimSuffix(folderPaths[*file.FolderID], "/") + "/" + file.Name,
+			ContentType: file.ContentType,
+			FileSize:    file.FileSize,
+			UpdatedAt:   file.UpdatedAt,
+		})
+	}
+
+	s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, domain.ShareAccessBrowse, access)
+
+	return &domain.SharedFolderContentsResponse{
+		ShareID:       share.ID,
+		Folder:        toSharedFolderItem(root, folder),
+		Folders:       folders,
+		Files:         fileItems,
+		Recursive:     recursive,
+		AllowDownload: share.AllowDownload,
+	}, nil
+}
+
+// GetSharedFolderFileURL issues a preview or download URL for a file inside a shared folder.
+// Downloads consume one download of the link's download limit; previews do not.
+func (s *ShareService) GetSharedFolderFileURL(ctx context.Context, shareLink string, fileID uuid.UUID, inline bool, access domain.ShareAccessInfo) (*domain.ShareLinkURLResponse, error) {
+	share, err := s.resolveFolderLink(ctx, shareLink, access)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found in share", "")
+		}
+		return nil, err
+	}
+	if file.FolderID == nil || file.Status != domain.FileStatusActive || file.IsDeleted() {
+		return nil, response.NewNotFoundError("file not found in share", "")
+	}
+	// 파일이 속한 폴더가 공유 범위 안에 있는지 확인
+	if _, err := s.scopedFolder(ctx, share, file.FolderID); err != nil {
+		return nil, response.NewNotFoundError("file not found in share", "")
+	}
+
+	action := domain.ShareAccessPreview
+	if !inline {
+		if err := s.consumeFolderDownload(ctx, share, access); err != nil {
+			return nil, err
+		}
+		action = domain.ShareAccessDownload
+	}
+
+	resp, err := s.shareFileURL(ctx, file, share.MaxDownloads, share.DownloadCount, inline)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, action, access)
+
+	return resp, nil
+}
+
+// PrepareSharedFolderArchive builds a ZIP archive plan for a shared folder or one of its subfolders.
+// The archive is validated before one download of the link's download limit is consumed.
+func (s *ShareService) PrepareSharedFolderArchive(ctx context.Context, shareLink string, folderID *uuid.UUID, access domain.ShareAccessInfo) (*ArchivePlan, error) {
+	share, err := s.resolveFolderLink(ctx, shareLink, access)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.scopedFolder(ctx, share, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !share.AllowDownload {
+		s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, domain.ShareAccessDenied, access)
+		return nil, response.NewForbiddenError("downloads are disabled for this share link", "")
+	}
+
+	plan, err := s.archives.PrepareFolderArchive(ctx, folder, share.IncludeChildren)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.consumeFolderDownload(ctx, share, access); err != nil {
+		return nil, err
+	}
+	s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, domain.ShareAccessDownload, access)
+
+	return plan, nil
+}
+
+// GetAccessLogs returns the access logs of a share link. Only the sharer can view them.
+// 공유 링크 접근 기록 조회 (공유를 생성한 사용자만 가능)
+func (s *ShareService) GetAccessLogs(ctx context.Context, shareID uuid.UUID, entityType domain.ShareType, userID uuid.UUID, page, pageSize int) (*domain.ShareAccessLogListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	var sharedByID uuid.UUID
+	if entityType == domain.ShareTypeFile {
+		share, err := s.shareRepo.FindFileShareByID(ctx, shareID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("share not found", shareID.String())
+			}
+			return nil, err
+		}
+		sharedByID = share.SharedByID
+	} else {
+		share, err := s.shareRepo.FindFolderShareByID(ctx, shareID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewNotFoundError("share not found", shareID.String())
+			}
+			return nil, err
+		}
+		sharedByID = share.SharedByID
+	}
+
+	if sharedByID != userID {
+		return nil, response.NewForbiddenError("not authorized to view access logs of this share", "")
+	}
+
+	logs, total, err := s.shareRepo.FindAccessLogs(ctx, shareID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	return &domain.ShareAccessLogListResponse{
+		Logs:       logs,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// findShareByLink finds the file or folder share for a link token. Exactly one of the results is non-nil.
+func (s *ShareService) findShareByLink(ctx context.Context, shareLink string) (*domain.FileShare, *domain.FolderShare, error) {
+	// 파일 공유 먼저 확인
+	fileShare, err := s.shareRepo.FindFileShareByLink(ctx, shareLink)
+	if err == nil {
+		return fileShare, nil, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
 	// 폴더 공유 확인
 	folderShare, err := s.shareRepo.FindFolderShareByLink(ctx, shareLink)
 	if err == nil {
-		// 만료 확인
-		if folderShare.IsExpired() {
-			return nil, response.NewForbiddenError("share link has expired", "")
+		return nil, folderShare, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+
+	// 토큰 존재 여부를 노출하지 않도록 링크 값은 응답에 포함하지 않음
+	return nil, nil, response.NewNotFoundError("share link not found", "")
+}
+
+// resolveFileLink resolves and authorizes a public link that must point to an available file
+func (s *ShareService) resolveFileLink(ctx context.Context, shareLink string, access domain.ShareAccessInfo) (*domain.FileShare, error) {
+	fileShare, _, err := s.findShareByLink(ctx, shareLink)
+	if err != nil {
+		return nil, err
+	}
+	if fileShare == nil {
+		return nil, response.NewValidationError("share link does not point to a file", "")
+	}
+
+	if err := s.authorizeLink(ctx, fileShare.ID, domain.ShareTypeFile, fileShare.IsExpired(), fileShare.PasswordHash, access); err != nil {
+		return nil, err
+	}
+
+	if fileShare.File == nil || fileShare.File.IsDeleted() || fileShare.File.Status != domain.FileStatusActive {
+		return nil, response.NewNotFoundError("shared file is no longer available", "")
+	}
+
+	return fileShare, nil
+}
+
+// authorizeLink checks expiration and password of a public link, recording failed attempts
+func (s *ShareService) authorizeLink(ctx context.Context, shareID uuid.UUID, shareType domain.ShareType, expired bool, passwordHash *string, access domain.ShareAccessInfo) error {
+	if expired {
+		s.recordAccess(ctx, shareID, shareType, domain.ShareAccessDenied, access)
+		return response.NewForbiddenError("share link has expired", "")
+	}
+
+	if passwordHash == nil || *passwordHash == "" {
+		return nil
+	}
+	if access.Password == "" {
+		return response.NewUnauthorizedError("share link password required", "PASSWORD_REQUIRED")
+	}
+	if !checkSharePassword(passwordHash, access.Password) {
+		s.recordAccess(ctx, shareID, shareType, domain.ShareAccessPasswordFailed, access)
+		return response.NewUnauthorizedError("invalid share link password", "INVALID_PASSWORD")
+	}
+
+	return nil
+}
+
+// shareFileURL generates a short-lived presigned URL for a shared file
+func (s *ShareService) shareFileURL(ctx context.Context, file *domain.File, maxDownloads *int, downloadCount int, inline bool) (*domain.ShareLinkURLResponse, error) {
+	url, err := s.s3Client.GenerateShareURL(ctx, file.FileKey, file.OriginalName, inline, shareLinkURLExpiry)
+	if err != nil {
+		return nil, response.NewInternalError("failed to generate share URL", err.Error())
+	}
+
+	resp := &domain.ShareLinkURLResponse{
+		URL:         url,
+		FileName:    file.OriginalName,
+		ContentType: file.ContentType,
+		FileSize:    file.FileSize,
+		ExpiresAt:   time.Now().Add(shareLinkURLExpiry),
+	}
+	if maxDownloads != nil {
+		remaining := *maxDownloads - downloadCount
+		if remaining < 0 {
+			remaining = 0
 		}
-		return &domain.ShareResponse{
-			ID:              folderShare.ID,
-			EntityType:      domain.ShareTypeFolder,
-			EntityID:        folderShare.FolderID,
-			EntityName:      folderShare.Folder.Name,
-			Permission:      folderShare.Permission,
-			ShareLink:       folderShare.ShareLink,
-			LinkExpiresAt:   folderShare.LinkExpiresAt,
-			IsPublic:        folderShare.IsPublic,
-			IncludeChildren: folderShare.IncludeChildren,
-			CreatedAt:       folderShare.CreatedAt,
-		}, nil
+		resp.RemainingDownloads = &remaining
+	}
+
+	return resp, nil
+}
+
+// resolveFolderLink resolves and authorizes a public link that must point to an available folder
+func (s *ShareService) resolveFolderLink(ctx context.Context, shareLink string, access domain.ShareAccessInfo) (*domain.FolderShare, error) {
+	_, folderShare, err := s.findShareByLink(ctx, shareLink)
+	if err != nil {
+		return nil, err
+	}
+	if folderShare == nil {
+		return nil, response.NewValidationError("share link does not point to a folder", "")
+	}
+
+	if err := s.authorizeLink(ctx, folderShare.ID, domain.ShareTypeFolder, folderShare.IsExpired(), folderShare.PasswordHash, access); err != nil {
+		return nil, err
+	}
+
+	if folderShare.Folder == nil || folderShare.Folder.IsDeleted() {
+		return nil, response.NewNotFoundError("shared folder is no longer available", "")
+	}
+
+	return folderShare, nil
+}
+
+// scopedFolder loads a folder reached through a folder share link.
+// nil selects the shared folder itself; other folders must be descendants and the share must include children.
+// Out-of-scope folders are reported as not found so that nothing outside the share is revealed.
+func (s *ShareService) scopedFolder(ctx context.Context, share *domain.FolderShare, folderID *uuid.UUID) (*domain.Folder, error) {
+	root := share.Folder
+	if folderID == nil || *folderID == root.ID {
+		return root, nil
+	}
+	if !share.IncludeChildren {
+		return nil, response.NewNotFoundError("folder not found in share", "")
+	}
+
+	folder, err := s.folderRepo.FindByID(ctx, *folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("folder not found in share", "")
+		}
+		return nil, err
+	}
+	if !inShareScope(root, folder) {
+		return nil, response.NewNotFoundError("folder not found in share", "")
+	}
+
+	return folder, nil
+}
+
+// consumeFolderDownload checks download permission and atomically consumes one download of a folder share
+func (s *ShareService) consumeFolderDownload(ctx context.Context, share *domain.FolderShare, access domain.ShareAccessInfo) error {
+	if !share.AllowDownload {
+		s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, domain.ShareAccessDenied, access)
+		return response.NewForbiddenError("downloads are disabled for this share link", "")
+	}
+
+	ok, err := s.shareRepo.IncrementFolderShareDownload(ctx, share.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.recordAccess(ctx, share.ID, domain.ShareTypeFolder, domain.ShareAccessDenied, access)
+		return response.NewForbiddenError("share link download limit reached", "")
+	}
+	share.DownloadCount++
+
+	return nil
+}
+
+// inShareScope reports whether folder is the shared root folder or one of its descendants
+func inShareScope(root, folder *domain.Folder) bool {
+	if folder.WorkspaceID != root.WorkspaceID || folder.IsDeleted() {
+		return false
+	}
+	return folder.ID == root.ID || strings.HasPrefix(folder.Path, root.Path+"/")
+}
+
+// sharedPath converts a folder path into a path relative to the shared root folder
+func sharedPath(root *domain.Folder, folderPath string) string {
+	rel := strings.TrimPrefix(folderPath, root.Path)
+	if rel == "" {
+		return "/"
+	}
+	return rel
+}
+
+// toSharedFolderItem converts a folder into its public representation, hiding the parent of the shared root
+func toSharedFolderItem(root, folder *domain.Folder) domain.SharedFolderItem {
+	item := domain.SharedFolderItem{
+		ID:        folder.ID,
+		Name:      folder.Name,
+		Path:      sharedPath(root, folder.Path),
+		UpdatedAt: folder.UpdatedAt,
 	}
+	if folder.ID != root.ID {
+		item.ParentID = folder.ParentID
+	}
+	return item
+}
 
-	return nil, response.NewNotFoundError("share link not found", shareLink)
+// recordAccess stores an access log entry. Failures are logged and never block the request.
+func (s *ShareService) recordAccess(ctx context.Context, shareID uuid.UUID, shareType domain.ShareType, action domain.ShareAccessAction, access domain.ShareAccessInfo) {
+	userAgent := access.UserAgent
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	log := &domain.ShareAccessLog{
+		ID:         uuid.New(),
+		ShareID:    shareID,
+		ShareType:  shareType,
+		Action:     action,
+		IPAddress:  access.IPAddress,
+		UserAgent:  userAgent,
+		AccessedAt: time.Now(),
+	}
+	if err := s.shareRepo.CreateAccessLog(ctx, log); err != nil {
+		s.logger.Warn("Failed to record share access",
+			zap.String("shareId", shareID.String()),
+			zap.String("action", string(action)),
+			zap.Error(err),
+		)
+	}
 }
 
 // UpdateShare updates a share's permission
@@ -397,6 +998,10 @@ func (s *ShareService) UpdateShare(ctx context.Context, shareID uuid.UUID, entit
 			}
 		}
 
+		if err := applyLinkSettings(req, &share.PasswordHash, &share.MaxDownloads, &share.AllowDownload); err != nil {
+			return err
+		}
+
 		return s.shareRepo.UpdateFileShare(ctx, share)
 	}
 
@@ -426,9 +1031,47 @@ func (s *ShareService) UpdateShare(ctx context.Context, shareID uuid.UUID, entit
 		}
 	}
 
+	if err := applyLinkSettings(req, &share.PasswordHash, &share.MaxDownloads, &share.AllowDownload); err != nil {
+		return err
+	}
+
 	return s.shareRepo.UpdateFolderShare(ctx, share)
 }
 
+// applyLinkSettings applies password, download limit and download permission changes.
+// An empty password removes the password and a zero limit removes the download limit.
+func applyLinkSettings(req domain.UpdateShareRequest, passwordHash **string, maxDownloads **int, allowDownload *bool) error {
+	if req.Password != nil {
+		if *req.Password == "" {
+			*passwordHash = nil
+		} else {
+			if len(*req.Password) < 4 {
+				return response.NewValidationError("share password must be at least 4 characters", "")
+			}
+			hash, err := hashSharePassword(*req.Password)
+			if err != nil {
+				return response.NewInternalError("failed to hash share password", err.Error())
+			}
+			*passwordHash = hash
+		}
+	}
+
+	if req.MaxDownloads != nil {
+		if *req.MaxDownloads <= 0 {
+			*maxDownloads = nil
+		} else {
+			limit := *req.MaxDownloads
+			*maxDownloads = &limit
+		}
+	}
+
+	if req.AllowDownload != nil {
+		*allowDownload = *req.AllowDownload
+	}
+
+	return nil
+}
+
 // DeleteShare deletes a share
 // 공유 삭제
 func (s *ShareService) DeleteShare(ctx context.Context, shareID uuid.UUID, entityType domain.ShareType, userID uuid.UUID) error {
@@ -3,13 +3,29 @@
 package service
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"storage-service/internal/client"
 	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+	"storage-service/internal/thumbnail"
 	"testing"
 	"time"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	apperrors "github.com/OrangesCloud/wealist-advanced-go-pkg/errors"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/featureflag"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // ============================================================
@@ -517,3 +533,857 @@ func TestStorageService_Concurrency_UUIDGeneration(t *testing.T) {
 		seen[id] = true
 	}
 }
+
+// ============================================================
+// 멀티파트 업로드 파트 크기 테스트
+// ============================================================
+
+func TestStorageService_Multipart_PartSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		fileSize     int64
+		wantPartSize int64
+		wantCount    int
+	}{
+		{"small file uses single part", 1024, MinPartSize, 1},
+		{"exact multiple of min part size", 3 * MinPartSize, MinPartSize, 3},
+		{"max size stays within part limit", MaxMultipartFileSize, 1024 * 1024, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			partSize, count := calculatePartSize(tt.fileSize)
+			assert.GreaterOrEqual(t, partSize, int64(MinPartSize))
+			assert.LessOrEqual(t, count, MaxPartCount)
+			assert.GreaterOrEqual(t, partSize*int64(count), tt.fileSize)
+			if tt.wantCount > 0 {
+				assert.Equal(t, tt.wantPartSize, partSize)
+				assert.Equal(t, tt.wantCount, count)
+			} else {
+				assert.Zero(t, partSize%tt.wantPartSize, "part size should be rounded to MB")
+			}
+		})
+	}
+}
+
+func TestStorageService_Multipart_UploadedSize(t *testing.T) {
+	// 쿼터가 없으면 저장소 조회 없이 선언 크기와 제한만 검증
+	s := &FileService{}
+	file := &domain.File{ID: uuid.New(), FileSize: 10 * MinPartSize}
+
+	assert.NoError(t, s.checkUploadedSize(context.Background(), file, 10*MinPartSize))
+
+	err := s.checkUploadedSize(context.Background(), file, 1024*MinPartSize)
+	var appErr *response.AppError
+	assert.True(t, errors.As(err, &appErr), "larger parts than declared must be rejected")
+
+	assert.Error(t, s.checkUploadedSize(context.Background(), file, 9*MinPartSize))
+
+	huge := &domain.File{ID: uuid.New(), FileSize: MaxMultipartFileSize + 1}
+	assert.Error(t, s.checkUploadedSize(context.Background(), huge, MaxMultipartFileSize+1))
+}
+
+func TestStorageService_Multipart_WorkspaceQuota(t *testing.T) {
+	tests := []struct {
+		name    string
+		used    int64
+		size    int64
+		quota   int64
+		wantErr bool
+	}{
+		{"no quota", 1 << 40, 1 << 40, 0, false},
+		{"within quota", 600, 400, 1000, false},
+		{"over quota", 600, 401, 1000, true},
+		{"quota already used", 1000, 1, 1000, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkWorkspaceQuota(tt.used, tt.size, tt.quota)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+// ============================================================
+// 일괄 작업 / ZIP 아카이브 테스트
+// ============================================================
+
+func TestStorageService_Bulk_UniqueIDs(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	result := uniqueIDs([]uuid.UUID{a, b, a, b, a})
+
+	assert.Equal(t, []uuid.UUID{a, b}, result)
+}
+
+func TestStorageService_Bulk_FailedResult(t *testing.T) {
+	id := uuid.New()
+
+	notFound := failedResult(id, domain.BulkItemTypeFile, response.NewNotFoundError("file not found", ""))
+	assert.False(t, notFound.Success)
+	assert.Equal(t, "NOT_FOUND", notFound.ErrorCode)
+	assert.Equal(t, "file not found", notFound.Error)
+
+	denied := failedResult(id, domain.BulkItemTypeFolder, response.ErrNotWorkspaceMember)
+	assert.Equal(t, "FORBIDDEN", denied.ErrorCode)
+
+	internal := failedResult(id, domain.BulkItemTypeFile, errors.New("db down"))
+	assert.Equal(t, "INTERNAL_ERROR", internal.ErrorCode)
+	assert.NotContains(t, internal.Error, "db down")
+}
+
+func TestStorageService_Archive_SanitizeName(t *testing.T) {
+	assert.Equal(t, "report.pdf", sanitizeArchiveName("report.pdf"))
+	assert.Equal(t, ".._.._etc_passwd", sanitizeArchiveName("../../etc/passwd"))
+	assert.Equal(t, "_", sanitizeArchiveName(".."))
+	assert.Equal(t, "_", sanitizeArchiveName("  "))
+}
+
+func TestStorageService_Archive_PlanDeduplicatesPaths(t *testing.T) {
+	plan := newArchivePlan("")
+	file := &domain.File{Name: "a.txt", FileKey: "k1", FileSize: 10}
+
+	assert.NoError(t, plan.addFile("docs", file))
+	assert.NoError(t, plan.addFile("docs", file))
+
+	assert.Equal(t, "archive.zip", plan.Name)
+	assert.Equal(t, "docs/a.txt", plan.Entries[0].Path)
+	assert.Equal(t, "docs/a (1).txt", plan.Entries[1].Path)
+	assert.Equal(t, int64(20), plan.TotalSize)
+}
+
+func TestStorageService_Archive_WriteDirectories(t *testing.T) {
+	plan := newArchivePlan("photos.zip")
+	assert.NoError(t, plan.addDir("photos", time.Now()))
+	assert.NoError(t, plan.addDir("photos/2024", time.Now()))
+
+	var buf bytes.Buffer
+	svc := &ArchiveService{}
+	assert.NoError(t, svc.WriteArchive(context.Background(), &buf, plan))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, "photos.zip", plan.Name)
+	assert.Len(t, zr.File, 2)
+	assert.Equal(t, "photos/2024/", zr.File[1].Name)
+}
+
+func TestStorageService_Archive_FolderOnlyTreeEntryLimit(t *testing.T) {
+	// Given: 파일 없이 빈 폴더만 MaxArchiveEntries 개를 넘는 트리
+	db := newStorageTestDB(t)
+	workspaceID := uuid.New()
+	root := createTestFolder(t, db, workspaceID, nil, "empty")
+	now := time.Now()
+	folders := make([]domain.Folder, MaxArchiveEntries)
+	for i := range folders {
+		folders[i] = domain.Folder{
+			ID: uuid.New(), WorkspaceID: workspaceID, ParentID: &root.ID, Name: fmt.Sprintf("dir-%d", i),
+			Path: fmt.Sprintf("%s/dir-%d", root.Path, i), CreatedBy: uuid.New(), CreatedAt: now, UpdatedAt: now,
+		}
+	}
+	assert.NoError(t, db.CreateInBatches(folders, 500).Error)
+	svc := NewArchiveService(repository.NewFileRepository(db), repository.NewFolderRepository(db), nil, nil, nil, zap.NewNop())
+
+	// When: 하위 폴더를 포함해 압축 계획 생성
+	_, err := svc.PrepareFolderArchive(context.Background(), root, true)
+
+	// Then: 폴더 항목도 항목 수 제한에 걸림
+	var appErr *response.AppError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, fmt.Sprintf("archive exceeds maximum of %d entries", MaxArchiveEntries), appErr.Message)
+	}
+
+	// 제한 이하의 폴더 트리는 그대로 계획됨
+	plan, err := svc.PrepareFolderArchive(context.Background(), &folders[0], true)
+	if assert.NoError(t, err) {
+		assert.Len(t, plan.Entries, 1)
+	}
+}
+
+func TestStorageService_Archive_DisabledByFeatureFlag(t *testing.T) {
+	blocked := uuid.New()
+	flags := featureflag.NewStaticClient(&featureflag.Snapshot{
+		Environment: "test",
+		Flags: map[string]featureflag.Flag{
+			FlagZIPDownload: {
+				Key: FlagZIPDownload, Type: featureflag.TypeBool, Enabled: true,
+				DefaultValue: json.RawMessage(`true`),
+				Rules:        []featureflag.Rule{{UserIDs: []string{blocked.String()}, Value: json.RawMessage(`false`)}},
+			},
+		},
+	})
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	defer cleanup()
+	svc := NewArchiveService(repository.NewFileRepository(db), repository.NewFolderRepository(db), nil, nil, flags, zap.NewNop())
+	req := domain.ArchiveRequest{WorkspaceID: uuid.New(), FileIDs: []uuid.UUID{uuid.New()}}
+
+	_, err := svc.PrepareArchive(context.Background(), req, blocked, "")
+	var appErr *response.AppError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "ZIP download is disabled", appErr.Message)
+	}
+
+	// 다른 사용자는 플래그를 통과해 파일 조회까지 진행
+	_, err = svc.PrepareArchive(context.Background(), req, uuid.New(), "")
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &appErr) && appErr.Message == "ZIP download is disabled")
+}
+
+// ============================================================
+// 폴더 복사/이동 테스트
+// ============================================================
+
+func TestStorageService_ConflictPolicy_Default(t *testing.T) {
+	assert.Equal(t, domain.ConflictPolicyRename, domain.ConflictPolicy("").OrDefault())
+	assert.Equal(t, domain.ConflictPolicySkip, domain.ConflictPolicySkip.OrDefault())
+	assert.Equal(t, domain.ConflictPolicyOverwrite, domain.ConflictPolicyOverwrite.OrDefault())
+}
+
+func TestStorageService_FolderMove_SameParent(t *testing.T) {
+	parentID := uuid.New()
+	target := &domain.Folder{ID: parentID}
+
+	assert.True(t, sameParent(nil, nil))
+	assert.True(t, sameParent(&parentID, target))
+	assert.False(t, sameParent(nil, target))
+	assert.False(t, sameParent(&parentID, nil))
+}
+
+func TestStorageService_FolderMove_SameProject(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	assert.True(t, sameProject(nil, nil))
+	assert.True(t, sameProject(&a, &a))
+	assert.False(t, sameProject(&a, &b))
+	assert.False(t, sameProject(&a, nil))
+}
+
+func TestStorageService_FolderMove_ApplyParent(t *testing.T) {
+	svc := &FolderService{}
+	projectID := uuid.New()
+	target := &domain.Folder{ID: uuid.New(), Path: "/docs", ProjectID: &projectID}
+	folder := &domain.Folder{ID: uuid.New(), Name: "reports", Path: "/reports"}
+
+	svc.applyParent(folder, target)
+	assert.Equal(t, "/docs/reports", folder.Path)
+	assert.Equal(t, target.ID, *folder.ParentID)
+	assert.Equal(t, projectID, *folder.ProjectID)
+
+	svc.applyParent(folder, nil)
+	assert.Equal(t, "/reports", folder.Path)
+	assert.Nil(t, folder.ParentID)
+}
+
+// newStorageTestDB creates the folder and file tables in SQLite
+func newStorageTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE storage_folders (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, project_id TEXT, parent_id TEXT, name TEXT NOT NULL,
+			path TEXT NOT NULL, color TEXT, created_by TEXT NOT NULL, created_at DATETIME, updated_at DATETIME,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE storage_files (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, project_id TEXT, folder_id TEXT, name TEXT NOT NULL,
+			original_name TEXT NOT NULL, file_key TEXT NOT NULL UNIQUE, file_size INTEGER NOT NULL,
+			content_type TEXT NOT NULL, status TEXT NOT NULL DEFAULT 'ACTIVE', version INTEGER NOT NULL DEFAULT 1,
+			upload_id TEXT, part_size INTEGER NOT NULL DEFAULT 0, thumbnail_status TEXT,
+			thumbnail_attempts INTEGER NOT NULL DEFAULT 0, thumbnail_error TEXT, thumbnail_updated_at DATETIME,
+			source TEXT NOT NULL DEFAULT '', uploaded_by TEXT NOT NULL, created_at DATETIME, updated_at DATETIME,
+			deleted_at DATETIME
+		)`,
+	} {
+		if err := db.Exec(ddl).Error; err != nil {
+			t.Fatalf("failed to create table: %v", err)
+		}
+	}
+	return db
+}
+
+// createTestFolder creates a folder under parent (nil means root)
+func createTestFolder(t *testing.T, db *gorm.DB, workspaceID uuid.UUID, parent *domain.Folder, name string) *domain.Folder {
+	t.Helper()
+	now := time.Now()
+	folder := &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Name: name, Path: "/" + name, CreatedBy: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	if parent != nil {
+		folder.ParentID = &parent.ID
+		folder.Path = parent.Path + "/" + name
+	}
+	if err := db.Create(folder).Error; err != nil {
+		t.Fatalf("failed to create folder: %v", err)
+	}
+	return folder
+}
+
+func assertFolderActive(t *testing.T, db *gorm.DB, folder *domain.Folder) {
+	t.Helper()
+	var stored domain.Folder
+	if assert.NoError(t, db.First(&stored, "id = ?", folder.ID).Error) {
+		assert.Nil(t, stored.DeletedAt, "folder %s must not be trashed", folder.Path)
+		assert.Equal(t, folder.Path, stored.Path)
+	}
+}
+
+func TestStorageService_FolderMove_OverwriteAncestor(t *testing.T) {
+	// Given: /reports/2024/reports 를 루트로 이동하면 같은 이름의 조상 /reports 와 충돌
+	db := newStorageTestDB(t)
+	workspaceID := uuid.New()
+	ancestor := createTestFolder(t, db, workspaceID, nil, "reports")
+	middle := createTestFolder(t, db, workspaceID, ancestor, "2024")
+	folder := createTestFolder(t, db, workspaceID, middle, "reports")
+	child := createTestFolder(t, db, workspaceID, folder, "q1")
+	svc := NewFolderService(repository.NewFolderRepository(db), repository.NewFileRepository(db), nil, zap.NewNop())
+
+	// When: 덮어쓰기 정책으로 이동
+	_, err := svc.MoveFolder(context.Background(), folder.ID, domain.MoveFolderRequest{ConflictPolicy: domain.ConflictPolicyOverwrite}, uuid.New())
+
+	// Then: 조상을 휴지통으로 보내지 않고 거부
+	var appErr *response.AppError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, apperrors.ErrCodeConflict, appErr.Code)
+	}
+	for _, f := range []*domain.Folder{ancestor, middle, folder, child} {
+		assertFolderActive(t, db, f)
+	}
+}
+
+func TestStorageService_FolderCopy_OverwriteSelf(t *testing.T) {
+	// Given: 같은 부모로 복사하면 원본 폴더 자체와 이름이 충돌
+	db := newStorageTestDB(t)
+	workspaceID := uuid.New()
+	parent := createTestFolder(t, db, workspaceID, nil, "docs")
+	source := createTestFolder(t, db, workspaceID, parent, "specs")
+	child := createTestFolder(t, db, workspaceID, source, "drafts")
+	svc := NewFolderService(repository.NewFolderRepository(db), repository.NewFileRepository(db), nil, zap.NewNop())
+
+	// When: 덮어쓰기 정책으로 복사
+	_, err := svc.CopyFolder(context.Background(), source.ID, domain.CopyFolderRequest{TargetFolderID: &parent.ID, ConflictPolicy: domain.ConflictPolicyOverwrite}, uuid.New())
+
+	// Then: 원본을 휴지통으로 보내지 않고 거부하며 복사본도 만들지 않음
+	var appErr *response.AppError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, apperrors.ErrCodeConflict, appErr.Code)
+	}
+	for _, f := range []*domain.Folder{parent, source, child} {
+		assertFolderActive(t, db, f)
+	}
+	var count int64
+	assert.NoError(t, db.Model(&domain.Folder{}).Count(&count).Error)
+	assert.Equal(t, int64(3), count)
+
+	// 이름 변경 정책은 그대로 복사본을 만듦
+	copied, err := svc.CopyFolder(context.Background(), source.ID, domain.CopyFolderRequest{TargetFolderID: &parent.ID}, uuid.New())
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, "specs", copied.Folder.Name)
+		assert.Equal(t, 2, copied.FoldersCopied)
+	}
+}
+
+// ============================================================
+// 휴지통 자동 비우기 테스트
+// ============================================================
+
+func TestStorageService_PurgeTrash_KeepsFoldersWithTrashedFiles(t *testing.T) {
+	// Given: 만료된 휴지통 폴더 아래 한 번에 비울 수 있는 수보다 많은 휴지통 파일
+	db := newStorageTestDB(t)
+	for _, ddl := range []string{
+		`CREATE TABLE storage_file_shares (id TEXT PRIMARY KEY, file_id TEXT NOT NULL)`,
+		`CREATE TABLE storage_folder_shares (id TEXT PRIMARY KEY, folder_id TEXT NOT NULL)`,
+		`CREATE TABLE storage_item_tags (id TEXT PRIMARY KEY, item_type TEXT NOT NULL, item_id TEXT NOT NULL)`,
+		`CREATE TABLE storage_stars (id TEXT PRIMARY KEY, item_type TEXT NOT NULL, item_id TEXT NOT NULL)`,
+		`CREATE TABLE storage_recent_items (id TEXT PRIMARY KEY, item_type TEXT NOT NULL, item_id TEXT NOT NULL)`,
+	} {
+		assert.NoError(t, db.Exec(ddl).Error)
+	}
+
+	workspaceID := uuid.New()
+	expired := time.Now().AddDate(0, 0, -60)
+	recent := time.Now().AddDate(0, 0, -1)
+	old := createTestFolder(t, db, workspaceID, nil, "old")
+	sub := createTestFolder(t, db, workspaceID, old, "sub")
+	keep := createTestFolder(t, db, workspaceID, nil, "keep")
+	assert.NoError(t, db.Model(&domain.Folder{}).Where("id IN ?", []uuid.UUID{old.ID, sub.ID, keep.ID}).
+		Update("deleted_at", expired).Error)
+
+	trashed := func(folderID uuid.UUID, deletedAt time.Time) domain.File {
+		return domain.File{
+			ID: uuid.New(), WorkspaceID: workspaceID, FolderID: &folderID, Name: "a.txt", OriginalName: "a.txt",
+			FileKey: uuid.NewString(), FileSize: 1, ContentType: "text/plain", Status: domain.FileStatusDeleted,
+			Version: 1, UploadedBy: uuid.New(), CreatedAt: deletedAt, UpdatedAt: deletedAt, DeletedAt: &deletedAt,
+		}
+	}
+	files := make([]domain.File, 0, purgeBatchSize+21)
+	for i := 0; i < purgeBatchSize+20; i++ {
+		files = append(files, trashed(old.ID, expired))
+	}
+	// 아직 보관 기간이 남은 파일
+	files = append(files, trashed(keep.ID, recent))
+	assert.NoError(t, db.CreateInBatches(files, 100).Error)
+
+	svc := NewMaintenanceService(repository.NewFileRepository(db), repository.NewFolderRepository(db),
+		repository.NewShareRepository(db), nil, nil, nil, nil, MaintenanceOptions{}, zap.NewNop())
+	cutoff := time.Now().AddDate(0, 0, -30)
+	folderExists := func(folder *domain.Folder) bool {
+		var count int64
+		assert.NoError(t, db.Model(&domain.Folder{}).Where("id = ?", folder.ID).Count(&count).Error)
+		return count > 0
+	}
+	assertNoOrphanFiles := func() {
+		var orphans int64
+		assert.NoError(t, db.Model(&domain.File{}).
+			Where("folder_id IS NOT NULL AND folder_id NOT IN (SELECT id FROM storage_folders)").Count(&orphans).Error)
+		assert.Equal(t, int64(0), orphans)
+	}
+
+	// When: 첫 실행은 파일 500개만 비움
+	result := &domain.PurgeResult{}
+	svc.purgeWorkspaceTrash(context.Background(), workspaceID, cutoff, result)
+
+	// Then: 빈 하위 폴더만 삭제되고, 휴지통 파일이 남은 폴더는 유지
+	assert.Equal(t, purgeBatchSize, result.FilesPurged)
+	assert.Equal(t, 1, result.FoldersPurged)
+	assert.False(t, folderExists(sub))
+	assert.True(t, folderExists(old))
+	assert.True(t, folderExists(keep))
+	assertNoOrphanFiles()
+
+	// When: 다음 실행에서 남은 파일을 비운 뒤 폴더 삭제
+	result = &domain.PurgeResult{}
+	svc.purgeWorkspaceTrash(context.Background(), workspaceID, cutoff, result)
+
+	assert.Equal(t, 20, result.FilesPurged)
+	assert.Equal(t, 1, result.FoldersPurged)
+	assert.False(t, folderExists(old))
+	assert.True(t, folderExists(keep))
+	assertNoOrphanFiles()
+}
+
+// ============================================================
+// 공유 링크 보호 (비밀번호/다운로드 제한) 테스트
+// ============================================================
+
+func TestStorageService_ShareLink_Password(t *testing.T) {
+	hash, err := hashSharePassword("s3cret")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "s3cret", *hash)
+
+	assert.True(t, checkSharePassword(hash, "s3cret"))
+	assert.False(t, checkSharePassword(hash, "wrong"))
+	assert.True(t, checkSharePassword(nil, ""))
+
+	share := &domain.FileShare{PasswordHash: hash}
+	assert.True(t, share.HasPassword())
+	assert.False(t, (&domain.FileShare{}).HasPassword())
+}
+
+func TestStorageService_ShareLink_DownloadLimit(t *testing.T) {
+	limit := 2
+	share := &domain.FolderShare{MaxDownloads: &limit, DownloadCount: 1}
+	assert.False(t, share.DownloadLimitReached())
+
+	share.DownloadCount = 2
+	assert.True(t, share.DownloadLimitReached())
+
+	assert.False(t, (&domain.FolderShare{DownloadCount: 100}).DownloadLimitReached())
+}
+
+func TestStorageService_ShareLink_ApplyLinkSettings(t *testing.T) {
+	existing := "hash"
+	limit := 5
+	passwordHash := &existing
+	maxDownloads := &limit
+	allowDownload := true
+
+	// 빈 비밀번호와 0 제한은 설정을 제거
+	empty, zero, disallow := "", 0, false
+	err := applyLinkSettings(domain.UpdateShareRequest{Password: &empty, MaxDownloads: &zero, AllowDownload: &disallow},
+		&passwordHash, &maxDownloads, &allowDownload)
+	assert.NoError(t, err)
+	assert.Nil(t, passwordHash)
+	assert.Nil(t, maxDownloads)
+	assert.False(t, allowDownload)
+
+	short := "abc"
+	err = applyLinkSettings(domain.UpdateShareRequest{Password: &short}, &passwordHash, &maxDownloads, &allowDownload)
+	var appErr *response.AppError
+	assert.True(t, errors.As(err, &appErr))
+
+	password, newLimit := "s3cret", 3
+	err = applyLinkSettings(domain.UpdateShareRequest{Password: &password, MaxDownloads: &newLimit}, &passwordHash, &maxDownloads, &allowDownload)
+	assert.NoError(t, err)
+	assert.True(t, checkSharePassword(passwordHash, "s3cret"))
+	assert.Equal(t, 3, *maxDownloads)
+}
+
+// ============================================================
+// 공개 폴더 공유 범위 테스트
+// ============================================================
+
+func TestStorageService_SharedFolder_Scope(t *testing.T) {
+	workspaceID := uuid.New()
+	root := &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/docs"}
+	now := time.Now()
+
+	assert.True(t, inShareScope(root, root))
+	assert.True(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/docs/reports"}))
+	assert.True(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/docs/reports/2024"}))
+
+	// 접두사만 같은 형제 폴더, 다른 워크스페이스, 휴지통 폴더는 범위 밖
+	assert.False(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/docs-private"}))
+	assert.False(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/"}))
+	assert.False(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: uuid.New(), Path: "/docs/reports"}))
+	assert.False(t, inShareScope(root, &domain.Folder{ID: uuid.New(), WorkspaceID: workspaceID, Path: "/docs/old", DeletedAt: &now}))
+}
+
+func TestStorageService_SharedFolder_RelativePath(t *testing.T) {
+	parentID := uuid.New()
+	root := &domain.Folder{ID: uuid.New(), ParentID: &parentID, Name: "docs", Path: "/team/docs"}
+	child := &domain.Folder{ID: uuid.New(), ParentID: &root.ID, Name: "reports", Path: "/team/docs/reports"}
+
+	assert.Equal(t, "/", sharedPath(root, root.Path))
+	assert.Equal(t, "/reports", sharedPath(root, child.Path))
+
+	// 공유 루트의 상위 폴더 ID는 노출하지 않음
+	rootItem := toSharedFolderItem(root, root)
+	assert.Nil(t, rootItem.ParentID)
+	assert.Equal(t, "/", rootItem.Path)
+
+	childItem := toSharedFolderItem(root, child)
+	assert.Equal(t, root.ID, *childItem.ParentID)
+	assert.Equal(t, "/reports", childItem.Path)
+}
+
+// ============================================================
+// 썸네일 테스트
+// ============================================================
+
+func TestStorageService_Thumbnail_ResultStatus(t *testing.T) {
+	assert.Equal(t, domain.ThumbnailStatusReady, thumbnailResultStatus(nil, 1))
+
+	// 디코딩 불가/크기 초과는 재시도하지 않음
+	assert.Equal(t, domain.ThumbnailStatusUnsupported, thumbnailResultStatus(thumbnail.ErrUnsupported, 1))
+	assert.Equal(t, domain.ThumbnailStatusUnsupported, thumbnailResultStatus(thumbnail.ErrTooLarge, 1))
+
+	// 일시적 오류는 최대 시도 횟수까지 재시도
+	assert.Equal(t, domain.ThumbnailStatusPending, thumbnailResultStatus(errors.New("s3 timeout"), 1))
+	assert.Equal(t, domain.ThumbnailStatusFailed, thumbnailResultStatus(errors.New("s3 timeout"), domain.MaxThumbnailAttempts))
+}
+
+func TestStorageService_Thumbnail_InitialStatus(t *testing.T) {
+	image := &domain.File{Name: "photo.JPG", ContentType: "image/jpeg"}
+	assert.Equal(t, domain.ThumbnailStatusPending, image.InitialThumbnailStatus())
+
+	webp := &domain.File{Name: "photo.webp", ContentType: "image/webp"}
+	assert.Equal(t, domain.ThumbnailStatusNone, webp.InitialThumbnailStatus())
+
+	doc := &domain.File{Name: "report.pdf", ContentType: "application/pdf"}
+	assert.Equal(t, domain.ThumbnailStatusNone, doc.InitialThumbnailStatus())
+}
+
+func TestStorageService_Thumbnail_ResponseURLs(t *testing.T) {
+	file := &domain.File{
+		ID:              uuid.New(),
+		Name:            "photo.png",
+		FileKey:         "storage/ws/2024/01/abc.png",
+		ThumbnailStatus: domain.ThumbnailStatusReady,
+	}
+
+	resp := file.ToResponse("https://cdn.example.com/storage/ws/2024/01/abc.png")
+	assert.Equal(t, "https://cdn.example.com/thumbnails/small/storage/ws/2024/01/abc.png.jpg", *resp.ThumbnailURL)
+	assert.Equal(t, "https://cdn.example.com/thumbnails/large/storage/ws/2024/01/abc.png.jpg", *resp.PreviewURL)
+
+	// 준비되지 않은 썸네일은 URL을 노출하지 않음
+	file.ThumbnailStatus = domain.ThumbnailStatusPending
+	resp = file.ToResponse("https://cdn.example.com/storage/ws/2024/01/abc.png")
+	assert.Nil(t, resp.ThumbnailURL)
+	assert.Nil(t, resp.PreviewURL)
+}
+
+// ============================================================
+// 태그 및 검색 필터 테스트
+// ============================================================
+
+func TestStorageService_Tag_NormalizeName(t *testing.T) {
+	name, err := normalizeTagName("  Design   Review ")
+	assert.NoError(t, err)
+	assert.Equal(t, "design review", name)
+
+	_, err = normalizeTagName("   ")
+	assert.Error(t, err)
+	var appErr *response.AppError
+	assert.True(t, errors.As(err, &appErr))
+}
+
+func TestStorageService_Search_ValidateFilter(t *testing.T) {
+	valid := domain.FileSearchFilter{Sort: domain.FileSearchSortName, TypeFamily: domain.FileTypeDocument}
+	assert.NoError(t, validateSearchFilter(valid))
+
+	minSize, maxSize := int64(100), int64(10)
+	assert.Error(t, validateSearchFilter(domain.FileSearchFilter{Sort: domain.FileSearchSortSize, MinSize: &minSize, MaxSize: &maxSize}))
+
+	after := time.Now()
+	before := after.Add(-time.Hour)
+	assert.Error(t, validateSearchFilter(domain.FileSearchFilter{Sort: domain.FileSearchSortCreatedAt, CreatedAfter: &after, CreatedBefore: &before}))
+
+	assert.Error(t, validateSearchFilter(domain.FileSearchFilter{Sort: "fileKey"}))
+	assert.Error(t, validateSearchFilter(domain.FileSearchFilter{Sort: domain.FileSearchSortName, TypeFamily: "spreadsheet"}))
+}
+
+func TestStorageService_Search_TypeFamilies(t *testing.T) {
+	image, ok := domain.FileTypeImage.ContentTypes()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"image/"}, image.Prefixes)
+
+	document, ok := domain.FileTypeDocument.ContentTypes()
+	assert.True(t, ok)
+	assert.Contains(t, document.Types, "application/pdf")
+
+	_, ok = domain.FileTypeFamily("").ContentTypes()
+	assert.False(t, ok)
+}
+
+// ============================================================
+// 외부 객체 연결 테스트
+// ============================================================
+
+func TestStorageService_Link_ValidateRequest(t *testing.T) {
+	req := domain.LinkObjectRequest{
+		Source:     domain.FileSourceBoard,
+		FileKey:    "board/board/ws/2025/01/a_1.png",
+		FileSize:   1024,
+		FolderPath: []string{"Board attachments", "Roadmap"},
+	}
+	assert.NoError(t, validateLinkRequest(req))
+
+	// storage 자체 객체나 다른 서비스 prefix는 연결 불가
+	storageKey := req
+	storageKey.FileKey = "storage/ws/a.png"
+	assert.Error(t, validateLinkRequest(storageKey))
+
+	traversal := req
+	traversal.FileKey = "board/../storage/ws/a.png"
+	assert.Error(t, validateLinkRequest(traversal))
+
+	unknown := req
+	unknown.Source = domain.FileSourceStorage
+	assert.Error(t, validateLinkRequest(unknown))
+
+	badFolder := req
+	badFolder.FolderPath = []string{"a/b"}
+	assert.Error(t, validateLinkRequest(badFolder))
+
+	// 소스 프로젝트로 연결하면 폴더는 해당 프로젝트 안에서만 지정 가능
+	sourceProjectID := uuid.New()
+	sourceProject := req
+	sourceProject.SourceProjectID = &sourceProjectID
+	assert.NoError(t, validateLinkRequest(sourceProject))
+
+	folderID := uuid.New()
+	sourceProjectFolder := sourceProject
+	sourceProjectFolder.FolderID = &folderID
+	assert.Error(t, validateLinkRequest(sourceProjectFolder))
+}
+
+func TestStorageService_Link_IsLinked(t *testing.T) {
+	assert.False(t, (&domain.File{}).IsLinked())
+	assert.True(t, (&domain.File{Source: domain.FileSourceChat}).IsLinked())
+	assert.False(t, domain.FileSourceBoard.OwnsKey("board/"))
+	assert.True(t, domain.FileSourceChat.OwnsKey("chat/ws/room/a.png"))
+}
+
+// ============================================================
+// 게스트 접근 테스트
+// ============================================================
+
+// guestTestProjectRepo는 게스트 권한 계산에 필요한 메서드만 구현합니다.
+type guestTestProjectRepo struct {
+	repository.ProjectRepository
+	member *domain.ProjectMember
+}
+
+func (r *guestTestProjectRepo) GetMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	if r.member == nil {
+		return nil, repository.ErrProjectMemberNotFound
+	}
+	return r.member, nil
+}
+
+func (r *guestTestProjectRepo) GetUserPermission(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectPermission, error) {
+	perm := domain.ProjectPermissionEditor
+	return &perm, nil
+}
+
+// guestTestUserClient는 고정된 워크스페이스 접근 결과를 반환합니다.
+type guestTestUserClient struct {
+	client.UserClient
+	access *commonclient.WorkspaceValidationResponse
+}
+
+func (c *guestTestUserClient) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID, token string) (*commonclient.WorkspaceValidationResponse, error) {
+	return c.access, nil
+}
+
+func TestStorageService_GuestAccess_ResolveProjectPermission(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	shared := &domain.Project{ID: uuid.New(), WorkspaceID: uuid.New(), IsPublic: true, DefaultPermission: domain.ProjectPermissionEditor}
+	other := &domain.Project{ID: uuid.New(), WorkspaceID: shared.WorkspaceID, IsPublic: true}
+	guest := &guestTestUserClient{access: &commonclient.WorkspaceValidationResponse{
+		IsGuest:                true,
+		GuestStorageProjectIDs: []uuid.UUID{shared.ID},
+	}}
+
+	// 공유받은 프로젝트는 공개 기본 권한과 무관하게 뷰어로 접근
+	perm, err := resolveProjectPermission(ctx, &guestTestProjectRepo{}, guest, logger, shared, uuid.New(), "token")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ProjectPermissionViewer, *perm)
+
+	// 직접 멤버로 추가된 게스트는 멤버 권한 사용
+	member := &domain.ProjectMember{Permission: domain.ProjectPermissionEditor}
+	perm, err = resolveProjectPermission(ctx, &guestTestProjectRepo{member: member}, guest, logger, shared, uuid.New(), "token")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ProjectPermissionEditor, *perm)
+
+	// 공유받지 않은 프로젝트는 공개 프로젝트라도 거부
+	_, err = resolveProjectPermission(ctx, &guestTestProjectRepo{}, guest, logger, other, uuid.New(), "token")
+	assert.True(t, errors.Is(err, response.ErrNotWorkspaceMember))
+
+	// 워크스페이스 멤버는 기존 권한 계산을 그대로 사용
+	memberClient := &guestTestUserClient{access: &commonclient.WorkspaceValidationResponse{IsMember: true}}
+	perm, err = resolveProjectPermission(ctx, &guestTestProjectRepo{}, memberClient, logger, other, uuid.New(), "token")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ProjectPermissionEditor, *perm)
+}
+
+// ============================================================
+// 워크스페이스 권한(커스텀 역할) 테스트
+// ============================================================
+
+// workspaceTestChecker는 고정된 워크스페이스 권한을 반환합니다.
+type workspaceTestChecker struct {
+	perms permission.Set
+	err   error
+}
+
+func (c workspaceTestChecker) Can(ctx context.Context, workspaceID, userID uuid.UUID, p permission.Permission) (bool, error) {
+	return c.perms.Has(p), c.err
+}
+
+func TestStorageService_AuthorizeProjectPermission(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	project := &domain.Project{ID: uuid.New(), WorkspaceID: uuid.New()}
+	memberClient := &guestTestUserClient{access: &commonclient.WorkspaceValidationResponse{IsMember: true}}
+	guestClient := &guestTestUserClient{access: &commonclient.WorkspaceValidationResponse{
+		IsGuest:                true,
+		GuestStorageProjectIDs: []uuid.UUID{project.ID},
+	}}
+	readOnly := permission.NewResolver(workspaceTestChecker{perms: permission.NewSet(permission.StorageRead)}, logger)
+	unavailable := permission.NewResolver(workspaceTestChecker{err: errors.New("unavailable")}, logger)
+
+	tests := []struct {
+		name        string
+		userClient  client.UserClient
+		member      *domain.ProjectMember
+		permissions *permission.Resolver
+		required    domain.ProjectPermission
+		want        bool
+	}{
+		{"project editor without resolver", memberClient, nil, nil, domain.ProjectPermissionEditor, true},
+		{"custom role limits a project editor", memberClient, nil, readOnly, domain.ProjectPermissionEditor, false},
+		{"custom role keeps read access", memberClient, nil, readOnly, domain.ProjectPermissionViewer, true},
+		{"user-service down keeps project permission", memberClient, nil, unavailable, domain.ProjectPermissionEditor, true},
+		{"workspace role never grants beyond project permission", memberClient, nil, readOnly, domain.ProjectPermissionOwner, false},
+		{"guest editors are bound by project permission only", guestClient, &domain.ProjectMember{Permission: domain.ProjectPermissionEditor}, readOnly, domain.ProjectPermissionEditor, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, allowed, err := authorizeProjectPermission(ctx, &guestTestProjectRepo{member: tt.member}, tt.userClient, tt.permissions, logger, project, uuid.New(), "token", tt.required)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, allowed)
+		})
+	}
+}
+
+// linkTestProjectRepo는 소스 프로젝트 조회/생성과 멤버 추가를 기록합니다.
+type linkTestProjectRepo struct {
+	repository.ProjectRepository
+	existing *domain.Project
+	members  map[uuid.UUID]bool
+	added    []*domain.ProjectMember
+}
+
+func (r *linkTestProjectRepo) FindOrCreateBySource(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	if r.existing == nil {
+		r.existing = project
+	}
+	return r.existing, nil
+}
+
+func (r *linkTestProjectRepo) GetMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	if r.members[userID] {
+		return &domain.ProjectMember{ProjectID: projectID, UserID: userID}, nil
+	}
+	return nil, repository.ErrProjectMemberNotFound
+}
+
+func (r *linkTestProjectRepo) AddMember(ctx context.Context, member *domain.ProjectMember) error {
+	r.added = append(r.added, member)
+	return nil
+}
+
+func TestStorageService_Link_EnsureSourceProject(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	sourceProjectID := uuid.New()
+	creator := uuid.New()
+	req := domain.LinkObjectRequest{
+		WorkspaceID:       workspaceID,
+		SourceProjectID:   &sourceProjectID,
+		SourceProjectName: "Roadmap",
+		Source:            domain.FileSourceBoard,
+		UploadedBy:        creator,
+	}
+
+	// 첫 연결은 비공개 프로젝트를 만들고 업로더를 소유자로 둠
+	repo := &linkTestProjectRepo{}
+	s := &LinkService{projectRepo: repo, logger: zap.NewNop()}
+	projectID, err := s.ensureSourceProject(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, repo.existing.ID, *projectID)
+	assert.False(t, repo.existing.IsPublic)
+	assert.Equal(t, "Roadmap", repo.existing.Name)
+	assert.Equal(t, &sourceProjectID, repo.existing.SourceProjectID)
+	assert.Empty(t, repo.added)
+
+	// 다른 업로더는 뷰어로 추가되고, 이미 멤버면 다시 추가하지 않음
+	other := req
+	other.UploadedBy = uuid.New()
+	_, err = s.ensureSourceProject(ctx, other)
+	assert.NoError(t, err)
+	if assert.Len(t, repo.added, 1) {
+		assert.Equal(t, other.UploadedBy, repo.added[0].UserID)
+		assert.Equal(t, domain.ProjectPermissionViewer, repo.added[0].Permission)
+	}
+	repo.members = map[uuid.UUID]bool{other.UploadedBy: true}
+	_, err = s.ensureSourceProject(ctx, other)
+	assert.NoError(t, err)
+	assert.Len(t, repo.added, 1)
+
+	// 다른 워크스페이스의 프로젝트나 삭제된 프로젝트에는 연결하지 않음
+	foreign := req
+	foreign.WorkspaceID = uuid.New()
+	_, err = s.ensureSourceProject(ctx, foreign)
+	assert.Error(t, err)
+
+	deletedAt := time.Now()
+	repo.existing.DeletedAt = &deletedAt
+	_, err = s.ensureSourceProject(ctx, req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// TagService handles workspace tags and their assignment to files and folders
+// 태그는 워크스페이스 단위로 공유되며, 이름은 소문자로 정규화하여 중복을 방지합니다.
+type TagService struct {
+	tagRepo    *repository.TagRepository
+	fileRepo   *repository.FileRepository
+	folderRepo *repository.FolderRepository
+	logger     *zap.Logger
+}
+
+// NewTagService creates a new TagService
+func NewTagService(
+	tagRepo *repository.TagRepository,
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	logger *zap.Logger,
+) *TagService {
+	return &TagService{
+		tagRepo:    tagRepo,
+		fileRepo:   fileRepo,
+		folderRepo: folderRepo,
+		logger:     logger,
+	}
+}
+
+// CreateTag creates a new tag in a workspace
+func (s *TagService) CreateTag(ctx context.Context, req domain.CreateTagRequest, userID uuid.UUID) (*domain.Tag, error) {
+	name, err := normalizeTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.tagRepo.CountByWorkspaceID(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tags: %w", err)
+	}
+	if count >= domain.MaxTagsPerWorkspace {
+		return nil, response.NewValidationError(fmt.Sprintf("workspace cannot have more than %d tags", domain.MaxTagsPerWorkspace), "")
+	}
+
+	exists, err := s.tagRepo.ExistsByName(ctx, req.WorkspaceID, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check tag name: %w", err)
+	}
+	if exists {
+		return nil, response.NewAlreadyExistsError("tag already exists", name)
+	}
+
+	tag := &domain.Tag{
+		WorkspaceID: req.WorkspaceID,
+		Name:        name,
+		Color:       req.Color,
+		CreatedBy:   userID,
+	}
+	if err := s.tagRepo.Create(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	s.logger.Info("Tag created",
+		zap.String("tagId", tag.ID.String()),
+		zap.String("workspaceId", tag.WorkspaceID.String()),
+		zap.String("name", tag.Name),
+	)
+
+	return tag, nil
+}
+
+// GetTag gets a tag by ID
+func (s *TagService) GetTag(ctx context.Context, tagID uuid.UUID) (*domain.Tag, error) {
+	tag, err := s.tagRepo.FindByID(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("tag not found", tagID.String())
+		}
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return tag, nil
+}
+
+// GetWorkspaceTags lists the tags of a workspace with their item counts
+func (s *TagService) GetWorkspaceTags(ctx context.Context, workspaceID uuid.UUID) ([]domain.TagResponse, error) {
+	tags, err := s.tagRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	counts, err := s.tagRepo.CountItems(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tagged items: %w", err)
+	}
+	countByTag := make(map[uuid.UUID]int64, len(counts))
+	for _, c := range counts {
+		countByTag[c.TagID] = c.Count
+	}
+
+	responses := make([]domain.TagResponse, 0, len(tags))
+	for i := range tags {
+		resp := tags[i].ToResponse()
+		resp.ItemCount = countByTag[tags[i].ID]
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// UpdateTag renames or recolors a tag
+func (s *TagService) UpdateTag(ctx context.Context, tagID uuid.UUID, req domain.UpdateTagRequest) (*domain.Tag, error) {
+	tag, err := s.GetTag(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name, err := normalizeTagName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if name != tag.Name {
+			exists, err := s.tagRepo.ExistsByName(ctx, tag.WorkspaceID, name, &tag.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check tag name: %w", err)
+			}
+			if exists {
+				return nil, response.NewAlreadyExistsError("tag already exists", name)
+			}
+			tag.Name = name
+		}
+	}
+	if req.Color != nil {
+		// 빈 문자열은 색상 제거
+		if *req.Color == "" {
+			tag.Color = nil
+		} else {
+			tag.Color = req.Color
+		}
+	}
+
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return tag, nil
+}
+
+// DeleteTag deletes a tag and removes it from all files and folders
+func (s *TagService) DeleteTag(ctx context.Context, tagID uuid.UUID) error {
+	tag, err := s.GetTag(ctx, tagID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tagRepo.Delete(ctx, tag.ID); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	s.logger.Info("Tag deleted",
+		zap.String("tagId", tag.ID.String()),
+		zap.String("workspaceId", tag.WorkspaceID.String()),
+	)
+	return nil
+}
+
+// SetFileTags replaces the tags of a file
+func (s *TagService) SetFileTags(ctx context.Context, fileID uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) ([]domain.TagResponse, error) {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("file not found", fileID.String())
+		}
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	return s.setItemTags(ctx, file.WorkspaceID, domain.ItemTypeFile, file.ID, tagIDs, userID)
+}
+
+// SetFolderTags replaces the tags of a folder
+func (s *TagService) SetFolderTags(ctx context.Context, folderID uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) ([]domain.TagResponse, error) {
+	folder, err := s.folderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("folder not found", folderID.String())
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	return s.setItemTags(ctx, folder.WorkspaceID, domain.ItemTypeFolder, folder.ID, tagIDs, userID)
+}
+
+// setItemTags validates that all tags belong to the item's workspace and replaces the item's tags
+func (s *TagService) setItemTags(ctx context.Context, workspaceID uuid.UUID, itemType domain.ItemType, itemID uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) ([]domain.TagResponse, error) {
+	tagIDs = uniqueIDs(tagIDs)
+	if len(tagIDs) > domain.MaxTagsPerItem {
+		return nil, response.NewValidationError(fmt.Sprintf("an item cannot have more than %d tags", domain.MaxTagsPerItem), "")
+	}
+
+	tags, err := s.tagRepo.FindByIDs(ctx, workspaceID, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	if len(tags) != len(tagIDs) {
+		return nil, response.NewValidationError("one or more tags do not exist in this workspace", "")
+	}
+
+	if err := s.tagRepo.SetItemTags(ctx, workspaceID, itemType, itemID, tagIDs, userID); err != nil {
+		return nil, fmt.Errorf("failed to set tags: %w", err)
+	}
+
+	responses := make([]domain.TagResponse, 0, len(tags))
+	for i := range tags {
+		responses = append(responses, tags[i].ToResponse())
+	}
+	return responses, nil
+}
+
+// AttachFileTags fills the Tags field of file responses
+func (s *TagService) AttachFileTags(ctx context.Context, files []domain.FileResponse) error {
+	ids := make([]uuid.UUID, len(files))
+	for i := range files {
+		ids[i] = files[i].ID
+	}
+	tagsByItem, err := s.itemTags(ctx, domain.ItemTypeFile, ids)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		files[i].Tags = tagsByItem[files[i].ID]
+	}
+	return nil
+}
+
+// AttachFolderTags fills the Tags field of folder responses
+func (s *TagService) AttachFolderTags(ctx context.Context, folders []domain.FolderResponse) error {
+	ids := make([]uuid.UUID, len(folders))
+	for i := range folders {
+		ids[i] = folders[i].ID
+	}
+	tagsByItem, err := s.itemTags(ctx, domain.ItemTypeFolder, ids)
+	if err != nil {
+		return err
+	}
+	for i := range folders {
+		folders[i].Tags = tagsByItem[folders[i].ID]
+	}
+	return nil
+}
+
+// itemTags loads the tags of the given items grouped by item ID
+func (s *TagService) itemTags(ctx context.Context, itemType domain.ItemType, itemIDs []uuid.UUID) (map[uuid.UUID][]domain.TagResponse, error) {
+	rows, err := s.tagRepo.FindItemTags(ctx, itemType, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load item tags: %w", err)
+	}
+	result := make(map[uuid.UUID][]domain.TagResponse, len(itemIDs))
+	for i := range rows {
+		result[rows[i].ItemID] = append(result[rows[i].ItemID], rows[i].Tag.ToResponse())
+	}
+	return result, nil
+}
+
+// normalizeTagName trims and lowercases a tag name
+func normalizeTagName(name string) (string, error) {
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if name == "" {
+		return "", response.NewValidationError("tag name cannot be empty", "")
+	}
+	if len([]rune(name)) > 50 {
+		return "", response.NewValidationError("tag name cannot exceed 50 characters", "")
+	}
+	return name, nil
+}
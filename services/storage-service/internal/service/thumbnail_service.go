@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storage-service/internal/client"
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/thumbnail"
+)
+
+// Thumbnail job timing
+const (
+	thumbnailRetryDelay  = time.Minute      // wait before retrying a failed attempt
+	thumbnailStaleAfter  = 10 * time.Minute // PROCESSING longer than this is treated as a crashed worker
+	thumbnailFileTimeout = 2 * time.Minute
+)
+
+// ThumbnailService generates image thumbnails asynchronously
+// 파일 레코드의 thumbnail_status를 작업 큐로 사용합니다.
+// 업로드 확정 시 PENDING으로 표시되고, 워커가 주기적으로 가져가 처리합니다.
+type ThumbnailService struct {
+	fileRepo    *repository.FileRepository
+	s3Client    *client.S3Client
+	concurrency int
+	logger      *zap.Logger
+}
+
+// NewThumbnailService creates a new ThumbnailService
+func NewThumbnailService(fileRepo *repository.FileRepository, s3Client *client.S3Client, concurrency int, logger *zap.Logger) *ThumbnailService {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &ThumbnailService{
+		fileRepo:    fileRepo,
+		s3Client:    s3Client,
+		concurrency: concurrency,
+		logger:      logger,
+	}
+}
+
+// ProcessPending claims up to limit pending files and generates their thumbnails.
+// Returns the number of files claimed.
+func (s *ThumbnailService) ProcessPending(ctx context.Context, limit int) (int, error) {
+	if s.s3Client == nil {
+		return 0, errors.New("s3 client not configured")
+	}
+
+	now := time.Now()
+	files, err := s.fileRepo.ClaimThumbnailJobs(ctx, limit, now.Add(-thumbnailRetryDelay), now.Add(-thumbnailStaleAfter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim thumbnail jobs: %w", err)
+	}
+
+	// 이미지 디코딩은 메모리를 많이 사용하므로 동시 처리 수 제한
+	sem := make(chan struct{}, s.concurrency)
+	var wg sync.WaitGroup
+	for i := range files {
+		file := &files[i]
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.process(ctx, file)
+		}()
+	}
+	wg.Wait()
+
+	return len(files), nil
+}
+
+// Backfill queues thumbnails for existing image files that have none
+func (s *ThumbnailService) Backfill(ctx context.Context, req domain.ThumbnailBackfillRequest) (*domain.ThumbnailBackfillResult, error) {
+	queued, err := s.fileRepo.QueueThumbnailBackfill(ctx, req.WorkspaceID, domain.ThumbnailExtensions, req.RetryFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue thumbnail backfill: %w", err)
+	}
+
+	s.logger.Info("Thumbnail backfill queued",
+		zap.Int64("queued", queued),
+		zap.Bool("retryFailed", req.RetryFailed),
+	)
+
+	return &domain.ThumbnailBackfillResult{Queued: queued}, nil
+}
+
+// process generates thumbnails for a single claimed file and records the outcome
+func (s *ThumbnailService) process(ctx context.Context, file *domain.File) {
+	ctx, cancel := context.WithTimeout(ctx, thumbnailFileTimeout)
+	defer cancel()
+
+	err := s.generate(ctx, file)
+	status := thumbnailResultStatus(err, file.ThumbnailAttempts)
+
+	var errMsg *string
+	if err != nil {
+		msg := err.Error()
+		if len(msg) > 512 {
+			msg = msg[:512]
+		}
+		errMsg = &msg
+
+		s.logger.Warn("Thumbnail generation failed",
+			zap.String("fileId", file.ID.String()),
+			zap.Int("attempt", file.ThumbnailAttempts),
+			zap.String("status", string(status)),
+			zap.Error(err),
+		)
+	}
+
+	// 워커 종료 중에도 결과는 기록
+	if err := s.fileRepo.UpdateThumbnailStatus(context.WithoutCancel(ctx), file.ID, status, errMsg); err != nil {
+		s.logger.Error("Failed to update thumbnail status",
+			zap.String("fileId", file.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// generate downloads the original, renders every thumbnail size and stores them in S3
+func (s *ThumbnailService) generate(ctx context.Context, file *domain.File) error {
+	if !file.SupportsThumbnail() {
+		return thumbnail.ErrUnsupported
+	}
+	if file.FileSize > thumbnail.MaxSourceBytes {
+		return thumbnail.ErrTooLarge
+	}
+
+	body, err := s.s3Client.GetObject(ctx, file.FileKey)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	maxDims := make([]int, len(domain.ThumbnailSizes))
+	for i, size := range domain.ThumbnailSizes {
+		maxDims[i] = size.MaxDimension
+	}
+
+	thumbs, err := thumbnail.Generate(body, maxDims)
+	if err != nil {
+		return err
+	}
+
+	for i, size := range domain.ThumbnailSizes {
+		if err := s.s3Client.PutObject(ctx, domain.ThumbnailKey(file.FileKey, size), "image/jpeg", thumbs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// thumbnailResultStatus maps a generation error to the status to store.
+// Unsupported or oversized images are never retried; other errors are retried
+// until MaxThumbnailAttempts is reached.
+func thumbnailResultStatus(err error, attempts int) domain.ThumbnailStatus {
+	switch {
+	case err == nil:
+		return domain.ThumbnailStatusReady
+	case errors.Is(err, thumbnail.ErrUnsupported), errors.Is(err, thumbnail.ErrTooLarge):
+		return domain.ThumbnailStatusUnsupported
+	case attempts >= domain.MaxThumbnailAttempts:
+		return domain.ThumbnailStatusFailed
+	default:
+		return domain.ThumbnailStatusPending
+	}
+}
+
+// deleteThumbnails removes generated thumbnails of a file. Failures are only logged.
+func deleteThumbnails(ctx context.Context, s3Client *client.S3Client, logger *zap.Logger, file *domain.File) {
+	if s3Client == nil || file.ThumbnailStatus == domain.ThumbnailStatusNone || file.ThumbnailStatus == domain.ThumbnailStatusUnsupported {
+		return
+	}
+	for _, size := range domain.ThumbnailSizes {
+		if err := s3Client.DeleteFile(ctx, domain.ThumbnailKey(file.FileKey, size)); err != nil {
+			logger.Warn("Failed to delete thumbnail",
+				zap.String("fileId", file.ID.String()),
+				zap.String("size", size.Name),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"storage-service/internal/domain"
+	"storage-service/internal/repository"
+	"storage-service/internal/response"
+)
+
+// UserItemService handles per-user starred items and recently accessed lists
+type UserItemService struct {
+	userItemRepo *repository.UserItemRepository
+	fileRepo     *repository.FileRepository
+	folderRepo   *repository.FolderRepository
+	fileService  *FileService
+	logger       *zap.Logger
+}
+
+// NewUserItemService creates a new UserItemService
+func NewUserItemService(
+	userItemRepo *repository.UserItemRepository,
+	fileRepo *repository.FileRepository,
+	folderRepo *repository.FolderRepository,
+	fileService *FileService,
+	logger *zap.Logger,
+) *UserItemService {
+	return &UserItemService{
+		userItemRepo: userItemRepo,
+		fileRepo:     fileRepo,
+		folderRepo:   folderRepo,
+		fileService:  fileService,
+		logger:       logger,
+	}
+}
+
+// StarFile stars a file for the user
+func (s *UserItemService) StarFile(ctx context.Context, fileID, userID uuid.UUID) error {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("file not found", fileID.String())
+		}
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+	return s.star(ctx, userID, domain.ItemTypeFile, file.ID, file.WorkspaceID)
+}
+
+// StarFolder stars a folder for the user
+func (s *UserItemService) StarFolder(ctx context.Context, folderID, userID uuid.UUID) error {
+	folder, err := s.folderRepo.FindByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("folder not found", folderID.String())
+		}
+		return fmt.Errorf("failed to get folder: %w", err)
+	}
+	return s.star(ctx, userID, domain.ItemTypeFolder, folder.ID, folder.WorkspaceID)
+}
+
+// star stores a star; starring twice is idempotent
+func (s *UserItemService) star(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemID, workspaceID uuid.UUID) error {
+	err := s.userItemRepo.Star(ctx, &domain.Star{
+		UserID:      userID,
+		ItemType:    itemType,
+		ItemID:      itemID,
+		WorkspaceID: workspaceID,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to star item: %w", err)
+	}
+	return nil
+}
+
+// Unstar removes the user's star from an item; unstarring an item that is not starred is a no-op
+func (s *UserItemService) Unstar(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemID uuid.UUID) error {
+	if err := s.userItemRepo.Unstar(ctx, userID, itemType, itemID); err != nil {
+		return fmt.Errorf("failed to unstar item: %w", err)
+	}
+	return nil
+}
+
+// GetStarred lists the files and folders the user starred in a workspace
+func (s *UserItemService) GetStarred(ctx context.Context, userID, workspaceID uuid.UUID) (*domain.StarredItemsResponse, error) {
+	folders, err := s.userItemRepo.FindStarredFolders(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get starred folders: %w", err)
+	}
+	files, err := s.userItemRepo.FindStarredFiles(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get starred files: %w", err)
+	}
+
+	result := &domain.StarredItemsResponse{
+		Folders: make([]domain.FolderResponse, 0, len(folders)),
+		Files:   make([]domain.FileResponse, 0, len(files)),
+	}
+	for i := range folders {
+		resp := folders[i].ToResponse()
+		resp.IsStarred = true
+		result.Folders = append(result.Folders, resp)
+	}
+	for i := range files {
+		resp := files[i].ToResponse(s.fileService.GetFileURL(files[i].FileKey))
+		resp.IsStarred = true
+		result.Files = append(result.Files, resp)
+	}
+	return result, nil
+}
+
+// MarkStarredFiles sets IsStarred on the file responses the user starred
+func (s *UserItemService) MarkStarredFiles(ctx context.Context, userID uuid.UUID, files []domain.FileResponse) error {
+	ids := make([]uuid.UUID, len(files))
+	for i := range files {
+		ids[i] = files[i].ID
+	}
+	starred, err := s.starredSet(ctx, userID, domain.ItemTypeFile, ids)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		files[i].IsStarred = starred[files[i].ID]
+	}
+	return nil
+}
+
+// MarkStarredFolders sets IsStarred on the folder responses the user starred
+func (s *UserItemService) MarkStarredFolders(ctx context.Context, userID uuid.UUID, folders []domain.FolderResponse) error {
+	ids := make([]uuid.UUID, len(folders))
+	for i := range folders {
+		ids[i] = folders[i].ID
+	}
+	starred, err := s.starredSet(ctx, userID, domain.ItemTypeFolder, ids)
+	if err != nil {
+		return err
+	}
+	for i := range folders {
+		folders[i].IsStarred = starred[folders[i].ID]
+	}
+	return nil
+}
+
+// starredSet returns the subset of items starred by the user
+func (s *UserItemService) starredSet(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	ids, err := s.userItemRepo.FindStarredIDs(ctx, userID, itemType, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load starred items: %w", err)
+	}
+	set := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set, nil
+}
+
+// RecordAccess records that the user opened a file or folder.
+// Failures are only logged so that they never break the request being served.
+func (s *UserItemService) RecordAccess(ctx context.Context, userID uuid.UUID, itemType domain.ItemType, itemID, workspaceID uuid.UUID) {
+	err := s.userItemRepo.RecordAccess(ctx, &domain.RecentItem{
+		UserID:      userID,
+		ItemType:    itemType,
+		ItemID:      itemID,
+		WorkspaceID: workspaceID,
+		AccessedAt:  time.Now(),
+	}, domain.MaxRecentItems)
+	if err != nil {
+		s.logger.Warn("Failed to record recent access",
+			zap.String("userId", userID.String()),
+			zap.String("itemType", string(itemType)),
+			zap.String("itemId", itemID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// GetRecent lists the files and folders the user accessed most recently in a workspace.
+// Items that were deleted or moved to trash are skipped.
+func (s *UserItemService) GetRecent(ctx context.Context, userID, workspaceID uuid.UUID, limit int) (*domain.RecentItemsResponse, error) {
+	if limit < 1 || limit > domain.MaxRecentItems {
+		limit = 20
+	}
+
+	items, err := s.userItemRepo.FindRecent(ctx, userID, workspaceID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent items: %w", err)
+	}
+
+	result := &domain.RecentItemsResponse{Items: make([]domain.RecentItemResponse, 0, len(items))}
+	for _, item := range items {
+		entry := domain.RecentItemResponse{ItemType: item.ItemType, AccessedAt: item.AccessedAt}
+
+		switch item.ItemType {
+		case domain.ItemTypeFile:
+			file, err := s.fileRepo.FindByID(ctx, item.ItemID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get file: %w", err)
+			}
+			if file.Status != domain.FileStatusActive {
+				continue
+			}
+			resp := file.ToResponse(s.fileService.GetFileURL(file.FileKey))
+			entry.File = &resp
+		case domain.ItemTypeFolder:
+			folder, err := s.folderRepo.FindByID(ctx, item.ItemID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get folder: %w", err)
+			}
+			resp := folder.ToResponse()
+			entry.Folder = &resp
+		default:
+			continue
+		}
+
+		result.Items = append(result.Items, entry)
+	}
+	return result, nil
+}
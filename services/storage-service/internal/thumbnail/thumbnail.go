@@ -0,0 +1,169 @@
+// Package thumbnail generates JPEG thumbnails using only pure-Go image decoders,
+// so previews can be produced without external services or native libraries.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	// 표준 라이브러리 디코더 등록 (JPEG/PNG/GIF)
+	_ "image/gif"
+	_ "image/png"
+)
+
+// Limits protecting the worker from decompression bombs
+const (
+	MaxSourceBytes = 50 * 1024 * 1024 // 50MB
+	MaxPixels      = 50_000_000       // 50 megapixels
+	jpegQuality    = 85
+)
+
+var (
+	// ErrUnsupported means the image cannot be decoded with the available decoders
+	ErrUnsupported = errors.New("unsupported image format")
+	// ErrTooLarge means the image exceeds the size or pixel limits
+	ErrTooLarge = errors.New("image too large for thumbnail generation")
+)
+
+// Decode reads and decodes an image, enforcing MaxSourceBytes and MaxPixels
+// before the full image is decoded.
+func Decode(r io.Reader) (image.Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxSourceBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > MaxSourceBytes {
+		return nil, ErrTooLarge
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrUnsupported
+		}
+		return nil, fmt.Errorf("failed to read image header: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrUnsupported
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
+
+// Flatten draws the image onto an opaque white RGBA canvas.
+// JPEG has no alpha channel, so transparent areas would otherwise turn black.
+func Flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	return dst
+}
+
+// FitSize returns the dimensions of w x h scaled to fit within maxDim, preserving
+// aspect ratio. Images are never upscaled.
+func FitSize(w, h, maxDim int) (int, int) {
+	if w <= maxDim && h <= maxDim {
+		return w, h
+	}
+	if w >= h {
+		nh := h * maxDim / w
+		if nh < 1 {
+			nh = 1
+		}
+		return maxDim, nh
+	}
+	nw := w * maxDim / h
+	if nw < 1 {
+		nw = 1
+	}
+	return nw, maxDim
+}
+
+// Resize downscales src to fit within maxDim using area averaging,
+// which gives good quality for large reduction factors.
+func Resize(src *image.RGBA, maxDim int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := FitSize(sw, sh, maxDim)
+	if dw == sw && dh == sh {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		y0 := dy * sh / dh
+		y1 := (dy + 1) * sh / dh
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for dx := 0; dx < dw; dx++ {
+			x0 := dx * sw / dw
+			x1 := (dx + 1) * sw / dw
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			// 원본 영역의 픽셀 평균
+			var r, g, b, a, n uint64
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride:]
+				for x := x0; x < x1; x++ {
+					p := row[x*4 : x*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+
+			o := dst.PixOffset(dx, dy)
+			dst.Pix[o] = uint8(r / n)
+			dst.Pix[o+1] = uint8(g / n)
+			dst.Pix[o+2] = uint8(b / n)
+			dst.Pix[o+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// EncodeJPEG encodes an image as JPEG
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Generate decodes an image once and returns a JPEG thumbnail for each max dimension
+func Generate(r io.Reader, maxDims []int) ([][]byte, error) {
+	img, err := Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	flat := Flatten(img)
+
+	thumbs := make([][]byte, 0, len(maxDims))
+	for _, maxDim := range maxDims {
+		data, err := EncodeJPEG(Resize(flat, maxDim))
+		if err != nil {
+			return nil, err
+		}
+		thumbs = append(thumbs, data)
+	}
+	return thumbs, nil
+}
@@ -0,0 +1,70 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitSize(t *testing.T) {
+	w, h := FitSize(2000, 1000, 256)
+	assert.Equal(t, 256, w)
+	assert.Equal(t, 128, h)
+
+	w, h = FitSize(1000, 4000, 256)
+	assert.Equal(t, 64, w)
+	assert.Equal(t, 256, h)
+
+	// 작은 이미지는 확대하지 않음
+	w, h = FitSize(100, 50, 256)
+	assert.Equal(t, 100, w)
+	assert.Equal(t, 50, h)
+
+	// 극단적인 비율에서도 최소 1px 유지
+	w, h = FitSize(10000, 1, 256)
+	assert.Equal(t, 256, w)
+	assert.Equal(t, 1, h)
+}
+
+func TestGenerate(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 600, 300))
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 600; x++ {
+			src.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, src))
+
+	thumbs, err := Generate(&buf, []int{256, 1024})
+	require.NoError(t, err)
+	require.Len(t, thumbs, 2)
+
+	small, err := jpeg.DecodeConfig(bytes.NewReader(thumbs[0]))
+	require.NoError(t, err)
+	assert.Equal(t, 256, small.Width)
+	assert.Equal(t, 128, small.Height)
+
+	large, err := jpeg.DecodeConfig(bytes.NewReader(thumbs[1]))
+	require.NoError(t, err)
+	assert.Equal(t, 600, large.Width)
+	assert.Equal(t, 300, large.Height)
+}
+
+func TestFlatten_TransparentBecomesWhite(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	flat := Flatten(src)
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, flat.RGBAAt(0, 0))
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	_, err := Decode(strings.NewReader("%PDF-1.7 not an image"))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
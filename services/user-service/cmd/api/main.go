@@ -18,6 +18,10 @@
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// @securityDefinitions.apikey InternalAPIKey
+// @in header
+// @name x-internal-api-key
+
 package main
 
 import (
@@ -39,8 +43,11 @@ import (
 	"user-service/internal/client"
 	"user-service/internal/config"
 	"user-service/internal/database"
+	"user-service/internal/job"
 	"user-service/internal/middleware"
+	"user-service/internal/repository"
 	"user-service/internal/router"
+	"user-service/internal/service"
 )
 
 func main() {
@@ -152,6 +159,76 @@ func main() {
 		logger.Warn("S3 configuration incomplete, profile image uploads disabled")
 	}
 
+	// Initialize Notification client (workspace role change notifications)
+	var notiClient client.NotiClient
+	if cfg.NotiAPI.BaseURL != "" && cfg.NotiAPI.InternalAPIKey != "" {
+		notiClient = client.NewNotiClient(cfg.NotiAPI.BaseURL, cfg.NotiAPI.InternalAPIKey, cfg.NotiAPI.Timeout, logger)
+		logger.Info("Notification client initialized",
+			zap.String("noti_api_url", cfg.NotiAPI.BaseURL))
+	} else {
+		logger.Warn("Notification API configuration incomplete, workspace notifications disabled")
+	}
+
+	// Initialize deletion workflow (cross-service purge after grace period)
+	purgeTargets := make([]client.PurgeTarget, 0, len(cfg.Deletion.Targets))
+	for _, target := range cfg.Deletion.Targets {
+		if target.BaseURL == "" {
+			logger.Warn("Purge target not configured, its data will not be purged on deletion",
+				zap.String("purge.target", target.Name))
+			continue
+		}
+		purgeTargets = append(purgeTargets, client.PurgeTarget{
+			Name:     target.Name,
+			BaseURL:  target.BaseURL,
+			BasePath: target.BasePath,
+		})
+	}
+	var purgeClient client.PurgeClient
+	if len(purgeTargets) > 0 {
+		if cfg.Deletion.InternalAPIKey == "" {
+			logger.Warn("INTERNAL_API_KEY is not set, purge requests will be rejected")
+		}
+		purgeClient = client.NewPurgeClient(purgeTargets, cfg.Deletion.InternalAPIKey, cfg.Deletion.RequestTimeout, logger)
+	}
+	deletionService := service.NewDeletionService(repository.NewDeletionJobRepository(db), purgeClient, cfg.Deletion, logger)
+
+	deletionWorker := job.NewDeletionWorker(deletionService, cfg.Deletion.PollInterval, cfg.Deletion.BatchSize, logger)
+	deletionWorker.Start()
+	logger.Info("Deletion worker started",
+		zap.Duration("grace_period", cfg.Deletion.GracePeriod),
+		zap.Duration("poll_interval", cfg.Deletion.PollInterval),
+		zap.Int("purge_targets", len(purgeTargets)),
+	)
+
+	// Initialize personal data export (requires S3 for the archives)
+	var exportService *service.ExportService
+	var exportWorker *job.ExportWorker
+	if s3Client != nil {
+		var exportClient client.ExportClient
+		if len(purgeTargets) > 0 {
+			exportClient = client.NewExportClient(purgeTargets, cfg.Deletion.InternalAPIKey, cfg.Export.RequestTimeout, logger)
+		}
+		exportService = service.NewExportService(
+			repository.NewDataExportRepository(db),
+			repository.NewUserRepository(db),
+			repository.NewWorkspaceMemberRepository(db),
+			repository.NewUserProfileRepository(db),
+			exportClient,
+			s3Client,
+			notiClient,
+			cfg.Export,
+			logger,
+		)
+		exportWorker = job.NewExportWorker(exportService, cfg.Export.PollInterval, cfg.Export.BatchSize, logger)
+		exportWorker.Start()
+		logger.Info("Export worker started",
+			zap.Duration("poll_interval", cfg.Export.PollInterval),
+			zap.Duration("retention", cfg.Export.Retention),
+		)
+	} else {
+		logger.Warn("S3 is not configured, personal data exports disabled")
+	}
+
 	// Initialize Redis for rate limiting
 	if err := database.InitRedis(logger); err != nil {
 		logger.Warn("Failed to initialize Redis, rate limiting will be disabled", zap.Error(err))
@@ -188,6 +265,10 @@ func main() {
 		JWTSecret:       cfg.JWT.Secret,
 		BasePath:        cfg.Server.BasePath,
 		S3Client:        s3Client,
+		NotiClient:      notiClient,
+		DeletionService: deletionService,
+		ExportService:   exportService,
+		InternalAPIKey:  cfg.Deletion.InternalAPIKey,
 		TokenValidator:  tokenValidator,
 		RedisClient:     database.GetRedis(),
 		RateLimitConfig: cfg.RateLimit,
@@ -227,6 +308,12 @@ func main() {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// Stop background workers
+	deletionWorker.Stop()
+	if exportWorker != nil {
+		exportWorker.Stop()
+	}
+
 	logger.Info("Server exited gracefully")
 }
 
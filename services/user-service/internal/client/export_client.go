@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// ExportClient defines the interface for collecting the personal data a user owns in other services
+type ExportClient interface {
+	// Targets returns the names of the configured services
+	Targets() []string
+	ExportUser(ctx context.Context, target string, userID uuid.UUID) (json.RawMessage, error)
+}
+
+// exportClient implements ExportClient interface.
+// It calls the same services as the purge client, so it shares PurgeTarget.
+type exportClient struct {
+	targets        []PurgeTarget
+	httpClient     *http.Client
+	internalAPIKey string
+	logger         *zap.Logger
+}
+
+// NewExportClient creates a new export client. Targets without a base URL are skipped.
+func NewExportClient(targets []PurgeTarget, internalAPIKey string, timeout time.Duration, logger *zap.Logger) ExportClient {
+	configured := make([]PurgeTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.Name == "" || target.BaseURL == "" {
+			continue
+		}
+		target.BaseURL = strings.TrimRight(target.BaseURL, "/")
+		target.BasePath = "/" + strings.Trim(target.BasePath, "/")
+		configured = append(configured, target)
+	}
+	return &exportClient{
+		targets:        configured,
+		httpClient:     &http.Client{Timeout: timeout},
+		internalAPIKey: internalAPIKey,
+		logger:         logger,
+	}
+}
+
+// Targets returns the names of the configured services
+func (c *exportClient) Targets() []string {
+	names := make([]string, 0, len(c.targets))
+	for _, target := range c.targets {
+		names = append(names, target.Name)
+	}
+	return names
+}
+
+// ExportUser fetches the data of a user from the internal export endpoint of the target service
+func (c *exportClient) ExportUser(ctx context.Context, name string, userID uuid.UUID) (json.RawMessage, error) {
+	var target PurgeTarget
+	found := false
+	for _, t := range c.targets {
+		if t.Name == name {
+			target, found = t, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("export target %q is not configured", name)
+	}
+
+	startTime := time.Now()
+	log := commnotel.WithTraceContext(ctx, c.logger)
+	url := target.BaseURL + target.BasePath + "/internal/export/users/" + userID.String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	commnotel.InjectTraceHeaders(ctx, req)
+	req.Header.Set("x-internal-api-key", c.internalAPIKey)
+
+	resp, err := c.httpClient.Do(req)
+	duration := time.Since(startTime)
+	if err != nil {
+		log.Warn("Export request failed",
+			zap.String("export.target", name),
+			zap.String("http.url", url),
+			zap.Duration("http.duration", duration),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to call %s export: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s export response: %w", name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(body) > 1024 {
+			body = body[:1024]
+		}
+		log.Warn("Export endpoint returned error status",
+			zap.String("export.target", name),
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(body)),
+			zap.Duration("http.duration", duration),
+		)
+		return nil, fmt.Errorf("%s export returned status %d", name, resp.StatusCode)
+	}
+
+	data, err := unwrapExportBody(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s export response: %w", name, err)
+	}
+
+	log.Debug("Export request succeeded",
+		zap.String("export.target", name),
+		zap.Int("http.status_code", resp.StatusCode),
+		zap.Int("response.size", len(data)),
+		zap.Duration("http.duration", duration),
+	)
+	return data, nil
+}
+
+// unwrapExportBody returns the payload of a response, removing the
+// {"success": true, "data": ...} envelope used by most services
+func unwrapExportBody(body []byte) (json.RawMessage, error) {
+	var envelope struct {
+		Success *bool           `json:"success"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		if json.Valid(body) {
+			return json.RawMessage(body), nil // 객체가 아닌 JSON (예: 배열)
+		}
+		return nil, err
+	}
+	if envelope.Success != nil && len(envelope.Data) > 0 {
+		return envelope.Data, nil
+	}
+	return json.RawMessage(body), nil
+}
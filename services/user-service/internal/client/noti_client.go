@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// NotificationType defines notification types matching noti-service
+type NotificationType string
+
+const (
+	// Workspace notification types
+	NotificationTypeWorkspaceRoleChanged                NotificationType = "WORKSPACE_ROLE_CHANGED"
+	NotificationTypeWorkspaceOwnershipTransferRequested NotificationType = "WORKSPACE_OWNERSHIP_TRANSFER_REQUESTED"
+
+	// Account notification types
+	NotificationTypeExportReady NotificationType = "EXPORT_READY"
+)
+
+// ResourceType defines resource types matching noti-service
+type ResourceType string
+
+const (
+	ResourceTypeWorkspace ResourceType = "workspace"
+	ResourceTypeExport    ResourceType = "export"
+)
+
+// NotificationEvent represents the payload for creating a notification
+type NotificationEvent struct {
+	Type         NotificationType       `json:"type"`
+	ActorID      uuid.UUID              `json:"actorId"`
+	TargetUserID uuid.UUID              `json:"targetUserId"`
+	WorkspaceID  uuid.UUID              `json:"workspaceId"`
+	ResourceType ResourceType           `json:"resourceType"`
+	ResourceID   uuid.UUID              `json:"resourceId"`
+	ResourceName *string                `json:"resourceName,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// NotiClient defines the interface for notification service interactions
+type NotiClient interface {
+	SendNotification(ctx context.Context, event *NotificationEvent) error
+}
+
+// notiClient implements NotiClient interface
+type notiClient struct {
+	*commonclient.BaseHTTPClient
+	internalAPIKey string
+}
+
+// NewNotiClient creates a new Notification API client
+func NewNotiClient(baseURL string, internalAPIKey string, timeout time.Duration, logger *zap.Logger) NotiClient {
+	return &notiClient{
+		BaseHTTPClient: commonclient.NewBaseHTTPClient(baseURL, timeout, logger),
+		internalAPIKey: internalAPIKey,
+	}
+}
+
+// SendNotification sends a notification to noti-service
+// This is designed to be called asynchronously (in a goroutine) so notification
+// failures don't affect the main business logic
+func (c *notiClient) SendNotification(ctx context.Context, event *NotificationEvent) error {
+	startTime := time.Now()
+	log := commnotel.WithTraceContext(ctx, c.Logger)
+	url := c.BuildURL("/internal/notifications")
+
+	jsonData, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	commnotel.InjectTraceHeaders(ctx, req)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-internal-api-key", c.internalAPIKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	duration := time.Since(startTime)
+	if err != nil {
+		log.Error("Failed to send notification",
+			zap.Error(err),
+			zap.String("http.url", url),
+			zap.Duration("http.duration", duration),
+		)
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		log.Warn("Noti service returned error status",
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(respBody)),
+			zap.Duration("http.duration", duration),
+		)
+		return fmt.Errorf("noti service returned status %d", resp.StatusCode)
+	}
+
+	log.Debug("Notification sent successfully",
+		zap.String("notification.type", string(event.Type)),
+		zap.Int("http.status_code", resp.StatusCode),
+		zap.Duration("http.duration", duration),
+	)
+	return nil
+}
+
+// NewWorkspaceRoleChangedNotification creates a notification for a workspace role change
+func NewWorkspaceRoleChangedNotification(actorID, targetUserID, workspaceID uuid.UUID, workspaceName, oldRole, newRole, reason string) *NotificationEvent {
+	name := workspaceName
+	return &NotificationEvent{
+		Type:         NotificationTypeWorkspaceRoleChanged,
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		WorkspaceID:  workspaceID,
+		ResourceType: ResourceTypeWorkspace,
+		ResourceID:   workspaceID,
+		ResourceName: &name,
+		Metadata: map[string]interface{}{
+			"oldRole": oldRole,
+			"newRole": newRole,
+			"reason":  reason,
+		},
+	}
+}
+
+// NewOwnershipTransferRequestedNotification creates a notification asking the nominee to accept a workspace ownership transfer
+func NewOwnershipTransferRequestedNotification(ownerID, nomineeID, workspaceID uuid.UUID, workspaceName string, transferID uuid.UUID, expiresAt time.Time) *NotificationEvent {
+	name := workspaceName
+	return &NotificationEvent{
+		Type:         NotificationTypeWorkspaceOwnershipTransferRequested,
+		ActorID:      ownerID,
+		TargetUserID: nomineeID,
+		WorkspaceID:  workspaceID,
+		ResourceType: ResourceTypeWorkspace,
+		ResourceID:   workspaceID,
+		ResourceName: &name,
+		Metadata: map[string]interface{}{
+			"transferId": transferID.String(),
+			"expiresAt":  expiresAt.Format(time.RFC3339),
+		},
+	}
+}
+
+// NewExportReadyNotification creates a notification telling a user that the personal data export is ready.
+// Notifications are workspace scoped, so it is delivered in the user's default workspace.
+func NewExportReadyNotification(userID, workspaceID, exportID uuid.UUID, expiresAt time.Time) *NotificationEvent {
+	return &NotificationEvent{
+		Type:         NotificationTypeExportReady,
+		ActorID:      userID,
+		TargetUserID: userID,
+		WorkspaceID:  workspaceID,
+		ResourceType: ResourceTypeExport,
+		ResourceID:   exportID,
+		Metadata: map[string]interface{}{
+			"expiresAt": expiresAt.Format(time.RFC3339),
+		},
+	}
+}
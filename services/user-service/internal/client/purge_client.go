@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commnotel "github.com/OrangesCloud/wealist-advanced-go-pkg/otel"
+)
+
+// PurgeTarget is a downstream service that exposes internal purge endpoints
+type PurgeTarget struct {
+	Name     string // e.g. "board"
+	BaseURL  string // service host only (e.g. http://board-service:8000)
+	BasePath string // API prefix of the service (e.g. /api, /api/chats)
+}
+
+// PurgeClient defines the interface for hard-deleting data in other services
+type PurgeClient interface {
+	// Targets returns the names of the configured services
+	Targets() []string
+	PurgeWorkspace(ctx context.Context, target string, workspaceID uuid.UUID) error
+	PurgeUser(ctx context.Context, target string, userID uuid.UUID) error
+}
+
+// purgeClient implements PurgeClient interface
+type purgeClient struct {
+	targets        []PurgeTarget
+	httpClient     *http.Client
+	internalAPIKey string
+	logger         *zap.Logger
+}
+
+// NewPurgeClient creates a new purge client. Targets without a base URL are skipped.
+func NewPurgeClient(targets []PurgeTarget, internalAPIKey string, timeout time.Duration, logger *zap.Logger) PurgeClient {
+	configured := make([]PurgeTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.Name == "" || target.BaseURL == "" {
+			continue
+		}
+		target.BaseURL = strings.TrimRight(target.BaseURL, "/")
+		target.BasePath = "/" + strings.Trim(target.BasePath, "/")
+		configured = append(configured, target)
+	}
+	return &purgeClient{
+		targets:        configured,
+		httpClient:     &http.Client{Timeout: timeout},
+		internalAPIKey: internalAPIKey,
+		logger:         logger,
+	}
+}
+
+// Targets returns the names of the configured services
+func (c *purgeClient) Targets() []string {
+	names := make([]string, 0, len(c.targets))
+	for _, target := range c.targets {
+		names = append(names, target.Name)
+	}
+	return names
+}
+
+// PurgeWorkspace hard-deletes the workspace data owned by the target service
+func (c *purgeClient) PurgeWorkspace(ctx context.Context, target string, workspaceID uuid.UUID) error {
+	return c.purge(ctx, target, "/internal/purge/workspaces/"+workspaceID.String())
+}
+
+// PurgeUser hard-deletes the user data owned by the target service
+func (c *purgeClient) PurgeUser(ctx context.Context, target string, userID uuid.UUID) error {
+	return c.purge(ctx, target, "/internal/purge/users/"+userID.String())
+}
+
+// purge calls an idempotent purge endpoint; only a 2xx response counts as done
+func (c *purgeClient) purge(ctx context.Context, name, endpoint string) error {
+	target, ok := c.findTarget(name)
+	if !ok {
+		return fmt.Errorf("purge target %q is not configured", name)
+	}
+
+	startTime := time.Now()
+	log := commnotel.WithTraceContext(ctx, c.logger)
+	url := target.BaseURL + target.BasePath + endpoint
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Inject W3C Trace Context headers for distributed tracing
+	commnotel.InjectTraceHeaders(ctx, req)
+	req.Header.Set("x-internal-api-key", c.internalAPIKey)
+
+	resp, err := c.httpClient.Do(req)
+	duration := time.Since(startTime)
+	if err != nil {
+		log.Warn("Purge request failed",
+			zap.String("purge.target", name),
+			zap.String("http.url", url),
+			zap.Duration("http.duration", duration),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to call %s purge: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		log.Warn("Purge endpoint returned error status",
+			zap.String("purge.target", name),
+			zap.Int("http.status_code", resp.StatusCode),
+			zap.String("http.url", url),
+			zap.String("response.body", string(respBody)),
+			zap.Duration("http.duration", duration),
+		)
+		return fmt.Errorf("%s purge returned status %d", name, resp.StatusCode)
+	}
+
+	log.Debug("Purge request succeeded",
+		zap.String("purge.target", name),
+		zap.Int("http.status_code", resp.StatusCode),
+		zap.Duration("http.duration", duration),
+	)
+	return nil
+}
+
+// findTarget finds a configured target by name
+func (c *purgeClient) findTarget(name string) (PurgeTarget, bool) {
+	for _, target := range c.targets {
+		if target.Name == name {
+			return target, true
+		}
+	}
+	return PurgeTarget{}, false
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -186,3 +187,36 @@ func (c *S3Client) DeleteFile(ctx context.Context, fileKey string) error {
 	}
 	return nil
 }
+
+// UploadFile uploads an object created by the service itself (e.g. a data export archive)
+func (c *S3Client) UploadFile(ctx context.Context, fileKey, contentType string, body io.Reader, size int64) error {
+	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(c.bucket),
+		Key:           aws.String(fileKey),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+		Body:          body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	return nil
+}
+
+// GeneratePresignedDownloadURL generates a time-limited URL that downloads a file as an attachment
+func (c *S3Client) GeneratePresignedDownloadURL(ctx context.Context, fileKey, downloadName string, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.presignClient)
+
+	presignedReq, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(c.bucket),
+		Key:                        aws.String(fileKey),
+		ResponseContentDisposition: aws.String(fmt.Sprintf("attachment; filename=%q", downloadName)),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned download URL: %w", err)
+	}
+
+	return presignedReq.URL, nil
+}
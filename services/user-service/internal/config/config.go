@@ -21,6 +21,9 @@ type Config struct {
 	CORS      CORSConfig      `yaml:"cors"`
 	S3        S3Config        `yaml:"s3"`
 	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	NotiAPI   NotiAPIConfig   `yaml:"noti_api"` // 알림 전송용 (미설정 시 알림 비활성화)
+	Deletion  DeletionConfig  `yaml:"deletion"` // 워크스페이스/계정 영구 삭제 작업
+	Export    ExportConfig    `yaml:"export"`   // 개인 데이터 내보내기 작업
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -72,6 +75,57 @@ type AuthAPIConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+// NotiAPIConfig holds Notification API configuration
+type NotiAPIConfig struct {
+	BaseURL        string        `yaml:"base_url"`
+	Timeout        time.Duration `yaml:"timeout"`
+	InternalAPIKey string        `yaml:"internal_api_key"`
+}
+
+// DeletionConfig holds configuration of the cross-service deletion workflow
+type DeletionConfig struct {
+	GracePeriod    time.Duration       `yaml:"grace_period"`  // 삭제 후 복구 가능 기간
+	PollInterval   time.Duration       `yaml:"poll_interval"` // 삭제 작업 워커 주기
+	BatchSize      int                 `yaml:"batch_size"`
+	MaxAttempts    int                 `yaml:"max_attempts"` // 서비스별 최대 재시도 횟수
+	RetryBaseDelay time.Duration       `yaml:"retry_base_delay"`
+	RetryMaxDelay  time.Duration       `yaml:"retry_max_delay"`
+	RequestTimeout time.Duration       `yaml:"request_timeout"`
+	InternalAPIKey string              `yaml:"internal_api_key"`
+	Targets        []PurgeTargetConfig `yaml:"targets"`
+}
+
+// PurgeTargetConfig holds a service that exposes internal purge endpoints
+type PurgeTargetConfig struct {
+	Name     string `yaml:"name"`
+	BaseURL  string `yaml:"base_url"`
+	BasePath string `yaml:"base_path"`
+}
+
+// defaultPurgeTargets lists the services purged on deletion, with the env var of their URL
+var defaultPurgeTargets = []struct {
+	name     string
+	env      string
+	basePath string
+}{
+	{name: "board", env: "BOARD_SERVICE_URL", basePath: "/api"},
+	{name: "chat", env: "CHAT_SERVICE_URL", basePath: "/api/chats"},
+	{name: "storage", env: "STORAGE_SERVICE_URL", basePath: "/api"},
+	{name: "noti", env: "NOTI_SERVICE_URL", basePath: "/api"},
+}
+
+// ExportConfig holds configuration of the personal data export workflow.
+// Exports are collected from the services configured in Deletion.Targets.
+type ExportConfig struct {
+	PollInterval   time.Duration `yaml:"poll_interval"` // 내보내기 작업 워커 주기
+	BatchSize      int           `yaml:"batch_size"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	RetryDelay     time.Duration `yaml:"retry_delay"`
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	Retention      time.Duration `yaml:"retention"`    // 내보내기 파일 보관 기간
+	DownloadURLTTL time.Duration `yaml:"download_ttl"` // 다운로드 링크 유효 시간
+}
+
 // CORSConfig holds CORS configuration
 type CORSConfig struct {
 	AllowedOrigins string `yaml:"allowed_origins"`
@@ -274,6 +328,131 @@ func (c *Config) overrideFromEnv() {
 	if c.RateLimit.RequestsPerMinute == 0 {
 		c.RateLimit.RequestsPerMinute = 60
 	}
+
+	// Noti API - NOTI_SERVICE_URL (알림 전송용)
+	if baseURL := os.Getenv("NOTI_SERVICE_URL"); baseURL != "" {
+		c.NotiAPI.BaseURL = baseURL
+	}
+	if timeout := os.Getenv("NOTI_API_TIMEOUT"); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			c.NotiAPI.Timeout = d
+		}
+	}
+	if c.NotiAPI.Timeout == 0 {
+		c.NotiAPI.Timeout = 5 * time.Second
+	}
+	// Internal API Key for service-to-service authentication
+	if apiKey := os.Getenv("INTERNAL_API_KEY"); apiKey != "" {
+		c.NotiAPI.InternalAPIKey = apiKey
+		c.Deletion.InternalAPIKey = apiKey
+	}
+
+	// Deletion
+	c.overrideDeletionFromEnv()
+
+	// Export
+	c.overrideExportFromEnv()
+}
+
+// overrideDeletionFromEnv applies deletion workflow env vars and defaults
+func (c *Config) overrideDeletionFromEnv() {
+	if gracePeriod := os.Getenv("DELETION_GRACE_PERIOD"); gracePeriod != "" {
+		if d, err := time.ParseDuration(gracePeriod); err == nil {
+			c.Deletion.GracePeriod = d
+		}
+	}
+	if interval := os.Getenv("DELETION_POLL_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Deletion.PollInterval = d
+		}
+	}
+	if maxAttempts := os.Getenv("DELETION_MAX_ATTEMPTS"); maxAttempts != "" {
+		if v, err := strconv.Atoi(maxAttempts); err == nil {
+			c.Deletion.MaxAttempts = v
+		}
+	}
+	if c.Deletion.GracePeriod == 0 {
+		c.Deletion.GracePeriod = 14 * 24 * time.Hour
+	}
+	if c.Deletion.PollInterval == 0 {
+		c.Deletion.PollInterval = time.Minute
+	}
+	if c.Deletion.BatchSize == 0 {
+		c.Deletion.BatchSize = 10
+	}
+	if c.Deletion.MaxAttempts == 0 {
+		c.Deletion.MaxAttempts = 8
+	}
+	if c.Deletion.RetryBaseDelay == 0 {
+		c.Deletion.RetryBaseDelay = 30 * time.Second
+	}
+	if c.Deletion.RetryMaxDelay == 0 {
+		c.Deletion.RetryMaxDelay = time.Hour
+	}
+	if c.Deletion.RequestTimeout == 0 {
+		c.Deletion.RequestTimeout = 30 * time.Second
+	}
+
+	// 서비스 URL 환경 변수로 대상 서비스 설정 (yaml 설정보다 우선)
+	for _, def := range defaultPurgeTargets {
+		idx := -1
+		for i, target := range c.Deletion.Targets {
+			if target.Name == def.name {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			c.Deletion.Targets = append(c.Deletion.Targets, PurgeTargetConfig{Name: def.name})
+			idx = len(c.Deletion.Targets) - 1
+		}
+		if baseURL := os.Getenv(def.env); baseURL != "" {
+			c.Deletion.Targets[idx].BaseURL = baseURL
+		}
+		if c.Deletion.Targets[idx].BasePath == "" {
+			c.Deletion.Targets[idx].BasePath = def.basePath
+		}
+	}
+}
+
+// overrideExportFromEnv applies data export env vars and defaults
+func (c *Config) overrideExportFromEnv() {
+	if interval := os.Getenv("EXPORT_POLL_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Export.PollInterval = d
+		}
+	}
+	if retention := os.Getenv("EXPORT_RETENTION"); retention != "" {
+		if d, err := time.ParseDuration(retention); err == nil {
+			c.Export.Retention = d
+		}
+	}
+	if ttl := os.Getenv("EXPORT_DOWNLOAD_URL_TTL"); ttl != "" {
+		if d, err := time.ParseDuration(ttl); err == nil {
+			c.Export.DownloadURLTTL = d
+		}
+	}
+	if c.Export.PollInterval == 0 {
+		c.Export.PollInterval = 15 * time.Second
+	}
+	if c.Export.BatchSize == 0 {
+		c.Export.BatchSize = 5
+	}
+	if c.Export.MaxAttempts == 0 {
+		c.Export.MaxAttempts = 3
+	}
+	if c.Export.RetryDelay == 0 {
+		c.Export.RetryDelay = time.Minute
+	}
+	if c.Export.RequestTimeout == 0 {
+		c.Export.RequestTimeout = time.Minute
+	}
+	if c.Export.Retention == 0 {
+		c.Export.Retention = 7 * 24 * time.Hour
+	}
+	if c.Export.DownloadURLTTL == 0 {
+		c.Export.DownloadURLTTL = 15 * time.Minute
+	}
 }
 
 // validate validates the configuration
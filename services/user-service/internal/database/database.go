@@ -9,6 +9,8 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"user-service/internal/domain"
 )
 
@@ -50,14 +52,50 @@ func New(cfg Config) (*gorm.DB, error) {
 
 // AutoMigrate runs database migrations
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(
 		&domain.User{},
 		&domain.Workspace{},
 		&domain.WorkspaceMember{},
 		&domain.UserProfile{},
 		&domain.WorkspaceJoinRequest{},
 		&domain.Attachment{},
-	)
+		&domain.WorkspaceOwnershipTransfer{},
+		&domain.WorkspaceAuditLog{},
+		&domain.DeletionJob{},
+		&domain.DeletionJobStep{},
+		&domain.DataExport{},
+		&domain.WorkspaceEmailDomain{},
+		&domain.WorkspaceInviteLink{},
+		&domain.WorkspaceRole{},
+		&domain.WorkspaceGuestProject{},
+		&domain.UserStatus{},
+		&domain.UserIdentity{},
+		&domain.IdentityLinkToken{},
+	); err != nil {
+		return err
+	}
+
+	if err := backfillMemberStatus(db); err != nil {
+		return err
+	}
+
+	// users.google_id를 user_identities로 이전 (Google은 검증된 이메일만 발급)
+	return db.Exec(`
+		INSERT INTO user_identities (id, user_id, provider, subject, email, email_verified, created_at, updated_at)
+		SELECT gen_random_uuid(), u.id, 'google', u.google_id, u.email, true, NOW(), NOW()
+		FROM users u
+		WHERE u.google_id IS NOT NULL AND u.google_id <> ''
+		AND NOT EXISTS (
+			SELECT 1 FROM user_identities i WHERE i.provider = 'google' AND i.subject = u.google_id
+		)`).Error
+}
+
+// backfillMemberStatus marks members removed before the status column existed as REMOVED.
+// The column defaults to ACTIVE, so without it removed members would look active or suspended.
+func backfillMemberStatus(db *gorm.DB) error {
+	return db.Model(&domain.WorkspaceMember{}).
+		Where("is_active = false AND status = ?", domain.MemberStatusActive).
+		Update("status", domain.MemberStatusRemoved).Error
 }
 
 // SeedDefaultData creates required default data (system user, default workspace)
@@ -101,6 +139,49 @@ func SeedDefaultData(db *gorm.DB) error {
 		fmt.Println("Created default workspace")
 	}
 
+	// 3. Create or refresh built-in roles so they match the shared permission model
+	if err := seedBuiltinRoles(db); err != nil {
+		return fmt.Errorf("failed to seed built-in roles: %w", err)
+	}
+
+	return nil
+}
+
+// seedBuiltinRoles stores the built-in workspace roles of the shared permission package
+func seedBuiltinRoles(db *gorm.DB) error {
+	now := time.Now()
+	for _, builtin := range permission.BuiltinRoles(permission.ScopeWorkspace) {
+		perms := make([]string, len(builtin.Permissions))
+		for i, p := range builtin.Permissions {
+			perms[i] = string(p)
+		}
+
+		var role domain.WorkspaceRole
+		result := db.Where("workspace_id IS NULL AND is_builtin = true AND name = ?", builtin.Name).First(&role)
+		if result.Error == gorm.ErrRecordNotFound {
+			role = domain.WorkspaceRole{
+				ID:          uuid.New(),
+				Name:        builtin.Name,
+				Permissions: perms,
+				IsBuiltin:   true,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+			if err := db.Create(&role).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+
+		role.Permissions = perms
+		role.UpdatedAt = now
+		if err := db.Save(&role).Error; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
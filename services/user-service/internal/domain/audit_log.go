@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction represents a workspace action recorded in the audit log
+type AuditAction string
+
+const (
+	AuditActionOwnershipTransferred AuditAction = "OWNERSHIP_TRANSFERRED"
+	AuditActionMemberSuspended      AuditAction = "MEMBER_SUSPENDED"
+	AuditActionMemberReactivated    AuditAction = "MEMBER_REACTIVATED"
+	AuditActionRoleCreated          AuditAction = "ROLE_CREATED"
+	AuditActionRoleUpdated          AuditAction = "ROLE_UPDATED"
+	AuditActionRoleDeleted          AuditAction = "ROLE_DELETED"
+	AuditActionMemberRoleAssigned   AuditAction = "MEMBER_CUSTOM_ROLE_ASSIGNED"
+	AuditActionGuestInvited         AuditAction = "GUEST_INVITED"
+	AuditActionGuestProjectsUpdated AuditAction = "GUEST_PROJECTS_UPDATED"
+)
+
+// WorkspaceAuditLog records a security-relevant change in a workspace
+type WorkspaceAuditLog struct {
+	ID           uuid.UUID              `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"auditLogId"`
+	WorkspaceID  uuid.UUID              `gorm:"type:uuid;not null;index:idx_workspace_audit_logs_workspace,priority:1" json:"workspaceId"`
+	ActorID      uuid.UUID              `gorm:"type:uuid;not null" json:"actorId"`
+	Action       AuditAction            `gorm:"type:varchar(50);not null" json:"action"`
+	TargetUserID *uuid.UUID             `gorm:"type:uuid" json:"targetUserId,omitempty"`
+	Metadata     map[string]interface{} `gorm:"type:jsonb;serializer:json" json:"metadata,omitempty"`
+	CreatedAt    time.Time              `gorm:"not null;index:idx_workspace_audit_logs_workspace,priority:2" json:"createdAt"`
+}
+
+// TableName specifies the table name for WorkspaceAuditLog
+func (WorkspaceAuditLog) TableName() string {
+	return "workspace_audit_logs"
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus represents the status of a personal data export
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "PENDING"
+	DataExportStatusProcessing DataExportStatus = "PROCESSING"
+	DataExportStatusCompleted  DataExportStatus = "COMPLETED"
+	DataExportStatusFailed     DataExportStatus = "FAILED"
+	DataExportStatusExpired    DataExportStatus = "EXPIRED" // 보관 기간 경과로 파일 삭제됨
+)
+
+// DataExport tracks an asynchronous export of all personal data of a user.
+// The result is a ZIP archive of JSON files kept in object storage until ExpiresAt.
+type DataExport struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"exportId"`
+	UserID      uuid.UUID        `gorm:"type:uuid;not null;index" json:"userId"`
+	Status      DataExportStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	Attempts    int              `gorm:"not null;default:0" json:"attempts"`
+	ObjectKey   *string          `gorm:"type:varchar(500)" json:"-"`
+	FileSize    int64            `gorm:"not null;default:0" json:"fileSize"`
+	LastError   *string          `gorm:"type:text" json:"lastError,omitempty"`
+	LockedUntil *time.Time       `json:"-"` // 처리 중 임대 또는 다음 재시도까지 잠금
+	CompletedAt *time.Time       `json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time       `gorm:"index" json:"expiresAt,omitempty"`
+	CreatedAt   time.Time        `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time        `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for DataExport
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+// IsDownloadable reports whether the archive of the export can still be downloaded
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == DataExportStatusCompleted && e.ObjectKey != nil &&
+		e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// DataExportResponse represents the data export response
+type DataExportResponse struct {
+	ExportID    uuid.UUID        `json:"exportId"`
+	Status      DataExportStatus `json:"status"`
+	FileSize    int64            `json:"fileSize,omitempty"`
+	DownloadURL string           `json:"downloadUrl,omitempty"` // 완료된 경우에만 제공되는 시간 제한 링크
+	CompletedAt *time.Time       `json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time       `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time        `json:"createdAt"`
+}
+
+// ToResponse converts DataExport to DataExportResponse without a download link
+func (e *DataExport) ToResponse() DataExportResponse {
+	return DataExportResponse{
+		ExportID:    e.ID,
+		Status:      e.Status,
+		FileSize:    e.FileSize,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+		CreatedAt:   e.CreatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeletionResourceType represents the kind of resource a deletion job purges
+type DeletionResourceType string
+
+const (
+	DeletionResourceWorkspace DeletionResourceType = "WORKSPACE"
+	DeletionResourceUser      DeletionResourceType = "USER"
+)
+
+// DeletionJobStatus represents the status of a deletion job
+type DeletionJobStatus string
+
+const (
+	DeletionJobStatusScheduled  DeletionJobStatus = "SCHEDULED"   // 유예 기간 중 (복구 가능)
+	DeletionJobStatusInProgress DeletionJobStatus = "IN_PROGRESS" // 서비스별 영구 삭제 진행 중
+	DeletionJobStatusCompleted  DeletionJobStatus = "COMPLETED"
+	DeletionJobStatusFailed     DeletionJobStatus = "FAILED" // 재시도 횟수 초과
+	DeletionJobStatusCancelled  DeletionJobStatus = "CANCELLED"
+)
+
+// DeletionStepStatus represents the purge status of a single downstream service
+type DeletionStepStatus string
+
+const (
+	DeletionStepStatusPending   DeletionStepStatus = "PENDING"
+	DeletionStepStatusSucceeded DeletionStepStatus = "SUCCEEDED"
+	DeletionStepStatusFailed    DeletionStepStatus = "FAILED"
+)
+
+// DeletionJob tracks the hard purge of a deleted workspace or user account across services.
+// The purge starts once PurgeAfter has passed; until then the job can be cancelled.
+type DeletionJob struct {
+	ID           uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"jobId"`
+	ResourceType DeletionResourceType `gorm:"type:varchar(20);not null;index:idx_deletion_jobs_resource,priority:1" json:"resourceType"`
+	ResourceID   uuid.UUID            `gorm:"type:uuid;not null;index:idx_deletion_jobs_resource,priority:2" json:"resourceId"`
+	RequestedBy  uuid.UUID            `gorm:"type:uuid;not null" json:"requestedBy"`
+	Status       DeletionJobStatus    `gorm:"type:varchar(20);not null;index:idx_deletion_jobs_due,priority:1" json:"status"`
+	PurgeAfter   time.Time            `gorm:"not null;index:idx_deletion_jobs_due,priority:2" json:"purgeAfter"`
+	LockedUntil  *time.Time           `json:"-"` // 처리 중 임대 또는 다음 재시도까지 잠금
+	CompletedAt  *time.Time           `json:"completedAt,omitempty"`
+	CreatedAt    time.Time            `gorm:"not null" json:"createdAt"`
+	UpdatedAt    time.Time            `gorm:"not null" json:"updatedAt"`
+
+	// Relations
+	Steps []DeletionJobStep `gorm:"foreignKey:JobID" json:"steps,omitempty"`
+}
+
+// TableName specifies the table name for DeletionJob
+func (DeletionJob) TableName() string {
+	return "deletion_jobs"
+}
+
+// DeletionJobStep tracks the purge call to one downstream service
+type DeletionJobStep struct {
+	ID            uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"stepId"`
+	JobID         uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_deletion_job_steps_job_service,priority:1" json:"jobId"`
+	Service       string             `gorm:"type:varchar(50);not null;uniqueIndex:idx_deletion_job_steps_job_service,priority:2" json:"service"`
+	Status        DeletionStepStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Attempts      int                `gorm:"not null;default:0" json:"attempts"`
+	LastError     *string            `gorm:"type:text" json:"lastError,omitempty"`
+	NextAttemptAt time.Time          `gorm:"not null" json:"nextAttemptAt"`
+	CompletedAt   *time.Time         `json:"completedAt,omitempty"`
+	UpdatedAt     time.Time          `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for DeletionJobStep
+func (DeletionJobStep) TableName() string {
+	return "deletion_job_steps"
+}
+
+// IsCancellable reports whether the job is still within its grace period
+func (j *DeletionJob) IsCancellable(now time.Time) bool {
+	return j.Status == DeletionJobStatusScheduled && now.Before(j.PurgeAfter)
+}
+
+// DeletionJobResponse represents the deletion job response
+type DeletionJobResponse struct {
+	JobID        uuid.UUID              `json:"jobId"`
+	ResourceType DeletionResourceType   `json:"resourceType"`
+	ResourceID   uuid.UUID              `json:"resourceId"`
+	Status       DeletionJobStatus      `json:"status"`
+	PurgeAfter   time.Time              `json:"purgeAfter"`
+	CompletedAt  *time.Time             `json:"completedAt,omitempty"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	Steps        []DeletionStepResponse `json:"steps"`
+}
+
+// DeletionStepResponse represents the per-service purge status
+type DeletionStepResponse struct {
+	Service     string             `json:"service"`
+	Status      DeletionStepStatus `json:"status"`
+	Attempts    int                `json:"attempts"`
+	LastError   *string            `json:"lastError,omitempty"`
+	CompletedAt *time.Time         `json:"completedAt,omitempty"`
+}
+
+// ToResponse converts DeletionJob to DeletionJobResponse
+func (j *DeletionJob) ToResponse() DeletionJobResponse {
+	steps := make([]DeletionStepResponse, 0, len(j.Steps))
+	for _, step := range j.Steps {
+		steps = append(steps, DeletionStepResponse{
+			Service:     step.Service,
+			Status:      step.Status,
+			Attempts:    step.Attempts,
+			LastError:   step.LastError,
+			CompletedAt: step.CompletedAt,
+		})
+	}
+	return DeletionJobResponse{
+		JobID:        j.ID,
+		ResourceType: j.ResourceType,
+		ResourceID:   j.ResourceID,
+		Status:       j.Status,
+		PurgeAfter:   j.PurgeAfter,
+		CompletedAt:  j.CompletedAt,
+		CreatedAt:    j.CreatedAt,
+		Steps:        steps,
+	}
+}
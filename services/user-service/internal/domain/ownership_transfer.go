@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OwnershipTransferTTL is how long a nominee has to confirm an ownership transfer
+const OwnershipTransferTTL = 7 * 24 * time.Hour
+
+// OwnershipTransferStatus represents the status of an ownership transfer
+type OwnershipTransferStatus string
+
+const (
+	TransferStatusPending   OwnershipTransferStatus = "PENDING"
+	TransferStatusAccepted  OwnershipTransferStatus = "ACCEPTED"
+	TransferStatusDeclined  OwnershipTransferStatus = "DECLINED"
+	TransferStatusCancelled OwnershipTransferStatus = "CANCELLED"
+)
+
+// WorkspaceOwnershipTransfer represents an owner's nomination of a new owner.
+// The roles are swapped only after the nominee accepts.
+type WorkspaceOwnershipTransfer struct {
+	ID          uuid.UUID               `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"transferId"`
+	WorkspaceID uuid.UUID               `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	FromUserID  uuid.UUID               `gorm:"type:uuid;not null" json:"fromUserId"`
+	ToUserID    uuid.UUID               `gorm:"type:uuid;not null;index" json:"toUserId"`
+	Status      OwnershipTransferStatus `gorm:"type:varchar(20);not null;default:'PENDING'" json:"status"`
+	ExpiresAt   time.Time               `gorm:"not null" json:"expiresAt"`
+	RespondedAt *time.Time              `json:"respondedAt,omitempty"`
+	CreatedAt   time.Time               `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time               `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for WorkspaceOwnershipTransfer
+func (WorkspaceOwnershipTransfer) TableName() string {
+	return "workspace_ownership_transfers"
+}
+
+// IsExpired returns true if the nominee can no longer accept the transfer
+func (t *WorkspaceOwnershipTransfer) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// TransferOwnershipRequest represents the request to nominate a new workspace owner
+type TransferOwnershipRequest struct {
+	NewOwnerID uuid.UUID `json:"newOwnerId" binding:"required"`
+}
+
+// OwnershipTransferResponse represents the ownership transfer response
+type OwnershipTransferResponse struct {
+	TransferID  uuid.UUID               `json:"transferId"`
+	WorkspaceID uuid.UUID               `json:"workspaceId"`
+	FromUserID  uuid.UUID               `json:"fromUserId"`
+	ToUserID    uuid.UUID               `json:"toUserId"`
+	Status      OwnershipTransferStatus `json:"status"`
+	ExpiresAt   time.Time               `json:"expiresAt"`
+	RespondedAt *time.Time              `json:"respondedAt,omitempty"`
+	CreatedAt   time.Time               `json:"createdAt"`
+}
+
+// ToResponse converts WorkspaceOwnershipTransfer to OwnershipTransferResponse
+func (t *WorkspaceOwnershipTransfer) ToResponse() OwnershipTransferResponse {
+	return OwnershipTransferResponse{
+		TransferID:  t.ID,
+		WorkspaceID: t.WorkspaceID,
+		FromUserID:  t.FromUserID,
+		ToUserID:    t.ToUserID,
+		Status:      t.Status,
+		ExpiresAt:   t.ExpiresAt,
+		RespondedAt: t.RespondedAt,
+		CreatedAt:   t.CreatedAt,
+	}
+}
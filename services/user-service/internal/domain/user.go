@@ -11,7 +11,7 @@ type User struct {
 	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"userId"`
 	Email     string     `gorm:"uniqueIndex;not null" json:"email"`
 	Name      string     `gorm:"not null;default:''" json:"name"`
-	GoogleID  *string    `gorm:"uniqueIndex;column:google_id" json:"googleId,omitempty"`
+	GoogleID  *string    `gorm:"uniqueIndex;column:google_id" json:"googleId,omitempty"` // Deprecated: user_identities로 이전됨
 	Provider  string     `gorm:"default:'google'" json:"provider"`
 	IsActive  bool       `gorm:"default:true" json:"isActive"`
 	CreatedAt time.Time  `gorm:"not null" json:"createdAt"`
@@ -42,11 +42,18 @@ type UpdateUserRequest struct {
 	IsActive *bool   `json:"isActive,omitempty"`
 }
 
-// OAuthLoginRequest represents the request for OAuth login (internal API)
+// OAuthLoginRequest represents the request for OAuth login (internal API).
+// Subject identifies the provider account; without it the login falls back to matching by email.
+// With LinkToken set, the identity is linked to the token owner instead of logging in;
+// LinkBinding is the binding cookie of the browser that started the flow and must match the token.
 type OAuthLoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Name     string `json:"name" binding:"required"`
-	Provider string `json:"provider" binding:"required"`
+	Email         string `json:"email" binding:"required,email"`
+	Name          string `json:"name" binding:"required"`
+	Provider      string `json:"provider" binding:"required"`
+	Subject       string `json:"subject,omitempty"`
+	EmailVerified bool   `json:"emailVerified"`
+	LinkToken     string `json:"linkToken,omitempty"`
+	LinkBinding   string `json:"linkBinding,omitempty"`
 }
 
 // UserResponse represents the user response
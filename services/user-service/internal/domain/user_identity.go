@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity is an external login (OAuth provider account) of a user.
+// A user can log in with any linked identity; (provider, subject) is unique across users.
+type UserIdentity struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"identityId"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
+	Provider      string     `gorm:"type:varchar(50);not null;uniqueIndex:idx_user_identities_subject,priority:1" json:"provider"`
+	Subject       string     `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identities_subject,priority:2" json:"-"` // 제공자가 발급한 계정 식별자 (sub)
+	Email         string     `gorm:"type:varchar(255);not null;default:''" json:"email"`
+	EmailVerified bool       `gorm:"not null;default:false" json:"emailVerified"`
+	LastLoginAt   *time.Time `json:"lastLoginAt,omitempty"`
+	CreatedAt     time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt     time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserIdentity
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+// IdentityLinkToken lets auth-service attach the next OAuth login to a logged-in account
+// instead of logging in. Tokens are single use and short-lived.
+// BindingHash ties the token to the browser that requested it, so a token leaked to (or planted in)
+// another browser cannot link that browser's login to the token owner.
+type IdentityLinkToken struct {
+	Token       string     `gorm:"type:varchar(64);primaryKey" json:"linkToken"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
+	BindingHash string     `gorm:"type:varchar(64);not null;default:''" json:"-"` // 바인딩 쿠키 값의 SHA-256 (hex)
+	ExpiresAt   time.Time  `gorm:"not null" json:"expiresAt"`
+	UsedAt      *time.Time `json:"usedAt,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
+}
+
+// TableName specifies the table name for IdentityLinkToken
+func (IdentityLinkToken) TableName() string {
+	return "identity_link_tokens"
+}
+
+// UserIdentityResponse represents a linked login of the current user
+type UserIdentityResponse struct {
+	IdentityID    uuid.UUID  `json:"identityId"`
+	Provider      string     `json:"provider"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"emailVerified"`
+	LastLoginAt   *time.Time `json:"lastLoginAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+// ToResponse converts UserIdentity to UserIdentityResponse
+func (i *UserIdentity) ToResponse() UserIdentityResponse {
+	return UserIdentityResponse{
+		IdentityID:    i.ID,
+		Provider:      i.Provider,
+		Email:         i.Email,
+		EmailVerified: i.EmailVerified,
+		LastLoginAt:   i.LastLoginAt,
+		CreatedAt:     i.CreatedAt,
+	}
+}
+
+// IdentityLinkTokenResponse is returned when the current user starts linking a new login.
+// The frontend passes the token to auth-service as link_token when starting the OAuth flow.
+// Binding is never serialized; the handler sets it as an HttpOnly cookie that auth-service forwards.
+type IdentityLinkTokenResponse struct {
+	LinkToken string    `json:"linkToken"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Binding   string    `json:"-"`
+}
@@ -41,6 +41,25 @@ type UpdateProfileRequest struct {
 	ProfileImageURL *string `json:"profileImageUrl,omitempty"`
 }
 
+// MaxBatchProfiles limits the number of profiles resolved by a single batch request
+const MaxBatchProfiles = 100
+
+// ProfileKey identifies a workspace-specific profile in a batch request
+type ProfileKey struct {
+	WorkspaceID uuid.UUID `json:"workspaceId"`
+	UserID      uuid.UUID `json:"userId" binding:"required"`
+}
+
+// BatchProfilesRequest represents the request to resolve many profiles at once
+type BatchProfilesRequest struct {
+	Profiles []ProfileKey `json:"profiles" binding:"required,min=1,max=100,dive"`
+}
+
+// BatchProfilesResponse represents the resolved profiles; pairs without a profile are omitted
+type BatchProfilesResponse struct {
+	Profiles []UserProfileResponse `json:"profiles"`
+}
+
 // UserProfileResponse represents the user profile response
 type UserProfileResponse struct {
 	ProfileID       uuid.UUID `json:"profileId"`
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+)
+
+// UserStatus stores a user's custom status, do-not-disturb schedule and out-of-office period.
+// Availability belongs to the user, so it is shared by every workspace profile.
+type UserStatus struct {
+	UserID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"userId"`
+	StatusEmoji    string     `gorm:"type:varchar(32);not null;default:''" json:"statusEmoji"`
+	StatusText     string     `gorm:"type:varchar(100);not null;default:''" json:"statusText"`
+	StatusClearAt  *time.Time `json:"statusClearAt,omitempty"`
+	DNDEnabled     bool       `gorm:"not null;default:false" json:"dndEnabled"`
+	DNDStartTime   string     `gorm:"type:varchar(5);not null;default:''" json:"dndStartTime"`
+	DNDEndTime     string     `gorm:"type:varchar(5);not null;default:''" json:"dndEndTime"`
+	DNDWeekdays    []int      `gorm:"type:text;serializer:json" json:"dndWeekdays"`
+	DNDTimezone    string     `gorm:"type:varchar(64);not null;default:''" json:"dndTimezone"`
+	DNDSnoozeUntil *time.Time `json:"dndSnoozeUntil,omitempty"`
+	OOOStartAt     *time.Time `json:"oooStartAt,omitempty"`
+	OOOEndAt       *time.Time `gorm:"index" json:"oooEndAt,omitempty"`
+	OOOMessage     string     `gorm:"type:text;not null;default:''" json:"oooMessage"`
+	UpdatedAt      time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for UserStatus
+func (UserStatus) TableName() string {
+	return "user_statuses"
+}
+
+// UpdateUserStatusRequest replaces the caller's availability.
+// Omitting a section clears it.
+type UpdateUserStatusRequest struct {
+	CustomStatus *commonclient.CustomStatus `json:"customStatus"`
+	DoNotDisturb *commonclient.DoNotDisturb `json:"doNotDisturb"`
+	OutOfOffice  *commonclient.OutOfOffice  `json:"outOfOffice"`
+}
+
+// UserStatusResponse represents a user's availability.
+// The owner sees every setting; other users only see what applies right now.
+type UserStatusResponse struct {
+	commonclient.UserAvailability
+	IsDoNotDisturb bool       `json:"isDoNotDisturb"`
+	IsOutOfOffice  bool       `json:"isOutOfOffice"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ToAvailability converts UserStatus to the shared availability model
+func (s *UserStatus) ToAvailability() *commonclient.UserAvailability {
+	availability := &commonclient.UserAvailability{UserID: s.UserID}
+	if s.StatusEmoji != "" || s.StatusText != "" {
+		availability.CustomStatus = &commonclient.CustomStatus{
+			Emoji:   s.StatusEmoji,
+			Text:    s.StatusText,
+			ClearAt: s.StatusClearAt,
+		}
+	}
+	if s.DNDEnabled || s.DNDStartTime != "" || s.DNDSnoozeUntil != nil {
+		availability.DoNotDisturb = &commonclient.DoNotDisturb{
+			Enabled:     s.DNDEnabled,
+			StartTime:   s.DNDStartTime,
+			EndTime:     s.DNDEndTime,
+			Weekdays:    s.DNDWeekdays,
+			Timezone:    s.DNDTimezone,
+			SnoozeUntil: s.DNDSnoozeUntil,
+		}
+	}
+	if s.OOOStartAt != nil && s.OOOEndAt != nil {
+		availability.OutOfOffice = &commonclient.OutOfOffice{
+			StartAt: *s.OOOStartAt,
+			EndAt:   *s.OOOEndAt,
+			Message: s.OOOMessage,
+		}
+	}
+	return availability
+}
+
+// ToResponse converts UserStatus to the owner's view with every setting
+func (s *UserStatus) ToResponse(now time.Time) UserStatusResponse {
+	availability := s.ToAvailability()
+	updatedAt := s.UpdatedAt
+	return UserStatusResponse{
+		UserAvailability: *availability,
+		IsDoNotDisturb:   availability.IsDoNotDisturb(now),
+		IsOutOfOffice:    availability.ActiveOutOfOffice(now) != nil,
+		UpdatedAt:        &updatedAt,
+	}
+}
+
+// ToPublicResponse converts UserStatus to the view other users see.
+// The do-not-disturb schedule stays private; expired status and out-of-office are hidden.
+func (s *UserStatus) ToPublicResponse(now time.Time) UserStatusResponse {
+	availability := s.ToAvailability()
+	resp := UserStatusResponse{
+		UserAvailability: commonclient.UserAvailability{
+			UserID:       s.UserID,
+			CustomStatus: availability.ActiveStatus(now),
+			OutOfOffice:  availability.ActiveOutOfOffice(now),
+		},
+		IsDoNotDisturb: availability.IsDoNotDisturb(now),
+	}
+	resp.IsOutOfOffice = resp.OutOfOffice != nil
+	return resp
+}
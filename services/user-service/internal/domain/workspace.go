@@ -108,6 +108,9 @@ type WorkspaceSettingsResponse struct {
 	IsPublic             bool      `json:"isPublic"`
 	RequiresApproval     bool      `json:"requiresApproval"`
 	OnlyOwnerCanInvite   bool      `json:"onlyOwnerCanInvite"`
+
+	EmailDomains []EmailDomainSetting `json:"emailDomains"`
+	InviteLinks  []InviteLinkResponse `json:"inviteLinks"` // 사용 가능한 링크만 포함
 }
 
 // ToSettingsResponse converts Workspace to WorkspaceSettingsResponse
@@ -123,6 +126,8 @@ func (w *Workspace) ToSettingsResponse() WorkspaceSettingsResponse {
 		IsPublic:             w.IsPublic,
 		RequiresApproval:     w.NeedApproved,
 		OnlyOwnerCanInvite:   w.OnlyOwnerCanInvite,
+		EmailDomains:         []EmailDomainSetting{},
+		InviteLinks:          []InviteLinkResponse{},
 	}
 }
 
@@ -133,4 +138,11 @@ type UpdateWorkspaceSettingsRequest struct {
 	IsPublic             *bool   `json:"isPublic,omitempty"`
 	RequiresApproval     *bool   `json:"requiresApproval,omitempty"`
 	OnlyOwnerCanInvite   *bool   `json:"onlyOwnerCanInvite,omitempty"`
+
+	// EmailDomains replaces the auto-join email domains when set (empty list removes all)
+	EmailDomains *[]EmailDomainSetting `json:"emailDomains,omitempty" binding:"omitempty,max=20,dive"`
+	// CreateInviteLink creates a new shareable invite link
+	CreateInviteLink *CreateInviteLinkRequest `json:"createInviteLink,omitempty"`
+	// RevokeInviteLinkIDs revokes existing invite links
+	RevokeInviteLinkIDs []uuid.UUID `json:"revokeInviteLinkIds,omitempty"`
 }
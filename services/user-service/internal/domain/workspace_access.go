@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DomainJoinPolicy represents what happens when a user of a workspace email domain logs in
+type DomainJoinPolicy string
+
+const (
+	DomainJoinPolicyAutoJoin    DomainJoinPolicy = "AUTO_JOIN"    // 바로 멤버로 추가
+	DomainJoinPolicyAutoRequest DomainJoinPolicy = "AUTO_REQUEST" // 참여 요청 생성 (승인 필요)
+)
+
+// IsValid reports whether the policy is a known value
+func (p DomainJoinPolicy) IsValid() bool {
+	return p == DomainJoinPolicyAutoJoin || p == DomainJoinPolicyAutoRequest
+}
+
+// WorkspaceEmailDomain is a verified email domain whose users join a workspace on OAuth login
+type WorkspaceEmailDomain struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"emailDomainId"`
+	WorkspaceID uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_email_domains_workspace_domain,priority:1" json:"workspaceId"`
+	Domain      string           `gorm:"type:varchar(255);not null;uniqueIndex:idx_workspace_email_domains_workspace_domain,priority:2;index" json:"domain"`
+	JoinPolicy  DomainJoinPolicy `gorm:"type:varchar(20);not null" json:"joinPolicy"`
+	VerifiedBy  uuid.UUID        `gorm:"type:uuid;not null" json:"verifiedBy"` // 같은 도메인 이메일로 인증된 설정자
+	CreatedAt   time.Time        `gorm:"not null" json:"createdAt"`
+}
+
+// TableName specifies the table name for WorkspaceEmailDomain
+func (WorkspaceEmailDomain) TableName() string {
+	return "workspace_email_domains"
+}
+
+// WorkspaceInviteLink is a shareable link that adds whoever opens it to a workspace
+type WorkspaceInviteLink struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"inviteLinkId"`
+	WorkspaceID uuid.UUID  `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	Token       string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"token"`
+	DefaultRole RoleName   `gorm:"type:varchar(20);not null;default:'MEMBER'" json:"defaultRole"`
+	MaxUses     *int       `json:"maxUses,omitempty"` // nil이면 무제한
+	UseCount    int        `gorm:"not null;default:0" json:"useCount"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"` // nil이면 만료 없음
+	CreatedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"createdBy"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
+}
+
+// TableName specifies the table name for WorkspaceInviteLink
+func (WorkspaceInviteLink) TableName() string {
+	return "workspace_invite_links"
+}
+
+// IsUsable reports whether the link can still be used to join
+func (l *WorkspaceInviteLink) IsUsable(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	if l.ExpiresAt != nil && !now.Before(*l.ExpiresAt) {
+		return false
+	}
+	return l.MaxUses == nil || l.UseCount < *l.MaxUses
+}
+
+// EmailDomainSetting represents an email domain entry in the workspace settings
+type EmailDomainSetting struct {
+	Domain     string           `json:"domain" binding:"required"`
+	JoinPolicy DomainJoinPolicy `json:"joinPolicy" binding:"required"`
+}
+
+// CreateInviteLinkRequest represents the settings of a new invite link
+type CreateInviteLinkRequest struct {
+	DefaultRole    RoleName `json:"defaultRole,omitempty"`
+	MaxUses        *int     `json:"maxUses,omitempty" binding:"omitempty,min=1"`
+	ExpiresInHours *int     `json:"expiresInHours,omitempty" binding:"omitempty,min=1,max=8760"`
+}
+
+// InviteLinkResponse represents an invite link in the workspace settings
+type InviteLinkResponse struct {
+	InviteLinkID uuid.UUID  `json:"inviteLinkId"`
+	Token        string     `json:"token"`
+	DefaultRole  RoleName   `json:"defaultRole"`
+	MaxUses      *int       `json:"maxUses,omitempty"`
+	UseCount     int        `json:"useCount"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	CreatedBy    uuid.UUID  `json:"createdBy"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// ToResponse converts WorkspaceInviteLink to InviteLinkResponse
+func (l *WorkspaceInviteLink) ToResponse() InviteLinkResponse {
+	return InviteLinkResponse{
+		InviteLinkID: l.ID,
+		Token:        l.Token,
+		DefaultRole:  l.DefaultRole,
+		MaxUses:      l.MaxUses,
+		UseCount:     l.UseCount,
+		ExpiresAt:    l.ExpiresAt,
+		CreatedBy:    l.CreatedBy,
+		CreatedAt:    l.CreatedAt,
+	}
+}
+
+// InviteLinkPreviewResponse represents the workspace shown before joining through an invite link
+type InviteLinkPreviewResponse struct {
+	WorkspaceID          uuid.UUID  `json:"workspaceId"`
+	WorkspaceName        string     `json:"workspaceName"`
+	WorkspaceDescription *string    `json:"workspaceDescription,omitempty"`
+	DefaultRole          RoleName   `json:"defaultRole"`
+	ExpiresAt            *time.Time `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestResource identifies which service owns a project shared with a guest
+type GuestResource string
+
+const (
+	GuestResourceBoard   GuestResource = "BOARD"   // board-service 프로젝트
+	GuestResourceStorage GuestResource = "STORAGE" // storage-service 프로젝트
+)
+
+// WorkspaceGuestProject grants a guest member access to a single project
+type WorkspaceGuestProject struct {
+	ID          uuid.UUID     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"guestProjectId"`
+	WorkspaceID uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_guest_projects_grant,priority:1" json:"workspaceId"`
+	UserID      uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_guest_projects_grant,priority:2" json:"userId"`
+	Resource    GuestResource `gorm:"type:varchar(20);not null;uniqueIndex:idx_workspace_guest_projects_grant,priority:3" json:"resource"`
+	ProjectID   uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_workspace_guest_projects_grant,priority:4" json:"projectId"`
+	GrantedBy   uuid.UUID     `gorm:"type:uuid;not null" json:"grantedBy"`
+	CreatedAt   time.Time     `gorm:"not null" json:"createdAt"`
+}
+
+// TableName specifies the table name for WorkspaceGuestProject
+func (WorkspaceGuestProject) TableName() string {
+	return "workspace_guest_projects"
+}
+
+// InviteGuestRequest represents the request to invite an external collaborator
+type InviteGuestRequest struct {
+	Email             string      `json:"email" binding:"required,email"`
+	ProjectIDs        []uuid.UUID `json:"projectIds"`        // board-service 프로젝트
+	StorageProjectIDs []uuid.UUID `json:"storageProjectIds"` // storage-service 프로젝트
+}
+
+// UpdateGuestProjectsRequest replaces the projects shared with a guest
+type UpdateGuestProjectsRequest struct {
+	ProjectIDs        []uuid.UUID `json:"projectIds"`
+	StorageProjectIDs []uuid.UUID `json:"storageProjectIds"`
+}
+
+// GuestResponse represents a guest member with the projects shared with them
+type GuestResponse struct {
+	WorkspaceMemberResponse
+	ProjectIDs        []uuid.UUID `json:"projectIds"`
+	StorageProjectIDs []uuid.UUID `json:"storageProjectIds"`
+}
+
+// MemberAccessResponse is the result of validating workspace access for another service.
+// Guests are not members; their access is limited to the listed projects.
+type MemberAccessResponse struct {
+	IsMember               bool        `json:"isMember"`
+	RoleName               RoleName    `json:"roleName,omitempty"` // set for active members only
+	IsGuest                bool        `json:"isGuest"`
+	GuestProjectIDs        []uuid.UUID `json:"guestProjectIds,omitempty"`
+	GuestStorageProjectIDs []uuid.UUID `json:"guestStorageProjectIds,omitempty"`
+}
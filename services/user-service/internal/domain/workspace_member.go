@@ -13,18 +13,34 @@ const (
 	RoleOwner  RoleName = "OWNER"
 	RoleAdmin  RoleName = "ADMIN"
 	RoleMember RoleName = "MEMBER"
+	RoleGuest  RoleName = "GUEST" // 외부 협업자, 공유받은 프로젝트에만 접근
 )
 
-// WorkspaceMember represents a member of a workspace
+// MemberStatus represents the lifecycle status of a workspace member
+type MemberStatus string
+
+const (
+	MemberStatusActive    MemberStatus = "ACTIVE"
+	MemberStatusSuspended MemberStatus = "SUSPENDED" // 접근 차단, 프로필과 작성 이력은 유지
+	MemberStatusRemoved   MemberStatus = "REMOVED"
+)
+
+// WorkspaceMember represents a member of a workspace.
+// IsActive is true only for ACTIVE members, so suspended and removed members lose access.
 type WorkspaceMember struct {
-	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"workspaceMemberId"`
-	WorkspaceID uuid.UUID `gorm:"type:uuid;not null;index" json:"workspaceId"`
-	UserID      uuid.UUID `gorm:"type:uuid;not null;index" json:"userId"`
-	RoleName    RoleName  `gorm:"type:varchar(20);not null;default:'MEMBER'" json:"roleName"`
-	IsDefault   bool      `gorm:"default:false" json:"isDefault"`
-	IsActive    bool      `gorm:"default:true" json:"isActive"`
-	JoinedAt    time.Time `gorm:"not null" json:"joinedAt"`
-	UpdatedAt   time.Time `gorm:"not null" json:"updatedAt"`
+	ID            uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"workspaceMemberId"`
+	WorkspaceID   uuid.UUID    `gorm:"type:uuid;not null;index" json:"workspaceId"`
+	UserID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"userId"`
+	RoleName      RoleName     `gorm:"type:varchar(20);not null;default:'MEMBER'" json:"roleName"`
+	CustomRoleID  *uuid.UUID   `gorm:"type:uuid;index" json:"customRoleId,omitempty"` // 설정되면 기본 역할 대신 커스텀 역할의 권한 적용
+	IsDefault     bool         `gorm:"default:false" json:"isDefault"`
+	IsActive      bool         `gorm:"default:true" json:"isActive"`
+	Status        MemberStatus `gorm:"type:varchar(20);not null;default:'ACTIVE'" json:"status"`
+	SuspendedAt   *time.Time   `json:"suspendedAt,omitempty"`
+	SuspendedBy   *uuid.UUID   `gorm:"type:uuid" json:"suspendedBy,omitempty"`
+	SuspendReason *string      `gorm:"type:varchar(500)" json:"suspendReason,omitempty"`
+	JoinedAt      time.Time    `gorm:"not null" json:"joinedAt"`
+	UpdatedAt     time.Time    `gorm:"not null" json:"updatedAt"`
 
 	// Relations
 	Workspace *Workspace `gorm:"foreignKey:WorkspaceID" json:"workspace,omitempty"`
@@ -47,19 +63,34 @@ type UpdateMemberRoleRequest struct {
 	RoleName RoleName `json:"roleName" binding:"required"`
 }
 
+// AssignCustomRoleRequest represents the request to assign a custom role to a member.
+// A nil CustomRoleID clears the custom role so the built-in role applies again.
+type AssignCustomRoleRequest struct {
+	CustomRoleID *uuid.UUID `json:"customRoleId"`
+}
+
+// SuspendMemberRequest represents the request to suspend a member
+type SuspendMemberRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
 // WorkspaceMemberResponse represents the workspace member response
 type WorkspaceMemberResponse struct {
-	WorkspaceMemberID uuid.UUID `json:"workspaceMemberId"`
-	WorkspaceID       uuid.UUID `json:"workspaceId"`
-	UserID            uuid.UUID `json:"userId"`
-	RoleName          RoleName  `json:"roleName"`
-	IsDefault         bool      `json:"isDefault"`
-	IsActive          bool      `json:"isActive"`
-	JoinedAt          time.Time `json:"joinedAt"`
-	UpdatedAt         time.Time `json:"updatedAt"`
-	UserEmail         string    `json:"userEmail,omitempty"`
-	NickName          string    `json:"nickName,omitempty"`
-	ProfileImageUrl   string    `json:"profileImageUrl,omitempty"`
+	WorkspaceMemberID uuid.UUID    `json:"workspaceMemberId"`
+	WorkspaceID       uuid.UUID    `json:"workspaceId"`
+	UserID            uuid.UUID    `json:"userId"`
+	RoleName          RoleName     `json:"roleName"`
+	CustomRoleID      *uuid.UUID   `json:"customRoleId,omitempty"`
+	IsDefault         bool         `json:"isDefault"`
+	IsActive          bool         `json:"isActive"`
+	Status            MemberStatus `json:"status"`
+	SuspendedAt       *time.Time   `json:"suspendedAt,omitempty"`
+	SuspendReason     *string      `json:"suspendReason,omitempty"`
+	JoinedAt          time.Time    `json:"joinedAt"`
+	UpdatedAt         time.Time    `json:"updatedAt"`
+	UserEmail         string       `json:"userEmail,omitempty"`
+	NickName          string       `json:"nickName,omitempty"`
+	ProfileImageUrl   string       `json:"profileImageUrl,omitempty"`
 }
 
 // ToResponse converts WorkspaceMember to WorkspaceMemberResponse
@@ -69,8 +100,12 @@ func (m *WorkspaceMember) ToResponse() WorkspaceMemberResponse {
 		WorkspaceID:       m.WorkspaceID,
 		UserID:            m.UserID,
 		RoleName:          m.RoleName,
+		CustomRoleID:      m.CustomRoleID,
 		IsDefault:         m.IsDefault,
 		IsActive:          m.IsActive,
+		Status:            m.Status,
+		SuspendedAt:       m.SuspendedAt,
+		SuspendReason:     m.SuspendReason,
 		JoinedAt:          m.JoinedAt,
 		UpdatedAt:         m.UpdatedAt,
 	}
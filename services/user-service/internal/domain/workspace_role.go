@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceRole is a named bundle of permissions.
+// Built-in roles (OWNER/ADMIN/MEMBER) have no workspace and are seeded on startup;
+// custom roles belong to a single workspace.
+type WorkspaceRole struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"roleId"`
+	WorkspaceID *uuid.UUID `gorm:"type:uuid;index" json:"workspaceId,omitempty"` // nil이면 기본 제공 역할
+	Name        string     `gorm:"type:varchar(50);not null" json:"name"`
+	Description *string    `gorm:"type:varchar(255)" json:"description,omitempty"`
+	Permissions []string   `gorm:"type:text;serializer:json;not null" json:"permissions"`
+	IsBuiltin   bool       `gorm:"not null;default:false" json:"isBuiltin"`
+	CreatedBy   *uuid.UUID `gorm:"type:uuid" json:"createdBy,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"createdAt"`
+	UpdatedAt   time.Time  `gorm:"not null" json:"updatedAt"`
+}
+
+// TableName specifies the table name for WorkspaceRole
+func (WorkspaceRole) TableName() string {
+	return "workspace_roles"
+}
+
+// CreateWorkspaceRoleRequest represents the request to create a custom role
+type CreateWorkspaceRoleRequest struct {
+	Name        string   `json:"name" binding:"required,max=50"`
+	Description *string  `json:"description,omitempty" binding:"omitempty,max=255"`
+	Permissions []string `json:"permissions" binding:"required,min=1"`
+}
+
+// UpdateWorkspaceRoleRequest represents the request to update a custom role
+type UpdateWorkspaceRoleRequest struct {
+	Name        *string   `json:"name,omitempty" binding:"omitempty,max=50"`
+	Description *string   `json:"description,omitempty" binding:"omitempty,max=255"`
+	Permissions *[]string `json:"permissions,omitempty" binding:"omitempty,min=1"`
+}
+
+// WorkspaceRoleResponse represents a built-in or custom role
+type WorkspaceRoleResponse struct {
+	RoleID      uuid.UUID  `json:"roleId"`
+	WorkspaceID *uuid.UUID `json:"workspaceId,omitempty"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	Permissions []string   `json:"permissions"`
+	IsBuiltin   bool       `json:"isBuiltin"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// ToResponse converts WorkspaceRole to WorkspaceRoleResponse
+func (r *WorkspaceRole) ToResponse() WorkspaceRoleResponse {
+	perms := r.Permissions
+	if perms == nil {
+		perms = []string{}
+	}
+	return WorkspaceRoleResponse{
+		RoleID:      r.ID,
+		WorkspaceID: r.WorkspaceID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: perms,
+		IsBuiltin:   r.IsBuiltin,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// DeletionHandler handles deletion job HTTP requests
+type DeletionHandler struct {
+	deletionService *service.DeletionService
+}
+
+// NewDeletionHandler creates a new DeletionHandler
+func NewDeletionHandler(deletionService *service.DeletionService) *DeletionHandler {
+	return &DeletionHandler{deletionService: deletionService}
+}
+
+// RetryDeletionJob godoc
+// @Summary Retry a failed deletion job (internal)
+// @Description Resets the failed per-service purge steps so the worker retries them
+// @Tags Internal
+// @Produce json
+// @Security InternalAPIKey
+// @Param jobId path string true "Deletion job ID"
+// @Success 200 {object} domain.DeletionJobResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /internal/deletion-jobs/{jobId}/retry [post]
+func (h *DeletionHandler) RetryDeletionJob(c *gin.Context) {
+	log := getLogger(c)
+
+	jobID, err := uuid.Parse(c.Param("jobId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid job ID")
+		return
+	}
+
+	job, err := h.deletionService.RetryDeletionJob(jobID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	log.Info("Deletion job retry requested", zap.String("job.id", jobID.String()))
+	response.OK(c, job.ToResponse())
+}
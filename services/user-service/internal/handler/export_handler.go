@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// ExportHandler handles personal data export HTTP requests
+type ExportHandler struct {
+	exportService *service.ExportService
+}
+
+// NewExportHandler creates a new ExportHandler
+func NewExportHandler(exportService *service.ExportService) *ExportHandler {
+	return &ExportHandler{exportService: exportService}
+}
+
+// RequestExport godoc
+// @Summary Request a personal data export
+// @Description Starts an asynchronous export of all data of the current user across services.
+// @Description An EXPORT_READY notification is sent once the ZIP archive can be downloaded.
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 202 {object} domain.DataExportResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/export [post]
+func (h *ExportHandler) RequestExport(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		log.Warn("RequestExport user not authenticated")
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	export, err := h.exportService.RequestExport(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	log.Info("Data export requested",
+		zap.String("enduser.id", userID.String()),
+		zap.String("export.id", export.ID.String()))
+	c.JSON(http.StatusAccepted, export.ToResponse())
+}
+
+// GetLatestExport godoc
+// @Summary Get latest personal data export
+// @Description Returns the most recent export of the current user with a time-limited download URL once completed
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.DataExportResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/export [get]
+func (h *ExportHandler) GetLatestExport(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		log.Warn("GetLatestExport user not authenticated")
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	export, err := h.exportService.GetLatestExport(c.Request.Context(), userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, export)
+}
+
+// GetExport godoc
+// @Summary Get a personal data export
+// @Description Returns an export of the current user with a time-limited download URL once completed
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param exportId path string true "Export ID"
+// @Success 200 {object} domain.DataExportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/export/{exportId} [get]
+func (h *ExportHandler) GetExport(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		log.Warn("GetExport user not authenticated")
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	exportID, err := uuid.Parse(c.Param("exportId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid export ID")
+		return
+	}
+
+	export, err := h.exportService.GetExport(c.Request.Context(), userID, exportID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, export)
+}
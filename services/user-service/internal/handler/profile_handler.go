@@ -158,6 +158,37 @@ func (h *ProfileHandler) GetUserProfile(c *gin.Context) {
 	response.OK(c, profile.ToResponse())
 }
 
+// GetProfilesBatch godoc
+// @Summary Resolve many workspace profiles (internal)
+// @Description Resolves up to 100 (workspaceId, userId) pairs in one request. Pairs without a profile are omitted.
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param request body domain.BatchProfilesRequest true "Profile pairs"
+// @Success 200 {object} domain.BatchProfilesResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /internal/profiles/batch [post]
+func (h *ProfileHandler) GetProfilesBatch(c *gin.Context) {
+	var req domain.BatchProfilesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	profiles, err := h.profileService.GetProfilesBatch(req.Profiles)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	responses := make([]domain.UserProfileResponse, len(profiles))
+	for i, p := range profiles {
+		responses[i] = p.ToResponse()
+	}
+
+	response.OK(c, domain.BatchProfilesResponse{Profiles: responses})
+}
+
 // UpdateProfile godoc
 // @Summary Update my profile
 // @Tags Profiles
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"errors"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -204,6 +206,36 @@ func (h *UserHandler) DeleteMe(c *gin.Context) {
 	response.Success(c, "User deleted successfully")
 }
 
+// GetMyDeletionStatus godoc
+// @Summary Get deletion status of current user
+// @Description Returns the account deletion job with its grace period and per-service purge status
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.DeletionJobResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/deletion [get]
+func (h *UserHandler) GetMyDeletionStatus(c *gin.Context) {
+	log := getLogger(c)
+	log.Debug("GetMyDeletionStatus started")
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		log.Warn("GetMyDeletionStatus user not authenticated")
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	job, err := h.userService.GetDeletionStatus(c.Request.Context(), userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, job.ToResponse())
+}
+
 // RestoreUser godoc
 // @Summary Restore deleted user
 // @Tags Users
@@ -212,6 +244,7 @@ func (h *UserHandler) DeleteMe(c *gin.Context) {
 // @Param userId path string true "User ID"
 // @Success 200 {object} domain.UserResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Grace period has ended"
 // @Router /users/{userId}/restore [put]
 func (h *UserHandler) RestoreUser(c *gin.Context) {
 	log := getLogger(c)
@@ -229,6 +262,13 @@ func (h *UserHandler) RestoreUser(c *gin.Context) {
 
 	user, err := h.userService.RestoreUser(c.Request.Context(), userID)
 	if err != nil {
+		// 유예 기간 만료 등 서비스 에러는 해당 상태 코드로 반환
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			log.Warn("RestoreUser rejected", zap.String("enduser.id", userID.String()), zap.Error(err))
+			response.HandleError(c, err)
+			return
+		}
 		log.Debug("RestoreUser user not found", zap.String("enduser.id", userID.String()))
 		response.NotFound(c, "User not found")
 		return
@@ -246,6 +286,7 @@ func (h *UserHandler) RestoreUser(c *gin.Context) {
 // @Param request body domain.OAuthLoginRequest true "OAuth login request"
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /internal/oauth/login [post]
 func (h *UserHandler) OAuthLogin(c *gin.Context) {
@@ -261,12 +302,13 @@ func (h *UserHandler) OAuthLogin(c *gin.Context) {
 
 	log.Debug("OAuthLogin calling service",
 		zap.String("user.email", req.Email),
-		zap.String("oauth.provider", req.Provider))
+		zap.String("oauth.provider", req.Provider),
+		zap.Bool("oauth.linking", req.LinkToken != ""))
 
-	user, err := h.userService.FindOrCreateOAuthUser(c.Request.Context(), req.Email, req.Name, req.Provider)
+	user, err := h.userService.FindOrCreateOAuthUser(c.Request.Context(), req)
 	if err != nil {
-		log.Error("OAuthLogin service error", zap.Error(err))
-		response.InternalErrorWithDetails(c, "OAuth login failed", err)
+		log.Warn("OAuthLogin service error", zap.Error(err))
+		response.HandleError(c, err)
 		return
 	}
 
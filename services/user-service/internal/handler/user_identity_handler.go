@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// identityLinkBindingCookie carries the binding of a link token to the browser that requested it.
+// auth-service reads it when the OAuth flow starts and forwards it with the link token.
+const identityLinkBindingCookie = "wealist_link_binding"
+
+// UserIdentityHandler handles linked login identity HTTP requests
+type UserIdentityHandler struct {
+	identityService *service.UserIdentityService
+}
+
+// NewUserIdentityHandler creates a new UserIdentityHandler
+func NewUserIdentityHandler(identityService *service.UserIdentityService) *UserIdentityHandler {
+	return &UserIdentityHandler{identityService: identityService}
+}
+
+// GetMyIdentities godoc
+// @Summary List my logins
+// @Description Returns the OAuth provider accounts linked to the current user
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} domain.UserIdentityResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/identities [get]
+func (h *UserIdentityHandler) GetMyIdentities(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	identities, err := h.identityService.GetMyIdentities(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, identities)
+}
+
+// CreateLinkToken godoc
+// @Summary Start linking a login
+// @Description Issues a short-lived single-use token. Pass it as link_token when starting the OAuth flow
+// @Description of the provider to link; the login is then attached to the current user.
+// @Description The token only works from the same browser: it is bound to an HttpOnly cookie set by this response.
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} domain.IdentityLinkTokenResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/identities/link-token [post]
+func (h *UserIdentityHandler) CreateLinkToken(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	token, err := h.identityService.CreateLinkToken(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	// 링크 토큰을 요청한 브라우저에 바인딩 (다른 브라우저로 전달된 토큰은 사용할 수 없음)
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(identityLinkBindingCookie, token.Binding, int(time.Until(token.ExpiresAt).Seconds()), "/", "", secure, true)
+
+	response.Created(c, token)
+}
+
+// UnlinkIdentity godoc
+// @Summary Unlink a login
+// @Description Removes a linked provider account. The last login of an account cannot be removed.
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param identityId path string true "Identity ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/me/identities/{identityId} [delete]
+func (h *UserIdentityHandler) UnlinkIdentity(c *gin.Context) {
+	log := getLogger(c)
+
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	identityIDStr := c.Param("identityId")
+	identityID, err := uuid.Parse(identityIDStr)
+	if err != nil {
+		log.Warn("UnlinkIdentity invalid identity ID", zap.String("identityId", identityIDStr))
+		response.BadRequest(c, "Invalid identity ID")
+		return
+	}
+
+	if err := h.identityService.UnlinkIdentity(userID, identityID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, "Identity unlinked successfully")
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+	"user-service/internal/service"
+)
+
+// UserStatusHandler handles custom status, do-not-disturb and out-of-office HTTP requests
+type UserStatusHandler struct {
+	statusService *service.UserStatusService
+}
+
+// NewUserStatusHandler creates a new UserStatusHandler
+func NewUserStatusHandler(statusService *service.UserStatusService) *UserStatusHandler {
+	return &UserStatusHandler{statusService: statusService}
+}
+
+// GetMyStatus godoc
+// @Summary Get my availability
+// @Description Returns the custom status, do-not-disturb schedule and out-of-office period of the current user
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.UserStatusResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/status [get]
+func (h *UserStatusHandler) GetMyStatus(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	status, err := h.statusService.GetMyStatus(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, status)
+}
+
+// UpdateMyStatus godoc
+// @Summary Update my availability
+// @Description Replaces the custom status, do-not-disturb schedule and out-of-office period of the current user.
+// @Description Omitted sections are cleared.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.UpdateUserStatusRequest true "Availability"
+// @Success 200 {object} domain.UserStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/status [put]
+func (h *UserStatusHandler) UpdateMyStatus(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req domain.UpdateUserStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	status, err := h.statusService.UpdateMyStatus(userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, status)
+}
+
+// ClearMyStatus godoc
+// @Summary Clear my availability
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/status [delete]
+func (h *UserStatusHandler) ClearMyStatus(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	if err := h.statusService.ClearMyStatus(userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, "Status cleared successfully")
+}
+
+// GetUserStatus godoc
+// @Summary Get a user's availability
+// @Description Returns what applies right now: the active custom status, whether do-not-disturb is on,
+// @Description and the out-of-office period with its auto-reply. The do-not-disturb schedule stays private.
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param userId path string true "User ID"
+// @Success 200 {object} domain.UserStatusResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{userId}/status [get]
+func (h *UserStatusHandler) GetUserStatus(c *gin.Context) {
+	log := getLogger(c)
+
+	userIDStr := c.Param("userId")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		log.Warn("GetUserStatus invalid user ID", zap.String("userId", userIDStr))
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	status, err := h.statusService.GetUserStatus(userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, status)
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+)
+
+// InviteGuest godoc
+// @Summary Invite an external collaborator limited to specific projects
+// @Tags Workspace Guests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.InviteGuestRequest true "Invite guest request"
+// @Success 201 {object} domain.GuestResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/guests [post]
+func (h *WorkspaceHandler) InviteGuest(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var req domain.InviteGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	guest, err := h.workspaceService.InviteGuest(workspaceID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, guest)
+}
+
+// GetGuests godoc
+// @Summary List guests of a workspace with the projects shared with them
+// @Tags Workspace Guests
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.GuestResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/guests [get]
+func (h *WorkspaceHandler) GetGuests(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	guests, err := h.workspaceService.GetGuests(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, guests)
+}
+
+// UpdateGuestProjects godoc
+// @Summary Replace the projects shared with a guest
+// @Tags Workspace Guests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param memberId path string true "Member ID"
+// @Param request body domain.UpdateGuestProjectsRequest true "Shared projects"
+// @Success 200 {object} domain.GuestResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/guests/{memberId}/projects [put]
+func (h *WorkspaceHandler) UpdateGuestProjects(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid member ID")
+		return
+	}
+
+	var req domain.UpdateGuestProjectsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	guest, err := h.workspaceService.UpdateGuestProjects(workspaceID, memberID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, guest)
+}
@@ -264,9 +264,17 @@ func (h *WorkspaceHandler) SetDefaultWorkspace(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param workspaceId path string true "Workspace ID"
+// @Param includeSuspended query bool false "Include suspended members"
 // @Success 200 {array} domain.WorkspaceMemberResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /workspaces/{workspaceId}/members [get]
 func (h *WorkspaceHandler) GetMembers(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
 	workspaceIDStr := c.Param("workspaceId")
 	workspaceID, err := uuid.Parse(workspaceIDStr)
 	if err != nil {
@@ -275,9 +283,10 @@ func (h *WorkspaceHandler) GetMembers(c *gin.Context) {
 	}
 
 	// Use GetMembersWithProfiles to include nickName and profileImageUrl
-	responses, err := h.workspaceService.GetMembersWithProfiles(workspaceID)
+	includeSuspended := c.Query("includeSuspended") == "true"
+	responses, err := h.workspaceService.GetMembersWithProfiles(workspaceID, userID, includeSuspended)
 	if err != nil {
-		response.InternalErrorWithDetails(c, "Failed to fetch members", err)
+		response.HandleError(c, err)
 		return
 	}
 
@@ -414,6 +423,95 @@ func (h *WorkspaceHandler) RemoveMember(c *gin.Context) {
 	response.Success(c, "Member removed successfully")
 }
 
+// SuspendMember godoc
+// @Summary Suspend workspace member
+// @Description Revokes the member's access while keeping the membership and profile, so authored content still resolves
+// @Tags Workspace Members
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param memberId path string true "Member ID"
+// @Param request body domain.SuspendMemberRequest false "Suspend member request"
+// @Success 200 {object} domain.WorkspaceMemberResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/members/{memberId}/deactivate [post]
+func (h *WorkspaceHandler) SuspendMember(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid member ID")
+		return
+	}
+
+	var req domain.SuspendMemberRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.ValidationError(c, err.Error())
+			return
+		}
+	}
+
+	member, err := h.workspaceService.SuspendMember(workspaceID, memberID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, member.ToResponse())
+}
+
+// ReactivateMember godoc
+// @Summary Reactivate suspended workspace member
+// @Tags Workspace Members
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param memberId path string true "Member ID"
+// @Success 200 {object} domain.WorkspaceMemberResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/members/{memberId}/reactivate [post]
+func (h *WorkspaceHandler) ReactivateMember(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid member ID")
+		return
+	}
+
+	member, err := h.workspaceService.ReactivateMember(workspaceID, memberID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, member.ToResponse())
+}
+
 // ValidateMember godoc
 // @Summary Validate user has access to workspace
 // @Tags Workspace Members
@@ -421,7 +519,7 @@ func (h *WorkspaceHandler) RemoveMember(c *gin.Context) {
 // @Security BearerAuth
 // @Param workspaceId path string true "Workspace ID"
 // @Param userId path string true "User ID"
-// @Success 200 {object} map[string]bool
+// @Success 200 {object} domain.MemberAccessResponse
 // @Router /workspaces/{workspaceId}/validate-member/{userId} [get]
 func (h *WorkspaceHandler) ValidateMember(c *gin.Context) {
 	workspaceIDStr := c.Param("workspaceId")
@@ -438,13 +536,13 @@ func (h *WorkspaceHandler) ValidateMember(c *gin.Context) {
 		return
 	}
 
-	isMember, err := h.workspaceService.ValidateMemberAccess(workspaceID, userID)
+	access, err := h.workspaceService.ValidateMemberAccess(workspaceID, userID)
 	if err != nil {
 		response.InternalErrorWithDetails(c, "Validation failed", err)
 		return
 	}
 
-	response.OK(c, gin.H{"isMember": isMember})
+	response.OK(c, access)
 }
 
 // CreateJoinRequest godoc
@@ -573,6 +671,12 @@ func (h *WorkspaceHandler) ProcessJoinRequest(c *gin.Context) {
 // @Success 200 {object} domain.WorkspaceSettingsResponse
 // @Router /workspaces/{workspaceId}/settings [get]
 func (h *WorkspaceHandler) GetWorkspaceSettings(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
 	workspaceIDStr := c.Param("workspaceId")
 	workspaceID, err := uuid.Parse(workspaceIDStr)
 	if err != nil {
@@ -580,13 +684,14 @@ func (h *WorkspaceHandler) GetWorkspaceSettings(c *gin.Context) {
 		return
 	}
 
-	workspace, err := h.workspaceService.GetWorkspace(workspaceID)
+	// 이메일 도메인과 초대 링크는 소유자/관리자에게만 포함
+	settings, err := h.workspaceService.GetWorkspaceSettings(workspaceID, userID)
 	if err != nil {
-		response.NotFound(c, "Workspace not found")
+		response.HandleError(c, err)
 		return
 	}
 
-	response.OK(c, workspace.ToSettingsResponse())
+	response.OK(c, settings)
 }
 
 // UpdateWorkspaceSettings godoc
@@ -619,12 +724,228 @@ func (h *WorkspaceHandler) UpdateWorkspaceSettings(c *gin.Context) {
 		return
 	}
 
-	workspace, err := h.workspaceService.UpdateWorkspaceSettings(workspaceID, userID, req)
-	if err != nil {
+	if _, err := h.workspaceService.UpdateWorkspaceSettings(workspaceID, userID, req); err != nil {
 		// 서비스 에러(Forbidden 등)를 자동으로 적절한 HTTP 상태 코드로 변환
 		response.HandleError(c, err)
 		return
 	}
 
-	response.OK(c, workspace.ToSettingsResponse())
+	settings, err := h.workspaceService.GetWorkspaceSettings(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, settings)
+}
+
+// GetInviteLink godoc
+// @Summary Preview the workspace of an invite link
+// @Tags Workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param token path string true "Invite link token"
+// @Success 200 {object} domain.InviteLinkPreviewResponse
+// @Router /workspaces/invite-links/{token} [get]
+func (h *WorkspaceHandler) GetInviteLink(c *gin.Context) {
+	preview, err := h.workspaceService.GetInviteLinkPreview(c.Param("token"))
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, preview)
+}
+
+// JoinByInviteLink godoc
+// @Summary Join a workspace through an invite link
+// @Tags Workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param token path string true "Invite link token"
+// @Success 201 {object} domain.WorkspaceMemberResponse
+// @Router /workspaces/invite-links/{token}/join [post]
+func (h *WorkspaceHandler) JoinByInviteLink(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	member, err := h.workspaceService.JoinByInviteLink(c.Param("token"), userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, member.ToResponse())
+}
+
+// TransferOwnership godoc
+// @Summary Nominate a new workspace owner
+// @Description The current owner nominates an active member. The transfer completes when the nominee accepts.
+// @Tags Workspace Members
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.TransferOwnershipRequest true "Transfer ownership request"
+// @Success 201 {object} domain.OwnershipTransferResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/transfer-ownership [post]
+func (h *WorkspaceHandler) TransferOwnership(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceIDStr := c.Param("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var req domain.TransferOwnershipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	transfer, err := h.workspaceService.TransferOwnership(workspaceID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, transfer.ToResponse())
+}
+
+// GetOwnershipTransfer godoc
+// @Summary Get pending ownership transfer
+// @Tags Workspace Members
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.OwnershipTransferResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/transfer-ownership [get]
+func (h *WorkspaceHandler) GetOwnershipTransfer(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceIDStr := c.Param("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	transfer, err := h.workspaceService.GetPendingOwnershipTransfer(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, transfer.ToResponse())
+}
+
+// AcceptOwnershipTransfer godoc
+// @Summary Accept ownership transfer
+// @Description The nominee accepts; the nominee becomes OWNER and the previous owner becomes ADMIN.
+// @Tags Workspace Members
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} domain.OwnershipTransferResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/transfer-ownership/accept [post]
+func (h *WorkspaceHandler) AcceptOwnershipTransfer(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceIDStr := c.Param("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	transfer, err := h.workspaceService.AcceptOwnershipTransfer(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, transfer.ToResponse())
+}
+
+// DeclineOwnershipTransfer godoc
+// @Summary Decline ownership transfer
+// @Tags Workspace Members
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/transfer-ownership/decline [post]
+func (h *WorkspaceHandler) DeclineOwnershipTransfer(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceIDStr := c.Param("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if err := h.workspaceService.DeclineOwnershipTransfer(workspaceID, userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, "Ownership transfer declined")
+}
+
+// CancelOwnershipTransfer godoc
+// @Summary Cancel pending ownership transfer
+// @Tags Workspace Members
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/transfer-ownership [delete]
+func (h *WorkspaceHandler) CancelOwnershipTransfer(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceIDStr := c.Param("workspaceId")
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	if err := h.workspaceService.CancelOwnershipTransfer(workspaceID, userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, "Ownership transfer cancelled")
 }
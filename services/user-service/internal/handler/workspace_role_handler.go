@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"user-service/internal/domain"
+	"user-service/internal/middleware"
+	"user-service/internal/response"
+)
+
+// GetPermissionDefinitions godoc
+// @Summary List permissions that can be bundled into custom roles
+// @Tags Workspace Roles
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} permission.Definition
+// @Router /workspaces/permissions [get]
+func (h *WorkspaceHandler) GetPermissionDefinitions(c *gin.Context) {
+	response.OK(c, h.workspaceService.GetPermissionDefinitions())
+}
+
+// GetMyPermissions godoc
+// @Summary Get my effective permissions in a workspace
+// @Tags Workspace Roles
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {object} permission.WorkspacePermissions
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/permissions/me [get]
+func (h *WorkspaceHandler) GetMyPermissions(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	perms, err := h.workspaceService.GetMemberPermissions(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, perms)
+}
+
+// GetMemberPermissions godoc
+// @Summary Get the effective permissions of a workspace member (internal)
+// @Description Used by other services through permission.RemoteChecker
+// @Tags Internal
+// @Produce json
+// @Param workspaceId path string true "Workspace ID"
+// @Param userId path string true "User ID"
+// @Success 200 {object} permission.WorkspacePermissions
+// @Failure 404 {object} ErrorResponse
+// @Router /internal/workspaces/{workspaceId}/users/{userId}/permissions [get]
+func (h *WorkspaceHandler) GetMemberPermissions(c *gin.Context) {
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	perms, err := h.workspaceService.GetMemberPermissions(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, perms)
+}
+
+// GetRoles godoc
+// @Summary List built-in and custom roles of a workspace
+// @Tags Workspace Roles
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Success 200 {array} domain.WorkspaceRoleResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/roles [get]
+func (h *WorkspaceHandler) GetRoles(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	roles, err := h.workspaceService.GetRoles(workspaceID, userID)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, roles)
+}
+
+// CreateRole godoc
+// @Summary Create a custom workspace role
+// @Tags Workspace Roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param request body domain.CreateWorkspaceRoleRequest true "Create role request"
+// @Success 201 {object} domain.WorkspaceRoleResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/roles [post]
+func (h *WorkspaceHandler) CreateRole(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	var req domain.CreateWorkspaceRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	role, err := h.workspaceService.CreateRole(workspaceID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Created(c, role.ToResponse())
+}
+
+// UpdateRole godoc
+// @Summary Update a custom workspace role
+// @Tags Workspace Roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param roleId path string true "Role ID"
+// @Param request body domain.UpdateWorkspaceRoleRequest true "Update role request"
+// @Success 200 {object} domain.WorkspaceRoleResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/roles/{roleId} [put]
+func (h *WorkspaceHandler) UpdateRole(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	roleID, err := uuid.Parse(c.Param("roleId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid role ID")
+		return
+	}
+
+	var req domain.UpdateWorkspaceRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	role, err := h.workspaceService.UpdateRole(workspaceID, roleID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, role.ToResponse())
+}
+
+// DeleteRole godoc
+// @Summary Delete a custom workspace role
+// @Description Members holding the role fall back to their built-in role
+// @Tags Workspace Roles
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param roleId path string true "Role ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/roles/{roleId} [delete]
+func (h *WorkspaceHandler) DeleteRole(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	roleID, err := uuid.Parse(c.Param("roleId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid role ID")
+		return
+	}
+
+	if err := h.workspaceService.DeleteRole(workspaceID, roleID, userID); err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.Success(c, "Role deleted successfully")
+}
+
+// AssignCustomRole godoc
+// @Summary Assign or clear the custom role of a workspace member
+// @Tags Workspace Roles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param workspaceId path string true "Workspace ID"
+// @Param memberId path string true "Member ID"
+// @Param request body domain.AssignCustomRoleRequest true "Custom role (null clears it)"
+// @Success 200 {object} domain.WorkspaceMemberResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /workspaces/{workspaceId}/members/{memberId}/custom-role [put]
+func (h *WorkspaceHandler) AssignCustomRole(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	workspaceID, err := uuid.Parse(c.Param("workspaceId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid workspace ID")
+		return
+	}
+
+	memberID, err := uuid.Parse(c.Param("memberId"))
+	if err != nil {
+		response.BadRequest(c, "Invalid member ID")
+		return
+	}
+
+	var req domain.AssignCustomRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationError(c, err.Error())
+		return
+	}
+
+	member, err := h.workspaceService.AssignCustomRole(workspaceID, memberID, userID, req)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, member.ToResponse())
+}
@@ -0,0 +1,72 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// batchWorker polls a processor and drains its due work in batches.
+// The processor claims its work in the database, so every service instance can run a worker.
+type batchWorker struct {
+	name       string // 로그에 표시되는 작업 이름
+	processDue func(ctx context.Context, limit int) (int, error)
+	interval   time.Duration
+	batchSize  int
+	logger     *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Start runs the worker loop in the background until Stop is called
+func (w *batchWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.drain(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the worker to stop and waits for the current batch to finish
+func (w *batchWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+}
+
+// drain processes batches until no due work remains
+func (w *batchWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := w.processDue(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to process "+w.name, zap.Error(err))
+			return
+		}
+		if claimed > 0 {
+			w.logger.Debug("Batch processed", zap.String("worker", w.name), zap.Int("claimed", claimed))
+		}
+		// 배치가 가득 차지 않았으면 대기 중인 작업이 더 없음
+		if claimed < w.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Package job provides background job implementations.
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DeletionProcessor runs the deletion jobs whose grace period has ended
+type DeletionProcessor interface {
+	ProcessDue(ctx context.Context, limit int) (int, error)
+}
+
+// DeletionWorker polls for due deletion jobs and purges them in batches.
+// Jobs are claimed in the database, so every service instance can run a worker.
+type DeletionWorker struct {
+	batchWorker
+}
+
+// NewDeletionWorker creates a new DeletionWorker instance
+func NewDeletionWorker(processor DeletionProcessor, interval time.Duration, batchSize int, logger *zap.Logger) *DeletionWorker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if batchSize <= 0 {
+		batchSize = 10
+	}
+	return &DeletionWorker{batchWorker{
+		name:       "deletion jobs",
+		processDue: processor.ProcessDue,
+		interval:   interval,
+		batchSize:  batchSize,
+		logger:     logger,
+	}}
+}
@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockDeletionProcessor is a mock implementation of DeletionProcessor
+type MockDeletionProcessor struct {
+	mock.Mock
+}
+
+func (m *MockDeletionProcessor) ProcessDue(ctx context.Context, limit int) (int, error) {
+	args := m.Called(ctx, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func TestDeletionWorker_DrainUntilBatchNotFull(t *testing.T) {
+	processor := new(MockDeletionProcessor)
+	processor.On("ProcessDue", mock.Anything, 3).Return(3, nil).Once()
+	processor.On("ProcessDue", mock.Anything, 3).Return(1, nil).Once()
+
+	worker := NewDeletionWorker(processor, time.Minute, 3, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessDue", 2)
+}
+
+func TestDeletionWorker_DrainStopsOnError(t *testing.T) {
+	processor := new(MockDeletionProcessor)
+	processor.On("ProcessDue", mock.Anything, 10).Return(0, errors.New("db down")).Once()
+
+	worker := NewDeletionWorker(processor, 0, 0, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessDue", 1)
+}
+
+func TestDeletionWorker_StartStop(t *testing.T) {
+	processor := new(MockDeletionProcessor)
+	processor.On("ProcessDue", mock.Anything, 10).Return(0, nil)
+
+	worker := NewDeletionWorker(processor, 10*time.Millisecond, 10, zap.NewNop())
+	worker.Start()
+	time.Sleep(30 * time.Millisecond)
+	worker.Stop()
+
+	assert.GreaterOrEqual(t, len(processor.Calls), 1)
+}
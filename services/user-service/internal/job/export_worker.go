@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ExportProcessor runs the pending personal data exports
+type ExportProcessor interface {
+	ProcessDue(ctx context.Context, limit int) (int, error)
+}
+
+// ExportWorker polls for pending data exports and builds their archives in batches.
+// Exports are claimed in the database, so every service instance can run a worker.
+type ExportWorker struct {
+	batchWorker
+}
+
+// NewExportWorker creates a new ExportWorker instance
+func NewExportWorker(processor ExportProcessor, interval time.Duration, batchSize int, logger *zap.Logger) *ExportWorker {
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	if batchSize <= 0 {
+		batchSize = 5
+	}
+	return &ExportWorker{batchWorker{
+		name:       "data exports",
+		processDue: processor.ProcessDue,
+		interval:   interval,
+		batchSize:  batchSize,
+		logger:     logger,
+	}}
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockExportProcessor is a mock implementation of ExportProcessor
+type MockExportProcessor struct {
+	mock.Mock
+}
+
+func (m *MockExportProcessor) ProcessDue(ctx context.Context, limit int) (int, error) {
+	args := m.Called(ctx, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func TestExportWorker_DrainUntilBatchNotFull(t *testing.T) {
+	processor := new(MockExportProcessor)
+	processor.On("ProcessDue", mock.Anything, 2).Return(2, nil).Once()
+	processor.On("ProcessDue", mock.Anything, 2).Return(0, nil).Once()
+
+	worker := NewExportWorker(processor, time.Minute, 2, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessDue", 2)
+}
+
+func TestExportWorker_DrainStopsOnError(t *testing.T) {
+	processor := new(MockExportProcessor)
+	processor.On("ProcessDue", mock.Anything, 5).Return(0, errors.New("storage down")).Once()
+
+	worker := NewExportWorker(processor, 0, 0, zap.NewNop())
+	worker.drain(context.Background())
+
+	processor.AssertNumberOfCalls(t, "ProcessDue", 1)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// AuditLogRepository handles workspace audit log data access
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new AuditLogRepository
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Create creates a new audit log entry
+func (r *AuditLogRepository) Create(log *domain.WorkspaceAuditLog) error {
+	return r.db.Create(log).Error
+}
+
+// FindByWorkspace finds audit log entries of a workspace, newest first
+func (r *AuditLogRepository) FindByWorkspace(workspaceID uuid.UUID, limit int) ([]domain.WorkspaceAuditLog, error) {
+	var logs []domain.WorkspaceAuditLog
+	err := r.db.Where("workspace_id = ?", workspaceID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&logs).Error
+	return logs, err
+}
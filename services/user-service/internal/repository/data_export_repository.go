@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// activeExportStatuses are the statuses of an export that has not finished yet
+var activeExportStatuses = []domain.DataExportStatus{
+	domain.DataExportStatusPending,
+	domain.DataExportStatusProcessing,
+}
+
+// DataExportRepository handles data export data access
+type DataExportRepository struct {
+	db *gorm.DB
+}
+
+// NewDataExportRepository creates a new DataExportRepository
+func NewDataExportRepository(db *gorm.DB) *DataExportRepository {
+	return &DataExportRepository{db: db}
+}
+
+// Create creates a data export
+func (r *DataExportRepository) Create(export *domain.DataExport) error {
+	return r.db.Create(export).Error
+}
+
+// FindByID finds a data export by ID
+func (r *DataExportRepository) FindByID(id uuid.UUID) (*domain.DataExport, error) {
+	var export domain.DataExport
+	err := r.db.Where("id = ?", id).First(&export).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// FindByIDAndUser finds a data export requested by the given user
+func (r *DataExportRepository) FindByIDAndUser(id, userID uuid.UUID) (*domain.DataExport, error) {
+	var export domain.DataExport
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&export).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// FindLatestByUser finds the most recent data export of a user
+func (r *DataExportRepository) FindLatestByUser(userID uuid.UUID) (*domain.DataExport, error) {
+	var export domain.DataExport
+	err := r.db.Where("user_id = ?", userID).
+		Order("created_at DESC").
+		First(&export).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// FindActiveByUser finds the unfinished data export of a user
+func (r *DataExportRepository) FindActiveByUser(userID uuid.UUID) (*domain.DataExport, error) {
+	var export domain.DataExport
+	err := r.db.Where("user_id = ? AND status IN ?", userID, activeExportStatuses).
+		Order("created_at DESC").
+		First(&export).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// FindDueIDs finds unlocked unfinished exports, oldest first
+func (r *DataExportRepository) FindDueIDs(now time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&domain.DataExport{}).
+		Where("status IN ?", activeExportStatuses).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Order("created_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Claim leases an unfinished export to the caller, moves it to PROCESSING and counts the attempt.
+// It returns false if another instance claimed the export first.
+func (r *DataExportRepository) Claim(id uuid.UUID, now time.Time, lease time.Duration) (bool, error) {
+	result := r.db.Model(&domain.DataExport{}).
+		Where("id = ? AND status IN ?", id, activeExportStatuses).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Updates(map[string]interface{}{
+			"status":       domain.DataExportStatusProcessing,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"locked_until": now.Add(lease),
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// MarkCompleted records the uploaded archive and releases the lease
+func (r *DataExportRepository) MarkCompleted(id uuid.UUID, objectKey string, fileSize int64, now, expiresAt time.Time) error {
+	return r.db.Model(&domain.DataExport{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       domain.DataExportStatusCompleted,
+			"object_key":   objectKey,
+			"file_size":    fileSize,
+			"last_error":   nil,
+			"locked_until": nil,
+			"completed_at": now,
+			"expires_at":   expiresAt,
+			"updated_at":   now,
+		}).Error
+}
+
+// MarkFailed records a failed attempt. A non-nil retryAt keeps the export pending
+// and locked until the retry is due; otherwise the export fails for good.
+func (r *DataExportRepository) MarkFailed(id uuid.UUID, lastError string, now time.Time, retryAt *time.Time) error {
+	status := domain.DataExportStatusFailed
+	if retryAt != nil {
+		status = domain.DataExportStatusPending
+	}
+	return r.db.Model(&domain.DataExport{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"last_error":   lastError,
+			"locked_until": retryAt,
+			"updated_at":   now,
+		}).Error
+}
+
+// FindExpired finds completed exports whose download period has ended
+func (r *DataExportRepository) FindExpired(now time.Time, limit int) ([]domain.DataExport, error) {
+	var exports []domain.DataExport
+	err := r.db.Where("status = ? AND expires_at <= ?", domain.DataExportStatusCompleted, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&exports).Error
+	return exports, err
+}
+
+// MarkExpired marks an export whose archive has been removed from storage
+func (r *DataExportRepository) MarkExpired(id uuid.UUID, now time.Time) error {
+	return r.db.Model(&domain.DataExport{}).
+		Where("id = ? AND status = ?", id, domain.DataExportStatusCompleted).
+		Updates(map[string]interface{}{
+			"status":     domain.DataExportStatusExpired,
+			"object_key": nil,
+			"updated_at": now,
+		}).Error
+}
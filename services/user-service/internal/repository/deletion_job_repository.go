@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// ErrDeletionJobStateChanged is returned when a deletion job is no longer in the expected state
+var ErrDeletionJobStateChanged = errors.New("deletion job state changed")
+
+// activeDeletionStatuses are the statuses of a job that has not finished yet
+var activeDeletionStatuses = []domain.DeletionJobStatus{
+	domain.DeletionJobStatusScheduled,
+	domain.DeletionJobStatusInProgress,
+}
+
+// DeletionJobRepository handles deletion job data access
+type DeletionJobRepository struct {
+	db *gorm.DB
+}
+
+// NewDeletionJobRepository creates a new DeletionJobRepository
+func NewDeletionJobRepository(db *gorm.DB) *DeletionJobRepository {
+	return &DeletionJobRepository{db: db}
+}
+
+// Create creates a deletion job together with its steps
+func (r *DeletionJobRepository) Create(job *domain.DeletionJob) error {
+	return r.db.Create(job).Error
+}
+
+// FindByID finds a deletion job with its steps
+func (r *DeletionJobRepository) FindByID(id uuid.UUID) (*domain.DeletionJob, error) {
+	var job domain.DeletionJob
+	err := r.db.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("service ASC")
+	}).Where("id = ?", id).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindLatestByResource finds the most recent deletion job of a resource
+func (r *DeletionJobRepository) FindLatestByResource(resourceType domain.DeletionResourceType, resourceID uuid.UUID) (*domain.DeletionJob, error) {
+	var job domain.DeletionJob
+	err := r.db.Preload("Steps", func(db *gorm.DB) *gorm.DB {
+		return db.Order("service ASC")
+	}).Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).
+		Order("created_at DESC").
+		First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindActiveByResource finds the unfinished deletion job of a resource
+func (r *DeletionJobRepository) FindActiveByResource(resourceType domain.DeletionResourceType, resourceID uuid.UUID) (*domain.DeletionJob, error) {
+	var job domain.DeletionJob
+	err := r.db.Where("resource_type = ? AND resource_id = ? AND status IN ?", resourceType, resourceID, activeDeletionStatuses).
+		Order("created_at DESC").
+		First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// RestoreUser restores a soft deleted user and cancels its scheduled deletion job, if any, in one transaction.
+// It returns ErrDeletionJobStateChanged if the job left its grace period in the meantime.
+func (r *DeletionJobRepository) RestoreUser(jobID *uuid.UUID, userID uuid.UUID, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if jobID != nil {
+			if err := cancelJob(tx, *jobID, now); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&domain.User{}).
+			Where("id = ?", userID).
+			Updates(map[string]interface{}{
+				"is_active":  true,
+				"deleted_at": nil,
+			}).Error
+	})
+}
+
+// cancelJob cancels a scheduled job whose grace period has not ended
+func cancelJob(tx *gorm.DB, id uuid.UUID, now time.Time) error {
+	result := tx.Model(&domain.DeletionJob{}).
+		Where("id = ? AND status = ? AND purge_after > ?", id, domain.DeletionJobStatusScheduled, now).
+		Updates(map[string]interface{}{
+			"status":     domain.DeletionJobStatusCancelled,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrDeletionJobStateChanged
+	}
+	return nil
+}
+
+// FindDueIDs finds unlocked jobs whose grace period has ended, oldest first
+func (r *DeletionJobRepository) FindDueIDs(now time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&domain.DeletionJob{}).
+		Where("status IN ? AND purge_after <= ?", activeDeletionStatuses, now).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Order("purge_after ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Claim leases a due job to the caller and moves it to IN_PROGRESS.
+// It returns false if another instance claimed the job first.
+func (r *DeletionJobRepository) Claim(id uuid.UUID, now time.Time, lease time.Duration) (bool, error) {
+	result := r.db.Model(&domain.DeletionJob{}).
+		Where("id = ? AND status IN ? AND purge_after <= ?", id, activeDeletionStatuses, now).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Updates(map[string]interface{}{
+			"status":       domain.DeletionJobStatusInProgress,
+			"locked_until": now.Add(lease),
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateStep saves the result of a purge attempt
+func (r *DeletionJobRepository) UpdateStep(step *domain.DeletionJobStep) error {
+	return r.db.Model(&domain.DeletionJobStep{}).
+		Where("id = ?", step.ID).
+		Updates(map[string]interface{}{
+			"status":          step.Status,
+			"attempts":        step.Attempts,
+			"last_error":      step.LastError,
+			"next_attempt_at": step.NextAttemptAt,
+			"completed_at":    step.CompletedAt,
+			"updated_at":      step.UpdatedAt,
+		}).Error
+}
+
+// Release sets the job status and releases the lease.
+// A non-nil notBefore keeps the job locked until the next retry is due.
+func (r *DeletionJobRepository) Release(id uuid.UUID, status domain.DeletionJobStatus, notBefore *time.Time) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":       status,
+		"locked_until": notBefore,
+		"updated_at":   now,
+	}
+	if status == domain.DeletionJobStatusCompleted {
+		updates["completed_at"] = now
+	}
+	return r.db.Model(&domain.DeletionJob{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// RetryFailed resets the failed steps of a failed job so the worker picks it up again
+func (r *DeletionJobRepository) RetryFailed(id uuid.UUID, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.DeletionJob{}).
+			Where("id = ? AND status = ?", id, domain.DeletionJobStatusFailed).
+			Updates(map[string]interface{}{
+				"status":       domain.DeletionJobStatusInProgress,
+				"locked_until": nil,
+				"updated_at":   now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrDeletionJobStateChanged
+		}
+
+		return tx.Model(&domain.DeletionJobStep{}).
+			Where("job_id = ? AND status = ?", id, domain.DeletionStepStatusFailed).
+			Updates(map[string]interface{}{
+				"status":          domain.DeletionStepStatusPending,
+				"attempts":        0,
+				"next_attempt_at": now,
+				"updated_at":      now,
+			}).Error
+	})
+}
+
+// purgeStep is a single hard delete executed inside a purge transaction
+type purgeStep struct {
+	model interface{}
+	query string
+	args  []interface{}
+}
+
+// runPurgeSteps executes the steps in order and stops at the first error
+func runPurgeSteps(tx *gorm.DB, steps []purgeStep) error {
+	for _, step := range steps {
+		if err := tx.Where(step.query, step.args...).Delete(step.model).Error; err != nil {
+			return fmt.Errorf("failed to purge %T: %w", step.model, err)
+		}
+	}
+	return nil
+}
+
+// PurgeWorkspaceData hard deletes the user-service rows of a deleted workspace
+func (r *DeletionJobRepository) PurgeWorkspaceData(workspaceID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return runPurgeSteps(tx, []purgeStep{
+			{&domain.WorkspaceJoinRequest{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.WorkspaceOwnershipTransfer{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.WorkspaceAuditLog{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.WorkspaceGuestProject{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.UserProfile{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.WorkspaceMember{}, "workspace_id = ?", []interface{}{workspaceID}},
+			{&domain.Workspace{}, "id = ? AND deleted_at IS NOT NULL", []interface{}{workspaceID}},
+		})
+	})
+}
+
+// PurgeUserData hard deletes the memberships, profiles and requests of a deleted user
+// and anonymizes the user row, which stays referenced by workspaces and audit logs.
+func (r *DeletionJobRepository) PurgeUserData(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := runPurgeSteps(tx, []purgeStep{
+			{&domain.WorkspaceJoinRequest{}, "user_id = ?", []interface{}{userID}},
+			{&domain.WorkspaceOwnershipTransfer{}, "status = ? AND (from_user_id = ? OR to_user_id = ?)",
+				[]interface{}{domain.TransferStatusPending, userID, userID}},
+			{&domain.WorkspaceGuestProject{}, "user_id = ?", []interface{}{userID}},
+			{&domain.UserStatus{}, "user_id = ?", []interface{}{userID}},
+			{&domain.UserIdentity{}, "user_id = ?", []interface{}{userID}},
+			{&domain.IdentityLinkToken{}, "user_id = ?", []interface{}{userID}},
+			{&domain.UserProfile{}, "user_id = ?", []interface{}{userID}},
+			{&domain.WorkspaceMember{}, "user_id = ?", []interface{}{userID}},
+		})
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&domain.User{}).
+			Where("id = ? AND deleted_at IS NOT NULL", userID).
+			Updates(map[string]interface{}{
+				"email":      fmt.Sprintf("deleted-%s@wealist.invalid", userID),
+				"name":       "",
+				"google_id":  nil,
+				"is_active":  false,
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrDeletionJobStateChanged
+		}
+		return nil
+	})
+}
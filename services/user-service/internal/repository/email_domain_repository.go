@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// EmailDomainRepository handles workspace email domain data access
+type EmailDomainRepository struct {
+	db *gorm.DB
+}
+
+// NewEmailDomainRepository creates a new EmailDomainRepository
+func NewEmailDomainRepository(db *gorm.DB) *EmailDomainRepository {
+	return &EmailDomainRepository{db: db}
+}
+
+// FindByWorkspace finds the email domains of a workspace
+func (r *EmailDomainRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceEmailDomain, error) {
+	var domains []domain.WorkspaceEmailDomain
+	err := r.db.Where("workspace_id = ?", workspaceID).Order("domain ASC").Find(&domains).Error
+	return domains, err
+}
+
+// FindByDomain finds the entries of an email domain in active workspaces
+func (r *EmailDomainRepository) FindByDomain(emailDomain string) ([]domain.WorkspaceEmailDomain, error) {
+	var domains []domain.WorkspaceEmailDomain
+	err := r.db.
+		Joins("JOIN workspaces ON workspaces.id = workspace_email_domains.workspace_id AND workspaces.is_active = true AND workspaces.deleted_at IS NULL").
+		Where("workspace_email_domains.domain = ?", emailDomain).
+		Find(&domains).Error
+	return domains, err
+}
+
+// ReplaceForWorkspace replaces all email domains of a workspace
+func (r *EmailDomainRepository) ReplaceForWorkspace(workspaceID uuid.UUID, domains []domain.WorkspaceEmailDomain) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_id = ?", workspaceID).Delete(&domain.WorkspaceEmailDomain{}).Error; err != nil {
+			return err
+		}
+		if len(domains) == 0 {
+			return nil
+		}
+		return tx.Create(&domains).Error
+	})
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// GuestProjectRepository handles the projects shared with workspace guests
+type GuestProjectRepository struct {
+	db *gorm.DB
+}
+
+// NewGuestProjectRepository creates a new GuestProjectRepository
+func NewGuestProjectRepository(db *gorm.DB) *GuestProjectRepository {
+	return &GuestProjectRepository{db: db}
+}
+
+// FindByGuest finds the projects shared with a guest in a workspace
+func (r *GuestProjectRepository) FindByGuest(workspaceID, userID uuid.UUID) ([]domain.WorkspaceGuestProject, error) {
+	var grants []domain.WorkspaceGuestProject
+	err := r.db.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Order("created_at ASC").
+		Find(&grants).Error
+	return grants, err
+}
+
+// FindByWorkspace finds the projects shared with every guest of a workspace
+func (r *GuestProjectRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceGuestProject, error) {
+	var grants []domain.WorkspaceGuestProject
+	err := r.db.Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&grants).Error
+	return grants, err
+}
+
+// ReplaceForGuest replaces all projects shared with a guest
+func (r *GuestProjectRepository) ReplaceForGuest(workspaceID, userID uuid.UUID, grants []domain.WorkspaceGuestProject) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+			Delete(&domain.WorkspaceGuestProject{}).Error; err != nil {
+			return err
+		}
+		if len(grants) == 0 {
+			return nil
+		}
+		return tx.Create(&grants).Error
+	})
+}
+
+// DeleteByGuest removes all projects shared with a guest
+func (r *GuestProjectRepository) DeleteByGuest(workspaceID, userID uuid.UUID) error {
+	return r.db.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Delete(&domain.WorkspaceGuestProject{}).Error
+}
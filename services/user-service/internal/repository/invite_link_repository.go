@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// ErrInviteLinkUnusable is returned when a link was revoked, expired or used up before it could be used
+var ErrInviteLinkUnusable = errors.New("invite link is no longer usable")
+
+// InviteLinkRepository handles workspace invite link data access
+type InviteLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewInviteLinkRepository creates a new InviteLinkRepository
+func NewInviteLinkRepository(db *gorm.DB) *InviteLinkRepository {
+	return &InviteLinkRepository{db: db}
+}
+
+// Create creates an invite link
+func (r *InviteLinkRepository) Create(link *domain.WorkspaceInviteLink) error {
+	return r.db.Create(link).Error
+}
+
+// FindByToken finds an invite link by its token
+func (r *InviteLinkRepository) FindByToken(token string) (*domain.WorkspaceInviteLink, error) {
+	var link domain.WorkspaceInviteLink
+	err := r.db.Where("token = ?", token).First(&link).Error
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// FindUsableByWorkspace finds the links of a workspace that are not revoked, expired or used up
+func (r *InviteLinkRepository) FindUsableByWorkspace(workspaceID uuid.UUID, now time.Time) ([]domain.WorkspaceInviteLink, error) {
+	var links []domain.WorkspaceInviteLink
+	err := r.db.Where("workspace_id = ? AND revoked_at IS NULL", workspaceID).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Where("max_uses IS NULL OR use_count < max_uses").
+		Order("created_at DESC").
+		Find(&links).Error
+	return links, err
+}
+
+// Revoke revokes links of a workspace
+func (r *InviteLinkRepository) Revoke(workspaceID uuid.UUID, ids []uuid.UUID, now time.Time) error {
+	return r.db.Model(&domain.WorkspaceInviteLink{}).
+		Where("workspace_id = ? AND id IN ? AND revoked_at IS NULL", workspaceID, ids).
+		Update("revoked_at", now).Error
+}
+
+// UseForMember counts a use of a link and adds the member it was used for in one transaction,
+// so a failed join does not use up the link. It returns ErrInviteLinkUnusable if the link was revoked,
+// expired or used up in the meantime.
+func (r *InviteLinkRepository) UseForMember(id uuid.UUID, member *domain.WorkspaceMember, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.WorkspaceInviteLink{}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Where("expires_at IS NULL OR expires_at > ?", now).
+			Where("max_uses IS NULL OR use_count < max_uses").
+			Update("use_count", gorm.Expr("use_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return ErrInviteLinkUnusable
+		}
+		return tx.Create(member).Error
+	})
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// ErrTransferStateChanged is returned when the transfer or workspace owner changed concurrently
+var ErrTransferStateChanged = errors.New("ownership transfer state changed")
+
+// OwnershipTransferRepository handles workspace ownership transfer data access
+type OwnershipTransferRepository struct {
+	db *gorm.DB
+}
+
+// NewOwnershipTransferRepository creates a new OwnershipTransferRepository
+func NewOwnershipTransferRepository(db *gorm.DB) *OwnershipTransferRepository {
+	return &OwnershipTransferRepository{db: db}
+}
+
+// Create creates a new ownership transfer
+func (r *OwnershipTransferRepository) Create(transfer *domain.WorkspaceOwnershipTransfer) error {
+	return r.db.Create(transfer).Error
+}
+
+// FindPendingByWorkspace finds the pending ownership transfer of a workspace
+func (r *OwnershipTransferRepository) FindPendingByWorkspace(workspaceID uuid.UUID) (*domain.WorkspaceOwnershipTransfer, error) {
+	var transfer domain.WorkspaceOwnershipTransfer
+	err := r.db.
+		Where("workspace_id = ? AND status = ?", workspaceID, domain.TransferStatusPending).
+		Order("created_at DESC").
+		First(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// UpdateStatus moves a pending transfer to the given status
+func (r *OwnershipTransferRepository) UpdateStatus(id uuid.UUID, status domain.OwnershipTransferStatus) error {
+	now := time.Now()
+	result := r.db.Model(&domain.WorkspaceOwnershipTransfer{}).
+		Where("id = ? AND status = ?", id, domain.TransferStatusPending).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"responded_at": now,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferStateChanged
+	}
+	return nil
+}
+
+// CancelPendingByWorkspace cancels all pending transfers of a workspace
+func (r *OwnershipTransferRepository) CancelPendingByWorkspace(workspaceID uuid.UUID) error {
+	now := time.Now()
+	return r.db.Model(&domain.WorkspaceOwnershipTransfer{}).
+		Where("workspace_id = ? AND status = ?", workspaceID, domain.TransferStatusPending).
+		Updates(map[string]interface{}{
+			"status":       domain.TransferStatusCancelled,
+			"responded_at": now,
+			"updated_at":   now,
+		}).Error
+}
+
+// CompleteTransfer accepts the transfer, swaps owner/admin roles and records the audit log in one transaction
+func (r *OwnershipTransferRepository) CompleteTransfer(transfer *domain.WorkspaceOwnershipTransfer, auditLog *domain.WorkspaceAuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// Only a still-pending transfer can be accepted
+		result := tx.Model(&domain.WorkspaceOwnershipTransfer{}).
+			Where("id = ? AND status = ?", transfer.ID, domain.TransferStatusPending).
+			Updates(map[string]interface{}{
+				"status":       domain.TransferStatusAccepted,
+				"responded_at": now,
+				"updated_at":   now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTransferStateChanged
+		}
+
+		// The nominating user must still be the owner
+		result = tx.Model(&domain.Workspace{}).
+			Where("id = ? AND owner_id = ? AND deleted_at IS NULL", transfer.WorkspaceID, transfer.FromUserID).
+			Update("owner_id", transfer.ToUserID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return ErrTransferStateChanged
+		}
+
+		if err := updateMemberRole(tx, transfer.WorkspaceID, transfer.FromUserID, domain.RoleAdmin, now); err != nil {
+			return err
+		}
+		if err := updateMemberRole(tx, transfer.WorkspaceID, transfer.ToUserID, domain.RoleOwner, now); err != nil {
+			return err
+		}
+
+		return tx.Create(auditLog).Error
+	})
+}
+
+// updateMemberRole sets the role of an active member inside a transaction
+func updateMemberRole(tx *gorm.DB, workspaceID, userID uuid.UUID, roleName domain.RoleName, now time.Time) error {
+	result := tx.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND is_active = true", workspaceID, userID).
+		Updates(map[string]interface{}{
+			"role_name":  roleName,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return ErrTransferStateChanged
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"user-service/internal/domain"
+)
+
+// ErrLastIdentity is returned when unlinking would leave a user without any way to log in
+var ErrLastIdentity = errors.New("cannot unlink the last login identity")
+
+// ErrLinkTokenInvalid is returned when a link token is unknown, expired or already used
+var ErrLinkTokenInvalid = errors.New("link token is invalid or expired")
+
+// UserIdentityRepository handles user login identity data access
+type UserIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewUserIdentityRepository creates a new UserIdentityRepository
+func NewUserIdentityRepository(db *gorm.DB) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db}
+}
+
+// Create links a new identity to a user
+func (r *UserIdentityRepository) Create(identity *domain.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+// CreateWithUser creates a user together with its first identity
+func (r *UserIdentityRepository) CreateWithUser(user *domain.User, identity *domain.UserIdentity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		return tx.Create(identity).Error
+	})
+}
+
+// FindByProviderSubject finds the identity of a provider account
+func (r *UserIdentityRepository) FindByProviderSubject(provider, subject string) (*domain.UserIdentity, error) {
+	var identity domain.UserIdentity
+	err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// FindByUserID finds the identities of a user, oldest first
+func (r *UserIdentityRepository) FindByUserID(userID uuid.UUID) ([]domain.UserIdentity, error) {
+	var identities []domain.UserIdentity
+	err := r.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error
+	return identities, err
+}
+
+// UpdateLogin records a login with the email the provider reported this time
+func (r *UserIdentityRepository) UpdateLogin(id uuid.UUID, email string, emailVerified bool, now time.Time) error {
+	return r.db.Model(&domain.UserIdentity{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"email":          email,
+			"email_verified": emailVerified,
+			"last_login_at":  now,
+			"updated_at":     now,
+		}).Error
+}
+
+// DeleteForUser unlinks an identity of a user. The user row is locked so that
+// concurrent unlinks cannot remove the last two identities at once.
+func (r *UserIdentityRepository) DeleteForUser(userID, identityID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var user domain.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", userID).
+			First(&user).Error; err != nil {
+			return err
+		}
+
+		var identity domain.UserIdentity
+		if err := tx.Where("id = ? AND user_id = ?", identityID, userID).First(&identity).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&domain.UserIdentity{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count <= 1 {
+			return ErrLastIdentity
+		}
+
+		return tx.Delete(&identity).Error
+	})
+}
+
+// CreateLinkToken stores a new link token
+func (r *UserIdentityRepository) CreateLinkToken(token *domain.IdentityLinkToken) error {
+	return r.db.Create(token).Error
+}
+
+// ConsumeLinkToken marks an unused, unexpired link token issued for bindingHash as used and returns it
+func (r *UserIdentityRepository) ConsumeLinkToken(token, bindingHash string, now time.Time) (*domain.IdentityLinkToken, error) {
+	result := r.db.Model(&domain.IdentityLinkToken{}).
+		Where("token = ? AND binding_hash = ? AND used_at IS NULL AND expires_at > ?", token, bindingHash, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrLinkTokenInvalid
+	}
+
+	var linkToken domain.IdentityLinkToken
+	if err := r.db.Where("token = ?", token).First(&linkToken).Error; err != nil {
+		return nil, err
+	}
+	return &linkToken, nil
+}
@@ -56,6 +56,21 @@ func (r *UserProfileRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain
 	return profiles, err
 }
 
+// FindByWorkspaceUserPairs finds the profiles for the given (workspace, user) pairs in one query
+func (r *UserProfileRepository) FindByWorkspaceUserPairs(keys []domain.ProfileKey) ([]domain.UserProfile, error) {
+	var profiles []domain.UserProfile
+	if len(keys) == 0 {
+		return profiles, nil
+	}
+
+	pairs := make([][]interface{}, len(keys))
+	for i, key := range keys {
+		pairs[i] = []interface{}{key.WorkspaceID, key.UserID}
+	}
+	err := r.db.Where("(workspace_id, user_id) IN ?", pairs).Find(&profiles).Error
+	return profiles, err
+}
+
 // Update updates a user profile
 func (r *UserProfileRepository) Update(profile *domain.UserProfile) error {
 	return r.db.Save(profile).Error
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// UserStatusRepository handles user availability data access
+type UserStatusRepository struct {
+	db *gorm.DB
+}
+
+// NewUserStatusRepository creates a new UserStatusRepository
+func NewUserStatusRepository(db *gorm.DB) *UserStatusRepository {
+	return &UserStatusRepository{db: db}
+}
+
+// FindByUserID finds the availability of a user
+func (r *UserStatusRepository) FindByUserID(userID uuid.UUID) (*domain.UserStatus, error) {
+	var status domain.UserStatus
+	err := r.db.Where("user_id = ?", userID).First(&status).Error
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// Save creates or replaces the availability of a user
+func (r *UserStatusRepository) Save(status *domain.UserStatus) error {
+	return r.db.Save(status).Error
+}
+
+// Delete removes the availability of a user
+func (r *UserStatusRepository) Delete(userID uuid.UUID) error {
+	return r.db.Where("user_id = ?", userID).Delete(&domain.UserStatus{}).Error
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -42,10 +44,21 @@ func (r *WorkspaceMemberRepository) FindByWorkspaceAndUser(workspaceID, userID u
 	return &member, nil
 }
 
-// FindByWorkspace finds all members of a workspace
+// FindByWorkspace finds all members of a workspace. Guests are not listed.
 func (r *WorkspaceMemberRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceMember, error) {
 	var members []domain.WorkspaceMember
-	err := r.db.Preload("User").Where("workspace_id = ? AND is_active = true", workspaceID).Find(&members).Error
+	err := r.db.Preload("User").
+		Where("workspace_id = ? AND is_active = true AND role_name <> ?", workspaceID, domain.RoleGuest).
+		Find(&members).Error
+	return members, err
+}
+
+// FindGuests finds the active guests of a workspace
+func (r *WorkspaceMemberRepository) FindGuests(workspaceID uuid.UUID) ([]domain.WorkspaceMember, error) {
+	var members []domain.WorkspaceMember
+	err := r.db.Preload("User").
+		Where("workspace_id = ? AND is_active = true AND role_name = ?", workspaceID, domain.RoleGuest).
+		Find(&members).Error
 	return members, err
 }
 
@@ -83,7 +96,83 @@ func (r *WorkspaceMemberRepository) Update(member *domain.WorkspaceMember) error
 func (r *WorkspaceMemberRepository) Delete(id uuid.UUID) error {
 	return r.db.Model(&domain.WorkspaceMember{}).
 		Where("id = ?", id).
-		Update("is_active", false).Error
+		Updates(map[string]interface{}{
+			"is_active": false,
+			"status":    domain.MemberStatusRemoved,
+		}).Error
+}
+
+// FindByWorkspaceIncludingSuspended finds active and suspended members of a workspace. Guests are not listed.
+func (r *WorkspaceMemberRepository) FindByWorkspaceIncludingSuspended(workspaceID uuid.UUID) ([]domain.WorkspaceMember, error) {
+	var members []domain.WorkspaceMember
+	err := r.db.Preload("User").
+		Where("workspace_id = ? AND (is_active = true OR status = ?) AND role_name <> ?", workspaceID, domain.MemberStatusSuspended, domain.RoleGuest).
+		Find(&members).Error
+	return members, err
+}
+
+// FindSuspendedByID finds a suspended workspace member by ID
+func (r *WorkspaceMemberRepository) FindSuspendedByID(id uuid.UUID) (*domain.WorkspaceMember, error) {
+	var member domain.WorkspaceMember
+	err := r.db.Preload("User").Where("id = ? AND status = ?", id, domain.MemberStatusSuspended).First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// HasMembership checks if user has ever been a member of workspace, in any status
+func (r *WorkspaceMemberRepository) HasMembership(workspaceID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// IsSuspended checks if user is a suspended member of workspace
+func (r *WorkspaceMemberRepository) IsSuspended(workspaceID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, domain.MemberStatusSuspended).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Suspend revokes the access of an active member while keeping the membership
+func (r *WorkspaceMemberRepository) Suspend(id, suspendedBy uuid.UUID, reason *string, now time.Time) (bool, error) {
+	result := r.db.Model(&domain.WorkspaceMember{}).
+		Where("id = ? AND is_active = true", id).
+		Updates(map[string]interface{}{
+			"is_active":      false,
+			"status":         domain.MemberStatusSuspended,
+			"suspended_at":   now,
+			"suspended_by":   suspendedBy,
+			"suspend_reason": reason,
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Reactivate restores the access of a suspended member
+func (r *WorkspaceMemberRepository) Reactivate(id uuid.UUID, now time.Time) (bool, error) {
+	result := r.db.Model(&domain.WorkspaceMember{}).
+		Where("id = ? AND status = ?", id, domain.MemberStatusSuspended).
+		Updates(map[string]interface{}{
+			"is_active":      true,
+			"status":         domain.MemberStatusActive,
+			"suspended_at":   nil,
+			"suspended_by":   nil,
+			"suspend_reason": nil,
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
 }
 
 // SetDefault sets a workspace as default for user
@@ -100,11 +189,20 @@ func (r *WorkspaceMemberRepository) SetDefault(userID, workspaceID uuid.UUID) er
 		Update("is_default", true).Error
 }
 
-// IsMember checks if user is a member of workspace
+// IsMember checks if user is a member of workspace. Guests are not members.
 func (r *WorkspaceMemberRepository) IsMember(workspaceID, userID uuid.UUID) (bool, error) {
 	var count int64
 	err := r.db.Model(&domain.WorkspaceMember{}).
-		Where("workspace_id = ? AND user_id = ? AND is_active = true", workspaceID, userID).
+		Where("workspace_id = ? AND user_id = ? AND is_active = true AND role_name <> ?", workspaceID, userID, domain.RoleGuest).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// IsGuest checks if user is an active guest of workspace
+func (r *WorkspaceMemberRepository) IsGuest(workspaceID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND is_active = true AND role_name = ?", workspaceID, userID, domain.RoleGuest).
 		Count(&count).Error
 	return count > 0, err
 }
@@ -127,3 +225,13 @@ func (r *WorkspaceMemberRepository) CountByRole(workspaceID uuid.UUID, roleName
 		Count(&count).Error
 	return count, err
 }
+
+// SetCustomRole assigns a custom role to a member. A nil roleID restores the built-in role.
+func (r *WorkspaceMemberRepository) SetCustomRole(id uuid.UUID, roleID *uuid.UUID, now time.Time) error {
+	return r.db.Model(&domain.WorkspaceMember{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"custom_role_id": roleID,
+			"updated_at":     now,
+		}).Error
+}
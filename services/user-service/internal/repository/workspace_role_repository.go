@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+)
+
+// WorkspaceRoleRepository handles built-in and custom workspace role data access
+type WorkspaceRoleRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceRoleRepository creates a new WorkspaceRoleRepository
+func NewWorkspaceRoleRepository(db *gorm.DB) *WorkspaceRoleRepository {
+	return &WorkspaceRoleRepository{db: db}
+}
+
+// Create creates a custom role
+func (r *WorkspaceRoleRepository) Create(role *domain.WorkspaceRole) error {
+	return r.db.Create(role).Error
+}
+
+// Update updates a custom role
+func (r *WorkspaceRoleRepository) Update(role *domain.WorkspaceRole) error {
+	return r.db.Save(role).Error
+}
+
+// FindBuiltin finds the built-in roles
+func (r *WorkspaceRoleRepository) FindBuiltin() ([]domain.WorkspaceRole, error) {
+	var roles []domain.WorkspaceRole
+	err := r.db.Where("workspace_id IS NULL AND is_builtin = true").
+		Order("created_at ASC").
+		Find(&roles).Error
+	return roles, err
+}
+
+// FindByWorkspace finds the custom roles of a workspace
+func (r *WorkspaceRoleRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain.WorkspaceRole, error) {
+	var roles []domain.WorkspaceRole
+	err := r.db.Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&roles).Error
+	return roles, err
+}
+
+// FindByIDAndWorkspace finds a custom role of a workspace
+func (r *WorkspaceRoleRepository) FindByIDAndWorkspace(id, workspaceID uuid.UUID) (*domain.WorkspaceRole, error) {
+	var role domain.WorkspaceRole
+	err := r.db.Where("id = ? AND workspace_id = ?", id, workspaceID).First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// ExistsByName checks whether a workspace already has a custom role with the name (case-insensitive)
+func (r *WorkspaceRoleRepository) ExistsByName(workspaceID uuid.UUID, name string, excludeID *uuid.UUID) (bool, error) {
+	query := r.db.Model(&domain.WorkspaceRole{}).
+		Where("workspace_id = ? AND LOWER(name) = LOWER(?)", workspaceID, name)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+	var count int64
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+// Delete deletes a custom role. Members holding it fall back to their built-in role.
+func (r *WorkspaceRoleRepository) Delete(id, workspaceID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.WorkspaceMember{}).
+			Where("workspace_id = ? AND custom_role_id = ?", workspaceID, id).
+			Updates(map[string]interface{}{
+				"custom_role_id": nil,
+				"updated_at":     time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ? AND workspace_id = ?", id, workspaceID).
+			Delete(&domain.WorkspaceRole{}).Error
+	})
+}
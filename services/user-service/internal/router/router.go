@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
 	commonhealth "github.com/OrangesCloud/wealist-advanced-go-pkg/health"
 	commonmw "github.com/OrangesCloud/wealist-advanced-go-pkg/middleware"
 	"github.com/OrangesCloud/wealist-advanced-go-pkg/ratelimit"
@@ -28,6 +29,10 @@ type Config struct {
 	JWTSecret       string
 	BasePath        string
 	S3Client        *client.S3Client
+	NotiClient      client.NotiClient         // nil이면 알림 비활성화
+	DeletionService *service.DeletionService  // nil이면 영구 삭제 작업 비활성화
+	ExportService   *service.ExportService    // nil이면 개인 데이터 내보내기 비활성화
+	InternalAPIKey  string                    // 운영용 내부 API 키 (비어 있으면 키가 필요한 내부 API 거부)
 	TokenValidator  middleware.TokenValidator // 공통 모듈의 TokenValidator 인터페이스 사용
 	Metrics         *metrics.Metrics
 	RedisClient     *redis.Client
@@ -90,10 +95,16 @@ func Setup(cfg Config) *gin.Engine {
 	profileRepo := repository.NewUserProfileRepository(cfg.DB)
 	joinReqRepo := repository.NewJoinRequestRepository(cfg.DB)
 	attachmentRepo := repository.NewAttachmentRepository(cfg.DB)
+	transferRepo := repository.NewOwnershipTransferRepository(cfg.DB)
+	auditLogRepo := repository.NewAuditLogRepository(cfg.DB)
+	emailDomainRepo := repository.NewEmailDomainRepository(cfg.DB)
+	inviteLinkRepo := repository.NewInviteLinkRepository(cfg.DB)
+	roleRepo := repository.NewWorkspaceRoleRepository(cfg.DB)
+	guestRepo := repository.NewGuestProjectRepository(cfg.DB)
+	statusRepo := repository.NewUserStatusRepository(cfg.DB)
+	identityRepo := repository.NewUserIdentityRepository(cfg.DB)
 
 	// Initialize services
-	// 사용자 서비스 초기화 (메트릭 포함)
-	userService := service.NewUserService(userRepo, cfg.Logger, m)
 	// 워크스페이스 서비스 초기화 (메트릭 포함)
 	workspaceService := service.NewWorkspaceService(
 		workspaceRepo,
@@ -101,17 +112,33 @@ func Setup(cfg Config) *gin.Engine {
 		joinReqRepo,
 		profileRepo,
 		userRepo,
+		transferRepo,
+		auditLogRepo,
+		emailDomainRepo,
+		identityRepo,
+		inviteLinkRepo,
+		roleRepo,
+		guestRepo,
+		cfg.NotiClient,
+		cfg.DeletionService,
 		cfg.Logger,
 		m,
 	)
+	// 사용자 서비스 초기화 (메트릭 포함, OAuth 로그인 시 이메일 도메인 자동 참여)
+	userService := service.NewUserService(userRepo, identityRepo, cfg.DeletionService, workspaceService, cfg.Logger, m)
 	// 프로필 서비스 초기화 (메트릭 포함)
-	profileService := service.NewProfileService(profileRepo, memberRepo, userRepo, cfg.Logger, m)
+	profileService := service.NewProfileService(profileRepo, memberRepo, userRepo, commonclient.NewProfileCacheClient(cfg.RedisClient), cfg.Logger, m)
 	attachmentService := service.NewAttachmentService(attachmentRepo, cfg.S3Client, cfg.Logger)
+	// 상태/방해 금지/부재 서비스 초기화 (Redis 미러링으로 다른 서비스에 공유)
+	statusService := service.NewUserStatusService(statusRepo, userRepo, cfg.RedisClient, cfg.Logger)
+	identityService := service.NewUserIdentityService(identityRepo, cfg.Logger)
 
 	// Initialize handlers
 	userHandler := handler.NewUserHandler(userService)
 	workspaceHandler := handler.NewWorkspaceHandler(workspaceService)
 	profileHandler := handler.NewProfileHandler(profileService, attachmentService)
+	statusHandler := handler.NewUserStatusHandler(statusService)
+	identityHandler := handler.NewUserIdentityHandler(identityService)
 
 	// API routes group
 	api := r.Group(cfg.BasePath)
@@ -142,6 +169,13 @@ func Setup(cfg Config) *gin.Engine {
 	{
 		internal.GET("/users/:userId/exists", userHandler.UserExists)
 		internal.POST("/oauth/login", userHandler.OAuthLogin)
+		internal.POST("/profiles/batch", profileHandler.GetProfilesBatch)
+		internal.GET("/users/:userId/status", statusHandler.GetUserStatus)
+		internal.GET("/workspaces/:workspaceId/users/:userId/permissions", workspaceHandler.GetMemberPermissions)
+		if cfg.DeletionService != nil {
+			deletionHandler := handler.NewDeletionHandler(cfg.DeletionService)
+			internal.POST("/deletion-jobs/:jobId/retry", middleware.InternalAuth(cfg.InternalAPIKey), deletionHandler.RetryDeletionJob)
+		}
 	}
 
 	// ============================================================
@@ -152,7 +186,21 @@ func Setup(cfg Config) *gin.Engine {
 		users.POST("", userHandler.CreateUser) // Public for OAuth callback
 		users.GET("/me", authMiddleware, userHandler.GetMe)
 		users.DELETE("/me", authMiddleware, userHandler.DeleteMe)
+		users.GET("/me/deletion", authMiddleware, userHandler.GetMyDeletionStatus)
+		users.GET("/me/status", authMiddleware, statusHandler.GetMyStatus)
+		users.PUT("/me/status", authMiddleware, statusHandler.UpdateMyStatus)
+		users.DELETE("/me/status", authMiddleware, statusHandler.ClearMyStatus)
+		users.GET("/me/identities", authMiddleware, identityHandler.GetMyIdentities)
+		users.POST("/me/identities/link-token", authMiddleware, identityHandler.CreateLinkToken)
+		users.DELETE("/me/identities/:identityId", authMiddleware, identityHandler.UnlinkIdentity)
+		if cfg.ExportService != nil {
+			exportHandler := handler.NewExportHandler(cfg.ExportService)
+			users.POST("/me/export", authMiddleware, exportHandler.RequestExport)
+			users.GET("/me/export", authMiddleware, exportHandler.GetLatestExport)
+			users.GET("/me/export/:exportId", authMiddleware, exportHandler.GetExport)
+		}
 		users.GET("/:userId", authMiddleware, userHandler.GetUser)
+		users.GET("/:userId/status", authMiddleware, statusHandler.GetUserStatus)
 		users.PUT("/:userId", authMiddleware, userHandler.UpdateUser)
 		users.PUT("/:userId/restore", authMiddleware, userHandler.RestoreUser)
 	}
@@ -166,6 +214,7 @@ func Setup(cfg Config) *gin.Engine {
 		workspaces.POST("/create", workspaceHandler.CreateWorkspace)
 		workspaces.GET("/all", workspaceHandler.GetAllWorkspaces)
 		workspaces.GET("/public/:workspaceName", workspaceHandler.SearchPublicWorkspaces)
+		workspaces.GET("/permissions", workspaceHandler.GetPermissionDefinitions)
 		workspaces.GET("/:workspaceId", workspaceHandler.GetWorkspace)
 		workspaces.PUT("/ids/:workspaceId", workspaceHandler.UpdateWorkspace)
 		workspaces.DELETE("/:workspaceId", workspaceHandler.DeleteWorkspace)
@@ -180,6 +229,14 @@ func Setup(cfg Config) *gin.Engine {
 		workspaces.POST("/:workspaceId/members/invite", workspaceHandler.InviteMember)
 		workspaces.PUT("/:workspaceId/members/:memberId/role", workspaceHandler.UpdateMemberRole)
 		workspaces.DELETE("/:workspaceId/members/:memberId", workspaceHandler.RemoveMember)
+		workspaces.POST("/:workspaceId/members/:memberId/deactivate", workspaceHandler.SuspendMember)
+		workspaces.POST("/:workspaceId/members/:memberId/reactivate", workspaceHandler.ReactivateMember)
+		workspaces.PUT("/:workspaceId/members/:memberId/custom-role", workspaceHandler.AssignCustomRole)
+
+		// Guests (external collaborators limited to shared projects)
+		workspaces.GET("/:workspaceId/guests", workspaceHandler.GetGuests)
+		workspaces.POST("/:workspaceId/guests", workspaceHandler.InviteGuest)
+		workspaces.PUT("/:workspaceId/guests/:memberId/projects", workspaceHandler.UpdateGuestProjects)
 		workspaces.GET("/:workspaceId/validate-member/:userId", workspaceHandler.ValidateMember)
 
 		// Join requests
@@ -187,6 +244,24 @@ func Setup(cfg Config) *gin.Engine {
 		workspaces.GET("/:workspaceId/joinRequests", workspaceHandler.GetJoinRequests)
 		workspaces.GET("/:workspaceId/pendingMembers", workspaceHandler.GetJoinRequests) // Alias for frontend compatibility
 		workspaces.PUT("/:workspaceId/joinRequests/:requestId", workspaceHandler.ProcessJoinRequest)
+
+		// Roles and permissions
+		workspaces.GET("/:workspaceId/roles", workspaceHandler.GetRoles)
+		workspaces.POST("/:workspaceId/roles", workspaceHandler.CreateRole)
+		workspaces.PUT("/:workspaceId/roles/:roleId", workspaceHandler.UpdateRole)
+		workspaces.DELETE("/:workspaceId/roles/:roleId", workspaceHandler.DeleteRole)
+		workspaces.GET("/:workspaceId/permissions/me", workspaceHandler.GetMyPermissions)
+
+		// Invite links (created and revoked through workspace settings)
+		workspaces.GET("/invite-links/:token", workspaceHandler.GetInviteLink)
+		workspaces.POST("/invite-links/:token/join", workspaceHandler.JoinByInviteLink)
+
+		// Ownership transfer
+		workspaces.POST("/:workspaceId/transfer-ownership", workspaceHandler.TransferOwnership)
+		workspaces.GET("/:workspaceId/transfer-ownership", workspaceHandler.GetOwnershipTransfer)
+		workspaces.DELETE("/:workspaceId/transfer-ownership", workspaceHandler.CancelOwnershipTransfer)
+		workspaces.POST("/:workspaceId/transfer-ownership/accept", workspaceHandler.AcceptOwnershipTransfer)
+		workspaces.POST("/:workspaceId/transfer-ownership/decline", workspaceHandler.DeclineOwnershipTransfer)
 	}
 
 	// ============================================================
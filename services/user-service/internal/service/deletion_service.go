@@ -0,0 +1,309 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 워크스페이스/계정 삭제 작업 관련 비즈니스 로직을 포함합니다.
+// 삭제 요청 시 유예 기간이 있는 삭제 작업을 기록하고, 유예 기간이 지나면
+// 각 서비스의 내부 삭제 API를 호출한 뒤 user-service의 데이터를 영구 삭제합니다.
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/client"
+	"user-service/internal/config"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// DeletionService schedules and runs the cross-service deletion of workspaces and user accounts
+type DeletionService struct {
+	jobRepo     *repository.DeletionJobRepository
+	purgeClient client.PurgeClient // nil이면 다른 서비스 삭제 없이 로컬 데이터만 삭제
+	cfg         config.DeletionConfig
+	logger      *zap.Logger
+	now         func() time.Time
+}
+
+// NewDeletionService creates a new DeletionService
+func NewDeletionService(jobRepo *repository.DeletionJobRepository, purgeClient client.PurgeClient, cfg config.DeletionConfig, logger *zap.Logger) *DeletionService {
+	return &DeletionService{
+		jobRepo:     jobRepo,
+		purgeClient: purgeClient,
+		cfg:         cfg,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// ScheduleDeletion records a deletion job that purges the resource after the grace period.
+// An unfinished job of the same resource is returned as is.
+func (s *DeletionService) ScheduleDeletion(resourceType domain.DeletionResourceType, resourceID, requestedBy uuid.UUID) (*domain.DeletionJob, error) {
+	existing, err := s.jobRepo.FindActiveByResource(resourceType, resourceID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	now := s.now()
+	purgeAfter := now.Add(s.cfg.GracePeriod)
+	job := &domain.DeletionJob{
+		ID:           uuid.New(),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		RequestedBy:  requestedBy,
+		Status:       domain.DeletionJobStatusScheduled,
+		PurgeAfter:   purgeAfter,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if s.purgeClient != nil {
+		for _, target := range s.purgeClient.Targets() {
+			job.Steps = append(job.Steps, domain.DeletionJobStep{
+				ID:            uuid.New(),
+				JobID:         job.ID,
+				Service:       target,
+				Status:        domain.DeletionStepStatusPending,
+				NextAttemptAt: purgeAfter,
+				UpdatedAt:     now,
+			})
+		}
+	}
+
+	if err := s.jobRepo.Create(job); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("삭제 작업 예약",
+		zap.String("job_id", job.ID.String()),
+		zap.String("resource_type", string(resourceType)),
+		zap.String("resource_id", resourceID.String()),
+		zap.Time("purge_after", purgeAfter),
+		zap.Int("steps", len(job.Steps)))
+	return job, nil
+}
+
+// RestoreUser restores a soft deleted user account during its grace period.
+// Cancelling the deletion job and restoring the user happen in one transaction,
+// so a restored account never keeps a job that would still purge it.
+func (s *DeletionService) RestoreUser(userID uuid.UUID) error {
+	now := s.now()
+	var jobID *uuid.UUID
+	job, err := s.jobRepo.FindActiveByResource(domain.DeletionResourceUser, userID)
+	switch {
+	case err == nil:
+		if !job.IsCancellable(now) {
+			return response.NewConflictError("Grace period has ended", "permanent deletion is already in progress")
+		}
+		jobID = &job.ID
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return response.NewInternalError("Failed to get deletion job", err.Error())
+	}
+
+	if err := s.jobRepo.RestoreUser(jobID, userID, now); err != nil {
+		if errors.Is(err, repository.ErrDeletionJobStateChanged) {
+			return response.NewConflictError("Grace period has ended", "permanent deletion is already in progress")
+		}
+		return response.NewInternalError("Failed to restore user", err.Error())
+	}
+
+	if jobID != nil {
+		s.logger.Info("삭제 작업 취소",
+			zap.String("job_id", jobID.String()),
+			zap.String("resource_type", string(domain.DeletionResourceUser)),
+			zap.String("resource_id", userID.String()))
+	}
+	return nil
+}
+
+// GetDeletionJob returns the most recent deletion job of a resource with its per-service status
+func (s *DeletionService) GetDeletionJob(resourceType domain.DeletionResourceType, resourceID uuid.UUID) (*domain.DeletionJob, error) {
+	job, err := s.jobRepo.FindLatestByResource(resourceType, resourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Deletion job not found", resourceID.String())
+		}
+		return nil, response.NewInternalError("Failed to get deletion job", err.Error())
+	}
+	return job, nil
+}
+
+// RetryDeletionJob resets the failed steps of a failed job
+func (s *DeletionService) RetryDeletionJob(jobID uuid.UUID) (*domain.DeletionJob, error) {
+	if err := s.jobRepo.RetryFailed(jobID, s.now()); err != nil {
+		if errors.Is(err, repository.ErrDeletionJobStateChanged) {
+			return nil, response.NewConflictError("Only failed deletion jobs can be retried", jobID.String())
+		}
+		return nil, response.NewInternalError("Failed to retry deletion job", err.Error())
+	}
+	s.logger.Info("삭제 작업 재시도 요청", zap.String("job_id", jobID.String()))
+	return s.jobRepo.FindByID(jobID)
+}
+
+// ProcessDue runs the due deletion jobs and returns how many jobs were claimed
+func (s *DeletionService) ProcessDue(ctx context.Context, limit int) (int, error) {
+	now := s.now()
+	ids, err := s.jobRepo.FindDueIDs(now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	claimed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := s.jobRepo.Claim(id, now, s.leaseDuration())
+		if err != nil {
+			return claimed, err
+		}
+		if !ok {
+			continue // 다른 인스턴스가 처리 중
+		}
+		claimed++
+		if err := s.processJob(ctx, id); err != nil {
+			s.logger.Error("삭제 작업 처리 실패",
+				zap.String("job_id", id.String()),
+				zap.Error(err))
+		}
+	}
+	return claimed, nil
+}
+
+// processJob calls the due purge steps of a claimed job and finishes it once every step succeeded
+func (s *DeletionService) processJob(ctx context.Context, id uuid.UUID) error {
+	job, err := s.jobRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	for i := range job.Steps {
+		step := &job.Steps[i]
+		if step.Status != domain.DeletionStepStatusPending || step.NextAttemptAt.After(s.now()) {
+			continue
+		}
+		s.runStep(ctx, job, step)
+	}
+
+	var pending, failed int
+	var nextAttempt *time.Time
+	for i := range job.Steps {
+		switch job.Steps[i].Status {
+		case domain.DeletionStepStatusPending:
+			pending++
+			if nextAttempt == nil || job.Steps[i].NextAttemptAt.Before(*nextAttempt) {
+				nextAttempt = &job.Steps[i].NextAttemptAt
+			}
+		case domain.DeletionStepStatusFailed:
+			failed++
+		}
+	}
+
+	switch {
+	case pending > 0:
+		// 다음 재시도 시각까지 작업을 잠가 둠
+		return s.jobRepo.Release(job.ID, domain.DeletionJobStatusInProgress, nextAttempt)
+	case failed > 0:
+		s.logger.Error("삭제 작업 실패: 재시도 횟수 초과",
+			zap.String("job_id", job.ID.String()),
+			zap.String("resource_type", string(job.ResourceType)),
+			zap.String("resource_id", job.ResourceID.String()),
+			zap.Int("failed_steps", failed))
+		return s.jobRepo.Release(job.ID, domain.DeletionJobStatusFailed, nil)
+	}
+
+	// 모든 서비스 삭제 완료 후 user-service 데이터 영구 삭제
+	if err := s.purgeLocalData(job); err != nil {
+		if errors.Is(err, repository.ErrDeletionJobStateChanged) {
+			_ = s.jobRepo.Release(job.ID, domain.DeletionJobStatusFailed, nil)
+			return err
+		}
+		retryAt := s.now().Add(s.cfg.RetryBaseDelay)
+		_ = s.jobRepo.Release(job.ID, domain.DeletionJobStatusInProgress, &retryAt)
+		return err
+	}
+
+	s.logger.Info("삭제 작업 완료",
+		zap.String("job_id", job.ID.String()),
+		zap.String("resource_type", string(job.ResourceType)),
+		zap.String("resource_id", job.ResourceID.String()))
+	return s.jobRepo.Release(job.ID, domain.DeletionJobStatusCompleted, nil)
+}
+
+// runStep calls the purge endpoint of one service and records the attempt
+func (s *DeletionService) runStep(ctx context.Context, job *domain.DeletionJob, step *domain.DeletionJobStep) {
+	var err error
+	switch {
+	case s.purgeClient == nil:
+		err = errors.New("purge client is not configured")
+	case job.ResourceType == domain.DeletionResourceWorkspace:
+		err = s.purgeClient.PurgeWorkspace(ctx, step.Service, job.ResourceID)
+	default:
+		err = s.purgeClient.PurgeUser(ctx, step.Service, job.ResourceID)
+	}
+
+	now := s.now()
+	step.Attempts++
+	step.UpdatedAt = now
+	if err == nil {
+		step.Status = domain.DeletionStepStatusSucceeded
+		step.LastError = nil
+		step.CompletedAt = &now
+	} else {
+		msg := err.Error()
+		step.LastError = &msg
+		if step.Attempts >= s.cfg.MaxAttempts {
+			step.Status = domain.DeletionStepStatusFailed
+		} else {
+			step.NextAttemptAt = now.Add(s.retryDelay(step.Attempts))
+		}
+		s.logger.Warn("서비스 데이터 삭제 실패",
+			zap.String("job_id", job.ID.String()),
+			zap.String("service", step.Service),
+			zap.Int("attempts", step.Attempts),
+			zap.Error(err))
+	}
+
+	if err := s.jobRepo.UpdateStep(step); err != nil {
+		s.logger.Error("삭제 단계 상태 저장 실패",
+			zap.String("job_id", job.ID.String()),
+			zap.String("service", step.Service),
+			zap.Error(err))
+	}
+}
+
+// purgeLocalData hard deletes the user-service data of the resource
+func (s *DeletionService) purgeLocalData(job *domain.DeletionJob) error {
+	if job.ResourceType == domain.DeletionResourceWorkspace {
+		return s.jobRepo.PurgeWorkspaceData(job.ResourceID)
+	}
+	return s.jobRepo.PurgeUserData(job.ResourceID)
+}
+
+// retryDelay returns the exponential backoff delay after the given number of attempts
+func (s *DeletionService) retryDelay(attempts int) time.Duration {
+	delay := s.cfg.RetryBaseDelay
+	for i := 1; i < attempts && delay < s.cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > s.cfg.RetryMaxDelay {
+		delay = s.cfg.RetryMaxDelay
+	}
+	return delay
+}
+
+// leaseDuration returns how long a claimed job stays locked while its steps run
+func (s *DeletionService) leaseDuration() time.Duration {
+	steps := 1
+	if s.purgeClient != nil {
+		steps += len(s.purgeClient.Targets())
+	}
+	return time.Duration(steps)*s.cfg.RequestTimeout + time.Minute
+}
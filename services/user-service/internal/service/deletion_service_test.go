@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"user-service/internal/config"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// TestDeletionService_RetryDelay verifies exponential backoff with a cap
+// 재시도 간격이 지수적으로 증가하고 최대값을 넘지 않는지 검증
+func TestDeletionService_RetryDelay(t *testing.T) {
+	svc := NewDeletionService(nil, nil, config.DeletionConfig{
+		RetryBaseDelay: 30 * time.Second,
+		RetryMaxDelay:  5 * time.Minute,
+	}, zap.NewNop())
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{20, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, svc.retryDelay(tt.attempts), "attempts=%d", tt.attempts)
+	}
+}
+
+// fakePurgeClient fails the purge calls of the targets listed in failing
+type fakePurgeClient struct {
+	targets []string
+	failing map[string]bool
+	calls   []string
+}
+
+func (c *fakePurgeClient) Targets() []string { return c.targets }
+
+func (c *fakePurgeClient) PurgeWorkspace(ctx context.Context, target string, workspaceID uuid.UUID) error {
+	return c.purge(target)
+}
+
+func (c *fakePurgeClient) PurgeUser(ctx context.Context, target string, userID uuid.UUID) error {
+	return c.purge(target)
+}
+
+func (c *fakePurgeClient) purge(target string) error {
+	c.calls = append(c.calls, target)
+	if c.failing[target] {
+		return errors.New(target + " unavailable")
+	}
+	return nil
+}
+
+// deletionTestFixture는 삭제 작업 테스트용 DB와 서비스를 준비합니다.
+type deletionTestFixture struct {
+	db       *gorm.DB
+	svc      *DeletionService
+	purge    *fakePurgeClient
+	userRepo *repository.UserRepository
+	now      time.Time
+}
+
+func newDeletionTestFixture(t *testing.T) *deletionTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE users (
+			id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT NOT NULL DEFAULT '', google_id TEXT,
+			provider TEXT DEFAULT 'google', is_active INTEGER DEFAULT 1,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE deletion_jobs (
+			id TEXT PRIMARY KEY, resource_type TEXT NOT NULL, resource_id TEXT NOT NULL, requested_by TEXT NOT NULL,
+			status TEXT NOT NULL, purge_after DATETIME NOT NULL, locked_until DATETIME, completed_at DATETIME,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE deletion_job_steps (
+			id TEXT PRIMARY KEY, job_id TEXT NOT NULL, service TEXT NOT NULL, status TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0, last_error TEXT, next_attempt_at DATETIME NOT NULL,
+			completed_at DATETIME, updated_at DATETIME NOT NULL
+		)`,
+		// 사용자 영구 삭제 대상 테이블 (조건 컬럼만)
+		`CREATE TABLE workspace_join_requests (id TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+		`CREATE TABLE workspace_ownership_transfers (id TEXT PRIMARY KEY, status TEXT, from_user_id TEXT, to_user_id TEXT)`,
+		`CREATE TABLE workspace_guest_projects (id TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+		`CREATE TABLE user_statuses (user_id TEXT PRIMARY KEY)`,
+		`CREATE TABLE user_identities (id TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+		`CREATE TABLE identity_link_tokens (token TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+		`CREATE TABLE user_profiles (id TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+		`CREATE TABLE workspace_members (id TEXT PRIMARY KEY, user_id TEXT NOT NULL)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	f := &deletionTestFixture{
+		db:       db,
+		purge:    &fakePurgeClient{targets: []string{"board", "chat"}, failing: map[string]bool{}},
+		userRepo: repository.NewUserRepository(db),
+		now:      time.Now().UTC().Truncate(time.Second),
+	}
+	f.svc = NewDeletionService(repository.NewDeletionJobRepository(db), f.purge, config.DeletionConfig{
+		GracePeriod:    24 * time.Hour,
+		MaxAttempts:    2,
+		RetryBaseDelay: time.Minute,
+		RetryMaxDelay:  time.Hour,
+		RequestTimeout: time.Second,
+	}, zap.NewNop())
+	f.svc.now = func() time.Time { return f.now }
+	return f
+}
+
+// deletedUser creates a soft deleted user with a linked identity
+func (f *deletionTestFixture) deletedUser(t *testing.T) *domain.User {
+	t.Helper()
+	user := &domain.User{ID: uuid.New(), Email: uuid.NewString() + "@example.com", Name: "User", Provider: "google", IsActive: true, CreatedAt: f.now, UpdatedAt: f.now}
+	require.NoError(t, f.userRepo.Create(user))
+	require.NoError(t, f.db.Exec(`INSERT INTO user_identities (id, user_id) VALUES (?, ?)`, uuid.NewString(), user.ID.String()).Error)
+	// UserRepository.SoftDelete uses NOW(), which SQLite lacks
+	require.NoError(t, f.db.Model(&domain.User{}).Where("id = ?", user.ID).
+		Updates(map[string]interface{}{"is_active": false, "deleted_at": f.now}).Error)
+	return user
+}
+
+func (f *deletionTestFixture) job(t *testing.T, id uuid.UUID) *domain.DeletionJob {
+	t.Helper()
+	job, err := repository.NewDeletionJobRepository(f.db).FindByID(id)
+	require.NoError(t, err)
+	return job
+}
+
+func stepByService(job *domain.DeletionJob, service string) domain.DeletionJobStep {
+	for _, step := range job.Steps {
+		if step.Service == service {
+			return step
+		}
+	}
+	return domain.DeletionJobStep{}
+}
+
+func assertConflict(t *testing.T, err error) {
+	t.Helper()
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok, "expected AppError, got %v", err)
+	assert.Equal(t, response.ErrCodeConflict, appErr.Code)
+}
+
+// TestDeletionService_JobLifecycle walks a user deletion job through its states
+// 유예 기간 → 서비스별 삭제 재시도 → 실패 → 재시도 요청 → 완료 순서로 상태가 바뀌는지 검증
+func TestDeletionService_JobLifecycle(t *testing.T) {
+	f := newDeletionTestFixture(t)
+	ctx := context.Background()
+	user := f.deletedUser(t)
+
+	job, err := f.svc.ScheduleDeletion(domain.DeletionResourceUser, user.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeletionJobStatusScheduled, job.Status)
+	assert.Len(t, job.Steps, 2)
+
+	// 같은 리소스의 중복 요청은 기존 작업을 반환
+	again, err := f.svc.ScheduleDeletion(domain.DeletionResourceUser, user.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, again.ID)
+
+	// 유예 기간 중에는 처리하지 않음
+	claimed, err := f.svc.ProcessDue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, claimed)
+	assert.Empty(t, f.purge.calls)
+
+	// 유예 기간 후: board 성공, chat 실패 → 재시도 대기
+	f.now = f.now.Add(25 * time.Hour)
+	f.purge.failing["chat"] = true
+	claimed, err = f.svc.ProcessDue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	current := f.job(t, job.ID)
+	assert.Equal(t, domain.DeletionJobStatusInProgress, current.Status)
+	assert.Equal(t, domain.DeletionStepStatusSucceeded, stepByService(current, "board").Status)
+	chat := stepByService(current, "chat")
+	assert.Equal(t, domain.DeletionStepStatusPending, chat.Status)
+	assert.Equal(t, 1, chat.Attempts)
+	require.NotNil(t, chat.LastError)
+
+	// 실패하지 않은 작업은 재시도 요청할 수 없음
+	_, err = f.svc.RetryDeletionJob(job.ID)
+	assertConflict(t, err)
+
+	// 재시도 시각 전에는 잠겨 있음
+	claimed, err = f.svc.ProcessDue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, claimed)
+
+	// 최대 재시도 횟수 초과 → 작업 실패
+	f.now = f.now.Add(2 * time.Minute)
+	claimed, err = f.svc.ProcessDue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	current = f.job(t, job.ID)
+	assert.Equal(t, domain.DeletionJobStatusFailed, current.Status)
+	assert.Equal(t, domain.DeletionStepStatusFailed, stepByService(current, "chat").Status)
+	assert.Equal(t, []string{"board", "chat", "chat"}, f.purge.calls)
+
+	// 재시도 요청 → 실패한 단계만 다시 실행되어 완료
+	retried, err := f.svc.RetryDeletionJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeletionJobStatusInProgress, retried.Status)
+	assert.Equal(t, 0, stepByService(retried, "chat").Attempts)
+
+	f.purge.failing["chat"] = false
+	claimed, err = f.svc.ProcessDue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	current = f.job(t, job.ID)
+	assert.Equal(t, domain.DeletionJobStatusCompleted, current.Status)
+	assert.NotNil(t, current.CompletedAt)
+	assert.Equal(t, []string{"board", "chat", "chat", "chat"}, f.purge.calls)
+
+	// user-service 데이터 영구 삭제 및 익명화
+	var identities int64
+	require.NoError(t, f.db.Table("user_identities").Where("user_id = ?", user.ID).Count(&identities).Error)
+	assert.Equal(t, int64(0), identities)
+	var purged domain.User
+	require.NoError(t, f.db.Where("id = ?", user.ID).First(&purged).Error)
+	assert.NotEqual(t, user.Email, purged.Email)
+	assert.False(t, purged.IsActive)
+
+	// 완료된 작업은 복구 불가
+	assert.NoError(t, f.svc.RestoreUser(user.ID), "no active job: restore only touches the user row")
+}
+
+// TestDeletionService_RestoreUser verifies restoring cancels the deletion job together with the user restore
+// 계정 복구 시 삭제 작업 취소와 사용자 복구가 함께 처리되는지 검증
+func TestDeletionService_RestoreUser(t *testing.T) {
+	loadUser := func(t *testing.T, f *deletionTestFixture, id uuid.UUID) domain.User {
+		t.Helper()
+		var user domain.User
+		require.NoError(t, f.db.Where("id = ?", id).First(&user).Error)
+		return user
+	}
+
+	t.Run("within the grace period", func(t *testing.T) {
+		f := newDeletionTestFixture(t)
+		user := f.deletedUser(t)
+		job, err := f.svc.ScheduleDeletion(domain.DeletionResourceUser, user.ID, user.ID)
+		require.NoError(t, err)
+
+		userSvc := NewUserService(f.userRepo, nil, f.svc, nil, zap.NewNop(), nil)
+		restored, err := userSvc.RestoreUser(context.Background(), user.ID)
+		require.NoError(t, err)
+		assert.True(t, restored.IsActive)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, domain.DeletionJobStatusCancelled, f.job(t, job.ID).Status)
+	})
+
+	t.Run("without a deletion job", func(t *testing.T) {
+		f := newDeletionTestFixture(t)
+		user := f.deletedUser(t)
+		require.NoError(t, f.svc.RestoreUser(user.ID))
+		assert.Nil(t, loadUser(t, f, user.ID).DeletedAt)
+	})
+
+	t.Run("after the grace period", func(t *testing.T) {
+		f := newDeletionTestFixture(t)
+		user := f.deletedUser(t)
+		job, err := f.svc.ScheduleDeletion(domain.DeletionResourceUser, user.ID, user.ID)
+		require.NoError(t, err)
+
+		f.now = f.now.Add(25 * time.Hour)
+		assertConflict(t, f.svc.RestoreUser(user.ID))
+		assert.NotNil(t, loadUser(t, f, user.ID).DeletedAt)
+		assert.Equal(t, domain.DeletionJobStatusScheduled, f.job(t, job.ID).Status)
+	})
+
+	t.Run("failed user restore keeps the job", func(t *testing.T) {
+		f := newDeletionTestFixture(t)
+		user := f.deletedUser(t)
+		job, err := f.svc.ScheduleDeletion(domain.DeletionResourceUser, user.ID, user.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, f.db.Exec(`CREATE TRIGGER fail_user_restore BEFORE UPDATE ON users
+			BEGIN SELECT RAISE(ABORT, 'restore failed'); END`).Error)
+		require.Error(t, f.svc.RestoreUser(user.ID))
+		assert.Equal(t, domain.DeletionJobStatusScheduled, f.job(t, job.ID).Status)
+		assert.NotNil(t, loadUser(t, f, user.ID).DeletedAt)
+	})
+}
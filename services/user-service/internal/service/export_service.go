@@ -0,0 +1,425 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 개인 데이터 내보내기 관련 비즈니스 로직을 포함합니다.
+// 요청된 내보내기 작업은 워커가 처리하며, 각 서비스의 내부 내보내기 API에서
+// 수집한 데이터를 JSON 파일로 묶은 ZIP을 오브젝트 스토리지에 업로드합니다.
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/client"
+	"user-service/internal/config"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// ExportStorage stores data export archives and issues their download links
+type ExportStorage interface {
+	UploadFile(ctx context.Context, fileKey, contentType string, body io.Reader, size int64) error
+	GeneratePresignedDownloadURL(ctx context.Context, fileKey, downloadName string, expires time.Duration) (string, error)
+	DeleteFile(ctx context.Context, fileKey string) error
+}
+
+// exportFile is a JSON file included in a data export archive
+type exportFile struct {
+	name string
+	data []byte
+}
+
+// exportedMembership represents a workspace membership in a data export
+type exportedMembership struct {
+	WorkspaceID   uuid.UUID       `json:"workspaceId"`
+	WorkspaceName string          `json:"workspaceName,omitempty"`
+	RoleName      domain.RoleName `json:"roleName"`
+	IsDefault     bool            `json:"isDefault"`
+	JoinedAt      time.Time       `json:"joinedAt"`
+}
+
+// exportManifest describes the contents of a data export archive
+type exportManifest struct {
+	ExportID    uuid.UUID `json:"exportId"`
+	UserID      uuid.UUID `json:"userId"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Files       []string  `json:"files"`
+}
+
+// ExportService handles personal data export business logic
+type ExportService struct {
+	exportRepo   *repository.DataExportRepository
+	userRepo     *repository.UserRepository
+	memberRepo   *repository.WorkspaceMemberRepository
+	profileRepo  *repository.UserProfileRepository
+	exportClient client.ExportClient // nil이면 user-service 데이터만 내보냄
+	storage      ExportStorage
+	notiClient   client.NotiClient // nil이면 완료 알림 비활성화
+	cfg          config.ExportConfig
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// NewExportService creates a new ExportService
+func NewExportService(
+	exportRepo *repository.DataExportRepository,
+	userRepo *repository.UserRepository,
+	memberRepo *repository.WorkspaceMemberRepository,
+	profileRepo *repository.UserProfileRepository,
+	exportClient client.ExportClient,
+	storage ExportStorage,
+	notiClient client.NotiClient,
+	cfg config.ExportConfig,
+	logger *zap.Logger,
+) *ExportService {
+	return &ExportService{
+		exportRepo:   exportRepo,
+		userRepo:     userRepo,
+		memberRepo:   memberRepo,
+		profileRepo:  profileRepo,
+		exportClient: exportClient,
+		storage:      storage,
+		notiClient:   notiClient,
+		cfg:          cfg,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// RequestExport starts a data export of the user.
+// An unfinished export of the same user is returned as is.
+func (s *ExportService) RequestExport(userID uuid.UUID) (*domain.DataExport, error) {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("User not found", userID.String())
+		}
+		return nil, response.NewInternalError("Failed to get user", err.Error())
+	}
+
+	existing, err := s.exportRepo.FindActiveByUser(userID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewInternalError("Failed to get data export", err.Error())
+	}
+
+	now := s.now()
+	export := &domain.DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    domain.DataExportStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.exportRepo.Create(export); err != nil {
+		return nil, response.NewInternalError("Failed to create data export", err.Error())
+	}
+
+	s.logger.Info("개인 데이터 내보내기 요청",
+		zap.String("export_id", export.ID.String()),
+		zap.String("user_id", userID.String()))
+	return export, nil
+}
+
+// GetExport returns a data export of the user with a time-limited download link once it is ready
+func (s *ExportService) GetExport(ctx context.Context, userID, exportID uuid.UUID) (*domain.DataExportResponse, error) {
+	export, err := s.exportRepo.FindByIDAndUser(exportID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Data export not found", exportID.String())
+		}
+		return nil, response.NewInternalError("Failed to get data export", err.Error())
+	}
+	return s.toResponse(ctx, export)
+}
+
+// GetLatestExport returns the most recent data export of the user
+func (s *ExportService) GetLatestExport(ctx context.Context, userID uuid.UUID) (*domain.DataExportResponse, error) {
+	export, err := s.exportRepo.FindLatestByUser(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Data export not found", userID.String())
+		}
+		return nil, response.NewInternalError("Failed to get data export", err.Error())
+	}
+	return s.toResponse(ctx, export)
+}
+
+// toResponse converts an export to its response, signing a download link that never outlives the archive
+func (s *ExportService) toResponse(ctx context.Context, export *domain.DataExport) (*domain.DataExportResponse, error) {
+	resp := export.ToResponse()
+	now := s.now()
+	if !export.IsDownloadable(now) {
+		return &resp, nil
+	}
+
+	ttl := s.cfg.DownloadURLTTL
+	if remaining := export.ExpiresAt.Sub(now); remaining < ttl {
+		ttl = remaining
+	}
+	downloadName := fmt.Sprintf("wealist-export-%s.zip", export.CreatedAt.Format("20060102"))
+	url, err := s.storage.GeneratePresignedDownloadURL(ctx, *export.ObjectKey, downloadName, ttl)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to generate download URL", err.Error())
+	}
+	resp.DownloadURL = url
+	return &resp, nil
+}
+
+// ProcessDue removes expired archives and runs the pending exports.
+// It returns how many exports were claimed.
+func (s *ExportService) ProcessDue(ctx context.Context, limit int) (int, error) {
+	s.removeExpired(ctx, limit)
+
+	now := s.now()
+	ids, err := s.exportRepo.FindDueIDs(now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	claimed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := s.exportRepo.Claim(id, now, s.leaseDuration())
+		if err != nil {
+			return claimed, err
+		}
+		if !ok {
+			continue // 다른 인스턴스가 처리 중
+		}
+		claimed++
+		if err := s.processExport(ctx, id); err != nil {
+			s.logger.Error("개인 데이터 내보내기 처리 실패",
+				zap.String("export_id", id.String()),
+				zap.Error(err))
+		}
+	}
+	return claimed, nil
+}
+
+// processExport collects the data of a claimed export, uploads the archive and notifies the user
+func (s *ExportService) processExport(ctx context.Context, id uuid.UUID) error {
+	export, err := s.exportRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	files, err := s.collect(ctx, export)
+	if err == nil {
+		err = s.upload(ctx, export, files)
+	}
+	if err != nil {
+		now := s.now()
+		var retryAt *time.Time
+		if export.Attempts < s.cfg.MaxAttempts {
+			next := now.Add(s.cfg.RetryDelay)
+			retryAt = &next
+		}
+		if markErr := s.exportRepo.MarkFailed(export.ID, err.Error(), now, retryAt); markErr != nil {
+			s.logger.Error("내보내기 실패 상태 저장 실패",
+				zap.String("export_id", export.ID.String()),
+				zap.Error(markErr))
+		}
+		return err
+	}
+
+	s.notifyReady(ctx, export)
+	return nil
+}
+
+// collect gathers the user-service data and the data of every configured service as JSON files
+func (s *ExportService) collect(ctx context.Context, export *domain.DataExport) ([]exportFile, error) {
+	user, err := s.userRepo.FindByID(export.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	profiles, err := s.profileRepo.FindByUser(export.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profiles: %w", err)
+	}
+	members, err := s.memberRepo.FindByUser(export.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memberships: %w", err)
+	}
+
+	profileResponses := make([]domain.UserProfileResponse, 0, len(profiles))
+	for i := range profiles {
+		profileResponses = append(profileResponses, profiles[i].ToResponse())
+	}
+	memberships := make([]exportedMembership, 0, len(members))
+	for _, member := range members {
+		m := exportedMembership{
+			WorkspaceID: member.WorkspaceID,
+			RoleName:    member.RoleName,
+			IsDefault:   member.IsDefault,
+			JoinedAt:    member.JoinedAt,
+		}
+		if member.Workspace != nil {
+			m.WorkspaceName = member.Workspace.WorkspaceName
+		}
+		memberships = append(memberships, m)
+	}
+
+	var files []exportFile
+	add := func(name string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		files = append(files, exportFile{name: name, data: data})
+		return nil
+	}
+
+	if err := add("profile.json", map[string]interface{}{
+		"user":     user.ToResponse(),
+		"profiles": profileResponses,
+	}); err != nil {
+		return nil, err
+	}
+	if err := add("memberships.json", memberships); err != nil {
+		return nil, err
+	}
+
+	if s.exportClient != nil {
+		for _, target := range s.exportClient.Targets() {
+			data, err := s.exportClient.ExportUser(ctx, target, export.UserID)
+			if err != nil {
+				return nil, err
+			}
+			var indented bytes.Buffer
+			if err := json.Indent(&indented, data, "", "  "); err != nil {
+				return nil, fmt.Errorf("invalid %s export: %w", target, err)
+			}
+			files = append(files, exportFile{name: target + ".json", data: indented.Bytes()})
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.name)
+	}
+	if err := add("manifest.json", exportManifest{
+		ExportID:    export.ID,
+		UserID:      export.UserID,
+		GeneratedAt: s.now(),
+		Files:       names,
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// upload writes the files into a ZIP archive, stores it and completes the export
+func (s *ExportService) upload(ctx context.Context, export *domain.DataExport, files []exportFile) error {
+	archive, err := buildExportArchive(files)
+	if err != nil {
+		return err
+	}
+
+	objectKey := fmt.Sprintf("exports/%s/%s.zip", export.UserID, export.ID)
+	if err := s.storage.UploadFile(ctx, objectKey, "application/zip", bytes.NewReader(archive), int64(len(archive))); err != nil {
+		return err
+	}
+
+	now := s.now()
+	expiresAt := now.Add(s.cfg.Retention)
+	if err := s.exportRepo.MarkCompleted(export.ID, objectKey, int64(len(archive)), now, expiresAt); err != nil {
+		return err
+	}
+	export.Status = domain.DataExportStatusCompleted
+	export.ExpiresAt = &expiresAt
+
+	s.logger.Info("개인 데이터 내보내기 완료",
+		zap.String("export_id", export.ID.String()),
+		zap.String("user_id", export.UserID.String()),
+		zap.Int("files", len(files)),
+		zap.Int("size", len(archive)))
+	return nil
+}
+
+// notifyReady sends an EXPORT_READY notification in the user's default workspace
+func (s *ExportService) notifyReady(ctx context.Context, export *domain.DataExport) {
+	if s.notiClient == nil || export.ExpiresAt == nil {
+		return
+	}
+	member, err := s.memberRepo.FindDefaultWorkspace(export.UserID)
+	if err != nil {
+		s.logger.Warn("기본 워크스페이스가 없어 내보내기 완료 알림 생략",
+			zap.String("export_id", export.ID.String()),
+			zap.Error(err))
+		return
+	}
+
+	event := client.NewExportReadyNotification(export.UserID, member.WorkspaceID, export.ID, *export.ExpiresAt)
+	if err := s.notiClient.SendNotification(ctx, event); err != nil {
+		s.logger.Warn("내보내기 완료 알림 전송 실패",
+			zap.String("export_id", export.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// removeExpired deletes the archives whose download period has ended
+func (s *ExportService) removeExpired(ctx context.Context, limit int) {
+	now := s.now()
+	exports, err := s.exportRepo.FindExpired(now, limit)
+	if err != nil {
+		s.logger.Error("만료된 내보내기 조회 실패", zap.Error(err))
+		return
+	}
+	for _, export := range exports {
+		if export.ObjectKey != nil {
+			if err := s.storage.DeleteFile(ctx, *export.ObjectKey); err != nil {
+				s.logger.Warn("만료된 내보내기 파일 삭제 실패",
+					zap.String("export_id", export.ID.String()),
+					zap.Error(err))
+				continue
+			}
+		}
+		if err := s.exportRepo.MarkExpired(export.ID, now); err != nil {
+			s.logger.Error("내보내기 만료 상태 저장 실패",
+				zap.String("export_id", export.ID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+// leaseDuration returns how long a claimed export stays locked while its data is collected
+func (s *ExportService) leaseDuration() time.Duration {
+	calls := 1
+	if s.exportClient != nil {
+		calls += len(s.exportClient.Targets())
+	}
+	return time.Duration(calls)*s.cfg.RequestTimeout + time.Minute
+}
+
+// buildExportArchive writes the files into an in-memory ZIP archive
+func buildExportArchive(files []exportFile) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to archive: %w", f.name, err)
+		}
+		if _, err := w.Write(f.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s to archive: %w", f.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
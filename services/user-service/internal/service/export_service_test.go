@@ -0,0 +1,256 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/config"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+)
+
+// TestBuildExportArchive verifies that every JSON file is written to the archive in order
+// 내보내기 파일이 순서대로 ZIP에 기록되는지 검증
+func TestBuildExportArchive(t *testing.T) {
+	files := []exportFile{
+		{name: "profile.json", data: []byte(`{"user":{}}`)},
+		{name: "board.json", data: []byte(`{"boards":[]}`)},
+	}
+
+	archive, err := buildExportArchive(files)
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 2)
+
+	for i, f := range zr.File {
+		assert.Equal(t, files[i].name, f.Name)
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		assert.Equal(t, files[i].data, data)
+	}
+}
+
+// fakeExportClient returns a fixed export payload per service
+type fakeExportClient struct {
+	payloads map[string]string
+	failing  map[string]bool
+}
+
+func (c *fakeExportClient) Targets() []string { return []string{"board"} }
+
+func (c *fakeExportClient) ExportUser(ctx context.Context, target string, userID uuid.UUID) (json.RawMessage, error) {
+	if c.failing[target] {
+		return nil, errors.New(target + " unavailable")
+	}
+	return json.RawMessage(c.payloads[target]), nil
+}
+
+// fakeExportStorage keeps uploaded archives in memory
+type fakeExportStorage struct {
+	files map[string][]byte
+}
+
+func (s *fakeExportStorage) UploadFile(ctx context.Context, fileKey, contentType string, body io.Reader, size int64) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.files[fileKey] = data
+	return nil
+}
+
+func (s *fakeExportStorage) GeneratePresignedDownloadURL(ctx context.Context, fileKey, downloadName string, expires time.Duration) (string, error) {
+	return "https://storage.example/" + fileKey, nil
+}
+
+func (s *fakeExportStorage) DeleteFile(ctx context.Context, fileKey string) error {
+	delete(s.files, fileKey)
+	return nil
+}
+
+type exportTestFixture struct {
+	db        *gorm.DB
+	svc       *ExportService
+	client    *fakeExportClient
+	storage   *fakeExportStorage
+	user      *domain.User
+	workspace *domain.Workspace
+}
+
+func newExportTestFixture(t *testing.T) *exportTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE users (
+			id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT NOT NULL DEFAULT '', google_id TEXT,
+			provider TEXT DEFAULT 'google', is_active INTEGER DEFAULT 1,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspaces (
+			id TEXT PRIMARY KEY, owner_id TEXT NOT NULL, workspace_name TEXT NOT NULL, workspace_description TEXT,
+			is_public INTEGER DEFAULT 1, need_approved INTEGER DEFAULT 1, only_owner_can_invite INTEGER DEFAULT 1,
+			is_active INTEGER DEFAULT 1, created_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspace_members (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, user_id TEXT NOT NULL, role_name TEXT NOT NULL DEFAULT 'MEMBER',
+			custom_role_id TEXT, is_default INTEGER DEFAULT 0, is_active INTEGER DEFAULT 1, status TEXT NOT NULL DEFAULT 'ACTIVE',
+			suspended_at DATETIME, suspended_by TEXT, suspend_reason TEXT, joined_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE user_profiles (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, workspace_id TEXT NOT NULL, nick_name TEXT NOT NULL,
+			email TEXT NOT NULL, profile_image_url TEXT, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE data_exports (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, status TEXT NOT NULL, attempts INTEGER NOT NULL DEFAULT 0,
+			object_key TEXT, file_size INTEGER NOT NULL DEFAULT 0, last_error TEXT, locked_until DATETIME,
+			completed_at DATETIME, expires_at DATETIME, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	now := time.Now()
+	f := &exportTestFixture{
+		db:      db,
+		client:  &fakeExportClient{payloads: map[string]string{"board": `{"boards":[{"title":"Roadmap"}]}`}, failing: map[string]bool{}},
+		storage: &fakeExportStorage{files: map[string][]byte{}},
+	}
+	f.user = &domain.User{ID: uuid.New(), Email: "alice@example.com", Name: "Alice", Provider: "google", IsActive: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, db.Create(f.user).Error)
+	f.workspace = &domain.Workspace{ID: uuid.New(), OwnerID: f.user.ID, WorkspaceName: "Acme", IsActive: true, CreatedAt: now}
+	require.NoError(t, db.Create(f.workspace).Error)
+	require.NoError(t, db.Create(&domain.WorkspaceMember{
+		ID: uuid.New(), WorkspaceID: f.workspace.ID, UserID: f.user.ID, RoleName: domain.RoleOwner,
+		IsDefault: true, IsActive: true, Status: domain.MemberStatusActive, JoinedAt: now, UpdatedAt: now,
+	}).Error)
+	require.NoError(t, db.Create(&domain.UserProfile{
+		ID: uuid.New(), UserID: f.user.ID, WorkspaceID: f.workspace.ID, NickName: "ally", Email: f.user.Email,
+		CreatedAt: now, UpdatedAt: now,
+	}).Error)
+
+	f.svc = NewExportService(
+		repository.NewDataExportRepository(db),
+		repository.NewUserRepository(db),
+		repository.NewWorkspaceMemberRepository(db),
+		repository.NewUserProfileRepository(db),
+		f.client,
+		f.storage,
+		nil,
+		config.ExportConfig{MaxAttempts: 2, RetryDelay: time.Minute, RequestTimeout: time.Second, Retention: 24 * time.Hour, DownloadURLTTL: time.Hour},
+		zap.NewNop(),
+	)
+	return f
+}
+
+// readArchive returns the files of an uploaded archive by name in archive order
+func readArchive(t *testing.T, archive []byte) ([]string, map[string][]byte) {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+
+	var names []string
+	contents := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		names = append(names, f.Name)
+		contents[f.Name] = data
+	}
+	return names, contents
+}
+
+// TestExportService_ProcessDue_ArchiveContents verifies the uploaded archive holds the user's data of every service
+// 업로드된 ZIP에 user-service 데이터와 각 서비스의 내보내기 데이터가 담기는지 검증
+func TestExportService_ProcessDue_ArchiveContents(t *testing.T) {
+	f := newExportTestFixture(t)
+	export, err := f.svc.RequestExport(f.user.ID)
+	require.NoError(t, err)
+
+	claimed, err := f.svc.ProcessDue(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+
+	objectKey := "exports/" + f.user.ID.String() + "/" + export.ID.String() + ".zip"
+	archive, ok := f.storage.files[objectKey]
+	require.True(t, ok, "archive should be uploaded to %s", objectKey)
+
+	names, contents := readArchive(t, archive)
+	assert.Equal(t, []string{"profile.json", "memberships.json", "board.json", "manifest.json"}, names)
+
+	var profile struct {
+		User     domain.UserResponse          `json:"user"`
+		Profiles []domain.UserProfileResponse `json:"profiles"`
+	}
+	require.NoError(t, json.Unmarshal(contents["profile.json"], &profile))
+	assert.Equal(t, f.user.ID, profile.User.UserID)
+	assert.Equal(t, "alice@example.com", profile.User.Email)
+	require.Len(t, profile.Profiles, 1)
+	assert.Equal(t, "ally", profile.Profiles[0].NickName)
+	assert.Equal(t, f.workspace.ID, profile.Profiles[0].WorkspaceID)
+
+	var memberships []exportedMembership
+	require.NoError(t, json.Unmarshal(contents["memberships.json"], &memberships))
+	require.Len(t, memberships, 1)
+	assert.Equal(t, f.workspace.ID, memberships[0].WorkspaceID)
+	assert.Equal(t, "Acme", memberships[0].WorkspaceName)
+	assert.Equal(t, domain.RoleOwner, memberships[0].RoleName)
+	assert.True(t, memberships[0].IsDefault)
+
+	assert.JSONEq(t, `{"boards":[{"title":"Roadmap"}]}`, string(contents["board.json"]))
+
+	var manifest exportManifest
+	require.NoError(t, json.Unmarshal(contents["manifest.json"], &manifest))
+	assert.Equal(t, export.ID, manifest.ExportID)
+	assert.Equal(t, f.user.ID, manifest.UserID)
+	assert.Equal(t, []string{"profile.json", "memberships.json", "board.json"}, manifest.Files)
+
+	resp, err := f.svc.GetExport(context.Background(), f.user.ID, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DataExportStatusCompleted, resp.Status)
+	assert.Equal(t, int64(len(archive)), resp.FileSize)
+	assert.Equal(t, "https://storage.example/"+objectKey, resp.DownloadURL)
+}
+
+// TestExportService_ProcessDue_ServiceFailure verifies a failing service export uploads nothing and schedules a retry
+// 서비스 내보내기가 실패하면 업로드 없이 재시도가 예약되는지 검증
+func TestExportService_ProcessDue_ServiceFailure(t *testing.T) {
+	f := newExportTestFixture(t)
+	f.client.failing["board"] = true
+	export, err := f.svc.RequestExport(f.user.ID)
+	require.NoError(t, err)
+
+	_, err = f.svc.ProcessDue(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Empty(t, f.storage.files)
+
+	var stored domain.DataExport
+	require.NoError(t, f.db.First(&stored, "id = ?", export.ID).Error)
+	assert.Equal(t, domain.DataExportStatusPending, stored.Status)
+	require.NotNil(t, stored.LastError)
+	assert.Contains(t, *stored.LastError, "board unavailable")
+	require.NotNil(t, stored.LockedUntil, "retry should be scheduled")
+}
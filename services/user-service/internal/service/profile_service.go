@@ -1,17 +1,24 @@
 package service
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+
 	"user-service/internal/domain"
 	"user-service/internal/metrics"
 	"user-service/internal/repository"
 	"user-service/internal/response"
 )
 
+// profileCacheTimeout bounds the Redis call that drops a cached profile
+const profileCacheTimeout = 2 * time.Second
+
 // ProfileService handles user profile business logic
 // 사용자 프로필 생성, 조회, 수정, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
@@ -19,16 +26,18 @@ type ProfileService struct {
 	profileRepo *repository.UserProfileRepository
 	memberRepo  *repository.WorkspaceMemberRepository
 	userRepo    *repository.UserRepository
+	redis       *redis.Client // 다른 서비스의 프로필 캐시 무효화용 (nil 허용)
 	logger      *zap.Logger
 	metrics     *metrics.Metrics // 메트릭 수집을 위한 필드
 }
 
 // NewProfileService creates a new ProfileService
-// metrics 파라미터가 nil인 경우에도 안전하게 동작합니다.
+// metrics, redisClient 파라미터가 nil인 경우에도 안전하게 동작합니다.
 func NewProfileService(
 	profileRepo *repository.UserProfileRepository,
 	memberRepo *repository.WorkspaceMemberRepository,
 	userRepo *repository.UserRepository,
+	redisClient *redis.Client,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *ProfileService {
@@ -36,6 +45,7 @@ func NewProfileService(
 		profileRepo: profileRepo,
 		memberRepo:  memberRepo,
 		userRepo:    userRepo,
+		redis:       redisClient,
 		logger:      logger,
 		metrics:     m,
 	}
@@ -122,6 +132,32 @@ func (s *ProfileService) GetUserProfile(viewerID, targetUserID, workspaceID uuid
 	return s.profileRepo.FindByUserAndWorkspace(targetUserID, workspaceID)
 }
 
+// GetProfilesBatch resolves the profiles for many (workspace, user) pairs in one query.
+// Pairs without a profile are omitted; there is no fallback to the default profile.
+func (s *ProfileService) GetProfilesBatch(keys []domain.ProfileKey) ([]domain.UserProfile, error) {
+	if len(keys) > domain.MaxBatchProfiles {
+		return nil, response.NewValidationError("Too many profiles requested", "")
+	}
+
+	// 중복 요청 제거
+	seen := make(map[domain.ProfileKey]struct{}, len(keys))
+	unique := make([]domain.ProfileKey, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, key)
+	}
+
+	profiles, err := s.profileRepo.FindByWorkspaceUserPairs(unique)
+	if err != nil {
+		s.logger.Error("Failed to find profiles", zap.Error(err), zap.Int("count", len(unique)))
+		return nil, err
+	}
+	return profiles, nil
+}
+
 // UpdateProfile updates a user profile (creates if not exists)
 func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain.UpdateProfileRequest) (*domain.UserProfile, error) {
 	profile, err := s.profileRepo.FindByUserAndWorkspace(userID, workspaceID)
@@ -165,6 +201,7 @@ func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain
 			s.metrics.RecordProfileCreated()
 		}
 
+		s.invalidateProfileCache(userID, workspaceID)
 		s.logger.Info("Profile created during update", zap.String("profileId", profile.ID.String()))
 		return profile, nil
 	}
@@ -182,6 +219,7 @@ func (s *ProfileService) UpdateProfile(userID, workspaceID uuid.UUID, req domain
 		return nil, err
 	}
 
+	s.invalidateProfileCache(userID, workspaceID)
 	s.logger.Info("Profile updated", zap.String("profileId", profile.ID.String()))
 	return profile, nil
 }
@@ -192,6 +230,7 @@ func (s *ProfileService) DeleteProfile(userID, workspaceID uuid.UUID) error {
 		s.logger.Error("Failed to delete profile", zap.Error(err))
 		return err
 	}
+	s.invalidateProfileCache(userID, workspaceID)
 	s.logger.Info("Profile deleted", zap.String("userId", userID.String()), zap.String("workspaceId", workspaceID.String()))
 	return nil
 }
@@ -211,6 +250,7 @@ func (s *ProfileService) UpdateProfileImage(userID, workspaceID uuid.UUID, image
 		return nil, err
 	}
 
+	s.invalidateProfileCache(userID, workspaceID)
 	s.logger.Info("Profile image updated", zap.String("profileId", profile.ID.String()))
 	return profile, nil
 }
@@ -263,3 +303,21 @@ func (s *ProfileService) GetOrCreateProfile(userID, workspaceID uuid.UUID, defau
 	s.logger.Info("Profile created", zap.String("profileId", newProfile.ID.String()))
 	return newProfile, nil
 }
+
+// invalidateProfileCache drops the profile cached by other services' profile resolvers.
+// Cache errors are logged only; entries expire on their own TTL.
+func (s *ProfileService) invalidateProfileCache(userID, workspaceID uuid.UUID) {
+	if s.redis == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), profileCacheTimeout)
+	defer cancel()
+
+	if err := commonclient.InvalidateProfile(ctx, s.redis, workspaceID, userID); err != nil {
+		s.logger.Warn("Failed to invalidate profile cache",
+			zap.Error(err),
+			zap.String("userId", userID.String()),
+			zap.String("workspaceId", workspaceID.String()))
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// identityLinkTokenTTL bounds how long the user has to finish the OAuth flow of the login being linked
+const identityLinkTokenTTL = 10 * time.Minute
+
+// UserIdentityService manages the login identities linked to the current user.
+// 새 아이덴티티 연결은 링크 토큰을 발급받은 뒤 auth-service OAuth 로그인으로 완료됩니다.
+type UserIdentityService struct {
+	identityRepo *repository.UserIdentityRepository
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// NewUserIdentityService creates a new UserIdentityService
+func NewUserIdentityService(identityRepo *repository.UserIdentityRepository, logger *zap.Logger) *UserIdentityService {
+	return &UserIdentityService{
+		identityRepo: identityRepo,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// GetMyIdentities lists the logins linked to the caller
+func (s *UserIdentityService) GetMyIdentities(userID uuid.UUID) ([]domain.UserIdentityResponse, error) {
+	identities, err := s.identityRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get identities", err.Error())
+	}
+
+	responses := make([]domain.UserIdentityResponse, len(identities))
+	for i := range identities {
+		responses[i] = identities[i].ToResponse()
+	}
+	return responses, nil
+}
+
+// CreateLinkToken issues a single-use token the caller passes to auth-service to link another login
+func (s *UserIdentityService) CreateLinkToken(userID uuid.UUID) (*domain.IdentityLinkTokenResponse, error) {
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, response.NewInternalError("Failed to generate link token", err.Error())
+	}
+
+	// 토큰을 발급받은 브라우저에만 쿠키로 전달되는 바인딩 값 (해시만 저장)
+	binding, err := generateInviteToken()
+	if err != nil {
+		return nil, response.NewInternalError("Failed to generate link token", err.Error())
+	}
+
+	now := s.now()
+	linkToken := &domain.IdentityLinkToken{
+		Token:       token,
+		UserID:      userID,
+		BindingHash: hashLinkBinding(binding),
+		ExpiresAt:   now.Add(identityLinkTokenTTL),
+		CreatedAt:   now,
+	}
+	if err := s.identityRepo.CreateLinkToken(linkToken); err != nil {
+		s.logger.Error("Failed to create link token", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, response.NewInternalError("Failed to create link token", err.Error())
+	}
+
+	return &domain.IdentityLinkTokenResponse{
+		LinkToken: linkToken.Token,
+		ExpiresAt: linkToken.ExpiresAt,
+		Binding:   binding,
+	}, nil
+}
+
+// hashLinkBinding hashes the binding cookie value of a link token
+func hashLinkBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+// UnlinkIdentity removes a login from the caller. The last remaining login cannot be removed.
+func (s *UserIdentityService) UnlinkIdentity(userID, identityID uuid.UUID) error {
+	if err := s.identityRepo.DeleteForUser(userID, identityID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Identity not found", identityID.String())
+		}
+		if errors.Is(err, repository.ErrLastIdentity) {
+			return response.NewConflictError("Cannot unlink the last login of the account", identityID.String())
+		}
+		s.logger.Error("Failed to unlink identity", zap.Error(err), zap.String("user_id", userID.String()))
+		return response.NewInternalError("Failed to unlink identity", err.Error())
+	}
+
+	s.logger.Info("User identity unlinked",
+		zap.String("user_id", userID.String()),
+		zap.String("identity_id", identityID.String()))
+	return nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// TestCanAutoLinkByEmail verifies a new provider login only merges into an existing account
+// when both the incoming and the existing email are verified
+// 새 로그인과 기존 계정의 이메일이 모두 검증된 경우에만 병합되는지 검증
+func TestCanAutoLinkByEmail(t *testing.T) {
+	const email = "user@example.com"
+	googleID := "google-sub"
+	legacyUser := &domain.User{Email: email, Provider: "google"}
+	legacyGoogleUser := &domain.User{Email: email, Provider: "google", GoogleID: &googleID}
+	verified := []domain.UserIdentity{{Provider: "google", Email: email, EmailVerified: true}}
+	unverified := []domain.UserIdentity{{Provider: "google", Email: email}}
+	verifiedOtherEmail := []domain.UserIdentity{{Provider: "google", Email: "old@example.com", EmailVerified: true}}
+
+	tests := []struct {
+		name       string
+		req        domain.OAuthLoginRequest
+		user       *domain.User
+		identities []domain.UserIdentity
+		want       bool
+	}{
+		{"incoming verified, existing verified", domain.OAuthLoginRequest{Provider: "github", Email: email, EmailVerified: true}, legacyUser, verified, true},
+		{"incoming verified, existing verified, case differs", domain.OAuthLoginRequest{Provider: "github", Email: "User@Example.com", EmailVerified: true}, legacyUser, verified, true},
+		{"incoming verified, existing unverified", domain.OAuthLoginRequest{Provider: "github", Email: email, EmailVerified: true}, legacyUser, unverified, false},
+		{"incoming verified, existing verified for another email", domain.OAuthLoginRequest{Provider: "github", Email: email, EmailVerified: true}, legacyUser, verifiedOtherEmail, false},
+		{"incoming unverified, existing verified", domain.OAuthLoginRequest{Provider: "github", Email: email}, legacyUser, verified, false},
+		{"incoming unverified, existing unverified", domain.OAuthLoginRequest{Provider: "github", Email: email}, legacyUser, unverified, false},
+		{"incoming verified, legacy account of the same provider", domain.OAuthLoginRequest{Provider: "google", Subject: "new-sub", Email: email, EmailVerified: true}, legacyUser, nil, true},
+		{"incoming verified, legacy account of another provider", domain.OAuthLoginRequest{Provider: "github", Email: email, EmailVerified: true}, legacyUser, nil, false},
+		{"incoming unverified, legacy account of the same provider", domain.OAuthLoginRequest{Provider: "google", Email: email}, legacyUser, nil, false},
+		{"incoming verified, legacy google account with the same subject", domain.OAuthLoginRequest{Provider: "google", Subject: googleID, Email: email, EmailVerified: true}, legacyGoogleUser, nil, true},
+		{"incoming verified, legacy google account with another subject", domain.OAuthLoginRequest{Provider: "google", Subject: "other-sub", Email: email, EmailVerified: true}, legacyGoogleUser, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canAutoLinkByEmail(tt.req, tt.user, tt.identities))
+		})
+	}
+}
+
+// recordingDomainJoiner records the users passed to JoinByEmailDomain
+type recordingDomainJoiner struct {
+	joined []uuid.UUID
+}
+
+func (j *recordingDomainJoiner) JoinByEmailDomain(ctx context.Context, user *domain.User) {
+	j.joined = append(j.joined, user.ID)
+}
+
+func setupIdentityTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE users (
+			id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT NOT NULL DEFAULT '', google_id TEXT,
+			provider TEXT DEFAULT 'google', is_active INTEGER DEFAULT 1,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE user_identities (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, provider TEXT NOT NULL, subject TEXT NOT NULL,
+			email TEXT NOT NULL DEFAULT '', email_verified INTEGER NOT NULL DEFAULT 0, last_login_at DATETIME,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, UNIQUE (provider, subject)
+		)`,
+		`CREATE TABLE identity_link_tokens (
+			token TEXT PRIMARY KEY, user_id TEXT NOT NULL, binding_hash TEXT NOT NULL DEFAULT '',
+			expires_at DATETIME NOT NULL, used_at DATETIME, created_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+	return db
+}
+
+// TestFindOrCreateOAuthUser_DomainJoinRequiresVerifiedEmail verifies new users only join by email domain
+// when the provider verified their email
+// 검증되지 않은 이메일로 가입한 새 사용자는 도메인 자동 참여를 하지 않는지 검증
+func TestFindOrCreateOAuthUser_DomainJoinRequiresVerifiedEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            domain.OAuthLoginRequest
+		wantDomainJoin bool
+	}{
+		{"verified email", domain.OAuthLoginRequest{Email: "new@corp.example", Name: "New", Provider: "google", Subject: "sub-1", EmailVerified: true}, true},
+		{"unverified email", domain.OAuthLoginRequest{Email: "new@corp.example", Name: "New", Provider: "github", Subject: "sub-2"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupIdentityTestDB(t)
+			joiner := &recordingDomainJoiner{}
+			svc := NewUserService(repository.NewUserRepository(db), repository.NewUserIdentityRepository(db), nil, joiner, zap.NewNop(), nil)
+
+			user, err := svc.FindOrCreateOAuthUser(context.Background(), tt.req)
+			require.NoError(t, err)
+			if tt.wantDomainJoin {
+				assert.Equal(t, []uuid.UUID{user.ID}, joiner.joined)
+			} else {
+				assert.Empty(t, joiner.joined)
+			}
+		})
+	}
+}
+
+// TestFindOrCreateOAuthUser_RequiresSubject verifies a login without a provider subject is rejected
+// instead of logging in to the account with the same email
+// 제공자 계정 식별자가 없는 로그인은 같은 이메일의 계정으로 로그인하거나 새 계정을 만들지 않고 거부되는지 검증
+func TestFindOrCreateOAuthUser_RequiresSubject(t *testing.T) {
+	db := setupIdentityTestDB(t)
+	userRepo := repository.NewUserRepository(db)
+	joiner := &recordingDomainJoiner{}
+	svc := NewUserService(userRepo, repository.NewUserIdentityRepository(db), nil, joiner, zap.NewNop(), nil)
+
+	now := time.Now()
+	victim := &domain.User{ID: uuid.New(), Email: "victim@corp.example", Provider: "google", IsActive: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, userRepo.Create(victim))
+
+	for _, email := range []string{victim.Email, "new@corp.example"} {
+		_, err := svc.FindOrCreateOAuthUser(context.Background(), domain.OAuthLoginRequest{
+			Email: email, Name: "Attacker", Provider: "github", EmailVerified: true,
+		})
+		require.Error(t, err)
+		appErr, ok := err.(*response.AppError)
+		require.True(t, ok)
+		assert.Equal(t, response.ErrCodeValidation, appErr.Code)
+	}
+
+	var users, identities int64
+	require.NoError(t, db.Model(&domain.User{}).Count(&users).Error)
+	require.NoError(t, db.Model(&domain.UserIdentity{}).Count(&identities).Error)
+	assert.Equal(t, int64(1), users)
+	assert.Equal(t, int64(0), identities)
+	assert.Empty(t, joiner.joined)
+}
+
+// TestFindOrCreateOAuthUser_ExistingUserDomainJoinRequiresVerifiedEmail verifies returning users only join
+// by email domain when this login's email is verified
+// 기존 사용자도 이번 로그인의 이메일이 검증된 경우에만 도메인 자동 참여하는지 검증
+func TestFindOrCreateOAuthUser_ExistingUserDomainJoinRequiresVerifiedEmail(t *testing.T) {
+	db := setupIdentityTestDB(t)
+	joiner := &recordingDomainJoiner{}
+	svc := NewUserService(repository.NewUserRepository(db), repository.NewUserIdentityRepository(db), nil, joiner, zap.NewNop(), nil)
+
+	login := domain.OAuthLoginRequest{Email: "user@corp.example", Name: "User", Provider: "github", Subject: "gh-1"}
+	user, err := svc.FindOrCreateOAuthUser(context.Background(), login)
+	require.NoError(t, err)
+	assert.Empty(t, joiner.joined)
+
+	_, err = svc.FindOrCreateOAuthUser(context.Background(), login)
+	require.NoError(t, err)
+	assert.Empty(t, joiner.joined)
+
+	login.EmailVerified = true
+	_, err = svc.FindOrCreateOAuthUser(context.Background(), login)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{user.ID}, joiner.joined)
+}
+
+// TestFindOrCreateOAuthUser_LinkTokenBinding verifies a link token only links a login
+// from the browser it was issued to
+// 링크 토큰은 발급받은 브라우저의 바인딩 쿠키와 함께일 때만 사용되는지 검증 (login CSRF)
+func TestFindOrCreateOAuthUser_LinkTokenBinding(t *testing.T) {
+	db := setupIdentityTestDB(t)
+	userRepo := repository.NewUserRepository(db)
+	identityRepo := repository.NewUserIdentityRepository(db)
+	svc := NewUserService(userRepo, identityRepo, nil, nil, zap.NewNop(), nil)
+	identitySvc := NewUserIdentityService(identityRepo, zap.NewNop())
+
+	now := time.Now()
+	owner := &domain.User{ID: uuid.New(), Email: "owner@example.com", Provider: "google", IsActive: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, userRepo.Create(owner))
+
+	token, err := identitySvc.CreateLinkToken(owner.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, token.Binding)
+
+	login := domain.OAuthLoginRequest{
+		Email: "someone@example.net", Name: "Someone", Provider: "github", Subject: "gh-1", LinkToken: token.LinkToken,
+	}
+
+	// 바인딩 쿠키가 없거나 다른 브라우저의 값이면 거부
+	for _, binding := range []string{"", "attacker-binding"} {
+		req := login
+		req.LinkBinding = binding
+		_, err := svc.FindOrCreateOAuthUser(context.Background(), req)
+		require.Error(t, err)
+		appErr, ok := err.(*response.AppError)
+		require.True(t, ok)
+		assert.Equal(t, response.ErrCodeValidation, appErr.Code)
+	}
+
+	req := login
+	req.LinkBinding = token.Binding
+	user, err := svc.FindOrCreateOAuthUser(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID, user.ID)
+
+	identities, err := identityRepo.FindByUserID(owner.ID)
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	assert.Equal(t, "github", identities[0].Provider)
+
+	// 한 번 사용한 토큰은 다시 사용할 수 없음
+	req.Subject = "gh-2"
+	_, err = svc.FindOrCreateOAuthUser(context.Background(), req)
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,22 +18,33 @@ import (
 	"user-service/internal/response"
 )
 
+// EmailDomainJoiner adds a user who logged in with OAuth to the workspaces registered for their email domain
+type EmailDomainJoiner interface {
+	JoinByEmailDomain(ctx context.Context, user *domain.User)
+}
+
 // UserService handles user business logic
 // 사용자 생성, 조회, 수정, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
 type UserService struct {
-	userRepo *repository.UserRepository
-	logger   *zap.Logger
-	metrics  *metrics.Metrics // 메트릭 수집을 위한 필드
+	userRepo        *repository.UserRepository
+	identityRepo    *repository.UserIdentityRepository
+	deletionService *DeletionService  // nil이면 영구 삭제 작업을 예약하지 않음
+	domainJoiner    EmailDomainJoiner // nil이면 이메일 도메인 자동 참여를 하지 않음
+	logger          *zap.Logger
+	metrics         *metrics.Metrics // 메트릭 수집을 위한 필드
 }
 
 // NewUserService creates a new UserService
-// metrics 파라미터가 nil인 경우에도 안전하게 동작합니다.
-func NewUserService(userRepo *repository.UserRepository, logger *zap.Logger, m *metrics.Metrics) *UserService {
+// metrics, deletionService, domainJoiner 파라미터가 nil인 경우에도 안전하게 동작합니다.
+func NewUserService(userRepo *repository.UserRepository, identityRepo *repository.UserIdentityRepository, deletionService *DeletionService, domainJoiner EmailDomainJoiner, logger *zap.Logger, m *metrics.Metrics) *UserService {
 	return &UserService{
-		userRepo: userRepo,
-		logger:   logger,
-		metrics:  m,
+		userRepo:        userRepo,
+		identityRepo:    identityRepo,
+		deletionService: deletionService,
+		domainJoiner:    domainJoiner,
+		logger:          logger,
+		metrics:         m,
 	}
 }
 
@@ -154,16 +166,31 @@ func (s *UserService) DeleteUser(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	// 유예 기간 후 모든 서비스에서 사용자 데이터 영구 삭제
+	if s.deletionService != nil {
+		if _, err := s.deletionService.ScheduleDeletion(domain.DeletionResourceUser, id, id); err != nil {
+			log.Error("DeleteUser failed to schedule deletion job", zap.Error(err))
+			return err
+		}
+	}
+
 	log.Info("User deleted", zap.String("enduser.id", id.String()))
 	return nil
 }
 
 // RestoreUser restores a soft deleted user
+// 영구 삭제 유예 기간이 지난 경우 ConflictError를 반환합니다.
 func (s *UserService) RestoreUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	log := s.log(ctx)
 	log.Debug("RestoreUser service started", zap.String("enduser.id", id.String()))
 
-	if err := s.userRepo.Restore(id); err != nil {
+	// 삭제 작업 취소와 사용자 복구는 한 트랜잭션으로 처리
+	if s.deletionService != nil {
+		if err := s.deletionService.RestoreUser(id); err != nil {
+			log.Warn("RestoreUser failed to restore user", zap.Error(err))
+			return nil, err
+		}
+	} else if err := s.userRepo.Restore(id); err != nil {
 		log.Error("RestoreUser failed to restore user", zap.Error(err))
 		return nil, err
 	}
@@ -172,6 +199,17 @@ func (s *UserService) RestoreUser(ctx context.Context, id uuid.UUID) (*domain.Us
 	return s.userRepo.FindByID(id)
 }
 
+// GetDeletionStatus returns the deletion job of a user account with its per-service status
+func (s *UserService) GetDeletionStatus(ctx context.Context, id uuid.UUID) (*domain.DeletionJob, error) {
+	log := s.log(ctx)
+	log.Debug("GetDeletionStatus service started", zap.String("enduser.id", id.String()))
+
+	if s.deletionService == nil {
+		return nil, response.NewNotFoundError("Deletion job not found", id.String())
+	}
+	return s.deletionService.GetDeletionJob(domain.DeletionResourceUser, id)
+}
+
 // UserExists checks if a user exists
 func (s *UserService) UserExists(ctx context.Context, id uuid.UUID) (bool, error) {
 	log := s.log(ctx)
@@ -179,91 +217,109 @@ func (s *UserService) UserExists(ctx context.Context, id uuid.UUID) (bool, error
 	return s.userRepo.Exists(id)
 }
 
-// FindOrCreateUser finds or creates a user (for OAuth)
-func (s *UserService) FindOrCreateUser(ctx context.Context, email string, googleID *string) (*domain.User, error) {
+// FindOrCreateOAuthUser finds or creates a user for OAuth login (called by auth-service)
+// 로그인은 (provider, subject) 아이덴티티 기준으로 식별하며, 이메일로 기존 계정에 자동 연결하는 것은
+// 제공자가 이메일을 검증한 경우에만 허용합니다. LinkToken이 있으면 토큰 소유자 계정에 연결합니다.
+func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, req domain.OAuthLoginRequest) (*domain.User, error) {
 	log := s.log(ctx)
-	log.Debug("FindOrCreateUser service started", zap.String("user.email", email))
-
-	// Try to find by Google ID first
-	if googleID != nil {
-		user, err := s.userRepo.FindByGoogleID(*googleID)
-		if err == nil {
-			log.Debug("FindOrCreateUser found by Google ID", zap.String("enduser.id", user.ID.String()))
-			return user, nil
-		}
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, err
-		}
+	log.Info("OAuth login attempt",
+		zap.String("user.email", req.Email),
+		zap.String("oauth.provider", req.Provider))
+
+	if req.Subject == "" {
+		// 이메일만으로 기존 계정에 로그인시키면 검증되지 않은 이메일로 계정을 탈취할 수 있음
+		log.Warn("OAuth login rejected, provider subject is missing",
+			zap.String("oauth.provider", req.Provider))
+		return nil, response.NewValidationError("Provider subject is required", req.Provider)
+	}
+
+	now := time.Now()
+	if req.LinkToken != "" {
+		return s.linkOAuthIdentity(ctx, req, now)
 	}
 
-	// Try to find by email
-	user, err := s.userRepo.FindByEmail(email)
+	identity, err := s.identityRepo.FindByProviderSubject(req.Provider, req.Subject)
 	if err == nil {
-		// Update Google ID if not set
-		if googleID != nil && user.GoogleID == nil {
-			user.GoogleID = googleID
-			user.UpdatedAt = time.Now()
-			if err := s.userRepo.Update(user); err != nil {
-				log.Error("FindOrCreateUser failed to update user with Google ID", zap.Error(err))
+		user, err := s.userRepo.FindByID(identity.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, response.NewForbiddenError("User account is deleted", identity.UserID.String())
 			}
+			return nil, response.NewInternalError("Failed to find user", err.Error())
 		}
-		log.Debug("FindOrCreateUser found by email", zap.String("enduser.id", user.ID.String()))
+		if err := s.identityRepo.UpdateLogin(identity.ID, req.Email, req.EmailVerified, now); err != nil {
+			log.Warn("FindOrCreateOAuthUser failed to record identity login", zap.Error(err))
+		}
+		s.fillEmptyName(ctx, user, req.Name)
+		log.Info("Existing user found for OAuth", zap.String("enduser.id", user.ID.String()))
+		s.joinByEmailDomain(ctx, user, req.EmailVerified)
 		return user, nil
 	}
 	if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, err
+		log.Error("FindOrCreateOAuthUser failed to find identity", zap.Error(err))
+		return nil, response.NewInternalError("Failed to find identity", err.Error())
 	}
 
-	// Create new user
-	log.Debug("FindOrCreateUser creating new user", zap.String("user.email", email))
-	return s.CreateUser(ctx, domain.CreateUserRequest{
-		Email:    email,
-		GoogleID: googleID,
-		Provider: "google",
-	})
-}
-
-// FindOrCreateOAuthUser finds or creates a user for OAuth login (called by auth-service)
-func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, email, name, provider string) (*domain.User, error) {
-	log := s.log(ctx)
-	log.Info("OAuth login attempt",
-		zap.String("user.email", email),
-		zap.String("oauth.provider", provider))
+	newIdentity := &domain.UserIdentity{
+		ID:            uuid.New(),
+		Provider:      req.Provider,
+		Subject:       req.Subject,
+		Email:         req.Email,
+		EmailVerified: req.EmailVerified,
+		LastLoginAt:   &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
 
-	// Try to find by email
-	user, err := s.userRepo.FindByEmail(email)
+	// 같은 이메일의 기존 계정
+	user, err := s.userRepo.FindByEmail(req.Email)
 	if err == nil {
-		// Update name if it was empty (for existing users without name)
-		if user.Name == "" && name != "" {
-			user.Name = name
-			user.UpdatedAt = time.Now()
-			if err := s.userRepo.Update(user); err != nil {
-				log.Error("FindOrCreateOAuthUser failed to update user name", zap.Error(err))
-			}
+		identities, err := s.identityRepo.FindByUserID(user.ID)
+		if err != nil {
+			return nil, response.NewInternalError("Failed to find identities", err.Error())
 		}
-		log.Info("Existing user found for OAuth", zap.String("enduser.id", user.ID.String()))
+		if !canAutoLinkByEmail(req, user, identities) {
+			log.Warn("OAuth login rejected, email matches an existing account but is not verified on both sides",
+				zap.String("enduser.id", user.ID.String()),
+				zap.String("oauth.provider", req.Provider))
+			return nil, response.NewConflictError(
+				"An account with this email already exists. Log in and link this provider from account settings",
+				req.Provider)
+		}
+
+		newIdentity.UserID = user.ID
+		if err := s.identityRepo.Create(newIdentity); err != nil {
+			log.Error("FindOrCreateOAuthUser failed to link identity", zap.Error(err))
+			return nil, response.NewInternalError("Failed to link identity", err.Error())
+		}
+		s.fillEmptyName(ctx, user, req.Name)
+		log.Info("OAuth identity linked by verified email",
+			zap.String("enduser.id", user.ID.String()),
+			zap.String("oauth.provider", req.Provider))
+		s.joinByEmailDomain(ctx, user, req.EmailVerified)
 		return user, nil
 	}
 	if !errors.Is(err, gorm.ErrRecordNotFound) {
 		log.Error("FindOrCreateOAuthUser failed to find user by email", zap.Error(err))
-		return nil, err
+		return nil, response.NewInternalError("Failed to find user", err.Error())
 	}
 
 	// Create new user
-	log.Debug("FindOrCreateOAuthUser creating new OAuth user", zap.String("user.email", email))
+	log.Debug("FindOrCreateOAuthUser creating new OAuth user", zap.String("user.email", req.Email))
 	newUser := &domain.User{
 		ID:        uuid.New(),
-		Email:     email,
-		Name:      name,
-		Provider:  provider,
+		Email:     req.Email,
+		Name:      req.Name,
+		Provider:  req.Provider,
 		IsActive:  true,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
+	newIdentity.UserID = newUser.ID
 
-	if err := s.userRepo.Create(newUser); err != nil {
+	if err := s.identityRepo.CreateWithUser(newUser, newIdentity); err != nil {
 		log.Error("FindOrCreateOAuthUser failed to create OAuth user", zap.Error(err))
-		return nil, err
+		return nil, response.NewInternalError("Failed to create user", err.Error())
 	}
 
 	// 메트릭 기록: OAuth 사용자 생성 성공
@@ -273,6 +329,116 @@ func (s *UserService) FindOrCreateOAuthUser(ctx context.Context, email, name, pr
 
 	log.Info("OAuth user created",
 		zap.String("enduser.id", newUser.ID.String()),
-		zap.String("user.email", email))
+		zap.String("user.email", req.Email))
+	s.joinByEmailDomain(ctx, newUser, req.EmailVerified)
 	return newUser, nil
 }
+
+// linkOAuthIdentity links the provider account to the owner of the link token.
+// The token proves the user is logged in, so the email does not need to match or be verified.
+// It is only accepted together with the binding cookie of the browser that requested it.
+func (s *UserService) linkOAuthIdentity(ctx context.Context, req domain.OAuthLoginRequest, now time.Time) (*domain.User, error) {
+	log := s.log(ctx)
+
+	if req.LinkBinding == "" {
+		log.Warn("linkOAuthIdentity rejected, link token without binding",
+			zap.String("oauth.provider", req.Provider))
+		return nil, response.NewValidationError("Link token is invalid or expired", "")
+	}
+
+	// 토큰을 발급받은 브라우저의 바인딩 쿠키가 일치해야 사용할 수 있음 (login CSRF 방지)
+	linkToken, err := s.identityRepo.ConsumeLinkToken(req.LinkToken, hashLinkBinding(req.LinkBinding), now)
+	if err != nil {
+		if errors.Is(err, repository.ErrLinkTokenInvalid) {
+			return nil, response.NewValidationError("Link token is invalid or expired", "")
+		}
+		return nil, response.NewInternalError("Failed to use link token", err.Error())
+	}
+
+	user, err := s.userRepo.FindByID(linkToken.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("User not found", linkToken.UserID.String())
+		}
+		return nil, response.NewInternalError("Failed to find user", err.Error())
+	}
+
+	identity, err := s.identityRepo.FindByProviderSubject(req.Provider, req.Subject)
+	if err == nil {
+		if identity.UserID != user.ID {
+			return nil, response.NewConflictError("This login is already linked to another account", req.Provider)
+		}
+		// 이미 연결된 아이덴티티
+		if err := s.identityRepo.UpdateLogin(identity.ID, req.Email, req.EmailVerified, now); err != nil {
+			log.Warn("linkOAuthIdentity failed to record identity login", zap.Error(err))
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewInternalError("Failed to find identity", err.Error())
+	}
+
+	if err := s.identityRepo.Create(&domain.UserIdentity{
+		ID:            uuid.New(),
+		UserID:        user.ID,
+		Provider:      req.Provider,
+		Subject:       req.Subject,
+		Email:         req.Email,
+		EmailVerified: req.EmailVerified,
+		LastLoginAt:   &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		log.Error("linkOAuthIdentity failed to link identity", zap.Error(err))
+		return nil, response.NewInternalError("Failed to link identity", err.Error())
+	}
+
+	log.Info("OAuth identity linked",
+		zap.String("enduser.id", user.ID.String()),
+		zap.String("oauth.provider", req.Provider))
+	return user, nil
+}
+
+// canAutoLinkByEmail reports whether a new provider login may be attached to the account with the same email.
+// 새 로그인의 이메일과 기존 계정의 이메일이 모두 검증된 경우에만 허용합니다.
+// 아이덴티티 도입 전 계정은 가입한 제공자가 이메일을 확인했으므로, 같은 제공자의 검증된 로그인만 연결합니다.
+func canAutoLinkByEmail(req domain.OAuthLoginRequest, user *domain.User, identities []domain.UserIdentity) bool {
+	if !req.EmailVerified {
+		return false
+	}
+	if len(identities) == 0 {
+		if user.Provider != req.Provider {
+			return false
+		}
+		// 이전 Google 로그인의 계정 식별자가 남아 있으면 같은 계정이어야 함
+		return user.GoogleID == nil || req.Provider != "google" || *user.GoogleID == req.Subject
+	}
+	for _, identity := range identities {
+		if identity.EmailVerified && strings.EqualFold(identity.Email, req.Email) {
+			return true
+		}
+	}
+	return false
+}
+
+// fillEmptyName sets the name of existing users created without one
+func (s *UserService) fillEmptyName(ctx context.Context, user *domain.User, name string) {
+	if user.Name != "" || name == "" {
+		return
+	}
+	user.Name = name
+	user.UpdatedAt = time.Now()
+	if err := s.userRepo.Update(user); err != nil {
+		s.log(ctx).Error("FindOrCreateOAuthUser failed to update user name", zap.Error(err))
+	}
+}
+
+// joinByEmailDomain adds an OAuth user to the workspaces of their email domain.
+// Only emails the provider verified count, otherwise anyone could claim a company domain.
+// Failures are logged by the joiner and never block the login.
+func (s *UserService) joinByEmailDomain(ctx context.Context, user *domain.User, emailVerified bool) {
+	if s.domainJoiner == nil || !user.IsActive || !emailVerified {
+		return
+	}
+	s.domainJoiner.JoinByEmailDomain(ctx, user)
+}
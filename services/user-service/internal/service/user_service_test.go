@@ -377,7 +377,7 @@ func TestNewUserService(t *testing.T) {
 	repo := &repository.UserRepository{}
 
 	// metrics는 nil 전달 가능 (nil-safe 설계)
-	svc := NewUserService(repo, logger, nil)
+	svc := NewUserService(repo, &repository.UserIdentityRepository{}, nil, nil, logger, nil)
 
 	assert.NotNil(t, svc)
 }
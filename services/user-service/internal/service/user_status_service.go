@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+const (
+	// maxStatusEmojiLength bounds the emoji of a custom status (multi-codepoint emoji included)
+	maxStatusEmojiLength = 32
+
+	// availabilityCacheTimeout bounds the Redis call that mirrors a user's availability
+	availabilityCacheTimeout = 2 * time.Second
+)
+
+// UserStatusService handles custom status, do-not-disturb and out-of-office settings.
+// 변경 내용은 Redis에 미러링되어 noti-service 등 다른 서비스가 요청 없이 읽을 수 있습니다.
+type UserStatusService struct {
+	statusRepo *repository.UserStatusRepository
+	userRepo   *repository.UserRepository
+	redis      *redis.Client // nil이면 미러링 비활성화
+	logger     *zap.Logger
+	now        func() time.Time
+}
+
+// NewUserStatusService creates a new UserStatusService
+func NewUserStatusService(statusRepo *repository.UserStatusRepository, userRepo *repository.UserRepository, redisClient *redis.Client, logger *zap.Logger) *UserStatusService {
+	return &UserStatusService{
+		statusRepo: statusRepo,
+		userRepo:   userRepo,
+		redis:      redisClient,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// GetMyStatus returns every availability setting of the caller
+func (s *UserStatusService) GetMyStatus(userID uuid.UUID) (*domain.UserStatusResponse, error) {
+	status, err := s.findStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := status.ToResponse(s.now())
+	if status.UpdatedAt.IsZero() {
+		resp.UpdatedAt = nil
+	}
+	return &resp, nil
+}
+
+// UpdateMyStatus replaces the caller's availability. Omitted sections are cleared.
+func (s *UserStatusService) UpdateMyStatus(userID uuid.UUID, req domain.UpdateUserStatusRequest) (*domain.UserStatusResponse, error) {
+	now := s.now()
+	if err := validateStatusRequest(req, now); err != nil {
+		return nil, err
+	}
+
+	status := buildUserStatus(userID, req, now)
+	if err := s.statusRepo.Save(status); err != nil {
+		s.logger.Error("Failed to save user status", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, response.NewInternalError("Failed to update status", err.Error())
+	}
+	s.mirrorAvailability(status.ToAvailability())
+
+	s.logger.Info("User status updated",
+		zap.String("user_id", userID.String()),
+		zap.Bool("has_custom_status", req.CustomStatus != nil),
+		zap.Bool("has_dnd", req.DoNotDisturb != nil),
+		zap.Bool("has_out_of_office", req.OutOfOffice != nil))
+
+	resp := status.ToResponse(now)
+	return &resp, nil
+}
+
+// ClearMyStatus removes every availability setting of the caller
+func (s *UserStatusService) ClearMyStatus(userID uuid.UUID) error {
+	if err := s.statusRepo.Delete(userID); err != nil {
+		s.logger.Error("Failed to delete user status", zap.Error(err), zap.String("user_id", userID.String()))
+		return response.NewInternalError("Failed to clear status", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), availabilityCacheTimeout)
+	defer cancel()
+	if err := commonclient.DeleteAvailability(ctx, s.redis, userID); err != nil {
+		s.logger.Warn("Failed to delete mirrored availability", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+
+	s.logger.Info("User status cleared", zap.String("user_id", userID.String()))
+	return nil
+}
+
+// GetUserStatus returns what other users may see of a user's availability right now.
+// The stored settings are mirrored again so a lost Redis entry heals on the next lookup.
+func (s *UserStatusService) GetUserStatus(userID uuid.UUID) (*domain.UserStatusResponse, error) {
+	if _, err := s.userRepo.FindByID(userID); err != nil {
+		return nil, response.NewNotFoundError("User not found", userID.String())
+	}
+
+	status, err := s.findStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !status.UpdatedAt.IsZero() {
+		s.mirrorAvailability(status.ToAvailability())
+	}
+
+	resp := status.ToPublicResponse(s.now())
+	return &resp, nil
+}
+
+// findStatus returns the stored status, or an empty one when the user never set any
+func (s *UserStatusService) findStatus(userID uuid.UUID) (*domain.UserStatus, error) {
+	status, err := s.statusRepo.FindByUserID(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &domain.UserStatus{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get status", err.Error())
+	}
+	return status, nil
+}
+
+// mirrorAvailability writes the availability to Redis. Errors are logged only.
+func (s *UserStatusService) mirrorAvailability(availability *commonclient.UserAvailability) {
+	ctx, cancel := context.WithTimeout(context.Background(), availabilityCacheTimeout)
+	defer cancel()
+
+	if err := commonclient.StoreAvailability(ctx, s.redis, availability); err != nil {
+		s.logger.Warn("Failed to mirror availability",
+			zap.Error(err),
+			zap.String("user_id", availability.UserID.String()))
+	}
+}
+
+// validateStatusRequest checks the lengths, periods and schedule of a status update
+func validateStatusRequest(req domain.UpdateUserStatusRequest, now time.Time) error {
+	if status := req.CustomStatus; status != nil {
+		if strings.TrimSpace(status.Emoji) == "" && strings.TrimSpace(status.Text) == "" {
+			return response.NewValidationError("Custom status needs an emoji or a text", "")
+		}
+		if utf8.RuneCountInString(status.Emoji) > maxStatusEmojiLength {
+			return response.NewValidationError("Status emoji is too long", "")
+		}
+		if utf8.RuneCountInString(status.Text) > commonclient.MaxStatusTextLength {
+			return response.NewValidationError("Status text is too long", "maximum is 100 characters")
+		}
+		if status.ClearAt != nil && !status.ClearAt.After(now) {
+			return response.NewValidationError("clearAt must be in the future", "")
+		}
+	}
+
+	if dnd := req.DoNotDisturb; dnd != nil {
+		if err := dnd.Validate(); err != nil {
+			return response.NewValidationError("Invalid do-not-disturb schedule", err.Error())
+		}
+	}
+
+	if ooo := req.OutOfOffice; ooo != nil {
+		if ooo.StartAt.IsZero() || ooo.EndAt.IsZero() {
+			return response.NewValidationError("Out-of-office needs startAt and endAt", "")
+		}
+		if !ooo.EndAt.After(ooo.StartAt) {
+			return response.NewValidationError("endAt must be after startAt", "")
+		}
+		if !ooo.EndAt.After(now) {
+			return response.NewValidationError("Out-of-office period has already ended", "")
+		}
+		if utf8.RuneCountInString(ooo.Message) > commonclient.MaxAutoReplyLength {
+			return response.NewValidationError("Auto-reply message is too long", "maximum is 1000 characters")
+		}
+	}
+	return nil
+}
+
+// buildUserStatus converts a validated request to the stored status
+func buildUserStatus(userID uuid.UUID, req domain.UpdateUserStatusRequest, now time.Time) *domain.UserStatus {
+	status := &domain.UserStatus{UserID: userID, UpdatedAt: now}
+	if cs := req.CustomStatus; cs != nil {
+		status.StatusEmoji = strings.TrimSpace(cs.Emoji)
+		status.StatusText = strings.TrimSpace(cs.Text)
+		status.StatusClearAt = cs.ClearAt
+	}
+	if dnd := req.DoNotDisturb; dnd != nil {
+		status.DNDEnabled = dnd.Enabled
+		status.DNDStartTime = dnd.StartTime
+		status.DNDEndTime = dnd.EndTime
+		status.DNDWeekdays = dnd.Weekdays
+		status.DNDTimezone = dnd.Timezone
+		status.DNDSnoozeUntil = dnd.SnoozeUntil
+	}
+	if ooo := req.OutOfOffice; ooo != nil {
+		startAt, endAt := ooo.StartAt, ooo.EndAt
+		status.OOOStartAt = &startAt
+		status.OOOEndAt = &endAt
+		status.OOOMessage = strings.TrimSpace(ooo.Message)
+	}
+	return status
+}
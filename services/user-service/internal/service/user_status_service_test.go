@@ -0,0 +1,75 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonclient "github.com/OrangesCloud/wealist-advanced-go-pkg/client"
+
+	"user-service/internal/domain"
+)
+
+// TestValidateStatusRequest verifies custom status, schedule and out-of-office validation
+// 사용자 상태 요청의 길이, 기간, 일정 검증
+func TestValidateStatusRequest(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	valid := domain.UpdateUserStatusRequest{
+		CustomStatus: &commonclient.CustomStatus{Emoji: "🌴", Text: "Vacation", ClearAt: &future},
+		DoNotDisturb: &commonclient.DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00"},
+		OutOfOffice:  &commonclient.OutOfOffice{StartAt: now, EndAt: future, Message: "Back soon"},
+	}
+	assert.NoError(t, validateStatusRequest(valid, now))
+	assert.NoError(t, validateStatusRequest(domain.UpdateUserStatusRequest{}, now))
+
+	invalid := map[string]domain.UpdateUserStatusRequest{
+		"empty custom status": {CustomStatus: &commonclient.CustomStatus{Text: "  "}},
+		"text too long":       {CustomStatus: &commonclient.CustomStatus{Text: strings.Repeat("a", commonclient.MaxStatusTextLength+1)}},
+		"clearAt in the past": {CustomStatus: &commonclient.CustomStatus{Text: "Busy", ClearAt: &past}},
+		"invalid schedule":    {DoNotDisturb: &commonclient.DoNotDisturb{Enabled: true, StartTime: "9am", EndTime: "17:00"}},
+		"reversed period":     {OutOfOffice: &commonclient.OutOfOffice{StartAt: future, EndAt: now}},
+		"period ended":        {OutOfOffice: &commonclient.OutOfOffice{StartAt: past.Add(-time.Hour), EndAt: past}},
+		"reply too long":      {OutOfOffice: &commonclient.OutOfOffice{StartAt: now, EndAt: future, Message: strings.Repeat("a", commonclient.MaxAutoReplyLength+1)}},
+	}
+	for name, req := range invalid {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, validateStatusRequest(req, now))
+		})
+	}
+}
+
+// TestUserStatus_PublicResponse verifies other users only see what applies right now
+// 다른 사용자에게는 현재 적용되는 상태만 노출되는지 검증
+func TestUserStatus_PublicResponse(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	clearAt := now.Add(-time.Minute)
+
+	status := buildUserStatus(userID, domain.UpdateUserStatusRequest{
+		CustomStatus: &commonclient.CustomStatus{Text: " Focusing ", ClearAt: &clearAt},
+		DoNotDisturb: &commonclient.DoNotDisturb{Enabled: true, StartTime: "22:00", EndTime: "08:00"},
+		OutOfOffice:  &commonclient.OutOfOffice{StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour), Message: "Away"},
+	}, now)
+	assert.Equal(t, "Focusing", status.StatusText)
+
+	resp := status.ToPublicResponse(now)
+	assert.Equal(t, userID, resp.UserID)
+	assert.Nil(t, resp.CustomStatus, "expired status must be hidden")
+	assert.Nil(t, resp.DoNotDisturb, "schedule must stay private")
+	assert.True(t, resp.IsDoNotDisturb)
+	require.NotNil(t, resp.OutOfOffice)
+	assert.True(t, resp.IsOutOfOffice)
+	assert.Equal(t, "Away", resp.OutOfOffice.Message)
+
+	owner := status.ToResponse(now)
+	require.NotNil(t, owner.DoNotDisturb)
+	assert.Equal(t, "22:00", owner.DoNotDisturb.StartTime)
+	require.NotNil(t, owner.CustomStatus)
+}
@@ -0,0 +1,447 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 이메일 도메인 자동 참여와 초대 링크 관련 비즈니스 로직을 포함합니다.
+// 두 기능 모두 워크스페이스 설정(UpdateWorkspaceSettings)에서 구성합니다.
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// publicEmailDomains are free mail providers that can never be used for domain auto-join
+var publicEmailDomains = map[string]bool{
+	"gmail.com":      true,
+	"googlemail.com": true,
+	"outlook.com":    true,
+	"hotmail.com":    true,
+	"live.com":       true,
+	"yahoo.com":      true,
+	"icloud.com":     true,
+	"me.com":         true,
+	"proton.me":      true,
+	"protonmail.com": true,
+	"naver.com":      true,
+	"daum.net":       true,
+	"hanmail.net":    true,
+	"kakao.com":      true,
+	"nate.com":       true,
+}
+
+// emailDomainOf returns the lowercased domain part of an email address
+func emailDomainOf(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+// ============================================================
+// 설정 조회/변경 메서드
+// ============================================================
+
+// GetWorkspaceSettings는 워크스페이스 설정을 조회합니다.
+// 이메일 도메인과 초대 링크는 workspace.update 권한이 있는 멤버에게만 포함됩니다.
+func (s *WorkspaceService) GetWorkspaceSettings(workspaceID, viewerID uuid.UUID) (*domain.WorkspaceSettingsResponse, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	settings := workspace.ToSettingsResponse()
+	if !s.hasPermission(workspace, viewerID, permission.WorkspaceUpdate) {
+		return &settings, nil
+	}
+
+	domains, err := s.emailDomainRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get email domains", err.Error())
+	}
+	for _, d := range domains {
+		settings.EmailDomains = append(settings.EmailDomains, domain.EmailDomainSetting{
+			Domain:     d.Domain,
+			JoinPolicy: d.JoinPolicy,
+		})
+	}
+
+	links, err := s.inviteLinkRepo.FindUsableByWorkspace(workspaceID, time.Now())
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get invite links", err.Error())
+	}
+	for i := range links {
+		settings.InviteLinks = append(settings.InviteLinks, links[i].ToResponse())
+	}
+	return &settings, nil
+}
+
+// normalizeEmailDomains는 설정 요청의 이메일 도메인을 검증하고 정규화합니다.
+// 도메인 소유 확인을 위해 설정자 본인의 로그인 중 제공자가 이메일을 인증한 로그인의 도메인만 새로 등록할 수 있으며,
+// 공용 메일 도메인은 등록할 수 없습니다. 계정 이메일은 인증되지 않았을 수 있으므로 근거로 쓰지 않습니다.
+// 이미 등록된 도메인은 다른 관리자가 확인한 것이므로 그대로 유지할 수 있고,
+// 참여 정책만 바뀌며 확인자(VerifiedBy)는 유지됩니다.
+func (s *WorkspaceService) normalizeEmailDomains(workspaceID, actorID uuid.UUID, settings []domain.EmailDomainSetting) ([]domain.WorkspaceEmailDomain, error) {
+	if len(settings) == 0 {
+		return nil, nil
+	}
+
+	identities, err := s.identityRepo.FindByUserID(actorID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get user identities", err.Error())
+	}
+	verifiedDomains := make(map[string]bool, len(identities))
+	for _, identity := range identities {
+		if identity.EmailVerified {
+			if d := emailDomainOf(identity.Email); d != "" {
+				verifiedDomains[d] = true
+			}
+		}
+	}
+
+	existing, err := s.emailDomainRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get email domains", err.Error())
+	}
+	registered := make(map[string]domain.WorkspaceEmailDomain, len(existing))
+	for _, d := range existing {
+		registered[d.Domain] = d
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(settings))
+	domains := make([]domain.WorkspaceEmailDomain, 0, len(settings))
+	for _, setting := range settings {
+		d := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(setting.Domain)), "@")
+		if d == "" || !strings.Contains(d, ".") {
+			return nil, response.NewValidationError("Invalid email domain", setting.Domain)
+		}
+		if !setting.JoinPolicy.IsValid() {
+			return nil, response.NewValidationError("Invalid join policy", string(setting.JoinPolicy))
+		}
+		if publicEmailDomains[d] {
+			return nil, response.NewValidationError("Public email domains cannot be used for auto-join", d)
+		}
+		if seen[d] {
+			continue
+		}
+		seen[d] = true
+		if entry, ok := registered[d]; ok {
+			entry.JoinPolicy = setting.JoinPolicy
+			domains = append(domains, entry)
+			continue
+		}
+		if !verifiedDomains[d] {
+			return nil, response.NewForbiddenError("Email domain is not verified", "only the domain of a verified email of your logins can be added")
+		}
+		domains = append(domains, domain.WorkspaceEmailDomain{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Domain:      d,
+			JoinPolicy:  setting.JoinPolicy,
+			VerifiedBy:  actorID,
+			CreatedAt:   now,
+		})
+	}
+	return domains, nil
+}
+
+// applyInviteLinkSettings는 설정 요청에 따라 초대 링크를 생성하거나 폐기합니다.
+func (s *WorkspaceService) applyInviteLinkSettings(workspaceID, actorID uuid.UUID, req domain.UpdateWorkspaceSettingsRequest) error {
+	now := time.Now()
+	if len(req.RevokeInviteLinkIDs) > 0 {
+		if err := s.inviteLinkRepo.Revoke(workspaceID, req.RevokeInviteLinkIDs, now); err != nil {
+			return response.NewInternalError("Failed to revoke invite links", err.Error())
+		}
+		s.logger.Info("초대 링크 폐기",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.Int("count", len(req.RevokeInviteLinkIDs)),
+			zap.String("revoked_by", actorID.String()))
+	}
+
+	if req.CreateInviteLink == nil {
+		return nil
+	}
+	token, err := generateInviteToken()
+	if err != nil {
+		return response.NewInternalError("Failed to create invite link", err.Error())
+	}
+	link := &domain.WorkspaceInviteLink{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Token:       token,
+		DefaultRole: req.CreateInviteLink.DefaultRole,
+		MaxUses:     req.CreateInviteLink.MaxUses,
+		CreatedBy:   actorID,
+		CreatedAt:   now,
+	}
+	if link.DefaultRole == "" {
+		link.DefaultRole = domain.RoleMember
+	}
+	if req.CreateInviteLink.ExpiresInHours != nil {
+		expiresAt := now.Add(time.Duration(*req.CreateInviteLink.ExpiresInHours) * time.Hour)
+		link.ExpiresAt = &expiresAt
+	}
+	if err := s.inviteLinkRepo.Create(link); err != nil {
+		return response.NewInternalError("Failed to create invite link", err.Error())
+	}
+
+	s.logger.Info("초대 링크 생성",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("invite_link_id", link.ID.String()),
+		zap.String("default_role", string(link.DefaultRole)),
+		zap.String("created_by", actorID.String()))
+	return nil
+}
+
+// validateInviteLinkRequest는 초대 링크 생성 요청의 기본 역할을 검증합니다.
+func validateInviteLinkRequest(req *domain.CreateInviteLinkRequest) error {
+	if req == nil {
+		return nil
+	}
+	switch req.DefaultRole {
+	case "", domain.RoleMember, domain.RoleAdmin:
+		return nil
+	case domain.RoleOwner:
+		return response.NewForbiddenError("Cannot assign owner role through invite link", "")
+	default:
+		return response.NewValidationError("Invalid default role", string(req.DefaultRole))
+	}
+}
+
+// generateInviteToken은 추측할 수 없는 초대 링크 토큰을 생성합니다.
+func generateInviteToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ============================================================
+// 초대 링크 참여 메서드
+// ============================================================
+
+// GetInviteLinkPreview는 초대 링크로 참여할 워크스페이스 정보를 조회합니다.
+func (s *WorkspaceService) GetInviteLinkPreview(token string) (*domain.InviteLinkPreviewResponse, error) {
+	link, err := s.findUsableInviteLink(token)
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := s.workspaceRepo.FindByID(link.WorkspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Invite link not found", "")
+	}
+	return &domain.InviteLinkPreviewResponse{
+		WorkspaceID:          workspace.ID,
+		WorkspaceName:        workspace.WorkspaceName,
+		WorkspaceDescription: workspace.WorkspaceDescription,
+		DefaultRole:          link.DefaultRole,
+		ExpiresAt:            link.ExpiresAt,
+	}, nil
+}
+
+// JoinByInviteLink는 초대 링크로 워크스페이스에 참여합니다.
+// 초대 링크는 초대와 같으므로 참여 승인 설정과 관계없이 바로 멤버로 추가됩니다.
+func (s *WorkspaceService) JoinByInviteLink(token string, userID uuid.UUID) (*domain.WorkspaceMember, error) {
+	link, err := s.findUsableInviteLink(token)
+	if err != nil {
+		return nil, err
+	}
+	workspaceID := link.WorkspaceID
+	if _, err := s.workspaceRepo.FindByID(workspaceID); err != nil {
+		return nil, response.NewNotFoundError("Invite link not found", "")
+	}
+
+	if isMember, _ := s.memberRepo.IsMember(workspaceID, userID); isMember {
+		return nil, response.NewAlreadyExistsError("Already a member of this workspace", "")
+	}
+	if isGuest, _ := s.memberRepo.IsGuest(workspaceID, userID); isGuest {
+		return nil, response.NewConflictError("You are a guest of this workspace", "ask an admin to change your role")
+	}
+	if isSuspended, _ := s.memberRepo.IsSuspended(workspaceID, userID); isSuspended {
+		return nil, response.NewForbiddenError("Your membership in this workspace is suspended", "")
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, response.NewNotFoundError("User not found", userID.String())
+	}
+
+	// 관리자 수 제한을 넘으면 일반 멤버로 참여
+	role := link.DefaultRole
+	if role == domain.RoleAdmin {
+		adminCount, err := s.memberRepo.CountByRole(workspaceID, domain.RoleAdmin)
+		if err != nil || adminCount >= 4 {
+			role = domain.RoleMember
+		}
+	}
+
+	// 사용 횟수 증가와 멤버 추가를 한 트랜잭션으로 처리
+	// (동시 사용 시 최대 사용 횟수를 넘지 않고, 참여에 실패하면 사용 횟수도 롤백)
+	now := time.Now()
+	member := newActiveMember(workspaceID, userID, role, now)
+	if err := s.inviteLinkRepo.UseForMember(link.ID, member, now); err != nil {
+		if errors.Is(err, repository.ErrInviteLinkUnusable) {
+			return nil, response.NewConflictError("Invite link is no longer valid", "")
+		}
+		return nil, response.NewInternalError("Failed to join workspace", err.Error())
+	}
+	s.createMemberProfile(workspaceID, user, now)
+	member.User = user
+
+	s.logger.Info("초대 링크로 참여 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("user_id", userID.String()),
+		zap.String("invite_link_id", link.ID.String()),
+		zap.String("role", string(role)))
+	return member, nil
+}
+
+// findUsableInviteLink는 사용 가능한 초대 링크를 토큰으로 조회합니다.
+func (s *WorkspaceService) findUsableInviteLink(token string) (*domain.WorkspaceInviteLink, error) {
+	link, err := s.inviteLinkRepo.FindByToken(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Invite link not found", "")
+		}
+		return nil, response.NewInternalError("Failed to get invite link", err.Error())
+	}
+	if !link.IsUsable(time.Now()) {
+		return nil, response.NewNotFoundError("Invite link has expired or been revoked", "")
+	}
+	return link, nil
+}
+
+// ============================================================
+// 이메일 도메인 자동 참여 메서드
+// ============================================================
+
+// JoinByEmailDomain은 OAuth 로그인한 사용자를 이메일 도메인이 등록된 워크스페이스에
+// 자동 참여시키거나 참여 요청을 생성합니다.
+// 한 번이라도 멤버였거나 참여 요청이 있었던 워크스페이스는 건너뛰므로,
+// 제거되거나 거절된 사용자가 로그인할 때마다 다시 참여하지 않습니다.
+func (s *WorkspaceService) JoinByEmailDomain(ctx context.Context, user *domain.User) {
+	emailDomain := emailDomainOf(user.Email)
+	if emailDomain == "" || publicEmailDomains[emailDomain] {
+		return
+	}
+
+	entries, err := s.emailDomainRepo.FindByDomain(emailDomain)
+	if err != nil {
+		s.logger.Warn("이메일 도메인 조회 실패",
+			zap.String("domain", emailDomain),
+			zap.Error(err))
+		return
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+		if hasMembership, err := s.memberRepo.HasMembership(entry.WorkspaceID, user.ID); err != nil || hasMembership {
+			continue
+		}
+		if _, err := s.joinReqRepo.FindByWorkspaceAndUser(entry.WorkspaceID, user.ID); err == nil {
+			continue
+		}
+
+		if entry.JoinPolicy == domain.DomainJoinPolicyAutoJoin {
+			if _, err := s.addMember(entry.WorkspaceID, user, domain.RoleMember); err != nil {
+				s.logger.Warn("도메인 자동 참여 실패",
+					zap.String("workspace_id", entry.WorkspaceID.String()),
+					zap.String("user_id", user.ID.String()),
+					zap.Error(err))
+				continue
+			}
+			s.logger.Info("도메인 자동 참여 완료",
+				zap.String("workspace_id", entry.WorkspaceID.String()),
+				zap.String("user_id", user.ID.String()),
+				zap.String("domain", emailDomain))
+			continue
+		}
+
+		request := &domain.WorkspaceJoinRequest{
+			ID:          uuid.New(),
+			WorkspaceID: entry.WorkspaceID,
+			UserID:      user.ID,
+			Status:      domain.JoinStatusPending,
+			RequestedAt: time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		if err := s.joinReqRepo.Create(request); err != nil {
+			s.logger.Warn("도메인 자동 참여 요청 실패",
+				zap.String("workspace_id", entry.WorkspaceID.String()),
+				zap.String("user_id", user.ID.String()),
+				zap.Error(err))
+			continue
+		}
+		s.logger.Info("도메인 자동 참여 요청 생성",
+			zap.String("workspace_id", entry.WorkspaceID.String()),
+			zap.String("user_id", user.ID.String()),
+			zap.String("domain", emailDomain))
+	}
+}
+
+// addMember는 사용자를 멤버로 추가하고 워크스페이스 프로필을 생성합니다.
+func (s *WorkspaceService) addMember(workspaceID uuid.UUID, user *domain.User, role domain.RoleName) (*domain.WorkspaceMember, error) {
+	now := time.Now()
+	member := newActiveMember(workspaceID, user.ID, role, now)
+	if err := s.memberRepo.Create(member); err != nil {
+		return nil, err
+	}
+	s.createMemberProfile(workspaceID, user, now)
+
+	member.User = user
+	return member, nil
+}
+
+// newActiveMember는 추가할 활성 멤버를 생성합니다.
+func newActiveMember(workspaceID, userID uuid.UUID, role domain.RoleName, now time.Time) *domain.WorkspaceMember {
+	return &domain.WorkspaceMember{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		RoleName:    role,
+		IsDefault:   false,
+		IsActive:    true,
+		Status:      domain.MemberStatusActive,
+		JoinedAt:    now,
+		UpdatedAt:   now,
+	}
+}
+
+// createMemberProfile은 새 멤버의 워크스페이스 프로필을 생성합니다. 실패해도 참여는 유지됩니다.
+func (s *WorkspaceService) createMemberProfile(workspaceID uuid.UUID, user *domain.User, now time.Time) {
+	profile := &domain.UserProfile{
+		ID:          uuid.New(),
+		UserID:      user.ID,
+		WorkspaceID: workspaceID,
+		NickName:    user.Name,
+		Email:       user.Email,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if profile.NickName == "" {
+		profile.NickName = user.Email
+	}
+	if err := s.profileRepo.Create(profile); err != nil {
+		s.logger.Warn("프로필 생성 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// TestEmailDomainOf verifies domain extraction from email addresses
+// 이메일 주소에서 도메인을 소문자로 추출하는지 검증
+func TestEmailDomainOf(t *testing.T) {
+	tests := []struct {
+		email    string
+		expected string
+	}{
+		{"alice@Example.COM", "example.com"},
+		{"bob@mail.example.co.kr", "mail.example.co.kr"},
+		{"no-at-sign", ""},
+		{"trailing@", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, emailDomainOf(tt.email), "email=%s", tt.email)
+	}
+}
+
+// TestValidateInviteLinkRequest verifies the default role of an invite link
+// 초대 링크로 소유자 역할을 부여할 수 없는지 검증
+func TestValidateInviteLinkRequest(t *testing.T) {
+	assert.NoError(t, validateInviteLinkRequest(nil))
+	assert.NoError(t, validateInviteLinkRequest(&domain.CreateInviteLinkRequest{}))
+	assert.NoError(t, validateInviteLinkRequest(&domain.CreateInviteLinkRequest{DefaultRole: domain.RoleAdmin}))
+	assert.Error(t, validateInviteLinkRequest(&domain.CreateInviteLinkRequest{DefaultRole: domain.RoleOwner}))
+	assert.Error(t, validateInviteLinkRequest(&domain.CreateInviteLinkRequest{DefaultRole: "GUEST"}))
+}
+
+// TestInviteLink_IsUsable verifies expiry, revocation and the use limit
+// 만료, 폐기, 최대 사용 횟수에 따라 초대 링크 사용 가능 여부가 결정되는지 검증
+func TestInviteLink_IsUsable(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	maxUses := 2
+
+	assert.True(t, (&domain.WorkspaceInviteLink{}).IsUsable(now))
+	assert.True(t, (&domain.WorkspaceInviteLink{ExpiresAt: &future, MaxUses: &maxUses, UseCount: 1}).IsUsable(now))
+	assert.False(t, (&domain.WorkspaceInviteLink{ExpiresAt: &past}).IsUsable(now))
+	assert.False(t, (&domain.WorkspaceInviteLink{RevokedAt: &past}).IsUsable(now))
+	assert.False(t, (&domain.WorkspaceInviteLink{MaxUses: &maxUses, UseCount: 2}).IsUsable(now))
+}
+
+// TestGenerateInviteToken verifies tokens are unique and fit the token column
+// 토큰이 매번 다르고 컬럼 길이(64)를 넘지 않는지 검증
+func TestGenerateInviteToken(t *testing.T) {
+	first, err := generateInviteToken()
+	require.NoError(t, err)
+	second, err := generateInviteToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.LessOrEqual(t, len(first), 64)
+}
+
+// accessTestFixture는 초대 링크와 이메일 도메인 테스트용 워크스페이스를 준비합니다.
+type accessTestFixture struct {
+	svc       *WorkspaceService
+	db        *gorm.DB
+	workspace *domain.Workspace
+	owner     *domain.User
+}
+
+func newAccessTestFixture(t *testing.T) *accessTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE users (
+			id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT NOT NULL DEFAULT '', google_id TEXT,
+			provider TEXT DEFAULT 'google', is_active INTEGER DEFAULT 1,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspaces (
+			id TEXT PRIMARY KEY, owner_id TEXT NOT NULL, workspace_name TEXT NOT NULL, workspace_description TEXT,
+			is_public INTEGER DEFAULT 1, need_approved INTEGER DEFAULT 1, only_owner_can_invite INTEGER DEFAULT 1,
+			is_active INTEGER DEFAULT 1, created_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspace_members (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, user_id TEXT NOT NULL, role_name TEXT NOT NULL DEFAULT 'MEMBER',
+			custom_role_id TEXT, is_default INTEGER DEFAULT 0, is_active INTEGER DEFAULT 1, status TEXT NOT NULL DEFAULT 'ACTIVE',
+			suspended_at DATETIME, suspended_by TEXT, suspend_reason TEXT, joined_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_join_requests (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, user_id TEXT NOT NULL, status TEXT NOT NULL DEFAULT 'PENDING',
+			requested_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE user_profiles (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, workspace_id TEXT NOT NULL, nick_name TEXT NOT NULL,
+			email TEXT NOT NULL, profile_image_url TEXT, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_email_domains (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, domain TEXT NOT NULL, join_policy TEXT NOT NULL,
+			verified_by TEXT NOT NULL, created_at DATETIME NOT NULL, UNIQUE (workspace_id, domain)
+		)`,
+		`CREATE TABLE workspace_invite_links (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, token TEXT NOT NULL UNIQUE, default_role TEXT NOT NULL DEFAULT 'MEMBER',
+			max_uses INTEGER, use_count INTEGER NOT NULL DEFAULT 0, expires_at DATETIME, created_by TEXT NOT NULL,
+			revoked_at DATETIME, created_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE user_identities (
+			id TEXT PRIMARY KEY, user_id TEXT NOT NULL, provider TEXT NOT NULL, subject TEXT NOT NULL,
+			email TEXT NOT NULL DEFAULT '', email_verified INTEGER NOT NULL DEFAULT 0, last_login_at DATETIME,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, UNIQUE (provider, subject)
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	f := &accessTestFixture{db: db}
+	f.owner = f.createUser(t, "owner@corp.example")
+	f.workspace = &domain.Workspace{ID: uuid.New(), OwnerID: f.owner.ID, WorkspaceName: "Access", IsActive: true, CreatedAt: time.Now()}
+	require.NoError(t, db.Create(f.workspace).Error)
+
+	f.svc = NewWorkspaceService(
+		repository.NewWorkspaceRepository(db),
+		repository.NewWorkspaceMemberRepository(db),
+		repository.NewJoinRequestRepository(db),
+		repository.NewUserProfileRepository(db),
+		repository.NewUserRepository(db),
+		nil, nil,
+		repository.NewEmailDomainRepository(db),
+		repository.NewUserIdentityRepository(db),
+		repository.NewInviteLinkRepository(db),
+		nil, nil, nil, nil, zap.NewNop(), nil,
+	)
+	return f
+}
+
+func (f *accessTestFixture) createUser(t *testing.T, email string) *domain.User {
+	t.Helper()
+	now := time.Now()
+	user := &domain.User{ID: uuid.New(), Email: email, Name: email, Provider: "google", IsActive: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, f.db.Create(user).Error)
+	return user
+}
+
+// addIdentity links an OAuth login with the given email to a user
+func (f *accessTestFixture) addIdentity(t *testing.T, user *domain.User, provider, email string, verified bool) {
+	t.Helper()
+	now := time.Now()
+	require.NoError(t, f.db.Create(&domain.UserIdentity{
+		ID: uuid.New(), UserID: user.ID, Provider: provider, Subject: uuid.NewString(),
+		Email: email, EmailVerified: verified, CreatedAt: now, UpdatedAt: now,
+	}).Error)
+}
+
+func (f *accessTestFixture) createInviteLink(t *testing.T, maxUses *int) *domain.WorkspaceInviteLink {
+	t.Helper()
+	link := &domain.WorkspaceInviteLink{
+		ID: uuid.New(), WorkspaceID: f.workspace.ID, Token: uuid.NewString(), DefaultRole: domain.RoleMember,
+		MaxUses: maxUses, CreatedBy: f.owner.ID, CreatedAt: time.Now(),
+	}
+	require.NoError(t, f.db.Create(link).Error)
+	return link
+}
+
+func (f *accessTestFixture) useCount(t *testing.T, linkID uuid.UUID) int {
+	t.Helper()
+	var link domain.WorkspaceInviteLink
+	require.NoError(t, f.db.First(&link, "id = ?", linkID).Error)
+	return link.UseCount
+}
+
+// TestJoinByInviteLink_ConsumesUses verifies each join uses the link once and a used up link is rejected
+// 참여할 때마다 사용 횟수가 증가하고, 최대 사용 횟수를 넘으면 참여할 수 없는지 검증
+func TestJoinByInviteLink_ConsumesUses(t *testing.T) {
+	f := newAccessTestFixture(t)
+	maxUses := 1
+	link := f.createInviteLink(t, &maxUses)
+	first := f.createUser(t, "first@example.com")
+	second := f.createUser(t, "second@example.com")
+
+	member, err := f.svc.JoinByInviteLink(link.Token, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleMember, member.RoleName)
+	assert.Equal(t, 1, f.useCount(t, link.ID))
+
+	var profiles int64
+	require.NoError(t, f.db.Model(&domain.UserProfile{}).Where("user_id = ?", first.ID).Count(&profiles).Error)
+	assert.Equal(t, int64(1), profiles)
+
+	_, err = f.svc.JoinByInviteLink(link.Token, second.ID)
+	require.Error(t, err)
+	assert.Equal(t, 1, f.useCount(t, link.ID))
+
+	_, err = f.svc.JoinByInviteLink(link.Token, first.ID)
+	require.Error(t, err)
+}
+
+// TestJoinByInviteLink_FailedJoinKeepsUse verifies a join that fails to add the member does not use up the link
+// 멤버 추가에 실패하면 사용 횟수도 롤백되는지 검증
+func TestJoinByInviteLink_FailedJoinKeepsUse(t *testing.T) {
+	f := newAccessTestFixture(t)
+	maxUses := 1
+	link := f.createInviteLink(t, &maxUses)
+	user := f.createUser(t, "user@example.com")
+
+	require.NoError(t, f.db.Exec(
+		`CREATE TRIGGER fail_member_insert BEFORE INSERT ON workspace_members
+		WHEN NEW.user_id = '`+user.ID.String()+`' BEGIN SELECT RAISE(ABORT, 'insert failed'); END`).Error)
+
+	_, err := f.svc.JoinByInviteLink(link.Token, user.ID)
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok)
+	assert.Equal(t, response.ErrCodeInternal, appErr.Code)
+	assert.Equal(t, 0, f.useCount(t, link.ID))
+
+	require.NoError(t, f.db.Exec(`DROP TRIGGER fail_member_insert`).Error)
+	_, err = f.svc.JoinByInviteLink(link.Token, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.useCount(t, link.ID))
+}
+
+// TestNormalizeEmailDomains verifies new domains must match a verified login email of the actor while registered domains are kept
+// 새 도메인은 설정자의 인증된 로그인 이메일 도메인만 허용하고, 등록된 도메인은 확인자를 유지한 채 정책만 바뀌는지 검증
+func TestNormalizeEmailDomains(t *testing.T) {
+	f := newAccessTestFixture(t)
+	f.addIdentity(t, f.owner, "google", "owner@corp.example", true)
+	partnerAdmin := uuid.New()
+	registered := domain.WorkspaceEmailDomain{
+		ID: uuid.New(), WorkspaceID: f.workspace.ID, Domain: "partner.example",
+		JoinPolicy: domain.DomainJoinPolicyAutoJoin, VerifiedBy: partnerAdmin, CreatedAt: time.Now().Add(-time.Hour),
+	}
+	require.NoError(t, f.db.Create(&registered).Error)
+
+	domains, err := f.svc.normalizeEmailDomains(f.workspace.ID, f.owner.ID, []domain.EmailDomainSetting{
+		{Domain: "Partner.example", JoinPolicy: domain.DomainJoinPolicyAutoRequest},
+		{Domain: "@corp.example", JoinPolicy: domain.DomainJoinPolicyAutoJoin},
+	})
+	require.NoError(t, err)
+	require.Len(t, domains, 2)
+	assert.Equal(t, registered.ID, domains[0].ID)
+	assert.Equal(t, partnerAdmin, domains[0].VerifiedBy)
+	assert.Equal(t, domain.DomainJoinPolicyAutoRequest, domains[0].JoinPolicy)
+	assert.Equal(t, "corp.example", domains[1].Domain)
+	assert.Equal(t, f.owner.ID, domains[1].VerifiedBy)
+
+	tests := []struct {
+		name    string
+		setting domain.EmailDomainSetting
+		code    string
+	}{
+		{"unverified domain", domain.EmailDomainSetting{Domain: "other.example", JoinPolicy: domain.DomainJoinPolicyAutoJoin}, response.ErrCodeForbidden},
+		{"public domain", domain.EmailDomainSetting{Domain: "gmail.com", JoinPolicy: domain.DomainJoinPolicyAutoJoin}, response.ErrCodeValidation},
+		{"invalid policy", domain.EmailDomainSetting{Domain: "corp.example", JoinPolicy: "ALWAYS"}, response.ErrCodeValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.svc.normalizeEmailDomains(f.workspace.ID, f.owner.ID, []domain.EmailDomainSetting{tt.setting})
+			require.Error(t, err)
+			appErr, ok := err.(*response.AppError)
+			require.True(t, ok)
+			assert.Equal(t, tt.code, appErr.Code)
+		})
+	}
+}
+
+// TestNormalizeEmailDomains_UnverifiedEmail verifies an account or login email the provider did not verify proves nothing
+// 제공자가 인증하지 않은 이메일로 가입한 사용자는 그 도메인을 등록할 수 없고, 인증된 다른 로그인의 도메인만 등록할 수 있는지 검증
+func TestNormalizeEmailDomains_UnverifiedEmail(t *testing.T) {
+	f := newAccessTestFixture(t)
+	attacker := f.createUser(t, "x@victim.example")
+	f.addIdentity(t, attacker, "kakao", "x@victim.example", false)
+	setting := domain.EmailDomainSetting{Domain: "victim.example", JoinPolicy: domain.DomainJoinPolicyAutoJoin}
+
+	_, err := f.svc.normalizeEmailDomains(f.workspace.ID, attacker.ID, []domain.EmailDomainSetting{setting})
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok)
+	assert.Equal(t, response.ErrCodeForbidden, appErr.Code)
+
+	// 계정 이메일과 다른 인증된 로그인의 도메인은 등록 가능
+	f.addIdentity(t, attacker, "github", "x@attacker.example", true)
+	domains, err := f.svc.normalizeEmailDomains(f.workspace.ID, attacker.ID, []domain.EmailDomainSetting{
+		{Domain: "attacker.example", JoinPolicy: domain.DomainJoinPolicyAutoJoin},
+	})
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	assert.Equal(t, attacker.ID, domains[0].VerifiedBy)
+
+	_, err = f.svc.normalizeEmailDomains(f.workspace.ID, attacker.ID, []domain.EmailDomainSetting{setting})
+	require.Error(t, err)
+}
+
+// TestJoinByEmailDomain verifies users join or request to join the workspaces of their email domain once
+// 이메일 도메인 정책에 따라 참여 또는 참여 요청이 생성되고, 이미 멤버였던 워크스페이스는 건너뛰는지 검증
+func TestJoinByEmailDomain(t *testing.T) {
+	f := newAccessTestFixture(t)
+	requestWorkspace := &domain.Workspace{ID: uuid.New(), OwnerID: f.owner.ID, WorkspaceName: "Requests", IsActive: true, CreatedAt: time.Now()}
+	require.NoError(t, f.db.Create(requestWorkspace).Error)
+	for _, d := range []domain.WorkspaceEmailDomain{
+		{ID: uuid.New(), WorkspaceID: f.workspace.ID, Domain: "corp.example", JoinPolicy: domain.DomainJoinPolicyAutoJoin, VerifiedBy: f.owner.ID, CreatedAt: time.Now()},
+		{ID: uuid.New(), WorkspaceID: requestWorkspace.ID, Domain: "corp.example", JoinPolicy: domain.DomainJoinPolicyAutoRequest, VerifiedBy: f.owner.ID, CreatedAt: time.Now()},
+	} {
+		require.NoError(t, f.db.Create(&d).Error)
+	}
+
+	user := f.createUser(t, "new@corp.example")
+	f.svc.JoinByEmailDomain(context.Background(), user)
+
+	var members, requests int64
+	require.NoError(t, f.db.Model(&domain.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", f.workspace.ID, user.ID).Count(&members).Error)
+	require.NoError(t, f.db.Model(&domain.WorkspaceJoinRequest{}).
+		Where("workspace_id = ? AND user_id = ?", requestWorkspace.ID, user.ID).Count(&requests).Error)
+	assert.Equal(t, int64(1), members)
+	assert.Equal(t, int64(1), requests)
+
+	// 다시 로그인해도 중복 참여나 요청이 생기지 않음
+	f.svc.JoinByEmailDomain(context.Background(), user)
+	require.NoError(t, f.db.Model(&domain.WorkspaceMember{}).Where("user_id = ?", user.ID).Count(&members).Error)
+	require.NoError(t, f.db.Model(&domain.WorkspaceJoinRequest{}).Where("user_id = ?", user.ID).Count(&requests).Error)
+	assert.Equal(t, int64(1), members)
+	assert.Equal(t, int64(1), requests)
+
+	outsider := f.createUser(t, "someone@gmail.com")
+	f.svc.JoinByEmailDomain(context.Background(), outsider)
+	require.NoError(t, f.db.Model(&domain.WorkspaceMember{}).Where("user_id = ?", outsider.ID).Count(&members).Error)
+	assert.Equal(t, int64(0), members)
+}
@@ -0,0 +1,230 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 게스트(외부 협업자) 관련 비즈니스 로직을 포함합니다.
+// 게스트는 워크스페이스 멤버가 아니며, 공유받은 board-service/storage-service 프로젝트에만 접근합니다.
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"user-service/internal/domain"
+	"user-service/internal/response"
+)
+
+// maxGuestProjects는 게스트 한 명에게 공유할 수 있는 프로젝트 수의 상한입니다.
+const maxGuestProjects = 50
+
+// ============================================================
+// 게스트 관리 메서드
+// ============================================================
+
+// InviteGuest는 사용자를 게스트로 초대하고 프로젝트를 공유합니다.
+// member.invite 권한이 필요하며, 최소 한 개의 프로젝트를 공유해야 합니다.
+func (s *WorkspaceService) InviteGuest(workspaceID, inviterID uuid.UUID, req domain.InviteGuestRequest) (*domain.GuestResponse, error) {
+	if _, err := s.requireGuestManager(workspaceID, inviterID); err != nil {
+		return nil, err
+	}
+
+	grants, err := buildGuestGrants(workspaceID, uuid.Nil, inviterID, req.ProjectIDs, req.StorageProjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByEmail(req.Email)
+	if err != nil {
+		return nil, response.NewNotFoundError("User not found with the provided email", req.Email)
+	}
+
+	if isMember, _ := s.memberRepo.IsMember(workspaceID, user.ID); isMember {
+		return nil, response.NewAlreadyExistsError("User is already a member of this workspace", "")
+	}
+	if isGuest, _ := s.memberRepo.IsGuest(workspaceID, user.ID); isGuest {
+		return nil, response.NewAlreadyExistsError("User is already a guest of this workspace", "update the shared projects instead")
+	}
+	if isSuspended, _ := s.memberRepo.IsSuspended(workspaceID, user.ID); isSuspended {
+		return nil, response.NewConflictError("User is a suspended member of this workspace", "reactivate the member instead")
+	}
+
+	for i := range grants {
+		grants[i].UserID = user.ID
+	}
+	if err := s.guestRepo.ReplaceForGuest(workspaceID, user.ID, grants); err != nil {
+		s.logger.Error("게스트 프로젝트 공유 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to share projects", err.Error())
+	}
+
+	member, err := s.addMember(workspaceID, user, domain.RoleGuest)
+	if err != nil {
+		s.logger.Error("게스트 생성 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to invite guest", err.Error())
+	}
+
+	s.recordMemberAudit(workspaceID, inviterID, member, domain.AuditActionGuestInvited, nil)
+
+	s.logger.Info("게스트 초대 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("user_id", user.ID.String()),
+		zap.Int("project_count", len(grants)),
+		zap.String("invited_by", inviterID.String()))
+
+	return toGuestResponse(member, grants), nil
+}
+
+// GetGuests는 워크스페이스의 게스트와 공유된 프로젝트 목록을 조회합니다.
+// 멤버 디렉터리에는 게스트가 포함되지 않으므로 초대 권한이 있는 멤버만 이 목록을 볼 수 있습니다.
+func (s *WorkspaceService) GetGuests(workspaceID, requesterID uuid.UUID) ([]domain.GuestResponse, error) {
+	if _, err := s.requireGuestManager(workspaceID, requesterID); err != nil {
+		return nil, err
+	}
+
+	guests, err := s.memberRepo.FindGuests(workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get guests", err.Error())
+	}
+	grants, err := s.guestRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get guest projects", err.Error())
+	}
+
+	grantsByUser := make(map[uuid.UUID][]domain.WorkspaceGuestProject)
+	for _, grant := range grants {
+		grantsByUser[grant.UserID] = append(grantsByUser[grant.UserID], grant)
+	}
+
+	responses := make([]domain.GuestResponse, 0, len(guests))
+	for i := range guests {
+		responses = append(responses, *toGuestResponse(&guests[i], grantsByUser[guests[i].UserID]))
+	}
+	return responses, nil
+}
+
+// UpdateGuestProjects는 게스트에게 공유된 프로젝트 목록을 교체합니다.
+// 공유를 모두 해제하려면 게스트를 워크스페이스에서 제거합니다.
+func (s *WorkspaceService) UpdateGuestProjects(workspaceID, memberID, actorID uuid.UUID, req domain.UpdateGuestProjectsRequest) (*domain.GuestResponse, error) {
+	if _, err := s.requireGuestManager(workspaceID, actorID); err != nil {
+		return nil, err
+	}
+
+	member, err := s.memberRepo.FindByID(memberID)
+	if err != nil || member.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("Member not found", memberID.String())
+	}
+	if member.RoleName != domain.RoleGuest {
+		return nil, response.NewValidationError("Member is not a guest", memberID.String())
+	}
+
+	grants, err := buildGuestGrants(workspaceID, member.UserID, actorID, req.ProjectIDs, req.StorageProjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.guestRepo.ReplaceForGuest(workspaceID, member.UserID, grants); err != nil {
+		s.logger.Error("게스트 프로젝트 변경 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("member_id", memberID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to update shared projects", err.Error())
+	}
+
+	s.recordMemberAudit(workspaceID, actorID, member, domain.AuditActionGuestProjectsUpdated, nil)
+
+	s.logger.Info("게스트 프로젝트 변경 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("member_id", memberID.String()),
+		zap.Int("project_count", len(grants)),
+		zap.String("updated_by", actorID.String()))
+
+	return toGuestResponse(member, grants), nil
+}
+
+// guestAccess는 게스트가 공유받은 프로젝트를 검증 응답으로 변환합니다.
+func (s *WorkspaceService) guestAccess(workspaceID, userID uuid.UUID) (*domain.MemberAccessResponse, error) {
+	grants, err := s.guestRepo.FindByGuest(workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	access := &domain.MemberAccessResponse{IsGuest: true}
+	access.GuestProjectIDs, access.GuestStorageProjectIDs = splitGuestGrants(grants)
+	return access, nil
+}
+
+// requireGuestManager는 사용자가 게스트를 관리할 수 있는지 확인합니다.
+func (s *WorkspaceService) requireGuestManager(workspaceID, userID uuid.UUID) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+	if !s.hasPermission(workspace, userID, permission.MemberInvite) {
+		return nil, response.NewForbiddenError("Only owner and admins can manage guests of this workspace", string(permission.MemberInvite))
+	}
+	return workspace, nil
+}
+
+// buildGuestGrants는 요청된 프로젝트 ID를 중복 없이 공유 항목으로 변환합니다.
+func buildGuestGrants(workspaceID, userID, grantedBy uuid.UUID, projectIDs, storageProjectIDs []uuid.UUID) ([]domain.WorkspaceGuestProject, error) {
+	now := time.Now()
+	grants := make([]domain.WorkspaceGuestProject, 0, len(projectIDs)+len(storageProjectIDs))
+	seen := make(map[domain.GuestResource]map[uuid.UUID]bool)
+	add := func(resource domain.GuestResource, ids []uuid.UUID) {
+		if seen[resource] == nil {
+			seen[resource] = make(map[uuid.UUID]bool)
+		}
+		for _, id := range ids {
+			if id == uuid.Nil || seen[resource][id] {
+				continue
+			}
+			seen[resource][id] = true
+			grants = append(grants, domain.WorkspaceGuestProject{
+				ID:          uuid.New(),
+				WorkspaceID: workspaceID,
+				UserID:      userID,
+				Resource:    resource,
+				ProjectID:   id,
+				GrantedBy:   grantedBy,
+				CreatedAt:   now,
+			})
+		}
+	}
+	add(domain.GuestResourceBoard, projectIDs)
+	add(domain.GuestResourceStorage, storageProjectIDs)
+
+	if len(grants) == 0 {
+		return nil, response.NewValidationError("A guest must be given at least one project", "")
+	}
+	if len(grants) > maxGuestProjects {
+		return nil, response.NewValidationError("Too many projects shared with a guest", "maximum is 50")
+	}
+	return grants, nil
+}
+
+// splitGuestGrants는 공유 항목을 board-service와 storage-service 프로젝트 ID로 나눕니다.
+func splitGuestGrants(grants []domain.WorkspaceGuestProject) (projectIDs, storageProjectIDs []uuid.UUID) {
+	projectIDs = []uuid.UUID{}
+	storageProjectIDs = []uuid.UUID{}
+	for _, grant := range grants {
+		switch grant.Resource {
+		case domain.GuestResourceBoard:
+			projectIDs = append(projectIDs, grant.ProjectID)
+		case domain.GuestResourceStorage:
+			storageProjectIDs = append(storageProjectIDs, grant.ProjectID)
+		}
+	}
+	return projectIDs, storageProjectIDs
+}
+
+// toGuestResponse는 게스트 멤버와 공유 항목을 응답으로 변환합니다.
+func toGuestResponse(member *domain.WorkspaceMember, grants []domain.WorkspaceGuestProject) *domain.GuestResponse {
+	resp := &domain.GuestResponse{WorkspaceMemberResponse: member.ToResponse()}
+	resp.ProjectIDs, resp.StorageProjectIDs = splitGuestGrants(grants)
+	return resp
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"user-service/internal/domain"
+)
+
+// TestBuildGuestGrants verifies shared projects are deduplicated per service
+// 서비스별로 중복 없이 공유 항목이 만들어지는지 검증
+func TestBuildGuestGrants(t *testing.T) {
+	workspaceID := uuid.New()
+	userID := uuid.New()
+	grantedBy := uuid.New()
+	project := uuid.New()
+
+	grants, err := buildGuestGrants(workspaceID, userID, grantedBy,
+		[]uuid.UUID{project, project, uuid.Nil},
+		[]uuid.UUID{project})
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+
+	assert.Equal(t, domain.GuestResourceBoard, grants[0].Resource)
+	assert.Equal(t, domain.GuestResourceStorage, grants[1].Resource)
+	for _, grant := range grants {
+		assert.Equal(t, workspaceID, grant.WorkspaceID)
+		assert.Equal(t, userID, grant.UserID)
+		assert.Equal(t, grantedBy, grant.GrantedBy)
+		assert.Equal(t, project, grant.ProjectID)
+	}
+}
+
+// TestBuildGuestGrants_Limits verifies a guest needs at least one and at most 50 projects
+// 게스트에게 공유하는 프로젝트 수의 하한과 상한을 검증
+func TestBuildGuestGrants_Limits(t *testing.T) {
+	_, err := buildGuestGrants(uuid.New(), uuid.New(), uuid.New(), nil, []uuid.UUID{uuid.Nil})
+	assert.Error(t, err)
+
+	tooMany := make([]uuid.UUID, maxGuestProjects+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+	_, err = buildGuestGrants(uuid.New(), uuid.New(), uuid.New(), tooMany, nil)
+	assert.Error(t, err)
+}
+
+// TestSplitGuestGrants verifies grants are split into board and storage projects
+// 공유 항목이 board/storage 프로젝트 ID로 나뉘는지 검증
+func TestSplitGuestGrants(t *testing.T) {
+	boardProject := uuid.New()
+	storageProject := uuid.New()
+
+	projectIDs, storageProjectIDs := splitGuestGrants([]domain.WorkspaceGuestProject{
+		{Resource: domain.GuestResourceBoard, ProjectID: boardProject},
+		{Resource: domain.GuestResourceStorage, ProjectID: storageProject},
+	})
+	assert.Equal(t, []uuid.UUID{boardProject}, projectIDs)
+	assert.Equal(t, []uuid.UUID{storageProject}, storageProjectIDs)
+
+	projectIDs, storageProjectIDs = splitGuestGrants(nil)
+	assert.NotNil(t, projectIDs)
+	assert.NotNil(t, storageProjectIDs)
+}
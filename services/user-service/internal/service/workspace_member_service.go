@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
 	"user-service/internal/domain"
 	"user-service/internal/response"
 )
@@ -26,9 +28,21 @@ func (s *WorkspaceService) GetMembers(workspaceID uuid.UUID) ([]domain.Workspace
 // GetMembersWithProfiles는 프로필 정보를 포함한 멤버 목록을 조회합니다.
 // 닉네임과 프로필 이미지 URL을 포함합니다.
 // 🔥 워크스페이스별 프로필이 없으면 기본 프로필(default)로 fallback합니다.
-func (s *WorkspaceService) GetMembersWithProfiles(workspaceID uuid.UUID) ([]domain.WorkspaceMemberResponse, error) {
+// includeSuspended가 true이면 정지된 멤버도 함께 반환합니다.
+// 게스트는 목록에 포함되지 않으며, 게스트는 멤버 목록을 조회할 수 없습니다.
+func (s *WorkspaceService) GetMembersWithProfiles(workspaceID, viewerID uuid.UUID, includeSuspended bool) ([]domain.WorkspaceMemberResponse, error) {
+	if isGuest, _ := s.memberRepo.IsGuest(workspaceID, viewerID); isGuest {
+		return nil, response.NewForbiddenError("Guests cannot view the member directory", "")
+	}
+
 	// 멤버 목록 조회
-	members, err := s.memberRepo.FindByWorkspace(workspaceID)
+	var members []domain.WorkspaceMember
+	var err error
+	if includeSuspended {
+		members, err = s.memberRepo.FindByWorkspaceIncludingSuspended(workspaceID)
+	} else {
+		members, err = s.memberRepo.FindByWorkspace(workspaceID)
+	}
 	if err != nil {
 		s.logger.Error("멤버 목록 조회 실패",
 			zap.String("workspace_id", workspaceID.String()),
@@ -105,33 +119,21 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
 	}
 
-	// 초대자의 역할 확인
-	inviterRole, err := s.memberRepo.GetRole(workspaceID, inviterID)
+	// 초대 권한 확인 (member.invite)
+	// 기본 역할에서는 OWNER와 ADMIN만 초대 가능하며, 커스텀 역할로 다른 멤버에게도 부여할 수 있음
+	inviterPerms, _, err := s.effectivePermissions(workspace, inviterID)
 	if err != nil {
-		s.logger.Error("초대자 역할 조회 실패",
+		s.logger.Warn("초대자 권한 조회 실패",
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("inviter_id", inviterID.String()),
 			zap.Error(err))
-		return nil, response.NewInternalError("Failed to verify invite permission", err.Error())
+		return nil, response.NewForbiddenError("Permission denied", "failed to verify role")
 	}
-
-	// 초대 권한 확인
-	// OnlyOwnerCanInvite=true일 때: OWNER와 ADMIN만 초대 가능
-	// OnlyOwnerCanInvite=false일 때: OWNER, ADMIN 초대 가능 (MEMBER는 불가)
-	if workspace.OnlyOwnerCanInvite {
-		// OWNER 또는 ADMIN만 초대 가능
-		if workspace.OwnerID != inviterID && inviterRole != domain.RoleAdmin {
-			s.logger.Warn("초대 권한 없음 - 소유자 또는 관리자만 초대 가능",
-				zap.String("workspace_id", workspaceID.String()),
-				zap.String("inviter_id", inviterID.String()),
-				zap.String("inviter_role", string(inviterRole)))
-			return nil, response.NewForbiddenError("Only owner and admins can invite members to this workspace", "")
-		}
-	} else {
-		// MEMBER는 초대 불가
-		if inviterRole == domain.RoleMember {
-			return nil, response.NewForbiddenError("Members cannot invite others", "")
-		}
+	if !inviterPerms.Has(permission.MemberInvite) {
+		s.logger.Warn("초대 권한 없음",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("inviter_id", inviterID.String()))
+		return nil, response.NewForbiddenError("Only owner and admins can invite members to this workspace", string(permission.MemberInvite))
 	}
 
 	// 이메일로 사용자 조회
@@ -149,6 +151,16 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 		return nil, response.NewAlreadyExistsError("User is already a member of this workspace", "")
 	}
 
+	// 게스트는 역할 변경으로 멤버가 되어야 함
+	if isGuest, _ := s.memberRepo.IsGuest(workspaceID, user.ID); isGuest {
+		return nil, response.NewConflictError("User is a guest of this workspace", "update the member role instead")
+	}
+
+	// 정지된 멤버는 새로 초대하지 않고 재활성화해야 함
+	if isSuspended, _ := s.memberRepo.IsSuspended(workspaceID, user.ID); isSuspended {
+		return nil, response.NewConflictError("User is a suspended member of this workspace", "reactivate the member instead")
+	}
+
 	// 역할 결정 (기본값: MEMBER)
 	roleName := domain.RoleMember
 	if req.RoleName != "" {
@@ -158,6 +170,10 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 	if roleName == domain.RoleOwner {
 		return nil, response.NewForbiddenError("Cannot assign owner role through invitation", "")
 	}
+	// 게스트는 공유할 프로젝트와 함께 게스트 초대로 추가
+	if roleName == domain.RoleGuest {
+		return nil, response.NewValidationError("Guests must be invited with the projects shared with them", "")
+	}
 
 	// ADMIN 역할인 경우 최대 4명 제한 확인
 	if roleName == domain.RoleAdmin {
@@ -181,6 +197,7 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 		RoleName:    roleName,
 		IsDefault:   false,
 		IsActive:    true,
+		Status:      domain.MemberStatusActive,
 		JoinedAt:    time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -225,7 +242,7 @@ func (s *WorkspaceService) InviteMember(workspaceID, inviterID uuid.UUID, req do
 }
 
 // UpdateMemberRole은 멤버의 역할을 업데이트합니다.
-// 소유자 또는 관리자만 역할을 변경할 수 있습니다.
+// member.role.update 권한이 있는 멤버만 역할을 변경할 수 있습니다.
 func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uuid.UUID, req domain.UpdateMemberRoleRequest) (*domain.WorkspaceMember, error) {
 	// 워크스페이스 조회
 	workspace, err := s.workspaceRepo.FindByID(workspaceID)
@@ -233,15 +250,13 @@ func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uui
 		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
 	}
 
-	// 업데이터의 역할 확인
-	updaterRole, err := s.memberRepo.GetRole(workspaceID, updaterID)
+	// 역할 변경 권한 확인
+	updaterPerms, _, err := s.effectivePermissions(workspace, updaterID)
 	if err != nil {
 		return nil, response.NewForbiddenError("Permission denied", "failed to verify role")
 	}
-
-	// MEMBER는 역할 변경 불가
-	if updaterRole == domain.RoleMember {
-		return nil, response.NewForbiddenError("Members cannot change roles", "")
+	if !updaterPerms.Has(permission.MemberRoleUpdate) {
+		return nil, response.NewForbiddenError("Members cannot change roles", string(permission.MemberRoleUpdate))
 	}
 
 	// 대상 멤버 조회
@@ -260,6 +275,12 @@ func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uui
 		return nil, response.NewForbiddenError("Cannot assign owner role", "")
 	}
 
+	// 멤버를 게스트로 바꾸면 공유 프로젝트가 없으므로 게스트 초대를 사용해야 함
+	if req.RoleName == domain.RoleGuest && member.RoleName != domain.RoleGuest {
+		return nil, response.NewValidationError("Members cannot be changed to guests", "remove the member and invite them as a guest")
+	}
+	wasGuest := member.RoleName == domain.RoleGuest
+
 	// ADMIN도 OWNER와 동일한 권한으로 다른 ADMIN의 역할 변경 가능
 
 	// ADMIN으로 변경하는 경우 최대 4명 제한 확인 (현재 ADMIN이 아닌 경우에만)
@@ -287,6 +308,15 @@ func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uui
 		return nil, err
 	}
 
+	// 게스트가 멤버가 되면 모든 프로젝트에 접근하므로 개별 공유는 정리
+	if wasGuest && member.RoleName != domain.RoleGuest {
+		if err := s.guestRepo.DeleteByGuest(workspaceID, member.UserID); err != nil {
+			s.logger.Warn("게스트 프로젝트 정리 실패",
+				zap.String("member_id", memberID.String()),
+				zap.Error(err))
+		}
+	}
+
 	s.logger.Info("멤버 역할 업데이트 완료",
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("member_id", memberID.String()),
@@ -297,7 +327,7 @@ func (s *WorkspaceService) UpdateMemberRole(workspaceID, memberID, updaterID uui
 }
 
 // RemoveMember는 워크스페이스에서 멤버를 제거합니다.
-// 소유자 또는 관리자만 멤버를 제거할 수 있습니다.
+// member.remove 권한이 있는 멤버만 다른 멤버를 제거할 수 있으며, 자기 탈퇴는 누구나 가능합니다.
 func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UUID) error {
 	// 워크스페이스 조회
 	workspace, err := s.workspaceRepo.FindByID(workspaceID)
@@ -305,8 +335,8 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 		return response.NewNotFoundError("Workspace not found", workspaceID.String())
 	}
 
-	// 제거자의 역할 확인
-	removerRole, err := s.memberRepo.GetRole(workspaceID, removerID)
+	// 제거자의 권한 확인
+	removerPerms, _, err := s.effectivePermissions(workspace, removerID)
 	if err != nil {
 		return response.NewForbiddenError("Permission denied", "failed to verify role")
 	}
@@ -319,10 +349,9 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 
 	// 자기 탈퇴가 아닌 경우 권한 확인
 	if member.UserID != removerID {
-		if removerRole == domain.RoleMember {
-			return response.NewForbiddenError("Members cannot remove others", "")
+		if !removerPerms.Has(permission.MemberRemove) {
+			return response.NewForbiddenError("Members cannot remove others", string(permission.MemberRemove))
 		}
-		// ADMIN도 OWNER와 동일한 권한으로 다른 ADMIN 제거 가능
 	}
 
 	// 소유자는 제거 불가
@@ -346,6 +375,16 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 			zap.Error(err))
 	}
 
+	// 게스트의 프로젝트 공유도 해제
+	if member.RoleName == domain.RoleGuest {
+		if err := s.guestRepo.DeleteByGuest(workspaceID, member.UserID); err != nil {
+			s.logger.Warn("게스트 프로젝트 정리 실패",
+				zap.String("user_id", member.UserID.String()),
+				zap.String("workspace_id", workspaceID.String()),
+				zap.Error(err))
+		}
+	}
+
 	s.logger.Info("멤버 제거 완료",
 		zap.String("workspace_id", workspaceID.String()),
 		zap.String("member_id", memberID.String()),
@@ -354,6 +393,147 @@ func (s *WorkspaceService) RemoveMember(workspaceID, memberID, removerID uuid.UU
 	return nil
 }
 
+// SuspendMember는 멤버의 워크스페이스 접근을 정지합니다.
+// 멤버십과 프로필은 유지되므로 작성한 보드, 댓글, 파일에서 프로필이 계속 조회됩니다.
+// member.suspend 권한이 있는 멤버만 정지할 수 있으며 소유자와 자기 자신은 정지할 수 없습니다.
+func (s *WorkspaceService) SuspendMember(workspaceID, memberID, actorID uuid.UUID, req domain.SuspendMemberRequest) (*domain.WorkspaceMember, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	if !s.hasPermission(workspace, actorID, permission.MemberSuspend) {
+		return nil, response.NewForbiddenError("Members cannot suspend others", string(permission.MemberSuspend))
+	}
+
+	member, err := s.memberRepo.FindByID(memberID)
+	if err != nil || member.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("Member not found", memberID.String())
+	}
+	if member.UserID == workspace.OwnerID {
+		return nil, response.NewForbiddenError("Cannot suspend workspace owner", "")
+	}
+	if member.UserID == actorID {
+		return nil, response.NewForbiddenError("Cannot suspend yourself", "")
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+	now := time.Now()
+	ok, err := s.memberRepo.Suspend(memberID, actorID, reason, now)
+	if err != nil {
+		s.logger.Error("멤버 정지 실패",
+			zap.String("member_id", memberID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to suspend member", err.Error())
+	}
+	if !ok {
+		return nil, response.NewConflictError("Member is no longer active", memberID.String())
+	}
+
+	member.IsActive = false
+	member.Status = domain.MemberStatusSuspended
+	member.SuspendedAt = &now
+	member.SuspendedBy = &actorID
+	member.SuspendReason = reason
+	member.UpdatedAt = now
+
+	s.recordMemberAudit(workspaceID, actorID, member, domain.AuditActionMemberSuspended, reason)
+
+	s.logger.Info("멤버 정지 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("member_id", memberID.String()),
+		zap.String("suspended_by", actorID.String()))
+
+	return member, nil
+}
+
+// ReactivateMember는 정지된 멤버의 워크스페이스 접근을 복구합니다.
+// member.suspend 권한이 있는 멤버만 복구할 수 있으며, 관리자 복구 시 최대 관리자 수 제한을 확인합니다.
+func (s *WorkspaceService) ReactivateMember(workspaceID, memberID, actorID uuid.UUID) (*domain.WorkspaceMember, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	if !s.hasPermission(workspace, actorID, permission.MemberSuspend) {
+		return nil, response.NewForbiddenError("Members cannot reactivate others", string(permission.MemberSuspend))
+	}
+
+	member, err := s.memberRepo.FindSuspendedByID(memberID)
+	if err != nil || member.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("Suspended member not found", memberID.String())
+	}
+
+	// 정지된 관리자는 관리자 수에 포함되지 않으므로 복구 전에 제한 확인
+	if member.RoleName == domain.RoleAdmin {
+		adminCount, err := s.memberRepo.CountByRole(workspaceID, domain.RoleAdmin)
+		if err != nil {
+			return nil, response.NewInternalError("Failed to verify admin count", err.Error())
+		}
+		if adminCount >= 4 {
+			return nil, response.NewForbiddenError("Maximum number of admins (4) reached", "change the member's role before reactivating")
+		}
+	}
+
+	now := time.Now()
+	ok, err := s.memberRepo.Reactivate(memberID, now)
+	if err != nil {
+		s.logger.Error("멤버 복구 실패",
+			zap.String("member_id", memberID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to reactivate member", err.Error())
+	}
+	if !ok {
+		return nil, response.NewConflictError("Member is no longer suspended", memberID.String())
+	}
+
+	member.IsActive = true
+	member.Status = domain.MemberStatusActive
+	member.SuspendedAt = nil
+	member.SuspendedBy = nil
+	member.SuspendReason = nil
+	member.UpdatedAt = now
+
+	s.recordMemberAudit(workspaceID, actorID, member, domain.AuditActionMemberReactivated, nil)
+
+	s.logger.Info("멤버 복구 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("member_id", memberID.String()),
+		zap.String("reactivated_by", actorID.String()))
+
+	return member, nil
+}
+
+// recordMemberAudit은 멤버 상태 변경을 감사 로그에 기록합니다.
+// 감사 로그 저장 실패는 상태 변경을 되돌리지 않습니다.
+func (s *WorkspaceService) recordMemberAudit(workspaceID, actorID uuid.UUID, member *domain.WorkspaceMember, action domain.AuditAction, reason *string) {
+	targetUserID := member.UserID
+	metadata := map[string]interface{}{
+		"memberId": member.ID.String(),
+	}
+	if reason != nil {
+		metadata["reason"] = *reason
+	}
+	auditLog := &domain.WorkspaceAuditLog{
+		ID:           uuid.New(),
+		WorkspaceID:  workspaceID,
+		ActorID:      actorID,
+		Action:       action,
+		TargetUserID: &targetUserID,
+		Metadata:     metadata,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.auditLogRepo.Create(auditLog); err != nil {
+		s.logger.Warn("감사 로그 저장 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("action", string(action)),
+			zap.Error(err))
+	}
+}
+
 // ============================================================
 // 멤버 검증 메서드
 // ============================================================
@@ -364,8 +544,9 @@ func (s *WorkspaceService) IsMember(workspaceID, userID uuid.UUID) (bool, error)
 }
 
 // ValidateMemberAccess는 사용자가 워크스페이스에 접근 권한이 있는지 확인합니다.
-// 멤버이거나 공개 워크스페이스인 경우 접근 가능합니다.
-func (s *WorkspaceService) ValidateMemberAccess(workspaceID, userID uuid.UUID) (bool, error) {
+// 멤버이거나 공개 워크스페이스인 경우 접근 가능하며, 정지된 멤버는 항상 거부됩니다.
+// 게스트는 멤버가 아니며, 공유받은 프로젝트 목록이 응답에 포함됩니다.
+func (s *WorkspaceService) ValidateMemberAccess(workspaceID, userID uuid.UUID) (*domain.MemberAccessResponse, error) {
 	// 먼저 멤버인지 확인
 	isMember, err := s.memberRepo.IsMember(workspaceID, userID)
 	if err != nil {
@@ -373,20 +554,55 @@ func (s *WorkspaceService) ValidateMemberAccess(workspaceID, userID uuid.UUID) (
 			zap.String("workspace_id", workspaceID.String()),
 			zap.String("user_id", userID.String()),
 			zap.Error(err))
-		return false, err
+		return nil, err
 	}
 	if isMember {
-		return true, nil
+		// 역할은 워크스페이스 설정 변경 등 관리자 전용 작업 판단에 사용
+		role, err := s.memberRepo.GetRole(workspaceID, userID)
+		if err != nil {
+			s.logger.Error("멤버 역할 조회 실패",
+				zap.String("workspace_id", workspaceID.String()),
+				zap.String("user_id", userID.String()),
+				zap.Error(err))
+			return nil, err
+		}
+		return &domain.MemberAccessResponse{IsMember: true, RoleName: role}, nil
+	}
+
+	// 정지된 멤버는 공개 워크스페이스라도 접근 불가
+	isSuspended, err := s.memberRepo.IsSuspended(workspaceID, userID)
+	if err != nil {
+		s.logger.Error("정지 멤버 확인 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	if isSuspended {
+		return &domain.MemberAccessResponse{}, nil
+	}
+
+	// 게스트는 공개 워크스페이스라도 공유받은 프로젝트에만 접근 가능
+	isGuest, err := s.memberRepo.IsGuest(workspaceID, userID)
+	if err != nil {
+		s.logger.Error("게스트 확인 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+	if isGuest {
+		return s.guestAccess(workspaceID, userID)
 	}
 
 	// 멤버가 아니면 공개 워크스페이스인지 확인
 	workspace, err := s.workspaceRepo.FindByID(workspaceID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	// 공개 워크스페이스면 접근 가능 (읽기만)
-	return workspace.IsPublic, nil
+	return &domain.MemberAccessResponse{IsMember: workspace.IsPublic}, nil
 }
 
 // ============================================================
@@ -413,6 +629,16 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 		return nil, response.NewAlreadyExistsError("Already a member of this workspace", "")
 	}
 
+	// 게스트는 참여 요청 대신 관리자가 역할을 변경해야 함
+	if isGuest, _ := s.memberRepo.IsGuest(workspaceID, userID); isGuest {
+		return nil, response.NewConflictError("You are a guest of this workspace", "ask an admin to change your role")
+	}
+
+	// 정지된 멤버는 참여 요청으로 접근을 되찾을 수 없음
+	if isSuspended, _ := s.memberRepo.IsSuspended(workspaceID, userID); isSuspended {
+		return nil, response.NewForbiddenError("Your membership in this workspace is suspended", "")
+	}
+
 	// 대기 중인 요청이 있는지 확인
 	hasPending, _ := s.joinReqRepo.HasPendingRequest(workspaceID, userID)
 	if hasPending {
@@ -428,6 +654,7 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 			RoleName:    domain.RoleMember,
 			IsDefault:   false,
 			IsActive:    true,
+			Status:      domain.MemberStatusActive,
 			JoinedAt:    time.Now(),
 			UpdatedAt:   time.Now(),
 		}
@@ -502,17 +729,16 @@ func (s *WorkspaceService) CreateJoinRequest(workspaceID, userID uuid.UUID) (*do
 }
 
 // GetJoinRequests는 워크스페이스의 참여 요청 목록을 조회합니다.
-// 소유자 또는 관리자만 조회할 수 있습니다.
+// join_request.approve 권한이 있는 멤버만 조회할 수 있습니다.
 func (s *WorkspaceService) GetJoinRequests(workspaceID, requesterID uuid.UUID) ([]domain.WorkspaceJoinRequest, error) {
-	// 요청자의 역할 확인
-	role, err := s.memberRepo.GetRole(workspaceID, requesterID)
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
 	if err != nil {
-		return nil, response.NewForbiddenError("Permission denied", "failed to verify role")
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
 	}
 
-	// MEMBER는 조회 불가
-	if role == domain.RoleMember {
-		return nil, response.NewForbiddenError("Members cannot view join requests", "")
+	// 참여 요청 조회 권한 확인
+	if !s.hasPermission(workspace, requesterID, permission.JoinRequestApprove) {
+		return nil, response.NewForbiddenError("Members cannot view join requests", string(permission.JoinRequestApprove))
 	}
 
 	return s.joinReqRepo.FindPendingByWorkspace(workspaceID)
@@ -524,17 +750,16 @@ func (s *WorkspaceService) GetPendingJoinRequests(workspaceID uuid.UUID) ([]doma
 }
 
 // ProcessJoinRequest는 참여 요청을 처리합니다 (승인/거부).
-// 소유자 또는 관리자만 처리할 수 있습니다.
+// join_request.approve 권한이 있는 멤버만 처리할 수 있습니다.
 func (s *WorkspaceService) ProcessJoinRequest(workspaceID, requestID, processorID uuid.UUID, req domain.ProcessJoinRequestRequest) (*domain.WorkspaceJoinRequest, error) {
-	// 처리자의 역할 확인
-	role, err := s.memberRepo.GetRole(workspaceID, processorID)
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
 	if err != nil {
-		return nil, response.NewForbiddenError("Permission denied", "failed to verify role")
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
 	}
 
-	// MEMBER는 처리 불가
-	if role == domain.RoleMember {
-		return nil, response.NewForbiddenError("Members cannot process join requests", "")
+	// 참여 요청 처리 권한 확인
+	if !s.hasPermission(workspace, processorID, permission.JoinRequestApprove) {
+		return nil, response.NewForbiddenError("Members cannot process join requests", string(permission.JoinRequestApprove))
 	}
 
 	// 요청 조회
@@ -573,6 +798,7 @@ func (s *WorkspaceService) ProcessJoinRequest(workspaceID, requestID, processorI
 			RoleName:    domain.RoleMember,
 			IsDefault:   false,
 			IsActive:    true,
+			Status:      domain.MemberStatusActive,
 			JoinedAt:    time.Now(),
 			UpdatedAt:   time.Now(),
 		}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"user-service/internal/domain"
+	"user-service/internal/response"
+)
+
+// TestSuspendMember_Rules verifies who may suspend whom
+// 정지 권한과 소유자/자기 자신 정지 금지 규칙을 검증
+func TestSuspendMember_Rules(t *testing.T) {
+	f := newRoleTestFixture(t)
+
+	tests := []struct {
+		name     string
+		actorID  uuid.UUID
+		memberID uuid.UUID
+		wantCode string
+	}{
+		{"member lacks permission", f.member.UserID, f.admin.ID, response.ErrCodeForbidden},
+		{"owner cannot be suspended", f.admin.UserID, f.owner.ID, response.ErrCodeForbidden},
+		{"cannot suspend yourself", f.admin.UserID, f.admin.ID, response.ErrCodeForbidden},
+		{"unknown member", f.admin.UserID, uuid.New(), response.ErrCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.svc.SuspendMember(f.workspace.ID, tt.memberID, tt.actorID, domain.SuspendMemberRequest{})
+			require.Error(t, err)
+			appErr, ok := err.(*response.AppError)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+		})
+	}
+}
+
+// TestSuspendAndReactivateMember verifies the ACTIVE -> SUSPENDED -> ACTIVE transitions
+// 정지 후 접근이 차단되고 복구 후 다시 허용되는지, 잘못된 전이는 거부되는지 검증
+func TestSuspendAndReactivateMember(t *testing.T) {
+	f := newRoleTestFixture(t)
+
+	suspended, err := f.svc.SuspendMember(f.workspace.ID, f.member.ID, f.admin.UserID, domain.SuspendMemberRequest{Reason: "left the team"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.MemberStatusSuspended, suspended.Status)
+	assert.False(t, suspended.IsActive)
+	require.NotNil(t, suspended.SuspendReason)
+	assert.Equal(t, "left the team", *suspended.SuspendReason)
+
+	var stored domain.WorkspaceMember
+	require.NoError(t, f.db.First(&stored, "id = ?", f.member.ID).Error)
+	assert.Equal(t, domain.MemberStatusSuspended, stored.Status)
+	require.NotNil(t, stored.SuspendedBy)
+	assert.Equal(t, f.admin.UserID, *stored.SuspendedBy)
+
+	// 정지된 멤버는 공개 워크스페이스라도 접근 불가
+	access, err := f.svc.ValidateMemberAccess(f.workspace.ID, f.member.UserID)
+	require.NoError(t, err)
+	assert.False(t, access.IsMember)
+
+	// 이미 정지된 멤버는 다시 정지할 수 없음
+	_, err = f.svc.SuspendMember(f.workspace.ID, f.member.ID, f.admin.UserID, domain.SuspendMemberRequest{})
+	assert.Error(t, err)
+
+	_, err = f.svc.ReactivateMember(f.workspace.ID, f.member.ID, f.member.UserID)
+	assert.Error(t, err, "a suspended member cannot reactivate themselves")
+
+	reactivated, err := f.svc.ReactivateMember(f.workspace.ID, f.member.ID, f.admin.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.MemberStatusActive, reactivated.Status)
+	assert.True(t, reactivated.IsActive)
+	assert.Nil(t, reactivated.SuspendReason)
+
+	var restored domain.WorkspaceMember
+	require.NoError(t, f.db.First(&restored, "id = ?", f.member.ID).Error)
+	assert.Equal(t, domain.MemberStatusActive, restored.Status)
+	assert.Nil(t, restored.SuspendedAt)
+	assert.Nil(t, restored.SuspendedBy)
+
+	access, err = f.svc.ValidateMemberAccess(f.workspace.ID, f.member.UserID)
+	require.NoError(t, err)
+	assert.True(t, access.IsMember)
+
+	// 활성 멤버는 복구 대상이 아님
+	_, err = f.svc.ReactivateMember(f.workspace.ID, f.member.ID, f.admin.UserID)
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok)
+	assert.Equal(t, response.ErrCodeNotFound, appErr.Code)
+
+	var actions []domain.AuditAction
+	require.NoError(t, f.db.Model(&domain.WorkspaceAuditLog{}).
+		Where("workspace_id = ?", f.workspace.ID).
+		Order("created_at").
+		Pluck("action", &actions).Error)
+	assert.Equal(t, []domain.AuditAction{domain.AuditActionMemberSuspended, domain.AuditActionMemberReactivated}, actions)
+}
+
+// TestReactivateMember_AdminLimit verifies a suspended admin is not restored past the admin limit
+// 관리자 수가 가득 찬 경우 정지된 관리자를 복구할 수 없는지 검증
+func TestReactivateMember_AdminLimit(t *testing.T) {
+	f := newRoleTestFixture(t)
+
+	_, err := f.svc.SuspendMember(f.workspace.ID, f.admin.ID, f.owner.UserID, domain.SuspendMemberRequest{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, f.db.Create(&domain.WorkspaceMember{
+			ID: uuid.New(), WorkspaceID: f.workspace.ID, UserID: uuid.New(), RoleName: domain.RoleAdmin,
+			IsActive: true, Status: domain.MemberStatusActive, JoinedAt: now, UpdatedAt: now,
+		}).Error)
+	}
+
+	_, err = f.svc.ReactivateMember(f.workspace.ID, f.admin.ID, f.owner.UserID)
+	require.Error(t, err)
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok)
+	assert.Equal(t, response.ErrCodeForbidden, appErr.Code)
+
+	var stored domain.WorkspaceMember
+	require.NoError(t, f.db.First(&stored, "id = ?", f.admin.ID).Error)
+	assert.Equal(t, domain.MemberStatusSuspended, stored.Status)
+}
@@ -0,0 +1,268 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 워크스페이스 소유권 이전 관련 비즈니스 로직을 포함합니다.
+// 현재 소유자가 활성 멤버를 지명하고, 지명된 멤버가 수락하면 역할이 교체됩니다.
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"user-service/internal/client"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// ownershipTransferReason은 역할 변경 알림에 포함되는 사유입니다.
+const ownershipTransferReason = "ownership_transfer"
+
+// TransferOwnership은 소유권 이전을 요청합니다.
+// 소유자만 요청할 수 있으며, 지명된 멤버가 수락해야 이전이 완료됩니다.
+// 기존 대기 중인 요청은 취소됩니다.
+func (s *WorkspaceService) TransferOwnership(workspaceID, ownerID uuid.UUID, req domain.TransferOwnershipRequest) (*domain.WorkspaceOwnershipTransfer, error) {
+	// 워크스페이스 조회
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	// 소유자만 이전 요청 가능
+	if workspace.OwnerID != ownerID {
+		return nil, response.NewForbiddenError("Only the owner can transfer ownership", "")
+	}
+
+	// 자기 자신에게 이전 불가
+	if req.NewOwnerID == ownerID {
+		return nil, response.NewValidationError("Cannot transfer ownership to yourself", "")
+	}
+
+	// 지명된 사용자는 활성 멤버여야 함
+	nominee, err := s.memberRepo.FindByWorkspaceAndUser(workspaceID, req.NewOwnerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewValidationError("New owner must be an active member of the workspace", req.NewOwnerID.String())
+		}
+		return nil, response.NewInternalError("Failed to verify new owner", err.Error())
+	}
+	if nominee.RoleName == domain.RoleGuest {
+		return nil, response.NewValidationError("New owner must be an active member of the workspace", req.NewOwnerID.String())
+	}
+
+	// 기존 소유자는 ADMIN이 되므로, 지명된 멤버가 ADMIN이 아니면 ADMIN 최대 4명 제한 확인
+	if nominee.RoleName != domain.RoleAdmin {
+		adminCount, err := s.memberRepo.CountByRole(workspaceID, domain.RoleAdmin)
+		if err != nil {
+			s.logger.Error("ADMIN 수 조회 실패",
+				zap.String("workspace_id", workspaceID.String()),
+				zap.Error(err))
+			return nil, response.NewInternalError("Failed to verify admin count", err.Error())
+		}
+		if adminCount >= 4 {
+			return nil, response.NewForbiddenError("Maximum number of admins (4) reached", "the current owner would become an admin")
+		}
+	}
+
+	// 기존 대기 중인 요청 취소
+	if err := s.transferRepo.CancelPendingByWorkspace(workspaceID); err != nil {
+		s.logger.Error("기존 소유권 이전 요청 취소 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to cancel previous transfer", err.Error())
+	}
+
+	now := time.Now()
+	transfer := &domain.WorkspaceOwnershipTransfer{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		FromUserID:  ownerID,
+		ToUserID:    req.NewOwnerID,
+		Status:      domain.TransferStatusPending,
+		ExpiresAt:   now.Add(domain.OwnershipTransferTTL),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.transferRepo.Create(transfer); err != nil {
+		s.logger.Error("소유권 이전 요청 생성 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("소유권 이전 요청 생성 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("from_user_id", ownerID.String()),
+		zap.String("to_user_id", req.NewOwnerID.String()))
+
+	// 지명된 멤버에게 수락 요청 알림 (비동기)
+	s.sendWorkspaceNotification(client.NewOwnershipTransferRequestedNotification(
+		ownerID, req.NewOwnerID, workspaceID, workspace.WorkspaceName, transfer.ID, transfer.ExpiresAt,
+	))
+
+	return transfer, nil
+}
+
+// GetPendingOwnershipTransfer는 대기 중인 소유권 이전 요청을 조회합니다.
+// 소유자와 지명된 멤버만 조회할 수 있습니다.
+func (s *WorkspaceService) GetPendingOwnershipTransfer(workspaceID, userID uuid.UUID) (*domain.WorkspaceOwnershipTransfer, error) {
+	transfer, err := s.findPendingTransfer(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.FromUserID != userID && transfer.ToUserID != userID {
+		return nil, response.NewForbiddenError("Permission denied", "only the owner or the nominee can view the transfer")
+	}
+	return transfer, nil
+}
+
+// AcceptOwnershipTransfer는 지명된 멤버가 소유권 이전을 수락합니다.
+// 소유자 변경, 역할 교체(OWNER ↔ ADMIN), 감사 로그 기록은 하나의 트랜잭션으로 처리됩니다.
+func (s *WorkspaceService) AcceptOwnershipTransfer(workspaceID, userID uuid.UUID) (*domain.WorkspaceOwnershipTransfer, error) {
+	transfer, err := s.findPendingTransfer(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 지명된 멤버만 수락 가능
+	if transfer.ToUserID != userID {
+		return nil, response.NewForbiddenError("Only the nominated member can accept the transfer", "")
+	}
+
+	// 만료 확인
+	if transfer.IsExpired() {
+		return nil, response.NewValidationError("Ownership transfer has expired", transfer.ExpiresAt.Format(time.RFC3339))
+	}
+
+	// 지명된 멤버가 여전히 활성 멤버인지 확인 (알림에 쓸 기존 역할도 조회)
+	nominee, err := s.memberRepo.FindByWorkspaceAndUser(workspaceID, userID)
+	if err != nil || nominee.RoleName == domain.RoleGuest {
+		return nil, response.NewForbiddenError("Nominated member is no longer an active member", "")
+	}
+
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	targetUserID := transfer.FromUserID
+	auditLog := &domain.WorkspaceAuditLog{
+		ID:           uuid.New(),
+		WorkspaceID:  workspaceID,
+		ActorID:      userID,
+		Action:       domain.AuditActionOwnershipTransferred,
+		TargetUserID: &targetUserID,
+		Metadata: map[string]interface{}{
+			"transferId":      transfer.ID.String(),
+			"previousOwnerId": transfer.FromUserID.String(),
+			"newOwnerId":      transfer.ToUserID.String(),
+		},
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.transferRepo.CompleteTransfer(transfer, auditLog); err != nil {
+		if errors.Is(err, repository.ErrTransferStateChanged) {
+			return nil, response.NewConflictError("Ownership transfer is no longer valid", "the transfer or workspace owner changed")
+		}
+		s.logger.Error("소유권 이전 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("transfer_id", transfer.ID.String()),
+			zap.Error(err))
+		return nil, response.NewInternalError("Failed to transfer ownership", err.Error())
+	}
+
+	now := time.Now()
+	transfer.Status = domain.TransferStatusAccepted
+	transfer.RespondedAt = &now
+	transfer.UpdatedAt = now
+
+	s.logger.Info("소유권 이전 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("from_user_id", transfer.FromUserID.String()),
+		zap.String("to_user_id", transfer.ToUserID.String()))
+
+	// 새 소유자와 기존 소유자에게 역할 변경 알림 (비동기)
+	s.sendWorkspaceNotification(client.NewWorkspaceRoleChangedNotification(
+		transfer.FromUserID, userID, workspaceID, workspace.WorkspaceName,
+		string(nominee.RoleName), string(domain.RoleOwner), ownershipTransferReason,
+	))
+	s.sendWorkspaceNotification(client.NewWorkspaceRoleChangedNotification(
+		userID, transfer.FromUserID, workspaceID, workspace.WorkspaceName,
+		string(domain.RoleOwner), string(domain.RoleAdmin), ownershipTransferReason,
+	))
+
+	return transfer, nil
+}
+
+// DeclineOwnershipTransfer는 지명된 멤버가 소유권 이전을 거절합니다.
+func (s *WorkspaceService) DeclineOwnershipTransfer(workspaceID, userID uuid.UUID) error {
+	transfer, err := s.findPendingTransfer(workspaceID)
+	if err != nil {
+		return err
+	}
+	if transfer.ToUserID != userID {
+		return response.NewForbiddenError("Only the nominated member can decline the transfer", "")
+	}
+	return s.respondToTransfer(transfer, domain.TransferStatusDeclined)
+}
+
+// CancelOwnershipTransfer는 소유자가 대기 중인 소유권 이전을 취소합니다.
+func (s *WorkspaceService) CancelOwnershipTransfer(workspaceID, userID uuid.UUID) error {
+	transfer, err := s.findPendingTransfer(workspaceID)
+	if err != nil {
+		return err
+	}
+	if transfer.FromUserID != userID {
+		return response.NewForbiddenError("Only the owner can cancel the transfer", "")
+	}
+	return s.respondToTransfer(transfer, domain.TransferStatusCancelled)
+}
+
+// findPendingTransfer는 워크스페이스의 대기 중인 소유권 이전 요청을 조회합니다.
+func (s *WorkspaceService) findPendingTransfer(workspaceID uuid.UUID) (*domain.WorkspaceOwnershipTransfer, error) {
+	transfer, err := s.transferRepo.FindPendingByWorkspace(workspaceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("No pending ownership transfer", workspaceID.String())
+		}
+		return nil, response.NewInternalError("Failed to get ownership transfer", err.Error())
+	}
+	return transfer, nil
+}
+
+// respondToTransfer는 대기 중인 요청의 상태를 변경합니다.
+func (s *WorkspaceService) respondToTransfer(transfer *domain.WorkspaceOwnershipTransfer, status domain.OwnershipTransferStatus) error {
+	if err := s.transferRepo.UpdateStatus(transfer.ID, status); err != nil {
+		if errors.Is(err, repository.ErrTransferStateChanged) {
+			return response.NewConflictError("Ownership transfer is no longer pending", "")
+		}
+		return response.NewInternalError("Failed to update ownership transfer", err.Error())
+	}
+
+	s.logger.Info("소유권 이전 요청 상태 변경",
+		zap.String("workspace_id", transfer.WorkspaceID.String()),
+		zap.String("transfer_id", transfer.ID.String()),
+		zap.String("status", string(status)))
+	return nil
+}
+
+// sendWorkspaceNotification은 워크스페이스 알림을 비동기로 전송합니다.
+// 알림 실패는 비즈니스 로직에 영향을 주지 않습니다.
+func (s *WorkspaceService) sendWorkspaceNotification(event *client.NotificationEvent) {
+	if s.notiClient == nil {
+		return
+	}
+	go func() {
+		if err := s.notiClient.SendNotification(context.Background(), event); err != nil {
+			s.logger.Warn("워크스페이스 알림 전송 실패",
+				zap.String("type", string(event.Type)),
+				zap.String("workspace_id", event.WorkspaceID.String()),
+				zap.String("target_user_id", event.TargetUserID.String()),
+				zap.Error(err))
+		}
+	}()
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"user-service/internal/client"
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// recordingNotiClient는 비동기로 전송된 알림을 채널로 전달합니다.
+type recordingNotiClient struct {
+	events chan *client.NotificationEvent
+}
+
+func (c *recordingNotiClient) SendNotification(ctx context.Context, event *client.NotificationEvent) error {
+	c.events <- event
+	return nil
+}
+
+// next waits for n notifications and returns them keyed by target user
+func (c *recordingNotiClient) next(t *testing.T, n int) map[uuid.UUID]*client.NotificationEvent {
+	t.Helper()
+	events := make(map[uuid.UUID]*client.NotificationEvent)
+	for i := 0; i < n; i++ {
+		select {
+		case event := <-c.events:
+			events[event.TargetUserID] = event
+		case <-time.After(time.Second):
+			t.Fatalf("received %d notifications, want %d", i, n)
+		}
+	}
+	return events
+}
+
+// assertNone verifies that no further notification was sent
+func (c *recordingNotiClient) assertNone(t *testing.T) {
+	t.Helper()
+	select {
+	case event := <-c.events:
+		t.Errorf("unexpected notification %s to %s", event.Type, event.TargetUserID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// ownershipTestFixture는 소유권 이전 테스트용 워크스페이스와 멤버를 준비합니다.
+type ownershipTestFixture struct {
+	svc       *WorkspaceService
+	db        *gorm.DB
+	noti      *recordingNotiClient
+	workspace *domain.Workspace
+	owner     uuid.UUID
+	member    uuid.UUID
+	guest     uuid.UUID
+}
+
+func newOwnershipTestFixture(t *testing.T) *ownershipTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE workspaces (
+			id TEXT PRIMARY KEY, owner_id TEXT NOT NULL, workspace_name TEXT NOT NULL, workspace_description TEXT,
+			is_public INTEGER DEFAULT 1, need_approved INTEGER DEFAULT 1, only_owner_can_invite INTEGER DEFAULT 1,
+			is_active INTEGER DEFAULT 1, created_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspace_members (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, user_id TEXT NOT NULL, role_name TEXT NOT NULL DEFAULT 'MEMBER',
+			custom_role_id TEXT, is_default INTEGER DEFAULT 0, is_active INTEGER DEFAULT 1, status TEXT NOT NULL DEFAULT 'ACTIVE',
+			suspended_at DATETIME, suspended_by TEXT, suspend_reason TEXT, joined_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_ownership_transfers (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, from_user_id TEXT NOT NULL, to_user_id TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'PENDING', expires_at DATETIME NOT NULL, responded_at DATETIME,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_audit_logs (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, actor_id TEXT NOT NULL, action TEXT NOT NULL,
+			target_user_id TEXT, metadata TEXT, created_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	f := &ownershipTestFixture{
+		db:     db,
+		noti:   &recordingNotiClient{events: make(chan *client.NotificationEvent, 10)},
+		owner:  uuid.New(),
+		member: uuid.New(),
+		guest:  uuid.New(),
+	}
+	now := time.Now()
+	f.workspace = &domain.Workspace{ID: uuid.New(), OwnerID: f.owner, WorkspaceName: "Acme", IsActive: true, CreatedAt: now}
+	require.NoError(t, db.Create(f.workspace).Error)
+	for userID, role := range map[uuid.UUID]domain.RoleName{f.owner: domain.RoleOwner, f.member: domain.RoleMember, f.guest: domain.RoleGuest} {
+		require.NoError(t, db.Create(&domain.WorkspaceMember{
+			ID: uuid.New(), WorkspaceID: f.workspace.ID, UserID: userID, RoleName: role,
+			IsActive: true, Status: domain.MemberStatusActive, JoinedAt: now, UpdatedAt: now,
+		}).Error)
+	}
+
+	f.svc = NewWorkspaceService(
+		repository.NewWorkspaceRepository(db),
+		repository.NewWorkspaceMemberRepository(db),
+		nil, nil, nil,
+		repository.NewOwnershipTransferRepository(db),
+		repository.NewAuditLogRepository(db),
+		nil, nil, nil, nil, nil,
+		f.noti, nil, zap.NewNop(), nil,
+	)
+	return f
+}
+
+func (f *ownershipTestFixture) role(t *testing.T, userID uuid.UUID) domain.RoleName {
+	t.Helper()
+	var member domain.WorkspaceMember
+	require.NoError(t, f.db.Where("workspace_id = ? AND user_id = ?", f.workspace.ID, userID).First(&member).Error)
+	return member.RoleName
+}
+
+func (f *ownershipTestFixture) transferStatus(t *testing.T, id uuid.UUID) domain.OwnershipTransferStatus {
+	t.Helper()
+	var transfer domain.WorkspaceOwnershipTransfer
+	require.NoError(t, f.db.First(&transfer, "id = ?", id).Error)
+	return transfer.Status
+}
+
+func assertAppErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*response.AppError)
+	require.True(t, ok, "expected *response.AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+// TestTransferOwnership_Nominate verifies nomination rules and that the nominee is asked to accept
+// 지명 규칙과 지명된 멤버에게 수락 요청 알림이 가는지 검증
+func TestTransferOwnership_Nominate(t *testing.T) {
+	f := newOwnershipTestFixture(t)
+
+	_, err := f.svc.TransferOwnership(f.workspace.ID, f.member, domain.TransferOwnershipRequest{NewOwnerID: f.owner})
+	assertAppErrorCode(t, err, response.ErrCodeForbidden)
+	_, err = f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.owner})
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+	_, err = f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.guest})
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+	_, err = f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: uuid.New()})
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+	f.noti.assertNone(t)
+
+	first, err := f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.member})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransferStatusPending, first.Status)
+
+	event := f.noti.next(t, 1)[f.member]
+	require.NotNil(t, event, "nominee should be notified")
+	assert.Equal(t, client.NotificationTypeWorkspaceOwnershipTransferRequested, event.Type)
+	assert.Equal(t, f.owner, event.ActorID)
+	assert.Equal(t, first.ID.String(), event.Metadata["transferId"])
+
+	// 다시 지명하면 이전 요청은 취소됨
+	second, err := f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.member})
+	require.NoError(t, err)
+	f.noti.next(t, 1)
+	assert.Equal(t, domain.TransferStatusCancelled, f.transferStatus(t, first.ID))
+	assert.Equal(t, domain.TransferStatusPending, f.transferStatus(t, second.ID))
+}
+
+// TestAcceptOwnershipTransfer verifies roles are swapped and both owners are notified of their new role
+// 수락 시 역할이 교체되고 새 소유자와 기존 소유자 모두 역할 변경 알림을 받는지 검증
+func TestAcceptOwnershipTransfer(t *testing.T) {
+	f := newOwnershipTestFixture(t)
+	transfer, err := f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.member})
+	require.NoError(t, err)
+	f.noti.next(t, 1)
+
+	_, err = f.svc.AcceptOwnershipTransfer(f.workspace.ID, f.owner)
+	assertAppErrorCode(t, err, response.ErrCodeForbidden)
+
+	accepted, err := f.svc.AcceptOwnershipTransfer(f.workspace.ID, f.member)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TransferStatusAccepted, accepted.Status)
+	assert.Equal(t, domain.TransferStatusAccepted, f.transferStatus(t, transfer.ID))
+
+	var workspace domain.Workspace
+	require.NoError(t, f.db.First(&workspace, "id = ?", f.workspace.ID).Error)
+	assert.Equal(t, f.member, workspace.OwnerID)
+	assert.Equal(t, domain.RoleOwner, f.role(t, f.member))
+	assert.Equal(t, domain.RoleAdmin, f.role(t, f.owner))
+
+	var audits int64
+	require.NoError(t, f.db.Model(&domain.WorkspaceAuditLog{}).
+		Where("workspace_id = ? AND action = ?", f.workspace.ID, domain.AuditActionOwnershipTransferred).
+		Count(&audits).Error)
+	assert.Equal(t, int64(1), audits)
+
+	events := f.noti.next(t, 2)
+	newOwner, formerOwner := events[f.member], events[f.owner]
+	require.NotNil(t, newOwner, "new owner should be notified")
+	require.NotNil(t, formerOwner, "former owner should be notified")
+	assert.Equal(t, client.NotificationTypeWorkspaceRoleChanged, newOwner.Type)
+	assert.Equal(t, string(domain.RoleMember), newOwner.Metadata["oldRole"])
+	assert.Equal(t, string(domain.RoleOwner), newOwner.Metadata["newRole"])
+	assert.Equal(t, f.owner, newOwner.ActorID)
+	assert.Equal(t, string(domain.RoleOwner), formerOwner.Metadata["oldRole"])
+	assert.Equal(t, string(domain.RoleAdmin), formerOwner.Metadata["newRole"])
+	assert.Equal(t, f.member, formerOwner.ActorID)
+
+	// 완료된 요청은 다시 수락할 수 없음
+	_, err = f.svc.AcceptOwnershipTransfer(f.workspace.ID, f.member)
+	assertAppErrorCode(t, err, response.ErrCodeNotFound)
+}
+
+// TestCancelOwnershipTransfer verifies only the owner cancels and a cancelled transfer cannot be accepted
+// 소유자만 취소할 수 있고 취소된 요청은 수락할 수 없는지 검증
+func TestCancelOwnershipTransfer(t *testing.T) {
+	f := newOwnershipTestFixture(t)
+	transfer, err := f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.member})
+	require.NoError(t, err)
+	f.noti.next(t, 1)
+
+	assertAppErrorCode(t, f.svc.CancelOwnershipTransfer(f.workspace.ID, f.member), response.ErrCodeForbidden)
+	require.NoError(t, f.svc.CancelOwnershipTransfer(f.workspace.ID, f.owner))
+	assert.Equal(t, domain.TransferStatusCancelled, f.transferStatus(t, transfer.ID))
+
+	_, err = f.svc.AcceptOwnershipTransfer(f.workspace.ID, f.member)
+	assertAppErrorCode(t, err, response.ErrCodeNotFound)
+	assert.Equal(t, domain.RoleOwner, f.role(t, f.owner))
+	assert.Equal(t, domain.RoleMember, f.role(t, f.member))
+	f.noti.assertNone(t)
+}
+
+// TestAcceptOwnershipTransfer_Expired verifies an expired transfer cannot be accepted and changes nothing
+// 만료된 요청은 수락할 수 없고 역할이 바뀌지 않는지 검증
+func TestAcceptOwnershipTransfer_Expired(t *testing.T) {
+	f := newOwnershipTestFixture(t)
+	transfer, err := f.svc.TransferOwnership(f.workspace.ID, f.owner, domain.TransferOwnershipRequest{NewOwnerID: f.member})
+	require.NoError(t, err)
+	f.noti.next(t, 1)
+	require.NoError(t, f.db.Model(&domain.WorkspaceOwnershipTransfer{}).
+		Where("id = ?", transfer.ID).
+		Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+	_, err = f.svc.AcceptOwnershipTransfer(f.workspace.ID, f.member)
+	assertAppErrorCode(t, err, response.ErrCodeValidation)
+	assert.Equal(t, domain.TransferStatusPending, f.transferStatus(t, transfer.ID))
+	assert.Equal(t, domain.RoleOwner, f.role(t, f.owner))
+	assert.Equal(t, domain.RoleMember, f.role(t, f.member))
+	f.noti.assertNone(t)
+}
@@ -0,0 +1,377 @@
+// Package service는 user-service의 비즈니스 로직을 구현합니다.
+//
+// 이 파일은 워크스페이스 권한 확인과 커스텀 역할 관련 비즈니스 로직을 포함합니다.
+// 권한 목록과 기본 역할은 wealist-advanced-go-pkg의 permission 패키지를 따릅니다.
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"user-service/internal/domain"
+	"user-service/internal/response"
+)
+
+// ============================================================
+// 권한 확인 메서드
+// ============================================================
+
+// effectivePermissions는 워크스페이스에서 사용자가 가진 권한을 계산합니다.
+// 소유자는 모든 권한을 가지며, 커스텀 역할이 지정된 멤버는 기본 역할 대신 커스텀 역할의 권한을 가집니다.
+// 활성 멤버가 아니면 gorm.ErrRecordNotFound를 반환합니다.
+func (s *WorkspaceService) effectivePermissions(workspace *domain.Workspace, userID uuid.UUID) (permission.Set, *domain.WorkspaceMember, error) {
+	member, err := s.memberRepo.FindByWorkspaceAndUser(workspace.ID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if workspace.OwnerID == userID {
+		return permission.ForRole(permission.ScopeWorkspace, permission.RoleOwner), member, nil
+	}
+	// 게스트에게는 커스텀 역할을 적용하지 않음
+	if member.CustomRoleID == nil || member.RoleName == domain.RoleGuest {
+		return permission.ForRole(permission.ScopeWorkspace, string(member.RoleName)), member, nil
+	}
+
+	role, err := s.roleRepo.FindByIDAndWorkspace(*member.CustomRoleID, workspace.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return permission.ForRole(permission.ScopeWorkspace, string(member.RoleName)), member, nil
+		}
+		return nil, nil, err
+	}
+	return rolePermissionSet(role), member, nil
+}
+
+// rolePermissionSet은 커스텀 역할의 권한 집합을 반환합니다.
+// 더 이상 존재하지 않는 권한은 무시합니다.
+func rolePermissionSet(role *domain.WorkspaceRole) permission.Set {
+	perms := make(permission.Set, len(role.Permissions))
+	for _, name := range role.Permissions {
+		if p := permission.Permission(name); p.IsValid() {
+			perms[p] = struct{}{}
+		}
+	}
+	return perms
+}
+
+// hasPermission은 사용자가 워크스페이스에서 권한을 가지고 있는지 확인합니다.
+func (s *WorkspaceService) hasPermission(workspace *domain.Workspace, userID uuid.UUID, p permission.Permission) bool {
+	perms, _, err := s.effectivePermissions(workspace, userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Warn("권한 조회 실패",
+				zap.String("workspace_id", workspace.ID.String()),
+				zap.String("user_id", userID.String()),
+				zap.Error(err))
+		}
+		return false
+	}
+	return perms.Has(p)
+}
+
+// GetMemberPermissions는 워크스페이스 멤버의 유효 권한을 조회합니다.
+// 다른 서비스는 permission.RemoteChecker로 이 결과를 사용합니다.
+func (s *WorkspaceService) GetMemberPermissions(workspaceID, userID uuid.UUID) (*permission.WorkspacePermissions, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	perms, member, err := s.effectivePermissions(workspace, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewNotFoundError("Not a member of this workspace", userID.String())
+		}
+		return nil, response.NewInternalError("Failed to resolve permissions", err.Error())
+	}
+
+	return &permission.WorkspacePermissions{
+		WorkspaceID:  workspaceID,
+		UserID:       userID,
+		Role:         string(member.RoleName),
+		CustomRoleID: member.CustomRoleID,
+		Permissions:  perms.List(),
+	}, nil
+}
+
+// ============================================================
+// 역할 관리 메서드
+// ============================================================
+
+// GetPermissionDefinitions는 커스텀 역할에 사용할 수 있는 권한 목록을 반환합니다.
+func (s *WorkspaceService) GetPermissionDefinitions() []permission.Definition {
+	return permission.Definitions()
+}
+
+// GetRoles는 기본 제공 역할과 워크스페이스의 커스텀 역할을 조회합니다.
+// 워크스페이스 멤버만 조회할 수 있습니다.
+func (s *WorkspaceService) GetRoles(workspaceID, requesterID uuid.UUID) ([]domain.WorkspaceRoleResponse, error) {
+	if isMember, _ := s.memberRepo.IsMember(workspaceID, requesterID); !isMember {
+		return nil, response.NewForbiddenError("Not a member of this workspace", "")
+	}
+
+	builtin, err := s.roleRepo.FindBuiltin()
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get roles", err.Error())
+	}
+	custom, err := s.roleRepo.FindByWorkspace(workspaceID)
+	if err != nil {
+		return nil, response.NewInternalError("Failed to get roles", err.Error())
+	}
+
+	roles := make([]domain.WorkspaceRoleResponse, 0, len(builtin)+len(custom))
+	for i := range builtin {
+		roles = append(roles, builtin[i].ToResponse())
+	}
+	for i := range custom {
+		roles = append(roles, custom[i].ToResponse())
+	}
+	return roles, nil
+}
+
+// CreateRole은 워크스페이스에 커스텀 역할을 생성합니다.
+// 역할 관리 권한이 필요하며, 자신이 가진 권한만 역할에 포함할 수 있습니다.
+func (s *WorkspaceService) CreateRole(workspaceID, actorID uuid.UUID, req domain.CreateWorkspaceRoleRequest) (*domain.WorkspaceRole, error) {
+	workspace, actorPerms, err := s.requireRoleManager(workspaceID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if err := s.validateRoleName(workspaceID, name, nil); err != nil {
+		return nil, err
+	}
+	perms, err := validateRolePermissions(req.Permissions, actorPerms)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	role := &domain.WorkspaceRole{
+		ID:          uuid.New(),
+		WorkspaceID: &workspace.ID,
+		Name:        name,
+		Description: req.Description,
+		Permissions: perms,
+		CreatedBy:   &actorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.roleRepo.Create(role); err != nil {
+		s.logger.Error("커스텀 역할 생성 실패", zap.Error(err))
+		return nil, response.NewInternalError("Failed to create role", err.Error())
+	}
+
+	s.recordRoleAudit(workspaceID, actorID, role, domain.AuditActionRoleCreated, nil)
+	s.logger.Info("커스텀 역할 생성 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("role_id", role.ID.String()),
+		zap.String("name", role.Name))
+	return role, nil
+}
+
+// UpdateRole은 커스텀 역할을 수정합니다.
+// 기본 제공 역할은 수정할 수 없습니다.
+func (s *WorkspaceService) UpdateRole(workspaceID, roleID, actorID uuid.UUID, req domain.UpdateWorkspaceRoleRequest) (*domain.WorkspaceRole, error) {
+	_, actorPerms, err := s.requireRoleManager(workspaceID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.roleRepo.FindByIDAndWorkspace(roleID, workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Role not found", roleID.String())
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if err := s.validateRoleName(workspaceID, name, &role.ID); err != nil {
+			return nil, err
+		}
+		role.Name = name
+	}
+	if req.Description != nil {
+		role.Description = req.Description
+	}
+	if req.Permissions != nil {
+		perms, err := validateRolePermissions(*req.Permissions, actorPerms)
+		if err != nil {
+			return nil, err
+		}
+		role.Permissions = perms
+	}
+	role.UpdatedAt = time.Now()
+
+	if err := s.roleRepo.Update(role); err != nil {
+		s.logger.Error("커스텀 역할 수정 실패", zap.Error(err))
+		return nil, response.NewInternalError("Failed to update role", err.Error())
+	}
+
+	s.recordRoleAudit(workspaceID, actorID, role, domain.AuditActionRoleUpdated, nil)
+	s.logger.Info("커스텀 역할 수정 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("role_id", role.ID.String()))
+	return role, nil
+}
+
+// DeleteRole은 커스텀 역할을 삭제합니다.
+// 이 역할을 가진 멤버는 기본 역할의 권한으로 돌아갑니다.
+func (s *WorkspaceService) DeleteRole(workspaceID, roleID, actorID uuid.UUID) error {
+	if _, _, err := s.requireRoleManager(workspaceID, actorID); err != nil {
+		return err
+	}
+
+	role, err := s.roleRepo.FindByIDAndWorkspace(roleID, workspaceID)
+	if err != nil {
+		return response.NewNotFoundError("Role not found", roleID.String())
+	}
+
+	if err := s.roleRepo.Delete(role.ID, workspaceID); err != nil {
+		s.logger.Error("커스텀 역할 삭제 실패", zap.Error(err))
+		return response.NewInternalError("Failed to delete role", err.Error())
+	}
+
+	s.recordRoleAudit(workspaceID, actorID, role, domain.AuditActionRoleDeleted, nil)
+	s.logger.Info("커스텀 역할 삭제 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("role_id", role.ID.String()))
+	return nil
+}
+
+// AssignCustomRole은 멤버에게 커스텀 역할을 지정하거나 해제합니다.
+// 멤버 역할 변경 권한이 필요하며, 소유자에게는 지정할 수 없습니다.
+func (s *WorkspaceService) AssignCustomRole(workspaceID, memberID, actorID uuid.UUID, req domain.AssignCustomRoleRequest) (*domain.WorkspaceMember, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+
+	actorPerms, _, err := s.effectivePermissions(workspace, actorID)
+	if err != nil || !actorPerms.Has(permission.MemberRoleUpdate) {
+		return nil, response.NewForbiddenError("You do not have permission to change member roles", string(permission.MemberRoleUpdate))
+	}
+
+	member, err := s.memberRepo.FindByID(memberID)
+	if err != nil || member.WorkspaceID != workspaceID {
+		return nil, response.NewNotFoundError("Member not found", memberID.String())
+	}
+	if member.UserID == workspace.OwnerID {
+		return nil, response.NewForbiddenError("Cannot change owner's role", "")
+	}
+	if member.RoleName == domain.RoleGuest && req.CustomRoleID != nil {
+		return nil, response.NewValidationError("Custom roles cannot be assigned to guests", "")
+	}
+
+	var role *domain.WorkspaceRole
+	if req.CustomRoleID != nil {
+		role, err = s.roleRepo.FindByIDAndWorkspace(*req.CustomRoleID, workspaceID)
+		if err != nil {
+			return nil, response.NewNotFoundError("Role not found", req.CustomRoleID.String())
+		}
+		// 자신보다 많은 권한을 부여할 수 없음
+		for _, p := range rolePermissionSet(role).List() {
+			if !actorPerms.Has(p) {
+				return nil, response.NewForbiddenError("Cannot grant a permission you do not have", string(p))
+			}
+		}
+	}
+
+	now := time.Now()
+	if err := s.memberRepo.SetCustomRole(member.ID, req.CustomRoleID, now); err != nil {
+		s.logger.Error("커스텀 역할 지정 실패", zap.Error(err))
+		return nil, response.NewInternalError("Failed to assign role", err.Error())
+	}
+	member.CustomRoleID = req.CustomRoleID
+	member.UpdatedAt = now
+
+	targetUserID := member.UserID
+	s.recordRoleAudit(workspaceID, actorID, role, domain.AuditActionMemberRoleAssigned, &targetUserID)
+
+	s.logger.Info("커스텀 역할 지정 완료",
+		zap.String("workspace_id", workspaceID.String()),
+		zap.String("member_id", member.ID.String()),
+		zap.Bool("cleared", req.CustomRoleID == nil))
+	return member, nil
+}
+
+// requireRoleManager는 사용자가 역할 관리 권한을 가지고 있는지 확인합니다.
+func (s *WorkspaceService) requireRoleManager(workspaceID, userID uuid.UUID) (*domain.Workspace, permission.Set, error) {
+	workspace, err := s.workspaceRepo.FindByID(workspaceID)
+	if err != nil {
+		return nil, nil, response.NewNotFoundError("Workspace not found", workspaceID.String())
+	}
+	perms, _, err := s.effectivePermissions(workspace, userID)
+	if err != nil || !perms.Has(permission.RoleManage) {
+		return nil, nil, response.NewForbiddenError("You do not have permission to manage roles", string(permission.RoleManage))
+	}
+	return workspace, perms, nil
+}
+
+// validateRoleName은 커스텀 역할 이름이 기본 역할이나 다른 커스텀 역할과 겹치지 않는지 확인합니다.
+func (s *WorkspaceService) validateRoleName(workspaceID uuid.UUID, name string, excludeID *uuid.UUID) error {
+	if name == "" {
+		return response.NewValidationError("Role name is required", "")
+	}
+	if permission.IsBuiltinRole(permission.ScopeWorkspace, strings.ToUpper(name)) {
+		return response.NewConflictError("Role name is reserved for a built-in role", name)
+	}
+	exists, err := s.roleRepo.ExistsByName(workspaceID, name, excludeID)
+	if err != nil {
+		return response.NewInternalError("Failed to check role name", err.Error())
+	}
+	if exists {
+		return response.NewConflictError("Role with this name already exists", name)
+	}
+	return nil
+}
+
+// validateRolePermissions는 권한 이름을 검증하고 정렬된 목록으로 반환합니다.
+// 권한 상승을 막기 위해 actorPerms에 없는 권한은 포함할 수 없습니다.
+func validateRolePermissions(names []string, actorPerms permission.Set) ([]string, error) {
+	perms, err := permission.Parse(names)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid permission", err.Error())
+	}
+
+	list := perms.List()
+	out := make([]string, len(list))
+	for i, p := range list {
+		if !actorPerms.Has(p) {
+			return nil, response.NewForbiddenError("Cannot grant a permission you do not have", string(p))
+		}
+		out[i] = string(p)
+	}
+	return out, nil
+}
+
+// recordRoleAudit는 역할 변경을 감사 로그에 기록합니다.
+func (s *WorkspaceService) recordRoleAudit(workspaceID, actorID uuid.UUID, role *domain.WorkspaceRole, action domain.AuditAction, targetUserID *uuid.UUID) {
+	metadata := map[string]interface{}{}
+	if role != nil {
+		metadata["roleId"] = role.ID.String()
+		metadata["roleName"] = role.Name
+		metadata["permissions"] = role.Permissions
+	}
+	auditLog := &domain.WorkspaceAuditLog{
+		ID:           uuid.New(),
+		WorkspaceID:  workspaceID,
+		ActorID:      actorID,
+		Action:       action,
+		TargetUserID: targetUserID,
+		Metadata:     metadata,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.auditLogRepo.Create(auditLog); err != nil {
+		s.logger.Warn("감사 로그 저장 실패",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("action", string(action)),
+			zap.Error(err))
+	}
+}
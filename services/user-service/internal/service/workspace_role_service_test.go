@@ -0,0 +1,249 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/testutil"
+
+	"user-service/internal/domain"
+	"user-service/internal/repository"
+	"user-service/internal/response"
+)
+
+// TestValidateRolePermissions verifies permission validation for custom roles
+// 알 수 없는 권한과 자신이 가지지 않은 권한은 역할에 포함할 수 없는지 검증
+func TestValidateRolePermissions(t *testing.T) {
+	admin := permission.ForRole(permission.ScopeWorkspace, permission.RoleAdmin)
+
+	perms, err := validateRolePermissions([]string{"storage.delete", "board.create", "board.create"}, admin)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"board.create", "storage.delete"}, perms)
+
+	_, err = validateRolePermissions([]string{"board.fly"}, admin)
+	assert.Error(t, err)
+
+	member := permission.ForRole(permission.ScopeWorkspace, permission.RoleMember)
+	_, err = validateRolePermissions([]string{"member.invite"}, member)
+	assert.Error(t, err)
+}
+
+// roleTestFixture는 역할 테스트용 워크스페이스와 멤버를 준비합니다.
+type roleTestFixture struct {
+	svc        *WorkspaceService
+	db         *gorm.DB
+	workspace  *domain.Workspace
+	customRole *domain.WorkspaceRole
+	owner      domain.WorkspaceMember
+	admin      domain.WorkspaceMember
+	member     domain.WorkspaceMember
+	custom     domain.WorkspaceMember
+	guest      domain.WorkspaceMember
+}
+
+func newRoleTestFixture(t *testing.T) *roleTestFixture {
+	t.Helper()
+	db, cleanup := testutil.SetupTestDB(t, nil)
+	t.Cleanup(cleanup)
+
+	// Create tables manually for SQLite compatibility
+	for _, ddl := range []string{
+		`CREATE TABLE users (
+			id TEXT PRIMARY KEY, email TEXT NOT NULL, name TEXT NOT NULL DEFAULT '', google_id TEXT,
+			provider TEXT DEFAULT 'google', is_active INTEGER DEFAULT 1,
+			created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspaces (
+			id TEXT PRIMARY KEY, owner_id TEXT NOT NULL, workspace_name TEXT NOT NULL, workspace_description TEXT,
+			is_public INTEGER DEFAULT 1, need_approved INTEGER DEFAULT 1, only_owner_can_invite INTEGER DEFAULT 1,
+			is_active INTEGER DEFAULT 1, created_at DATETIME NOT NULL, deleted_at DATETIME
+		)`,
+		`CREATE TABLE workspace_members (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, user_id TEXT NOT NULL, role_name TEXT NOT NULL DEFAULT 'MEMBER',
+			custom_role_id TEXT, is_default INTEGER DEFAULT 0, is_active INTEGER DEFAULT 1, status TEXT NOT NULL DEFAULT 'ACTIVE',
+			suspended_at DATETIME, suspended_by TEXT, suspend_reason TEXT, joined_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_roles (
+			id TEXT PRIMARY KEY, workspace_id TEXT, name TEXT NOT NULL, description TEXT, permissions TEXT NOT NULL,
+			is_builtin INTEGER NOT NULL DEFAULT 0, created_by TEXT, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL
+		)`,
+		`CREATE TABLE workspace_audit_logs (
+			id TEXT PRIMARY KEY, workspace_id TEXT NOT NULL, actor_id TEXT NOT NULL, action TEXT NOT NULL,
+			target_user_id TEXT, metadata TEXT, created_at DATETIME NOT NULL
+		)`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+
+	now := time.Now()
+	f := &roleTestFixture{db: db}
+	f.workspace = &domain.Workspace{ID: uuid.New(), OwnerID: uuid.New(), WorkspaceName: "Roles", IsActive: true, CreatedAt: now}
+	require.NoError(t, db.Create(f.workspace).Error)
+
+	f.customRole = &domain.WorkspaceRole{
+		ID:          uuid.New(),
+		WorkspaceID: &f.workspace.ID,
+		Name:        "Reviewer",
+		Permissions: []string{"board.create", "join_request.approve", "storage.read", "retired.permission"},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, db.Create(f.customRole).Error)
+
+	newMember := func(userID uuid.UUID, role domain.RoleName, customRoleID *uuid.UUID) domain.WorkspaceMember {
+		m := domain.WorkspaceMember{
+			ID: uuid.New(), WorkspaceID: f.workspace.ID, UserID: userID, RoleName: role,
+			CustomRoleID: customRoleID, IsActive: true, Status: domain.MemberStatusActive, JoinedAt: now, UpdatedAt: now,
+		}
+		require.NoError(t, db.Create(&m).Error)
+		return m
+	}
+	f.owner = newMember(f.workspace.OwnerID, domain.RoleOwner, nil)
+	f.admin = newMember(uuid.New(), domain.RoleAdmin, nil)
+	f.member = newMember(uuid.New(), domain.RoleMember, nil)
+	f.custom = newMember(uuid.New(), domain.RoleMember, &f.customRole.ID)
+	f.guest = newMember(uuid.New(), domain.RoleGuest, &f.customRole.ID)
+
+	f.svc = NewWorkspaceService(
+		repository.NewWorkspaceRepository(db),
+		repository.NewWorkspaceMemberRepository(db),
+		nil, nil, nil, nil,
+		repository.NewAuditLogRepository(db),
+		nil, nil, nil,
+		repository.NewWorkspaceRoleRepository(db),
+		nil, nil, nil,
+		zap.NewNop(), nil,
+	)
+	return f
+}
+
+// TestEffectivePermissions verifies how built-in and custom roles resolve to permissions
+// 소유자, 기본 역할, 커스텀 역할, 게스트, 비멤버의 유효 권한을 검증
+func TestEffectivePermissions(t *testing.T) {
+	f := newRoleTestFixture(t)
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		wantErr bool
+		has     []permission.Permission
+		hasNot  []permission.Permission
+	}{
+		{"owner holds every permission", f.owner.UserID, false, permission.All(), nil},
+		{"admin uses the built-in role", f.admin.UserID, false,
+			[]permission.Permission{permission.MemberInvite, permission.RoleManage},
+			[]permission.Permission{permission.StorageManage}},
+		{"member uses the built-in role", f.member.UserID, false,
+			[]permission.Permission{permission.BoardCreate, permission.StorageWrite},
+			[]permission.Permission{permission.MemberInvite}},
+		{"custom role replaces the built-in role", f.custom.UserID, false,
+			[]permission.Permission{permission.JoinRequestApprove, permission.StorageRead},
+			[]permission.Permission{permission.StorageWrite, permission.ProjectCreate, permission.Permission("retired.permission")}},
+		{"guests ignore custom roles", f.guest.UserID, false,
+			nil,
+			[]permission.Permission{permission.BoardCreate, permission.StorageRead}},
+		{"non-members are not found", uuid.New(), true, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perms, _, err := f.svc.effectivePermissions(f.workspace, tt.userID)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+				return
+			}
+			require.NoError(t, err)
+			for _, p := range tt.has {
+				assert.True(t, perms.Has(p), "expected %s", p)
+			}
+			for _, p := range tt.hasNot {
+				assert.False(t, perms.Has(p), "unexpected %s", p)
+			}
+		})
+	}
+}
+
+// TestEffectivePermissions_DeletedCustomRole verifies members fall back to their built-in role
+// 삭제된 커스텀 역할을 가진 멤버는 기본 역할 권한으로 돌아가는지 검증
+func TestEffectivePermissions_DeletedCustomRole(t *testing.T) {
+	f := newRoleTestFixture(t)
+	require.NoError(t, f.db.Delete(&domain.WorkspaceRole{}, "id = ?", f.customRole.ID).Error)
+
+	perms, _, err := f.svc.effectivePermissions(f.workspace, f.custom.UserID)
+	require.NoError(t, err)
+	assert.True(t, perms.Has(permission.StorageWrite))
+	assert.False(t, perms.Has(permission.JoinRequestApprove))
+}
+
+// TestAssignCustomRole verifies who may assign custom roles and to whom
+// 커스텀 역할 지정 권한, 대상 제한, 권한 상승 방지를 검증
+func TestAssignCustomRole(t *testing.T) {
+	f := newRoleTestFixture(t)
+	now := time.Now()
+	superRole := &domain.WorkspaceRole{
+		ID:          uuid.New(),
+		WorkspaceID: &f.workspace.ID,
+		Name:        "Super",
+		Permissions: []string{"storage.manage"},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, f.db.Create(superRole).Error)
+	otherWorkspaceRole := &domain.WorkspaceRole{
+		ID:          uuid.New(),
+		WorkspaceID: func() *uuid.UUID { id := uuid.New(); return &id }(),
+		Name:        "Elsewhere",
+		Permissions: []string{"storage.read"},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, f.db.Create(otherWorkspaceRole).Error)
+
+	tests := []struct {
+		name     string
+		actorID  uuid.UUID
+		memberID uuid.UUID
+		roleID   *uuid.UUID
+		wantCode string
+	}{
+		{"admin assigns a custom role", f.admin.UserID, f.member.ID, &f.customRole.ID, ""},
+		{"admin clears a custom role", f.admin.UserID, f.custom.ID, nil, ""},
+		{"member cannot assign roles", f.member.UserID, f.admin.ID, &f.customRole.ID, response.ErrCodeForbidden},
+		{"owner's role cannot change", f.admin.UserID, f.owner.ID, &f.customRole.ID, response.ErrCodeForbidden},
+		{"guests cannot get custom roles", f.admin.UserID, f.guest.ID, &f.customRole.ID, response.ErrCodeValidation},
+		{"admin cannot grant permissions they lack", f.admin.UserID, f.member.ID, &superRole.ID, response.ErrCodeForbidden},
+		{"role of another workspace is not found", f.admin.UserID, f.member.ID, &otherWorkspaceRole.ID, response.ErrCodeNotFound},
+		{"unknown member is not found", f.admin.UserID, uuid.New(), &f.customRole.ID, response.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member, err := f.svc.AssignCustomRole(f.workspace.ID, tt.memberID, tt.actorID, domain.AssignCustomRoleRequest{CustomRoleID: tt.roleID})
+			if tt.wantCode != "" {
+				var appErr *response.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.roleID, member.CustomRoleID)
+
+			var stored domain.WorkspaceMember
+			require.NoError(t, f.db.First(&stored, "id = ?", tt.memberID).Error)
+			assert.Equal(t, tt.roleID, stored.CustomRoleID)
+
+			var audits int64
+			f.db.Model(&domain.WorkspaceAuditLog{}).
+				Where("action = ? AND target_user_id = ?", domain.AuditActionMemberRoleAssigned, stored.UserID).
+				Count(&audits)
+			assert.Equal(t, int64(1), audits)
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/OrangesCloud/wealist-advanced-go-pkg/permission"
+
+	"user-service/internal/client"
 	"user-service/internal/domain"
 	"user-service/internal/metrics"
 	"user-service/internal/repository"
@@ -20,13 +23,22 @@ import (
 // 워크스페이스 생성, 조회, 수정, 삭제 등의 비즈니스 로직을 처리합니다.
 // 메트릭과 로깅을 통해 모니터링을 지원합니다.
 type WorkspaceService struct {
-	workspaceRepo *repository.WorkspaceRepository
-	memberRepo    *repository.WorkspaceMemberRepository
-	joinReqRepo   *repository.JoinRequestRepository
-	profileRepo   *repository.UserProfileRepository
-	userRepo      *repository.UserRepository
-	logger        *zap.Logger
-	metrics       *metrics.Metrics // 메트릭 수집을 위한 필드
+	workspaceRepo   *repository.WorkspaceRepository
+	memberRepo      *repository.WorkspaceMemberRepository
+	joinReqRepo     *repository.JoinRequestRepository
+	profileRepo     *repository.UserProfileRepository
+	userRepo        *repository.UserRepository
+	transferRepo    *repository.OwnershipTransferRepository
+	auditLogRepo    *repository.AuditLogRepository
+	emailDomainRepo *repository.EmailDomainRepository
+	identityRepo    *repository.UserIdentityRepository
+	inviteLinkRepo  *repository.InviteLinkRepository
+	roleRepo        *repository.WorkspaceRoleRepository
+	guestRepo       *repository.GuestProjectRepository
+	notiClient      client.NotiClient // nil이면 알림을 보내지 않음
+	deletionService *DeletionService  // nil이면 영구 삭제 작업을 예약하지 않음
+	logger          *zap.Logger
+	metrics         *metrics.Metrics // 메트릭 수집을 위한 필드
 }
 
 // NewWorkspaceService는 새 WorkspaceService를 생성합니다.
@@ -37,17 +49,35 @@ func NewWorkspaceService(
 	joinReqRepo *repository.JoinRequestRepository,
 	profileRepo *repository.UserProfileRepository,
 	userRepo *repository.UserRepository,
+	transferRepo *repository.OwnershipTransferRepository,
+	auditLogRepo *repository.AuditLogRepository,
+	emailDomainRepo *repository.EmailDomainRepository,
+	identityRepo *repository.UserIdentityRepository,
+	inviteLinkRepo *repository.InviteLinkRepository,
+	roleRepo *repository.WorkspaceRoleRepository,
+	guestRepo *repository.GuestProjectRepository,
+	notiClient client.NotiClient,
+	deletionService *DeletionService,
 	logger *zap.Logger,
 	m *metrics.Metrics,
 ) *WorkspaceService {
 	return &WorkspaceService{
-		workspaceRepo: workspaceRepo,
-		memberRepo:    memberRepo,
-		joinReqRepo:   joinReqRepo,
-		profileRepo:   profileRepo,
-		userRepo:      userRepo,
-		logger:        logger,
-		metrics:       m,
+		workspaceRepo:   workspaceRepo,
+		memberRepo:      memberRepo,
+		joinReqRepo:     joinReqRepo,
+		profileRepo:     profileRepo,
+		userRepo:        userRepo,
+		transferRepo:    transferRepo,
+		auditLogRepo:    auditLogRepo,
+		emailDomainRepo: emailDomainRepo,
+		identityRepo:    identityRepo,
+		inviteLinkRepo:  inviteLinkRepo,
+		roleRepo:        roleRepo,
+		guestRepo:       guestRepo,
+		notiClient:      notiClient,
+		deletionService: deletionService,
+		logger:          logger,
+		metrics:         m,
 	}
 }
 
@@ -107,6 +137,7 @@ func (s *WorkspaceService) CreateWorkspace(ownerID uuid.UUID, req domain.CreateW
 		RoleName:    domain.RoleOwner,
 		IsDefault:   true,
 		IsActive:    true,
+		Status:      domain.MemberStatusActive,
 		JoinedAt:    time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -170,20 +201,15 @@ func (s *WorkspaceService) SearchPublicWorkspaces(name string) ([]domain.Workspa
 }
 
 // UpdateWorkspace는 워크스페이스를 업데이트합니다.
-// 소유자 또는 관리자만 업데이트할 수 있습니다.
+// workspace.update 권한이 있는 멤버만 업데이트할 수 있습니다.
 func (s *WorkspaceService) UpdateWorkspace(id uuid.UUID, userID uuid.UUID, req domain.UpdateWorkspaceRequest) (*domain.Workspace, error) {
 	workspace, err := s.workspaceRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	// 소유자 또는 ADMIN 확인
-	if workspace.OwnerID != userID {
-		// ADMIN인지 확인
-		role, err := s.memberRepo.GetRole(id, userID)
-		if err != nil || role != domain.RoleAdmin {
-			return nil, response.NewForbiddenError("Only owner or admin can update workspace", "")
-		}
+	if !s.hasPermission(workspace, userID, permission.WorkspaceUpdate) {
+		return nil, response.NewForbiddenError("Only owner or admin can update workspace", string(permission.WorkspaceUpdate))
 	}
 
 	if req.WorkspaceName != nil {
@@ -211,21 +237,28 @@ func (s *WorkspaceService) UpdateWorkspace(id uuid.UUID, userID uuid.UUID, req d
 }
 
 // UpdateWorkspaceSettings는 워크스페이스 설정을 업데이트합니다.
-// 소유자 또는 관리자만 설정을 변경할 수 있습니다.
+// workspace.update 권한이 있는 멤버만 설정을 변경할 수 있습니다.
 func (s *WorkspaceService) UpdateWorkspaceSettings(id uuid.UUID, userID uuid.UUID, req domain.UpdateWorkspaceSettingsRequest) (*domain.Workspace, error) {
 	workspace, err := s.workspaceRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	// 소유자 또는 ADMIN 확인
-	if workspace.OwnerID != userID {
-		// ADMIN인지 확인
-		role, err := s.memberRepo.GetRole(id, userID)
-		if err != nil || role != domain.RoleAdmin {
-			return nil, response.NewForbiddenError("Only owner or admin can update workspace settings", "")
+	if !s.hasPermission(workspace, userID, permission.WorkspaceUpdate) {
+		return nil, response.NewForbiddenError("Only owner or admin can update workspace settings", string(permission.WorkspaceUpdate))
+	}
+
+	// 이메일 도메인과 초대 링크 설정은 변경 전에 모두 검증
+	var emailDomains []domain.WorkspaceEmailDomain
+	if req.EmailDomains != nil {
+		emailDomains, err = s.normalizeEmailDomains(id, userID, *req.EmailDomains)
+		if err != nil {
+			return nil, err
 		}
 	}
+	if err := validateInviteLinkRequest(req.CreateInviteLink); err != nil {
+		return nil, err
+	}
 
 	if req.WorkspaceName != nil {
 		workspace.WorkspaceName = *req.WorkspaceName
@@ -248,6 +281,16 @@ func (s *WorkspaceService) UpdateWorkspaceSettings(id uuid.UUID, userID uuid.UUI
 		return nil, err
 	}
 
+	if req.EmailDomains != nil {
+		if err := s.emailDomainRepo.ReplaceForWorkspace(id, emailDomains); err != nil {
+			s.logger.Error("이메일 도메인 설정 업데이트 실패", zap.Error(err))
+			return nil, response.NewInternalError("Failed to update email domains", err.Error())
+		}
+	}
+	if err := s.applyInviteLinkSettings(id, userID, req); err != nil {
+		return nil, err
+	}
+
 	s.logger.Info("워크스페이스 설정 업데이트 완료",
 		zap.String("workspace_id", workspace.ID.String()),
 	)
@@ -255,20 +298,15 @@ func (s *WorkspaceService) UpdateWorkspaceSettings(id uuid.UUID, userID uuid.UUI
 }
 
 // DeleteWorkspace는 워크스페이스를 소프트 삭제합니다.
-// 소유자 또는 관리자만 삭제할 수 있습니다.
+// workspace.delete 권한이 있는 멤버만 삭제할 수 있습니다.
 func (s *WorkspaceService) DeleteWorkspace(id uuid.UUID, userID uuid.UUID) error {
 	workspace, err := s.workspaceRepo.FindByID(id)
 	if err != nil {
 		return err
 	}
 
-	// 소유자 또는 ADMIN 확인
-	if workspace.OwnerID != userID {
-		// ADMIN인지 확인
-		role, err := s.memberRepo.GetRole(id, userID)
-		if err != nil || role != domain.RoleAdmin {
-			return response.NewForbiddenError("Only owner or admin can delete workspace", "")
-		}
+	if !s.hasPermission(workspace, userID, permission.WorkspaceDelete) {
+		return response.NewForbiddenError("Only owner or admin can delete workspace", string(permission.WorkspaceDelete))
 	}
 
 	if err := s.workspaceRepo.SoftDelete(id); err != nil {
@@ -276,6 +314,16 @@ func (s *WorkspaceService) DeleteWorkspace(id uuid.UUID, userID uuid.UUID) error
 		return err
 	}
 
+	// 유예 기간 후 모든 서비스에서 워크스페이스 데이터 영구 삭제
+	if s.deletionService != nil {
+		if _, err := s.deletionService.ScheduleDeletion(domain.DeletionResourceWorkspace, id, userID); err != nil {
+			s.logger.Error("워크스페이스 삭제 작업 예약 실패",
+				zap.String("workspace_id", id.String()),
+				zap.Error(err))
+			return response.NewInternalError("Failed to schedule workspace deletion", err.Error())
+		}
+	}
+
 	s.logger.Info("워크스페이스 삭제 완료",
 		zap.String("workspace_id", id.String()),
 	)